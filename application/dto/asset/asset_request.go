@@ -1,19 +1,48 @@
 package asset
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
 
 type CreateAssetRequest struct {
-	UniqueID      string     `json:"uniqueId" binding:"required"`
-	Name          string     `json:"name" binding:"required"`
-	Comment       string     `json:"comment"`
-	Detail        string     `json:"detail"`
-	Qty           int        `json:"qty" binding:"min=1"`
-	Brand         string     `json:"brand"`
-	Type          string     `json:"type" binding:"required,oneof=it non_it"`
-	Status        string     `json:"status" binding:"omitempty,oneof=available booked broken repair"`
-	Category      string     `json:"category"`
-	LocationID    string     `json:"locationId"` // Accept string, will be validated and converted to UUID
-	LocationLabel string     `json:"locationLabel"`
+	UniqueID         string       `json:"uniqueId" binding:"required"`
+	Name             string       `json:"name" binding:"required"`
+	Comment          string       `json:"comment"`
+	Detail           string       `json:"detail"`
+	Qty              int          `json:"qty" binding:"min=1"`
+	Brand            string       `json:"brand"`
+	Type             string       `json:"type" binding:"required,oneof=it non_it"`
+	Status           string       `json:"status" binding:"omitempty,oneof=available booked broken repair"`
+	Category         string       `json:"category"`
+	LocationID       string       `json:"locationId"` // Accept string, will be validated and converted to UUID
+	LocationLabel    string       `json:"locationLabel"`
+	DepartmentID     string       `json:"departmentId"` // Accept string, will be validated and converted to UUID
+	DataBearing      *bool        `json:"dataBearing"`  // Defaults to true for IT assets
+	PurchaseCost     entity.Money `json:"purchaseCost" binding:"min=0"`
+	Currency         string       `json:"currency" binding:"omitempty,len=3,alpha"` // ISO 4217, defaults to USD
+	PurchaseDate     *time.Time   `json:"purchaseDate"`
+	InvoiceNumber    string       `json:"invoiceNumber"`
+	Vendor           string       `json:"vendor"`
+	PurchaseVendorID string       `json:"purchaseVendorId"` // Accept string, will be validated and converted to UUID
+	ServiceVendorID  string       `json:"serviceVendorId"`  // Accept string, will be validated and converted to UUID
+}
+
+// GetDepartmentID returns the DepartmentID as UUID or nil if empty
+func (r *CreateAssetRequest) GetDepartmentID() *uuid.UUID {
+	return parseOptionalID(r.DepartmentID)
+}
+
+// GetPurchaseVendorID returns the PurchaseVendorID as UUID or nil if empty
+func (r *CreateAssetRequest) GetPurchaseVendorID() *uuid.UUID {
+	return parseOptionalID(r.PurchaseVendorID)
+}
+
+// GetServiceVendorID returns the ServiceVendorID as UUID or nil if empty
+func (r *CreateAssetRequest) GetServiceVendorID() *uuid.UUID {
+	return parseOptionalID(r.ServiceVendorID)
 }
 
 // GetLocationID returns the LocationID as UUID or nil if empty
@@ -29,16 +58,41 @@ func (r *CreateAssetRequest) GetLocationID() *uuid.UUID {
 }
 
 type UpdateAssetRequest struct {
-	Name          string `json:"name,omitempty"`
-	Comment       string `json:"comment,omitempty"`
-	Detail        string `json:"detail,omitempty"`
-	Qty           *int   `json:"qty,omitempty"`
-	Brand         string `json:"brand,omitempty"`
-	Type          string `json:"type,omitempty" binding:"omitempty,oneof=it non_it"`
-	Status        string `json:"status,omitempty" binding:"omitempty,oneof=available booked broken repair"`
-	Category      string `json:"category,omitempty"`
-	LocationID    string `json:"locationId,omitempty"` // Accept string, will be validated and converted to UUID
-	LocationLabel string `json:"locationLabel,omitempty"`
+	Name             string        `json:"name,omitempty"`
+	Comment          string        `json:"comment,omitempty"`
+	Detail           string        `json:"detail,omitempty"`
+	Qty              *int          `json:"qty,omitempty"`
+	Brand            string        `json:"brand,omitempty"`
+	Type             string        `json:"type,omitempty" binding:"omitempty,oneof=it non_it"`
+	Status           string        `json:"status,omitempty" binding:"omitempty,oneof=available booked broken repair"`
+	Category         string        `json:"category,omitempty"`
+	LocationID       string        `json:"locationId,omitempty"` // Accept string, will be validated and converted to UUID
+	LocationLabel    string        `json:"locationLabel,omitempty"`
+	DepartmentID     string        `json:"departmentId,omitempty"` // Accept string, will be validated and converted to UUID
+	DataBearing      *bool         `json:"dataBearing,omitempty"`
+	PurchaseCost     *entity.Money `json:"purchaseCost,omitempty" binding:"omitempty,min=0"`
+	Currency         string        `json:"currency,omitempty" binding:"omitempty,len=3,alpha"`
+	PurchaseDate     *time.Time    `json:"purchaseDate,omitempty"`
+	InvoiceNumber    string        `json:"invoiceNumber,omitempty"`
+	Vendor           string        `json:"vendor,omitempty"`
+	PurchaseVendorID string        `json:"purchaseVendorId,omitempty"` // Accept string, will be validated and converted to UUID
+	ServiceVendorID  string        `json:"serviceVendorId,omitempty"`  // Accept string, will be validated and converted to UUID
+	MoveComponents   bool          `json:"moveComponents,omitempty"`   // Move the assets it contains along with a new location
+}
+
+// GetDepartmentID returns the DepartmentID as UUID or nil if empty
+func (r *UpdateAssetRequest) GetDepartmentID() *uuid.UUID {
+	return parseOptionalID(r.DepartmentID)
+}
+
+// GetPurchaseVendorID returns the PurchaseVendorID as UUID or nil if empty
+func (r *UpdateAssetRequest) GetPurchaseVendorID() *uuid.UUID {
+	return parseOptionalID(r.PurchaseVendorID)
+}
+
+// GetServiceVendorID returns the ServiceVendorID as UUID or nil if empty
+func (r *UpdateAssetRequest) GetServiceVendorID() *uuid.UUID {
+	return parseOptionalID(r.ServiceVendorID)
 }
 
 // GetLocationID returns the LocationID as UUID or nil if empty
@@ -51,4 +105,27 @@ func (r *UpdateAssetRequest) GetLocationID() *uuid.UUID {
 		return nil
 	}
 	return &parsed
-}
\ No newline at end of file
+}
+
+func parseOptionalID(value string) *uuid.UUID {
+	if value == "" || value == "null" || value == "undefined" {
+		return nil
+	}
+	parsed, err := uuid.Parse(value)
+	if err != nil {
+		return nil
+	}
+	return &parsed
+}
+
+// UpdateAssetStatusRequest changes an asset's status. Retired, disposed and
+// lost need a reason.
+type UpdateAssetStatusRequest struct {
+	Status string `json:"status" binding:"required,oneof=available booked broken repair retired disposed lost"`
+	Reason string `json:"reason"`
+}
+
+type StatusReportRequest struct {
+	From *time.Time `form:"from" time_format:"2006-01-02"`
+	To   *time.Time `form:"to" time_format:"2006-01-02"`
+}
@@ -1,6 +1,10 @@
 package asset
 
-import "inventory-ticketing-system/domain/entity"
+import (
+	"time"
+
+	"inventory-ticketing-system/domain/entity"
+)
 
 type AssetResponse struct {
 	*entity.Asset
@@ -12,7 +16,13 @@ type AssetListResponse struct {
 }
 
 type PaginationInfo struct {
-	Limit  int  `json:"limit"`
-	Offset int  `json:"offset"`
+	Limit   int  `json:"limit"`
+	Offset  int  `json:"offset"`
 	HasMore bool `json:"hasMore"`
-}
\ No newline at end of file
+}
+
+type StatusReportResponse struct {
+	From   *time.Time                `json:"from"`
+	To     *time.Time                `json:"to"`
+	Assets []*entity.AssetStatusTime `json:"assets"`
+}
@@ -0,0 +1,15 @@
+package assetrelationship
+
+import "github.com/google/uuid"
+
+// AttachRequest relates the asset in the path to RelatedAssetID, read as
+// "<asset> <type> <related asset>".
+type AttachRequest struct {
+	Type           string    `json:"type" binding:"required,oneof=contains installed_in connected_to depends_on"`
+	RelatedAssetID uuid.UUID `json:"relatedAssetId" binding:"required"`
+	Notes          string    `json:"notes"`
+}
+
+type GraphRequest struct {
+	Depth int `form:"depth,default=2" binding:"min=1,max=5"`
+}
@@ -0,0 +1,44 @@
+package assetrequest
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateRequest struct {
+	Quantity int        `json:"quantity" binding:"omitempty,min=1"`
+	Reason   string     `json:"reason"`
+	ReturnBy *time.Time `json:"returnBy"`
+}
+
+// ListRequest filters asset requests. Awaiting lists the requests waiting
+// for the caller's decision.
+type ListRequest struct {
+	Status   string `form:"status" binding:"omitempty,oneof=pending rejected cancelled fulfilled"`
+	AssetID  string `form:"assetId" binding:"omitempty,uuid"`
+	Awaiting bool   `form:"awaiting"`
+	Limit    int    `form:"limit,default=20" binding:"min=1,max=100"`
+	Offset   int    `form:"offset,default=0" binding:"min=0"`
+}
+
+// DecisionRequest approves or rejects a step. Rejections need a comment.
+type DecisionRequest struct {
+	Comment string `json:"comment"`
+}
+
+type DelegationRequest struct {
+	DelegateID uuid.UUID  `json:"delegateId" binding:"required"`
+	StartsAt   *time.Time `json:"startsAt"`
+	EndsAt     time.Time  `json:"endsAt" binding:"required"`
+	Reason     string     `json:"reason"`
+}
+
+type CheckoutListRequest struct {
+	AssetID string `form:"assetId" binding:"omitempty,uuid"`
+	UserID  string `form:"userId" binding:"omitempty,uuid"`
+	Open    *bool  `form:"open"`
+	Overdue bool   `form:"overdue"`
+	Limit   int    `form:"limit,default=20" binding:"min=1,max=100"`
+	Offset  int    `form:"offset,default=0" binding:"min=0"`
+}
@@ -0,0 +1,43 @@
+package assetunit
+
+import "github.com/google/uuid"
+
+// CreateUnitRequest registers a unit of the asset in the path. The unit
+// starts available unless Status says otherwise and sits at the asset's
+// location unless LocationID is given.
+type CreateUnitRequest struct {
+	SerialNumber string     `json:"serialNumber" binding:"required"`
+	Status       string     `json:"status" binding:"omitempty,oneof=available booked broken repair"`
+	LocationID   *uuid.UUID `json:"locationId"`
+	CustodianID  *uuid.UUID `json:"custodianId"`
+	Notes        string     `json:"notes"`
+}
+
+type UpdateUnitRequest struct {
+	SerialNumber string     `json:"serialNumber" binding:"required"`
+	LocationID   *uuid.UUID `json:"locationId"`
+	CustodianID  *uuid.UUID `json:"custodianId"`
+	Notes        string     `json:"notes"`
+}
+
+// UpdateUnitStatusRequest changes a unit's status along the same lifecycle
+// as an asset's.
+type UpdateUnitStatusRequest struct {
+	Status string `json:"status" binding:"required,oneof=available booked broken repair retired disposed lost"`
+	Reason string `json:"reason"`
+}
+
+type UnitListRequest struct {
+	AssetID      string `form:"assetId" binding:"omitempty,uuid"`
+	SerialNumber string `form:"serialNumber"`
+	Status       string `form:"status"`
+	CustodianID  string `form:"custodianId" binding:"omitempty,uuid"`
+	LocationID   string `form:"locationId" binding:"omitempty,uuid"`
+	Limit        int    `form:"limit,default=20" binding:"min=1,max=100"`
+	Offset       int    `form:"offset,default=0" binding:"min=0"`
+}
+
+type TrackingModeRequest struct {
+	Category string `json:"category" binding:"required"`
+	Mode     string `json:"mode" binding:"required,oneof=pooled serialized"`
+}
@@ -0,0 +1,52 @@
+package department
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DepartmentRequest struct {
+	Name           string `json:"name" binding:"required"`
+	CostCenterCode string `json:"costCenterCode" binding:"required,max=50"`
+	ParentID       string `json:"parentId"`  // Accept string, will be validated and converted to UUID
+	ManagerID      string `json:"managerId"` // Accept string, will be validated and converted to UUID
+	Description    string `json:"description"`
+}
+
+// GetParentID returns the ParentID as UUID or nil if empty
+func (r *DepartmentRequest) GetParentID() *uuid.UUID {
+	return parseOptionalID(r.ParentID)
+}
+
+// GetManagerID returns the ManagerID as UUID or nil if empty
+func (r *DepartmentRequest) GetManagerID() *uuid.UUID {
+	return parseOptionalID(r.ManagerID)
+}
+
+// SetUserDepartmentRequest moves a user into a department; an empty
+// departmentId removes the user from their department.
+type SetUserDepartmentRequest struct {
+	DepartmentID string `json:"departmentId"`
+}
+
+// GetDepartmentID returns the DepartmentID as UUID or nil if empty
+func (r *SetUserDepartmentRequest) GetDepartmentID() *uuid.UUID {
+	return parseOptionalID(r.DepartmentID)
+}
+
+type DepartmentReportRequest struct {
+	From *time.Time `form:"from" time_format:"2006-01-02"`
+	To   *time.Time `form:"to" time_format:"2006-01-02"`
+}
+
+func parseOptionalID(value string) *uuid.UUID {
+	if value == "" || value == "null" || value == "undefined" {
+		return nil
+	}
+	parsed, err := uuid.Parse(value)
+	if err != nil {
+		return nil
+	}
+	return &parsed
+}
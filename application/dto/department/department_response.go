@@ -0,0 +1,17 @@
+package department
+
+import (
+	"time"
+
+	"inventory-ticketing-system/domain/entity"
+)
+
+type DepartmentListResponse struct {
+	Departments []*entity.Department `json:"departments"`
+}
+
+type DepartmentReportResponse struct {
+	From        *time.Time                  `json:"from"`
+	To          *time.Time                  `json:"to"`
+	Departments []*entity.DepartmentSummary `json:"departments"`
+}
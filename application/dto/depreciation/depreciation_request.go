@@ -0,0 +1,18 @@
+package depreciation
+
+import "time"
+
+// PolicyRequest creates or replaces the depreciation policy of a category.
+// DecliningRate only applies to declining balance and defaults to 2.
+type PolicyRequest struct {
+	Category        string  `json:"category" binding:"required"`
+	Method          string  `json:"method" binding:"required,oneof=straight_line declining_balance"`
+	UsefulLifeYears int     `json:"usefulLifeYears" binding:"required,min=1,max=100"`
+	SalvagePercent  float64 `json:"salvagePercent" binding:"min=0,lt=100"`
+	DecliningRate   float64 `json:"decliningRate" binding:"min=0"`
+}
+
+// ValuationRequest values the assets held at the start of At, or now.
+type ValuationRequest struct {
+	At *time.Time `form:"at" time_format:"2006-01-02"`
+}
@@ -0,0 +1,26 @@
+package disposal
+
+import "inventory-ticketing-system/domain/entity"
+
+type ProposeRequest struct {
+	Reason         string       `json:"reason" binding:"required"`
+	Method         string       `json:"method" binding:"required,oneof=sell donate recycle destroy"`
+	EstimatedValue entity.Money `json:"estimatedValue" binding:"min=0"`
+}
+
+type ListRequest struct {
+	Status  string `form:"status" binding:"omitempty,oneof=pending approved rejected cancelled"`
+	AssetID string `form:"assetId" binding:"omitempty,uuid"`
+	Limit   int    `form:"limit,default=20" binding:"min=1,max=100"`
+	Offset  int    `form:"offset,default=0" binding:"min=0"`
+}
+
+type DataWipeRequest struct {
+	Method string `json:"method" binding:"required"`
+	Notes  string `json:"notes"`
+}
+
+// DecisionRequest approves or rejects a request. Rejections need a comment.
+type DecisionRequest struct {
+	Comment string `json:"comment"`
+}
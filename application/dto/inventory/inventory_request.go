@@ -0,0 +1,54 @@
+package inventory
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReorderPointRequest sets the minimum stock of either an asset or a
+// category.
+type ReorderPointRequest struct {
+	AssetID              *uuid.UUID `json:"assetId"`
+	Category             string     `json:"category"`
+	MinQty               int        `json:"minQty" binding:"min=0"`
+	ReorderQty           int        `json:"reorderQty" binding:"required,min=1"`
+	DraftPurchaseRequest bool       `json:"draftPurchaseRequest"`
+}
+
+type ReorderPointListRequest struct {
+	AssetID  string `form:"assetId" binding:"omitempty,uuid"`
+	Category string `form:"category"`
+}
+
+type LowStockRequest struct {
+	Category     string `form:"category"`
+	DepartmentID string `form:"departmentId" binding:"omitempty,uuid"`
+}
+
+// CreateLotRequest receives a lot of the asset in the path. ExpiresAt is the
+// last day the lot may be used; lots without one never expire.
+type CreateLotRequest struct {
+	LotNumber  string     `json:"lotNumber" binding:"required"`
+	ExpiresAt  *time.Time `json:"expiresAt"`
+	Qty        int        `json:"qty" binding:"required,min=1"`
+	ReceivedAt *time.Time `json:"receivedAt"`
+	Notes      string     `json:"notes"`
+}
+
+type UpdateLotRequest struct {
+	LotNumber string     `json:"lotNumber" binding:"required"`
+	ExpiresAt *time.Time `json:"expiresAt"`
+	Qty       int        `json:"qty" binding:"min=0"`
+	Notes     string     `json:"notes"`
+}
+
+type UpdateLotStatusRequest struct {
+	Status string `json:"status" binding:"required,oneof=active quarantined"`
+}
+
+type ExpiringLotsRequest struct {
+	Days    int    `form:"days,default=30" binding:"min=0,max=3650"`
+	AssetID string `form:"assetId" binding:"omitempty,uuid"`
+	Status  string `form:"status" binding:"omitempty,oneof=active quarantined"`
+}
@@ -0,0 +1,56 @@
+package kit
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// KitComponent is a quantity of either a specific asset or any asset of a
+// category.
+type KitComponent struct {
+	AssetID  *uuid.UUID `json:"assetId"`
+	Category string     `json:"category"`
+	Quantity int        `json:"quantity" binding:"required,min=1"`
+}
+
+type KitRequest struct {
+	Name        string         `json:"name" binding:"required"`
+	Description string         `json:"description"`
+	Components  []KitComponent `json:"components" binding:"required,min=1,dive"`
+}
+
+type KitListRequest struct {
+	Name     string `form:"name"`
+	AssetID  string `form:"assetId" binding:"omitempty,uuid"`
+	Category string `form:"category"`
+	Limit    int    `form:"limit,default=20" binding:"min=1,max=100"`
+	Offset   int    `form:"offset,default=0" binding:"min=0"`
+}
+
+// CheckoutRequest hands a kit out to a user.
+type CheckoutRequest struct {
+	UserID uuid.UUID  `json:"userId" binding:"required"`
+	Notes  string     `json:"notes"`
+	DueAt  *time.Time `json:"dueAt"`
+}
+
+type CheckoutListRequest struct {
+	KitID  string `form:"kitId" binding:"omitempty,uuid"`
+	UserID string `form:"userId" binding:"omitempty,uuid"`
+	Open   *bool  `form:"open"`
+	Limit  int    `form:"limit,default=20" binding:"min=1,max=100"`
+	Offset int    `form:"offset,default=0" binding:"min=0"`
+}
+
+// ReturnItem is the condition one item of a kit checkout came back in.
+type ReturnItem struct {
+	CheckoutID uuid.UUID `json:"checkoutId" binding:"required"`
+	Condition  string    `json:"condition" binding:"required,oneof=good damaged missing"`
+	Notes      string    `json:"notes"`
+}
+
+// ReturnRequest checks in every item of a kit checkout.
+type ReturnRequest struct {
+	Items []ReturnItem `json:"items" binding:"required,min=1,dive"`
+}
@@ -1,10 +1,18 @@
 package location
 
+import "github.com/google/uuid"
+
 type CreateLocationRequest struct {
 	Name        string `json:"name" binding:"required"`
 	Area        string `json:"area" binding:"required"`
 	Description string `json:"description"`
 	Capacity    int    `json:"capacity" binding:"min=0"`
+	ParentID    string `json:"parentId"` // Accept string, will be validated and converted to UUID
+}
+
+// GetParentID returns the ParentID as UUID or nil if empty
+func (r *CreateLocationRequest) GetParentID() *uuid.UUID {
+	return parseOptionalID(r.ParentID)
 }
 
 type UpdateLocationRequest struct {
@@ -12,4 +20,21 @@ type UpdateLocationRequest struct {
 	Area        string `json:"area,omitempty"`
 	Description string `json:"description,omitempty"`
 	Capacity    *int   `json:"capacity,omitempty" binding:"omitempty,min=0"`
+	ParentID    string `json:"parentId,omitempty"` // Accept string, will be validated and converted to UUID
+}
+
+// GetParentID returns the ParentID as UUID or nil if empty
+func (r *UpdateLocationRequest) GetParentID() *uuid.UUID {
+	return parseOptionalID(r.ParentID)
+}
+
+func parseOptionalID(value string) *uuid.UUID {
+	if value == "" || value == "null" || value == "undefined" {
+		return nil
+	}
+	parsed, err := uuid.Parse(value)
+	if err != nil {
+		return nil
+	}
+	return &parsed
 }
\ No newline at end of file
@@ -0,0 +1,46 @@
+package maintenance
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PlanRequest creates or replaces a maintenance plan. Set either AssetID or
+// Category, and either IntervalDays or UsageInterval.
+type PlanRequest struct {
+	Name                string     `json:"name" binding:"required"`
+	Description         string     `json:"description"`
+	AssetID             *uuid.UUID `json:"assetId"`
+	Category            string     `json:"category"`
+	IntervalDays        int        `json:"intervalDays" binding:"min=0"`
+	UsageInterval       int        `json:"usageInterval" binding:"min=0"`
+	LeadDays            int        `json:"leadDays" binding:"min=0"`
+	LeadUsage           int        `json:"leadUsage" binding:"min=0"`
+	StartsAt            *time.Time `json:"startsAt"`
+	Checklist           []string   `json:"checklist"`
+	DefaultTechnicianID *uuid.UUID `json:"defaultTechnicianId"`
+	Severity            string     `json:"severity" binding:"omitempty,oneof=low medium high critical"`
+	IsActive            *bool      `json:"isActive"`
+}
+
+type PlanListRequest struct {
+	AssetID  string `form:"assetId" binding:"omitempty,uuid"`
+	Category string `form:"category"`
+	Active   *bool  `form:"active"`
+}
+
+type RecordListRequest struct {
+	Limit  int `form:"limit,default=20" binding:"min=1,max=100"`
+	Offset int `form:"offset,default=0" binding:"min=0"`
+}
+
+// CalendarRequest selects the days [From, To); both default from today.
+type CalendarRequest struct {
+	From time.Time `form:"from" time_format:"2006-01-02"`
+	To   time.Time `form:"to" time_format:"2006-01-02"`
+}
+
+type UsageRequest struct {
+	Amount int `json:"amount" binding:"required,min=1"`
+}
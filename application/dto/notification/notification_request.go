@@ -0,0 +1,27 @@
+package notification
+
+type CreateTemplateRequest struct {
+	Kind     string `json:"kind" binding:"required,oneof=ticket_created ticket_assigned ticket_resolved sla_breach_warning"`
+	Locale   string `json:"locale" binding:"required"`
+	Subject  string `json:"subject" binding:"required"`
+	TextBody string `json:"textBody"`
+	HTMLBody string `json:"htmlBody"`
+}
+
+type UpdateTemplateRequest struct {
+	Subject  string `json:"subject" binding:"required"`
+	TextBody string `json:"textBody"`
+	HTMLBody string `json:"htmlBody"`
+}
+
+type UpdatePreferencesRequest struct {
+	Locale       string   `json:"locale"`
+	EmailEnabled *bool    `json:"emailEnabled" binding:"required"`
+	MutedKinds   []string `json:"mutedKinds"`
+}
+
+type InboxListRequest struct {
+	Limit  int    `form:"limit,default=20" binding:"min=1,max=100"`
+	Cursor string `form:"cursor"`
+	Unread bool   `form:"unread"`
+}
@@ -0,0 +1,16 @@
+package notification
+
+import "inventory-ticketing-system/domain/entity"
+
+type TemplateListResponse struct {
+	Templates []*entity.NotificationTemplate `json:"templates"`
+	Kinds     []string                       `json:"kinds"`
+}
+
+// InboxResponse is one page of the caller's inbox. NextCursor is empty on the
+// last page; UnreadCount covers the whole inbox.
+type InboxResponse struct {
+	Items       []*entity.Notification `json:"items"`
+	UnreadCount int64                  `json:"unreadCount"`
+	NextCursor  string                 `json:"nextCursor"`
+}
@@ -0,0 +1,79 @@
+package procurement
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+type PurchaseRequestItem struct {
+	Description       string       `json:"description" binding:"required"`
+	Category          string       `json:"category"`
+	Quantity          int          `json:"quantity" binding:"required,min=1"`
+	EstimatedUnitCost entity.Money `json:"estimatedUnitCost" binding:"min=0"`
+}
+
+type CreatePurchaseRequest struct {
+	Title         string                `json:"title" binding:"required"`
+	Justification string                `json:"justification"`
+	Items         []PurchaseRequestItem `json:"items" binding:"required,min=1,dive"`
+}
+
+type PurchaseRequestListRequest struct {
+	Status       string `form:"status" binding:"omitempty,oneof=draft pending approved rejected cancelled ordered"`
+	DepartmentID string `form:"departmentId" binding:"omitempty,uuid"`
+	Mine         bool   `form:"mine"`
+	Limit        int    `form:"limit,default=20" binding:"min=1,max=100"`
+	Offset       int    `form:"offset,default=0" binding:"min=0"`
+}
+
+// DecisionRequest approves or rejects a purchase request. Rejections need a
+// comment.
+type DecisionRequest struct {
+	Comment string `json:"comment"`
+}
+
+type PurchaseOrderLine struct {
+	RequestItemID *uuid.UUID   `json:"requestItemId"`
+	Description   string       `json:"description" binding:"required"`
+	Category      string       `json:"category"`
+	AssetType     string       `json:"assetType" binding:"omitempty,oneof=it non_it"`
+	Brand         string       `json:"brand"`
+	AssetID       *uuid.UUID   `json:"assetId"`
+	Quantity      int          `json:"quantity" binding:"required,min=1"`
+	UnitCost      entity.Money `json:"unitCost" binding:"min=0"`
+}
+
+// CreatePurchaseOrderRequest places an order. With a requestId and no lines,
+// the order takes over the request's items.
+type CreatePurchaseOrderRequest struct {
+	VendorID   uuid.UUID           `json:"vendorId" binding:"required"`
+	RequestID  *uuid.UUID          `json:"requestId"`
+	Currency   string              `json:"currency" binding:"omitempty,len=3,alpha"`
+	ExpectedAt *time.Time          `json:"expectedAt"`
+	Notes      string              `json:"notes"`
+	Lines      []PurchaseOrderLine `json:"lines" binding:"dive"`
+}
+
+type PurchaseOrderListRequest struct {
+	Status    string `form:"status" binding:"omitempty,oneof=ordered partially_received received cancelled"`
+	VendorID  string `form:"vendorId" binding:"omitempty,uuid"`
+	RequestID string `form:"requestId" binding:"omitempty,uuid"`
+	Limit     int    `form:"limit,default=20" binding:"min=1,max=100"`
+	Offset    int    `form:"offset,default=0" binding:"min=0"`
+}
+
+type ReceiptItem struct {
+	OrderLineID uuid.UUID `json:"orderLineId" binding:"required"`
+	Quantity    int       `json:"quantity" binding:"required,min=1"`
+	UniqueIDs   []string  `json:"uniqueIds"`
+}
+
+type GoodsReceiptRequest struct {
+	ReceivedAt    *time.Time    `json:"receivedAt"`
+	LocationID    *uuid.UUID    `json:"locationId"`
+	InvoiceNumber string        `json:"invoiceNumber"`
+	Notes         string        `json:"notes"`
+	Items         []ReceiptItem `json:"items" binding:"required,min=1,dive"`
+}
@@ -0,0 +1,56 @@
+package reservation
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateReservationRequest books units of an asset. UserID reserves for
+// someone else and needs assets:write.
+type CreateReservationRequest struct {
+	AssetID  uuid.UUID  `json:"assetId" binding:"required"`
+	UserID   *uuid.UUID `json:"userId"`
+	Quantity int        `json:"quantity" binding:"omitempty,min=1"`
+	StartsAt time.Time  `json:"startsAt" binding:"required"`
+	EndsAt   time.Time  `json:"endsAt" binding:"required"`
+	Purpose  string     `json:"purpose"`
+}
+
+type UpdateReservationRequest struct {
+	Quantity int       `json:"quantity" binding:"omitempty,min=1"`
+	StartsAt time.Time `json:"startsAt" binding:"required"`
+	EndsAt   time.Time `json:"endsAt" binding:"required"`
+	Purpose  string    `json:"purpose"`
+}
+
+// ListRequest filters reservations; From and To keep the ones taking up
+// time in that range.
+type ListRequest struct {
+	AssetID string    `form:"assetId" binding:"omitempty,uuid"`
+	UserID  string    `form:"userId" binding:"omitempty,uuid"`
+	Status  string    `form:"status" binding:"omitempty,oneof=confirmed active completed cancelled"`
+	From    time.Time `form:"from"`
+	To      time.Time `form:"to"`
+	Limit   int       `form:"limit,default=20" binding:"min=1,max=100"`
+	Offset  int       `form:"offset,default=0" binding:"min=0"`
+}
+
+// AvailabilityRequest searches assets with Quantity units free for all of
+// [From, To), narrowed down like the asset list.
+type AvailabilityRequest struct {
+	From         time.Time `form:"from" binding:"required"`
+	To           time.Time `form:"to" binding:"required"`
+	Quantity     int       `form:"quantity,default=1" binding:"min=1"`
+	Type         string    `form:"type" binding:"omitempty,oneof=it non_it"`
+	Category     string    `form:"category"`
+	Brand        string    `form:"brand"`
+	DepartmentID string    `form:"departmentId" binding:"omitempty,uuid"`
+}
+
+// CreateFeedTokenRequest issues a token for a calendar feed. ResourceID is
+// the asset, or the user, and defaults to the caller for user feeds.
+type CreateFeedTokenRequest struct {
+	Feed       string     `json:"feed" binding:"required,oneof=asset user"`
+	ResourceID *uuid.UUID `json:"resourceId"`
+}
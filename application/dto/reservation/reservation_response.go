@@ -0,0 +1,15 @@
+package reservation
+
+import "inventory-ticketing-system/domain/entity"
+
+// CreateFeedTokenResponse carries the plaintext token and the feed URL path
+// to subscribe to, which are only ever returned once at creation time.
+type CreateFeedTokenResponse struct {
+	*entity.CalendarFeedToken
+	Token string `json:"token"`
+	URL   string `json:"url"`
+}
+
+type FeedTokenListResponse struct {
+	FeedTokens []*entity.CalendarFeedToken `json:"feedTokens"`
+}
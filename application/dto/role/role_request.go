@@ -0,0 +1,38 @@
+package role
+
+import "github.com/google/uuid"
+
+type CreateRoleRequest struct {
+	Name        string   `json:"name" binding:"required"`
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions" binding:"required"`
+}
+
+type UpdateRoleRequest struct {
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions" binding:"required"`
+}
+
+type SetUserRoleRequest struct {
+	Role string `json:"role" binding:"required"`
+}
+
+// AssignRoleRequest grants a role on top of the user's primary role. Without
+// locationId and category the grant applies everywhere.
+type AssignRoleRequest struct {
+	RoleID     uuid.UUID `json:"roleId" binding:"required"`
+	LocationID string    `json:"locationId"` // Accept string, will be validated and converted to UUID
+	Category   string    `json:"category"`
+}
+
+// GetLocationID returns the LocationID as UUID or nil if empty
+func (r *AssignRoleRequest) GetLocationID() *uuid.UUID {
+	if r.LocationID == "" || r.LocationID == "null" || r.LocationID == "undefined" {
+		return nil
+	}
+	parsed, err := uuid.Parse(r.LocationID)
+	if err != nil {
+		return nil
+	}
+	return &parsed
+}
@@ -0,0 +1,12 @@
+package role
+
+import "inventory-ticketing-system/domain/entity"
+
+type RoleListResponse struct {
+	Roles       []*entity.Role `json:"roles"`
+	Permissions []string       `json:"permissions"`
+}
+
+type RoleAssignmentListResponse struct {
+	Assignments []*entity.RoleAssignment `json:"assignments"`
+}
@@ -0,0 +1,47 @@
+package technician
+
+import "github.com/google/uuid"
+
+type CreateTechnicianRequest struct {
+	UserID         uuid.UUID `json:"userId" binding:"required"`
+	TeamID         string    `json:"teamId"` // Accept string, will be validated and converted to UUID
+	Skills         []string  `json:"skills"`
+	AssetTypes     []string  `json:"assetTypes" binding:"omitempty,dive,oneof=it non_it"`
+	MaxOpenTickets int       `json:"maxOpenTickets" binding:"min=0"`
+}
+
+// GetTeamID returns the TeamID as UUID or nil if empty
+func (r *CreateTechnicianRequest) GetTeamID() *uuid.UUID {
+	return parseOptionalID(r.TeamID)
+}
+
+type UpdateTechnicianRequest struct {
+	TeamID         string   `json:"teamId"` // Accept string, will be validated and converted to UUID
+	Skills         []string `json:"skills"`
+	AssetTypes     []string `json:"assetTypes" binding:"omitempty,dive,oneof=it non_it"`
+	IsActive       *bool    `json:"isActive"`
+	MaxOpenTickets int      `json:"maxOpenTickets" binding:"min=0"`
+}
+
+// GetTeamID returns the TeamID as UUID or nil if empty
+func (r *UpdateTechnicianRequest) GetTeamID() *uuid.UUID {
+	return parseOptionalID(r.TeamID)
+}
+
+type TeamRequest struct {
+	Name               string   `json:"name" binding:"required"`
+	Description        string   `json:"description"`
+	Categories         []string `json:"categories"`
+	AssignmentStrategy string   `json:"assignmentStrategy" binding:"omitempty,oneof=round_robin least_loaded skill_match"`
+}
+
+func parseOptionalID(value string) *uuid.UUID {
+	if value == "" || value == "null" || value == "undefined" {
+		return nil
+	}
+	parsed, err := uuid.Parse(value)
+	if err != nil {
+		return nil
+	}
+	return &parsed
+}
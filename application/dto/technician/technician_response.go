@@ -0,0 +1,11 @@
+package technician
+
+import "inventory-ticketing-system/domain/entity"
+
+type TechnicianListResponse struct {
+	Technicians []*entity.Technician `json:"technicians"`
+}
+
+type TeamListResponse struct {
+	Teams []*entity.Team `json:"teams"`
+}
@@ -3,10 +3,11 @@ package ticket
 import "github.com/google/uuid"
 
 type CreateTicketRequest struct {
-	AssetID  uuid.UUID `json:"assetId" binding:"required"`
-	Category string    `json:"kategori" binding:"required"`
-	Severity string    `json:"severity" binding:"required,oneof=low medium high critical"`
-	Comment  string    `json:"comment" binding:"required"`
+	AssetID  uuid.UUID  `json:"assetId" binding:"required"`
+	UnitID   *uuid.UUID `json:"unitId"`
+	Category string     `json:"kategori" binding:"required"`
+	Severity string     `json:"severity" binding:"required,oneof=low medium high critical"`
+	Comment  string     `json:"comment" binding:"required"`
 }
 
 type UpdateTicketRequest struct {
@@ -16,10 +17,13 @@ type UpdateTicketRequest struct {
 }
 
 type TicketListRequest struct {
-	Limit   int    `form:"limit,default=20" binding:"min=1,max=100"`
-	Offset  int    `form:"offset,default=0" binding:"min=0"`
-	Status  string `form:"status"`
-	AssetID string `form:"assetId"`
-	SortBy  string `form:"sortBy,default=created_at"`
-	Order   string `form:"order,default=desc" binding:"omitempty,oneof=asc desc"`
+	Limit        int    `form:"limit,default=20" binding:"min=1,max=100"`
+	Offset       int    `form:"offset,default=0" binding:"min=0"`
+	Status       string `form:"status"`
+	AssetID      string `form:"assetId"`
+	DirectOnly   bool   `form:"directOnly"` // Leave out the tickets of the asset's components
+	UnitID       string `form:"unitId"`
+	DepartmentID string `form:"departmentId"`
+	SortBy       string `form:"sortBy,default=created_at"`
+	Order        string `form:"order,default=desc" binding:"omitempty,oneof=asc desc"`
 }
\ No newline at end of file
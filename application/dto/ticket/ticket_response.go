@@ -10,6 +10,7 @@ import (
 type TicketResponse struct {
 	ID                uuid.UUID        `json:"id"`
 	AssetID           uuid.UUID        `json:"assetId"`
+	UnitID            *uuid.UUID       `json:"unitId"`
 	Category          string           `json:"kategori"`
 	Severity          string           `json:"severity"`
 	Status            string           `json:"status"`
@@ -18,6 +19,7 @@ type TicketResponse struct {
 	DueDate           time.Time        `json:"dueDate"`
 	Reporting         uuid.UUID        `json:"reporting"`
 	AssignedTo        *uuid.UUID       `json:"assignedTo"`
+	TeamID            *uuid.UUID       `json:"teamId"`
 	ResolutionComment string           `json:"resolutionComment"`
 	CreatedAt         time.Time        `json:"createdAt"`
 	UpdatedAt         time.Time        `json:"updatedAt"`
@@ -32,6 +34,7 @@ func NewTicketResponse(ticket *entity.Ticket) TicketResponse {
 	return TicketResponse{
 		ID:                ticket.ID,
 		AssetID:           ticket.AssetID,
+		UnitID:            ticket.UnitID,
 		Category:          ticket.Category,
 		Severity:          ticket.Severity,
 		Status:            ticket.Status,
@@ -40,8 +43,42 @@ func NewTicketResponse(ticket *entity.Ticket) TicketResponse {
 		DueDate:           ticket.DueDate,
 		Reporting:         ticket.Reporting,
 		AssignedTo:        ticket.AssignedTo,
+		TeamID:            ticket.TeamID,
 		ResolutionComment: ticket.ResolutionComment,
 		CreatedAt:         ticket.CreatedAt,
 		UpdatedAt:         ticket.UpdatedAt,
 	}
-}
\ No newline at end of file
+}
+
+// CreateTicketResponse is a new ticket with the warranty and support contract
+// coverage of its asset.
+type CreateTicketResponse struct {
+	TicketResponse
+	Coverage *entity.AssetCoverage `json:"coverage,omitempty"`
+}
+
+// TicketQueueItem is a ticket in a technician's queue with the time left
+// until its due date. MinutesRemaining is negative once the ticket is overdue.
+type TicketQueueItem struct {
+	TicketResponse
+	Overdue          bool `json:"overdue"`
+	MinutesRemaining int  `json:"minutesRemaining"`
+}
+
+type TicketQueueResponse struct {
+	Tickets []TicketQueueItem `json:"tickets"`
+	Total   int               `json:"total"`
+}
+
+func NewTicketQueueResponse(tickets []*entity.Ticket, now time.Time) TicketQueueResponse {
+	items := make([]TicketQueueItem, len(tickets))
+	for i, ticket := range tickets {
+		remaining := ticket.DueDate.Sub(now)
+		items[i] = TicketQueueItem{
+			TicketResponse:   NewTicketResponse(ticket),
+			Overdue:          remaining < 0,
+			MinutesRemaining: int(remaining.Minutes()),
+		}
+	}
+	return TicketQueueResponse{Tickets: items, Total: len(items)}
+}
@@ -0,0 +1,15 @@
+package token
+
+import "time"
+
+type CreateTokenRequest struct {
+	Name      string     `json:"name" binding:"required"`
+	Scopes    []string   `json:"scopes" binding:"required,min=1"`
+	ExpiresAt *time.Time `json:"expiresAt"`
+}
+
+type CreateServiceAccountRequest struct {
+	Name  string `json:"name" binding:"required"`
+	Email string `json:"email" binding:"required,email"`
+	Role  string `json:"role" binding:"omitempty"`
+}
@@ -0,0 +1,14 @@
+package token
+
+import "inventory-ticketing-system/domain/entity"
+
+// CreateTokenResponse carries the plaintext token, which is only ever
+// returned once at creation time.
+type CreateTokenResponse struct {
+	*entity.PersonalAccessToken
+	Token string `json:"token"`
+}
+
+type TokenListResponse struct {
+	Tokens []*entity.PersonalAccessToken `json:"tokens"`
+}
@@ -0,0 +1,58 @@
+package warranty
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+type WarrantyRequest struct {
+	AssetID   uuid.UUID `json:"assetId" binding:"required"`
+	Provider  string    `json:"provider" binding:"required"`
+	Coverage  string    `json:"coverage"`
+	Reference string    `json:"reference"`
+	StartDate time.Time `json:"startDate" binding:"required"`
+	EndDate   time.Time `json:"endDate" binding:"required"`
+	Documents []string  `json:"documents" binding:"omitempty,dive,url"`
+	Notes     string    `json:"notes"`
+}
+
+type ContractRequest struct {
+	Provider       string       `json:"provider" binding:"required"`
+	ContractNumber string       `json:"contractNumber"`
+	Coverage       string       `json:"coverage"`
+	StartDate      time.Time    `json:"startDate" binding:"required"`
+	EndDate        time.Time    `json:"endDate" binding:"required"`
+	Cost           entity.Money `json:"cost" binding:"min=0"`
+	Currency       string       `json:"currency" binding:"omitempty,len=3,uppercase"`
+	Documents      []string     `json:"documents" binding:"omitempty,dive,url"`
+	Notes          string       `json:"notes"`
+	AssetIDs       []uuid.UUID  `json:"assetIds"`
+}
+
+type ContractListRequest struct {
+	AssetID  string `form:"assetId" binding:"omitempty,uuid"`
+	Provider string `form:"provider"`
+	ActiveOn string `form:"activeOn" binding:"omitempty,datetime=2006-01-02"`
+	Limit    int    `form:"limit,default=20" binding:"min=1,max=100"`
+	Offset   int    `form:"offset,default=0" binding:"min=0"`
+}
+
+// ClaimRequest claims a ticket's repair under warranty. Without WarrantyID
+// the warranty that covered the asset when the ticket was reported is used.
+type ClaimRequest struct {
+	WarrantyID        *uuid.UUID `json:"warrantyId"`
+	ProviderReference string     `json:"providerReference"`
+	Notes             string     `json:"notes"`
+}
+
+type ClaimUpdateRequest struct {
+	Status            string `json:"status" binding:"omitempty,oneof=submitted approved rejected completed"`
+	ProviderReference string `json:"providerReference"`
+	Notes             string `json:"notes"`
+}
+
+type ExpiringRequest struct {
+	Days int `form:"days,default=30" binding:"min=1,max=366"`
+}
@@ -0,0 +1,20 @@
+package webhook
+
+type CreateWebhookRequest struct {
+	URL         string   `json:"url" binding:"required,url"`
+	Description string   `json:"description"`
+	Events      []string `json:"events" binding:"required,min=1"`
+}
+
+type UpdateWebhookRequest struct {
+	URL         string   `json:"url" binding:"required,url"`
+	Description string   `json:"description"`
+	Events      []string `json:"events" binding:"required,min=1"`
+	IsActive    *bool    `json:"isActive" binding:"required"`
+}
+
+type DeliveryListRequest struct {
+	Limit  int    `form:"limit,default=20" binding:"min=1,max=100"`
+	Offset int    `form:"offset,default=0" binding:"min=0"`
+	Status string `form:"status" binding:"omitempty,oneof=pending succeeded failed"`
+}
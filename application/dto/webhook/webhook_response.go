@@ -0,0 +1,15 @@
+package webhook
+
+import "inventory-ticketing-system/domain/entity"
+
+// WebhookSecretResponse carries the signing secret, which is only returned
+// when an endpoint is created or its secret is rotated.
+type WebhookSecretResponse struct {
+	*entity.WebhookEndpoint
+	Secret string `json:"secret"`
+}
+
+type WebhookListResponse struct {
+	Webhooks []*entity.WebhookEndpoint `json:"webhooks"`
+	Events   []string                  `json:"events"`
+}
@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/pkg/database"
+)
+
+// assetComponentsSQL selects an asset and every asset contained in or
+// installed in it, however deep.
+const assetComponentsSQL = `
+	WITH RECURSIVE components AS (
+		SELECT id FROM assets WHERE id = ?
+		UNION
+		SELECT CASE WHEN r.type = 'contains' THEN r.to_asset_id ELSE r.from_asset_id END
+		FROM asset_relationships r JOIN components c
+			ON (r.type = 'contains' AND r.from_asset_id = c.id)
+			OR (r.type = 'installed_in' AND r.to_asset_id = c.id)
+	)
+	SELECT id FROM components`
+
+// assetDependenciesSQL selects an asset and every asset it depends on,
+// however indirectly.
+const assetDependenciesSQL = `
+	WITH RECURSIVE dependencies AS (
+		SELECT id FROM assets WHERE id = ?
+		UNION
+		SELECT r.to_asset_id FROM asset_relationships r JOIN dependencies d
+			ON r.type = 'depends_on' AND r.from_asset_id = d.id
+	)
+	SELECT id FROM dependencies`
+
+// relationshipGraphLock is the advisory lock key that serializes changes to
+// the relationship graph.
+const relationshipGraphLock = 7_260_050
+
+type AssetRelationshipRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewAssetRelationshipRepository(db *gorm.DB) repository.AssetRelationshipRepository {
+	return &AssetRelationshipRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *AssetRelationshipRepositoryImpl) Create(ctx context.Context, relationship *entity.AssetRelationship) error {
+	return database.Conn(ctx, r.db).Omit(clause.Associations).Create(relationship).Error
+}
+
+func (r *AssetRelationshipRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.AssetRelationship, error) {
+	var relationship entity.AssetRelationship
+	err := database.Conn(ctx, r.db).Preload("FromAsset").Preload("ToAsset").Where("id = ?", id).First(&relationship).Error
+	if err != nil {
+		return nil, err
+	}
+	return &relationship, nil
+}
+
+func (r *AssetRelationshipRepositoryImpl) GetLink(ctx context.Context, fromID, toID uuid.UUID, relType string) (*entity.AssetRelationship, error) {
+	var relationship entity.AssetRelationship
+	err := database.Conn(ctx, r.db).
+		Where("from_asset_id = ? AND to_asset_id = ? AND type = ?", fromID, toID, relType).
+		First(&relationship).Error
+	if err != nil {
+		return nil, err
+	}
+	return &relationship, nil
+}
+
+func (r *AssetRelationshipRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return database.Conn(ctx, r.db).Delete(&entity.AssetRelationship{}, "id = ?", id).Error
+}
+
+func (r *AssetRelationshipRepositoryImpl) LockGraph(ctx context.Context) error {
+	return database.Conn(ctx, r.db).Exec("SELECT pg_advisory_xact_lock(?)", relationshipGraphLock).Error
+}
+
+func (r *AssetRelationshipRepositoryImpl) ListByAsset(ctx context.Context, assetID uuid.UUID) ([]*entity.AssetRelationship, error) {
+	var relationships []*entity.AssetRelationship
+	err := database.Conn(ctx, r.db).
+		Preload("FromAsset").
+		Preload("ToAsset").
+		Where("from_asset_id = ? OR to_asset_id = ?", assetID, assetID).
+		Order("type ASC, created_at ASC, id ASC").
+		Find(&relationships).Error
+	if err != nil {
+		return nil, err
+	}
+	return relationships, nil
+}
+
+func (r *AssetRelationshipRepositoryImpl) GetParentLink(ctx context.Context, assetID uuid.UUID) (*entity.AssetRelationship, error) {
+	var relationship entity.AssetRelationship
+	err := database.Conn(ctx, r.db).
+		Preload("FromAsset").
+		Preload("ToAsset").
+		Where("(type = 'contains' AND to_asset_id = ?) OR (type = 'installed_in' AND from_asset_id = ?)", assetID, assetID).
+		First(&relationship).Error
+	if err != nil {
+		return nil, err
+	}
+	return &relationship, nil
+}
+
+func (r *AssetRelationshipRepositoryImpl) ListComponentIDs(ctx context.Context, assetID uuid.UUID) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := database.Conn(ctx, r.db).Raw(assetComponentsSQL, assetID).Scan(&ids).Error
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (r *AssetRelationshipRepositoryImpl) ListDependencyIDs(ctx context.Context, assetID uuid.UUID) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := database.Conn(ctx, r.db).Raw(assetDependenciesSQL, assetID).Scan(&ids).Error
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
@@ -2,11 +2,15 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/policy"
 	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/pkg/database"
 )
 
 type AssetRepositoryImpl struct {
@@ -20,31 +24,64 @@ func NewAssetRepository(db *gorm.DB) repository.AssetRepository {
 }
 
 func (r *AssetRepositoryImpl) Create(ctx context.Context, asset *entity.Asset) error {
-	return r.db.WithContext(ctx).Create(asset).Error
+	return database.Conn(ctx, r.db).Create(asset).Error
 }
 
 func (r *AssetRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.Asset, error) {
 	var asset entity.Asset
-	err := r.db.WithContext(ctx).Preload("Location").Where("id = ?", id).First(&asset).Error
+	err := database.Conn(ctx, r.db).Preload("Location").Where("id = ?", id).First(&asset).Error
 	if err != nil {
 		return nil, err
 	}
 	return &asset, nil
 }
 
+func (r *AssetRepositoryImpl) LockByID(ctx context.Context, id uuid.UUID) (*entity.Asset, error) {
+	var asset entity.Asset
+	err := database.Conn(ctx, r.db).Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).First(&asset).Error
+	if err != nil {
+		return nil, err
+	}
+	return &asset, nil
+}
+
+// Update saves the asset's attributes. The usage counter only changes through
+// IncrementUsage and the quantity through AdjustQty, so concurrent edits
+// cannot lose usage or stock.
 func (r *AssetRepositoryImpl) Update(ctx context.Context, asset *entity.Asset) error {
-	return r.db.WithContext(ctx).Save(asset).Error
+	return database.Conn(ctx, r.db).Omit(clause.Associations, "usage_count", "qty").Save(asset).Error
+}
+
+func (r *AssetRepositoryImpl) AdjustQty(ctx context.Context, id uuid.UUID, delta int) (int, bool, error) {
+	var qty []int
+	err := database.Conn(ctx, r.db).
+		Raw("UPDATE assets SET qty = qty + ? WHERE id = ? AND qty + ? >= 0 RETURNING qty", delta, id, delta).
+		Scan(&qty).Error
+	if err != nil || len(qty) == 0 {
+		return 0, false, err
+	}
+	return qty[0], true, nil
 }
 
 func (r *AssetRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
-	return r.db.WithContext(ctx).Delete(&entity.Asset{}, "id = ?", id).Error
+	return database.Conn(ctx, r.db).Delete(&entity.Asset{}, "id = ?", id).Error
 }
 
+// List leaves out disposed assets unless filtered by status or asked to
+// "include_disposed".
 func (r *AssetRepositoryImpl) List(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.Asset, int, error) {
 	var assets []*entity.Asset
 	var total int64
 
-	query := r.db.WithContext(ctx).Model(&entity.Asset{}).Preload("Location")
+	query := database.Conn(ctx, r.db).Model(&entity.Asset{}).Preload("Location")
+
+	_, hasStatus := filters["status"]
+	if _, ok := filters["statuses"]; ok {
+		hasStatus = true
+	}
+	if includeDisposed, _ := filters["include_disposed"].(bool); !hasStatus && !includeDisposed {
+		query = query.Where("status <> ?", "disposed")
+	}
 
 	for key, value := range filters {
 		switch key {
@@ -52,10 +89,25 @@ func (r *AssetRepositoryImpl) List(ctx context.Context, limit, offset int, filte
 			query = query.Where("type = ?", value)
 		case "status":
 			query = query.Where("status = ?", value)
+		case "statuses":
+			query = query.Where("status IN ?", value)
 		case "category":
 			query = query.Where("category ILIKE ?", "%"+value.(string)+"%")
 		case "brand":
 			query = query.Where("brand ILIKE ?", "%"+value.(string)+"%")
+		case "department_id":
+			query = query.Where("department_id IN ("+departmentSubtreeSQL+")", value)
+		case "manufacturer_id":
+			query = query.Where("manufacturer_id = ?", value)
+		case "purchase_vendor_id":
+			query = query.Where("purchase_vendor_id = ?", value)
+		case "service_vendor_id":
+			query = query.Where("service_vendor_id = ?", value)
+		case "purchase_order_id":
+			query = query.Where("purchase_order_id = ?", value)
+		case "scope":
+			clause, args := scopeClause(value.([]policy.Condition), assetScopeColumns)
+			query = query.Where(clause, args...)
 		}
 	}
 
@@ -73,9 +125,42 @@ func (r *AssetRepositoryImpl) List(ctx context.Context, limit, offset int, filte
 
 func (r *AssetRepositoryImpl) GetByUniqueID(ctx context.Context, uniqueID string) (*entity.Asset, error) {
 	var asset entity.Asset
-	err := r.db.WithContext(ctx).Preload("Location").Where("unique_id = ?", uniqueID).First(&asset).Error
+	err := database.Conn(ctx, r.db).Preload("Location").Where("unique_id = ?", uniqueID).First(&asset).Error
 	if err != nil {
 		return nil, err
 	}
 	return &asset, nil
+}
+
+// ListByCategory returns the assets of a category, matched exactly.
+func (r *AssetRepositoryImpl) ListByCategory(ctx context.Context, category string) ([]*entity.Asset, error) {
+	var assets []*entity.Asset
+	err := database.Conn(ctx, r.db).Where("category = ?", category).Order("created_at ASC").Find(&assets).Error
+	if err != nil {
+		return nil, err
+	}
+	return assets, nil
+}
+
+func (r *AssetRepositoryImpl) IncrementUsage(ctx context.Context, id uuid.UUID, amount int) (int, error) {
+	var usage int
+	err := database.Conn(ctx, r.db).
+		Raw("UPDATE assets SET usage_count = usage_count + ? WHERE id = ? RETURNING usage_count", amount, id).
+		Scan(&usage).Error
+	return usage, err
+}
+
+func (r *AssetRepositoryImpl) ListHeldAt(ctx context.Context, at time.Time) ([]*entity.Asset, error) {
+	var assets []*entity.Asset
+	err := database.Conn(ctx, r.db).Preload("Location").
+		Where("COALESCE(purchase_date::timestamptz, created_at) <= ?", at).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM asset_status_changes c
+			WHERE c.asset_id = assets.id AND c.to_status = 'disposed' AND c.created_at <= ?
+		)`, at).
+		Find(&assets).Error
+	if err != nil {
+		return nil, err
+	}
+	return assets, nil
 }
\ No newline at end of file
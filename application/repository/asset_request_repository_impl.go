@@ -0,0 +1,250 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/policy"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/pkg/database"
+)
+
+type AssetRequestRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewAssetRequestRepository(db *gorm.DB) repository.AssetRequestRepository {
+	return &AssetRequestRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *AssetRequestRepositoryImpl) Create(ctx context.Context, request *entity.AssetRequest) error {
+	return database.Conn(ctx, r.db).Omit("Asset").Create(request).Error
+}
+
+func (r *AssetRequestRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.AssetRequest, error) {
+	var request entity.AssetRequest
+	err := database.Conn(ctx, r.db).Preload("Asset").Preload("Approvals", orderByStep).
+		Where("id = ?", id).First(&request).Error
+	if err != nil {
+		return nil, err
+	}
+	return &request, nil
+}
+
+func (r *AssetRequestRepositoryImpl) LockByID(ctx context.Context, id uuid.UUID) (*entity.AssetRequest, error) {
+	var locked entity.AssetRequest
+	err := database.Conn(ctx, r.db).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id").Where("id = ?", id).First(&locked).Error
+	if err != nil {
+		return nil, err
+	}
+	return r.GetByID(ctx, id)
+}
+
+func (r *AssetRequestRepositoryImpl) Update(ctx context.Context, request *entity.AssetRequest) error {
+	return database.Conn(ctx, r.db).Omit(clause.Associations).Save(request).Error
+}
+
+func (r *AssetRequestRepositoryImpl) UpdateApproval(ctx context.Context, approval *entity.AssetRequestApproval) error {
+	return database.Conn(ctx, r.db).Save(approval).Error
+}
+
+func (r *AssetRequestRepositoryImpl) List(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.AssetRequest, int, error) {
+	var requests []*entity.AssetRequest
+	var total int64
+
+	query := database.Conn(ctx, r.db).Model(&entity.AssetRequest{})
+	for key, value := range filters {
+		switch key {
+		case "status":
+			query = query.Where("status = ?", value)
+		case "asset_id":
+			query = query.Where("asset_id = ?", value)
+		case "requested_by":
+			query = query.Where("requested_by = ?", value)
+		case "not_requested_by":
+			query = query.Where("requested_by <> ?", value)
+		case "scope":
+			clause, args := scopeClause(value.([]policy.Condition), assetScopeColumns)
+			query = query.Where("asset_id IN (SELECT id FROM assets WHERE "+clause+")", args...)
+		case "awaiting_approver_ids":
+			// Requests whose current step any of these users, or a member of
+			// any of awaiting_roles, may decide
+			roles, _ := filters["awaiting_roles"].([]string)
+			query = query.Where(`id IN (SELECT request_id FROM asset_request_approvals
+				WHERE status = 'pending' AND (approver_id IN ? OR (approver_id IS NULL AND approver IN ?)))`,
+				value, roles)
+		}
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Preload("Asset").Preload("Approvals", orderByStep).
+		Order("created_at DESC").Limit(limit).Offset(offset).Find(&requests).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return requests, int(total), nil
+}
+
+func (r *AssetRequestRepositoryImpl) ListOverdueApprovals(ctx context.Context, now time.Time) ([]*entity.AssetRequestApproval, error) {
+	var approvals []*entity.AssetRequestApproval
+	err := database.Conn(ctx, r.db).
+		Where("status = 'pending' AND due_at < ? AND reminder_sent_at IS NULL", now).
+		Order("due_at ASC").Find(&approvals).Error
+	if err != nil {
+		return nil, err
+	}
+	return approvals, nil
+}
+
+type ApprovalDelegationRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewApprovalDelegationRepository(db *gorm.DB) repository.ApprovalDelegationRepository {
+	return &ApprovalDelegationRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *ApprovalDelegationRepositoryImpl) Create(ctx context.Context, delegation *entity.ApprovalDelegation) error {
+	return database.Conn(ctx, r.db).Create(delegation).Error
+}
+
+func (r *ApprovalDelegationRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.ApprovalDelegation, error) {
+	var delegation entity.ApprovalDelegation
+	err := database.Conn(ctx, r.db).Where("id = ?", id).First(&delegation).Error
+	if err != nil {
+		return nil, err
+	}
+	return &delegation, nil
+}
+
+func (r *ApprovalDelegationRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return database.Conn(ctx, r.db).Delete(&entity.ApprovalDelegation{}, "id = ?", id).Error
+}
+
+func (r *ApprovalDelegationRepositoryImpl) ListByUser(ctx context.Context, userID uuid.UUID) ([]*entity.ApprovalDelegation, error) {
+	var delegations []*entity.ApprovalDelegation
+	err := database.Conn(ctx, r.db).Where("delegator_id = ? OR delegate_id = ?", userID, userID).
+		Order("starts_at DESC").Find(&delegations).Error
+	if err != nil {
+		return nil, err
+	}
+	return delegations, nil
+}
+
+func (r *ApprovalDelegationRepositoryImpl) ListActiveByDelegators(ctx context.Context, delegatorIDs []uuid.UUID, at time.Time) ([]*entity.ApprovalDelegation, error) {
+	var delegations []*entity.ApprovalDelegation
+	if len(delegatorIDs) == 0 {
+		return delegations, nil
+	}
+	err := database.Conn(ctx, r.db).
+		Where("delegator_id IN ? AND starts_at <= ? AND ends_at > ?", delegatorIDs, at, at).
+		Find(&delegations).Error
+	if err != nil {
+		return nil, err
+	}
+	return delegations, nil
+}
+
+func (r *ApprovalDelegationRepositoryImpl) ListActiveForDelegate(ctx context.Context, delegateID uuid.UUID, at time.Time) ([]*entity.ApprovalDelegation, error) {
+	var delegations []*entity.ApprovalDelegation
+	err := database.Conn(ctx, r.db).
+		Where("delegate_id = ? AND starts_at <= ? AND ends_at > ?", delegateID, at, at).
+		Find(&delegations).Error
+	if err != nil {
+		return nil, err
+	}
+	return delegations, nil
+}
+
+type AssetCheckoutRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewAssetCheckoutRepository(db *gorm.DB) repository.AssetCheckoutRepository {
+	return &AssetCheckoutRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *AssetCheckoutRepositoryImpl) Create(ctx context.Context, checkout *entity.AssetCheckout) error {
+	return database.Conn(ctx, r.db).Omit(clause.Associations).Create(checkout).Error
+}
+
+func (r *AssetCheckoutRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.AssetCheckout, error) {
+	var checkout entity.AssetCheckout
+	err := database.Conn(ctx, r.db).Preload("Asset").Where("id = ?", id).First(&checkout).Error
+	if err != nil {
+		return nil, err
+	}
+	return &checkout, nil
+}
+
+func (r *AssetCheckoutRepositoryImpl) Update(ctx context.Context, checkout *entity.AssetCheckout) error {
+	return database.Conn(ctx, r.db).Omit(clause.Associations).Save(checkout).Error
+}
+
+func (r *AssetCheckoutRepositoryImpl) List(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.AssetCheckout, int, error) {
+	var checkouts []*entity.AssetCheckout
+	var total int64
+
+	query := database.Conn(ctx, r.db).Model(&entity.AssetCheckout{})
+	for key, value := range filters {
+		switch key {
+		case "asset_id":
+			query = query.Where("asset_id = ?", value)
+		case "user_id":
+			query = query.Where("user_id = ?", value)
+		case "open":
+			if value.(bool) {
+				query = query.Where("returned_at IS NULL")
+			} else {
+				query = query.Where("returned_at IS NOT NULL")
+			}
+		case "overdue_at":
+			query = query.Where("returned_at IS NULL AND due_at < ?", value)
+		case "scope":
+			clause, args := scopeClause(value.([]policy.Condition), assetScopeColumns)
+			query = query.Where("asset_id IN (SELECT id FROM assets WHERE "+clause+")", args...)
+		}
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Preload("Asset").Order("checked_out_at DESC").Limit(limit).Offset(offset).Find(&checkouts).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return checkouts, int(total), nil
+}
+
+func (r *AssetCheckoutRepositoryImpl) ListOverdue(ctx context.Context, now time.Time) ([]*entity.AssetCheckout, error) {
+	var checkouts []*entity.AssetCheckout
+	err := database.Conn(ctx, r.db).
+		Preload("Asset").
+		Where("returned_at IS NULL AND due_at < ? AND reminder_sent_at IS NULL", now).
+		Order("due_at ASC").Find(&checkouts).Error
+	if err != nil {
+		return nil, err
+	}
+	return checkouts, nil
+}
+
+func orderByStep(db *gorm.DB) *gorm.DB {
+	return db.Order("step ASC")
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/pkg/database"
+)
+
+type AssetStatusChangeRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewAssetStatusChangeRepository(db *gorm.DB) repository.AssetStatusChangeRepository {
+	return &AssetStatusChangeRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *AssetStatusChangeRepositoryImpl) Create(ctx context.Context, change *entity.AssetStatusChange) error {
+	return database.Conn(ctx, r.db).Create(change).Error
+}
+
+func (r *AssetStatusChangeRepositoryImpl) ListByAsset(ctx context.Context, assetID uuid.UUID) ([]*entity.AssetStatusChange, error) {
+	var changes []*entity.AssetStatusChange
+	err := database.Conn(ctx, r.db).
+		Where("asset_id = ?", assetID).
+		Order("created_at DESC, id DESC").
+		Find(&changes).Error
+	if err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+// SummarizeDurations ends each status period at the next change, or at to
+// for the current status.
+func (r *AssetStatusChangeRepositoryImpl) SummarizeDurations(ctx context.Context, from, to time.Time) ([]*entity.AssetStatusTime, error) {
+	var rows []struct {
+		AssetID       uuid.UUID
+		UniqueID      string
+		Name          string
+		CurrentStatus string
+		Status        string
+		Seconds       int64
+	}
+	err := database.Conn(ctx, r.db).Raw(`
+		WITH periods AS (
+			SELECT asset_id, to_status AS status, created_at AS started_at,
+				COALESCE(LEAD(created_at) OVER (PARTITION BY asset_id ORDER BY created_at, id), @to) AS ended_at
+			FROM asset_status_changes
+		)
+		SELECT a.id AS asset_id, a.unique_id, a.name, a.status AS current_status, p.status,
+			SUM(EXTRACT(EPOCH FROM LEAST(p.ended_at, @to) - GREATEST(p.started_at, @from)))::BIGINT AS seconds
+		FROM periods p
+		JOIN assets a ON a.id = p.asset_id
+		WHERE p.started_at < @to AND p.ended_at > @from
+		GROUP BY a.id, a.unique_id, a.name, a.status, p.status
+		ORDER BY a.name, a.id, p.status`,
+		map[string]interface{}{"from": from, "to": to},
+	).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var summaries []*entity.AssetStatusTime
+	for _, row := range rows {
+		if len(summaries) == 0 || summaries[len(summaries)-1].AssetID != row.AssetID {
+			summaries = append(summaries, &entity.AssetStatusTime{
+				AssetID:       row.AssetID,
+				UniqueID:      row.UniqueID,
+				Name:          row.Name,
+				CurrentStatus: row.CurrentStatus,
+				Seconds:       make(map[string]int64),
+			})
+		}
+		summaries[len(summaries)-1].Seconds[row.Status] = row.Seconds
+	}
+	return summaries, nil
+}
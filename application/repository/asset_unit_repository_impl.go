@@ -0,0 +1,186 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/policy"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/pkg/database"
+)
+
+type AssetUnitRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewAssetUnitRepository(db *gorm.DB) repository.AssetUnitRepository {
+	return &AssetUnitRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *AssetUnitRepositoryImpl) Create(ctx context.Context, unit *entity.AssetUnit) error {
+	return database.Conn(ctx, r.db).Omit(clause.Associations).Create(unit).Error
+}
+
+func (r *AssetUnitRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.AssetUnit, error) {
+	var unit entity.AssetUnit
+	err := database.Conn(ctx, r.db).Preload("Asset").Preload("Location").Where("id = ?", id).First(&unit).Error
+	if err != nil {
+		return nil, err
+	}
+	return &unit, nil
+}
+
+func (r *AssetUnitRepositoryImpl) GetBySerialNumber(ctx context.Context, serialNumber string) (*entity.AssetUnit, error) {
+	var unit entity.AssetUnit
+	err := database.Conn(ctx, r.db).Where("serial_number = ?", serialNumber).First(&unit).Error
+	if err != nil {
+		return nil, err
+	}
+	return &unit, nil
+}
+
+func (r *AssetUnitRepositoryImpl) Update(ctx context.Context, unit *entity.AssetUnit) error {
+	return database.Conn(ctx, r.db).Omit(clause.Associations).Save(unit).Error
+}
+
+func (r *AssetUnitRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return database.Conn(ctx, r.db).Delete(&entity.AssetUnit{}, "id = ?", id).Error
+}
+
+func (r *AssetUnitRepositoryImpl) List(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.AssetUnit, int, error) {
+	var units []*entity.AssetUnit
+	var total int64
+
+	query := database.Conn(ctx, r.db).Model(&entity.AssetUnit{})
+	for key, value := range filters {
+		switch key {
+		case "asset_id":
+			query = query.Where("asset_id = ?", value)
+		case "status":
+			query = query.Where("status = ?", value)
+		case "custodian_id":
+			query = query.Where("custodian_id = ?", value)
+		case "location_id":
+			query = query.Where("location_id = ?", value)
+		case "serial_number":
+			query = query.Where("serial_number ILIKE ?", "%"+value.(string)+"%")
+		case "scope":
+			clause, args := scopeClause(value.([]policy.Condition), assetScopeColumns)
+			query = query.Where("asset_id IN (SELECT id FROM assets WHERE "+clause+")", args...)
+		}
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Preload("Location").Order("serial_number ASC").Limit(limit).Offset(offset).Find(&units).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return units, int(total), nil
+}
+
+func (r *AssetUnitRepositoryImpl) CountByAsset(ctx context.Context, assetID uuid.UUID, status string) (int, error) {
+	var count int64
+	query := database.Conn(ctx, r.db).Model(&entity.AssetUnit{}).Where("asset_id = ?", assetID)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if err := query.Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+func (r *AssetUnitRepositoryImpl) CountByCategory(ctx context.Context, category string) (int, error) {
+	var count int64
+	err := database.Conn(ctx, r.db).Model(&entity.AssetUnit{}).
+		Where("asset_id IN (SELECT id FROM assets WHERE LOWER(category) = LOWER(?))", category).
+		Count(&count).Error
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+type AssetUnitStatusChangeRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewAssetUnitStatusChangeRepository(db *gorm.DB) repository.AssetUnitStatusChangeRepository {
+	return &AssetUnitStatusChangeRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *AssetUnitStatusChangeRepositoryImpl) Create(ctx context.Context, change *entity.AssetUnitStatusChange) error {
+	return database.Conn(ctx, r.db).Create(change).Error
+}
+
+func (r *AssetUnitStatusChangeRepositoryImpl) ListByUnit(ctx context.Context, unitID uuid.UUID) ([]*entity.AssetUnitStatusChange, error) {
+	var changes []*entity.AssetUnitStatusChange
+	err := database.Conn(ctx, r.db).
+		Where("unit_id = ?", unitID).
+		Order("created_at DESC, id DESC").
+		Find(&changes).Error
+	if err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+type CategoryTrackingModeRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewCategoryTrackingModeRepository(db *gorm.DB) repository.CategoryTrackingModeRepository {
+	return &CategoryTrackingModeRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *CategoryTrackingModeRepositoryImpl) Create(ctx context.Context, mode *entity.CategoryTrackingMode) error {
+	return database.Conn(ctx, r.db).Create(mode).Error
+}
+
+func (r *CategoryTrackingModeRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.CategoryTrackingMode, error) {
+	var mode entity.CategoryTrackingMode
+	err := database.Conn(ctx, r.db).Where("id = ?", id).First(&mode).Error
+	if err != nil {
+		return nil, err
+	}
+	return &mode, nil
+}
+
+func (r *CategoryTrackingModeRepositoryImpl) GetByCategory(ctx context.Context, category string) (*entity.CategoryTrackingMode, error) {
+	var mode entity.CategoryTrackingMode
+	err := database.Conn(ctx, r.db).Where("LOWER(category) = LOWER(?)", category).First(&mode).Error
+	if err != nil {
+		return nil, err
+	}
+	return &mode, nil
+}
+
+func (r *CategoryTrackingModeRepositoryImpl) Update(ctx context.Context, mode *entity.CategoryTrackingMode) error {
+	return database.Conn(ctx, r.db).Save(mode).Error
+}
+
+func (r *CategoryTrackingModeRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return database.Conn(ctx, r.db).Delete(&entity.CategoryTrackingMode{}, "id = ?", id).Error
+}
+
+func (r *CategoryTrackingModeRepositoryImpl) List(ctx context.Context) ([]*entity.CategoryTrackingMode, error) {
+	var modes []*entity.CategoryTrackingMode
+	err := database.Conn(ctx, r.db).Order("category ASC").Find(&modes).Error
+	if err != nil {
+		return nil, err
+	}
+	return modes, nil
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/pkg/database"
+)
+
+type CalendarFeedTokenRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewCalendarFeedTokenRepository(db *gorm.DB) repository.CalendarFeedTokenRepository {
+	return &CalendarFeedTokenRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *CalendarFeedTokenRepositoryImpl) Create(ctx context.Context, token *entity.CalendarFeedToken) error {
+	return database.Conn(ctx, r.db).Create(token).Error
+}
+
+func (r *CalendarFeedTokenRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.CalendarFeedToken, error) {
+	var token entity.CalendarFeedToken
+	err := database.Conn(ctx, r.db).Where("id = ?", id).First(&token).Error
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+func (r *CalendarFeedTokenRepositoryImpl) GetByHash(ctx context.Context, tokenHash string) (*entity.CalendarFeedToken, error) {
+	var token entity.CalendarFeedToken
+	err := database.Conn(ctx, r.db).Where("token_hash = ?", tokenHash).First(&token).Error
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+func (r *CalendarFeedTokenRepositoryImpl) ListByUser(ctx context.Context, userID uuid.UUID) ([]*entity.CalendarFeedToken, error) {
+	var tokens []*entity.CalendarFeedToken
+	err := database.Conn(ctx, r.db).
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Find(&tokens).Error
+	if err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+func (r *CalendarFeedTokenRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return database.Conn(ctx, r.db).Where("id = ?", id).Delete(&entity.CalendarFeedToken{}).Error
+}
+
+func (r *CalendarFeedTokenRepositoryImpl) TouchLastUsed(ctx context.Context, id uuid.UUID, usedAt time.Time) error {
+	return database.Conn(ctx, r.db).
+		Model(&entity.CalendarFeedToken{}).
+		Where("id = ?", id).
+		Update("last_used_at", usedAt).Error
+}
@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/pkg/database"
+)
+
+// departmentSubtreeSQL selects a department and every department below it.
+const departmentSubtreeSQL = `
+	WITH RECURSIVE subtree AS (
+		SELECT id FROM departments WHERE id = ?
+		UNION
+		SELECT d.id FROM departments d JOIN subtree s ON d.parent_id = s.id
+	)
+	SELECT id FROM subtree`
+
+type DepartmentRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewDepartmentRepository(db *gorm.DB) repository.DepartmentRepository {
+	return &DepartmentRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *DepartmentRepositoryImpl) Create(ctx context.Context, department *entity.Department) error {
+	return database.Conn(ctx, r.db).Create(department).Error
+}
+
+func (r *DepartmentRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.Department, error) {
+	var department entity.Department
+	err := database.Conn(ctx, r.db).Where("id = ?", id).First(&department).Error
+	if err != nil {
+		return nil, err
+	}
+	return &department, nil
+}
+
+func (r *DepartmentRepositoryImpl) GetByName(ctx context.Context, name string) (*entity.Department, error) {
+	var department entity.Department
+	err := database.Conn(ctx, r.db).Where("name = ?", name).First(&department).Error
+	if err != nil {
+		return nil, err
+	}
+	return &department, nil
+}
+
+func (r *DepartmentRepositoryImpl) GetByCostCenterCode(ctx context.Context, code string) (*entity.Department, error) {
+	var department entity.Department
+	err := database.Conn(ctx, r.db).Where("cost_center_code = ?", code).First(&department).Error
+	if err != nil {
+		return nil, err
+	}
+	return &department, nil
+}
+
+func (r *DepartmentRepositoryImpl) Update(ctx context.Context, department *entity.Department) error {
+	return database.Conn(ctx, r.db).Save(department).Error
+}
+
+func (r *DepartmentRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return database.Conn(ctx, r.db).Delete(&entity.Department{}, "id = ?", id).Error
+}
+
+func (r *DepartmentRepositoryImpl) List(ctx context.Context) ([]*entity.Department, error) {
+	var departments []*entity.Department
+	err := database.Conn(ctx, r.db).Order("name ASC").Find(&departments).Error
+	if err != nil {
+		return nil, err
+	}
+	return departments, nil
+}
+
+func (r *DepartmentRepositoryImpl) ListManagedBy(ctx context.Context, userID uuid.UUID) ([]*entity.Department, error) {
+	var departments []*entity.Department
+	err := database.Conn(ctx, r.db).Where("manager_id = ?", userID).Find(&departments).Error
+	if err != nil {
+		return nil, err
+	}
+	return departments, nil
+}
+
+// ListDescendantIDs returns the department and every department below it.
+func (r *DepartmentRepositoryImpl) ListDescendantIDs(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := database.Conn(ctx, r.db).Raw(departmentSubtreeSQL, id).Scan(&ids).Error
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// CountMembers counts the users, assets and child departments that still
+// reference the department.
+func (r *DepartmentRepositoryImpl) CountMembers(ctx context.Context, id uuid.UUID) (int, error) {
+	var count int64
+	err := database.Conn(ctx, r.db).Raw(`
+		SELECT
+			(SELECT COUNT(*) FROM users WHERE department_id = ?) +
+			(SELECT COUNT(*) FROM assets WHERE department_id = ?) +
+			(SELECT COUNT(*) FROM departments WHERE parent_id = ?)`, id, id, id).
+		Scan(&count).Error
+	return int(count), err
+}
+
+// Summarize returns asset and ticket counts per department, plus a row with
+// a nil department for assets without one. Tickets are attributed to the
+// department owning their asset; from and to limit tickets by creation time.
+func (r *DepartmentRepositoryImpl) Summarize(ctx context.Context, from, to *time.Time) ([]*entity.DepartmentSummary, error) {
+	ticketFilter := "TRUE"
+	var ticketArgs []interface{}
+	if from != nil {
+		ticketFilter += " AND t.created_at >= ?"
+		ticketArgs = append(ticketArgs, *from)
+	}
+	if to != nil {
+		ticketFilter += " AND t.created_at < ?"
+		ticketArgs = append(ticketArgs, *to)
+	}
+
+	var summaries []*entity.DepartmentSummary
+	err := database.Conn(ctx, r.db).Raw(`
+		WITH asset_totals AS (
+			SELECT department_id, COUNT(*) AS asset_count, COALESCE(SUM(qty), 0) AS asset_quantity
+			FROM assets
+			GROUP BY department_id
+		),
+		ticket_totals AS (
+			SELECT a.department_id,
+				COUNT(*) AS ticket_count,
+				COUNT(*) FILTER (WHERE t.status IN ('open', 'in_progress')) AS open_ticket_count
+			FROM tickets t JOIN assets a ON a.id = t.asset_id
+			WHERE `+ticketFilter+`
+			GROUP BY a.department_id
+		)
+		SELECT d.id AS department_id, d.name, d.cost_center_code,
+			COALESCE(ad.asset_count, 0) AS asset_count,
+			COALESCE(ad.asset_quantity, 0) AS asset_quantity,
+			COALESCE(td.ticket_count, 0) AS ticket_count,
+			COALESCE(td.open_ticket_count, 0) AS open_ticket_count
+		FROM departments d
+		LEFT JOIN asset_totals ad ON ad.department_id = d.id
+		LEFT JOIN ticket_totals td ON td.department_id = d.id
+		UNION ALL
+		SELECT NULL, 'Unassigned', '',
+			COALESCE((SELECT asset_count FROM asset_totals WHERE department_id IS NULL), 0),
+			COALESCE((SELECT asset_quantity FROM asset_totals WHERE department_id IS NULL), 0),
+			COALESCE((SELECT ticket_count FROM ticket_totals WHERE department_id IS NULL), 0),
+			COALESCE((SELECT open_ticket_count FROM ticket_totals WHERE department_id IS NULL), 0)
+		ORDER BY 2`, ticketArgs...).Scan(&summaries).Error
+	if err != nil {
+		return nil, err
+	}
+	return summaries, nil
+}
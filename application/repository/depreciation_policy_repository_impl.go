@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/pkg/database"
+)
+
+type DepreciationPolicyRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewDepreciationPolicyRepository(db *gorm.DB) repository.DepreciationPolicyRepository {
+	return &DepreciationPolicyRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *DepreciationPolicyRepositoryImpl) Create(ctx context.Context, policy *entity.DepreciationPolicy) error {
+	return database.Conn(ctx, r.db).Create(policy).Error
+}
+
+func (r *DepreciationPolicyRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.DepreciationPolicy, error) {
+	var policy entity.DepreciationPolicy
+	err := database.Conn(ctx, r.db).Where("id = ?", id).First(&policy).Error
+	if err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+func (r *DepreciationPolicyRepositoryImpl) GetByCategory(ctx context.Context, category string) (*entity.DepreciationPolicy, error) {
+	var policy entity.DepreciationPolicy
+	err := database.Conn(ctx, r.db).Where("LOWER(category) = LOWER(?)", category).First(&policy).Error
+	if err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+func (r *DepreciationPolicyRepositoryImpl) Update(ctx context.Context, policy *entity.DepreciationPolicy) error {
+	return database.Conn(ctx, r.db).Save(policy).Error
+}
+
+func (r *DepreciationPolicyRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return database.Conn(ctx, r.db).Delete(&entity.DepreciationPolicy{}, "id = ?", id).Error
+}
+
+func (r *DepreciationPolicyRepositoryImpl) List(ctx context.Context) ([]*entity.DepreciationPolicy, error) {
+	var policies []*entity.DepreciationPolicy
+	err := database.Conn(ctx, r.db).Order("category ASC").Find(&policies).Error
+	if err != nil {
+		return nil, err
+	}
+	return policies, nil
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/policy"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/pkg/database"
+)
+
+type DisposalRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewDisposalRepository(db *gorm.DB) repository.DisposalRepository {
+	return &DisposalRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *DisposalRepositoryImpl) Create(ctx context.Context, disposal *entity.DisposalRequest) error {
+	return database.Conn(ctx, r.db).Omit(clause.Associations).Create(disposal).Error
+}
+
+func (r *DisposalRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.DisposalRequest, error) {
+	var disposal entity.DisposalRequest
+	err := database.Conn(ctx, r.db).Preload("Asset").Where("id = ?", id).First(&disposal).Error
+	if err != nil {
+		return nil, err
+	}
+	return &disposal, nil
+}
+
+func (r *DisposalRepositoryImpl) Update(ctx context.Context, disposal *entity.DisposalRequest) error {
+	return database.Conn(ctx, r.db).Omit(clause.Associations).Save(disposal).Error
+}
+
+func (r *DisposalRepositoryImpl) List(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.DisposalRequest, int, error) {
+	var disposals []*entity.DisposalRequest
+	var total int64
+
+	query := database.Conn(ctx, r.db).Model(&entity.DisposalRequest{})
+	for key, value := range filters {
+		switch key {
+		case "status":
+			query = query.Where("status = ?", value)
+		case "asset_id":
+			query = query.Where("asset_id = ?", value)
+		case "requested_by":
+			query = query.Where("requested_by = ?", value)
+		case "scope":
+			clause, args := scopeClause(value.([]policy.Condition), assetScopeColumns)
+			query = query.Where("asset_id IN (SELECT id FROM assets WHERE "+clause+")", args...)
+		}
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Preload("Asset").Order("created_at DESC").Limit(limit).Offset(offset).Find(&disposals).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return disposals, int(total), nil
+}
+
+func (r *DisposalRepositoryImpl) GetPendingByAsset(ctx context.Context, assetID uuid.UUID) (*entity.DisposalRequest, error) {
+	var disposal entity.DisposalRequest
+	err := database.Conn(ctx, r.db).Where("asset_id = ? AND status = ?", assetID, "pending").First(&disposal).Error
+	if err != nil {
+		return nil, err
+	}
+	return &disposal, nil
+}
@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/enum"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/pkg/database"
+)
+
+type ScheduledJobRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewScheduledJobRepository(db *gorm.DB) repository.ScheduledJobRepository {
+	return &ScheduledJobRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *ScheduledJobRepositoryImpl) Upsert(ctx context.Context, job *entity.ScheduledJob) error {
+	return database.Conn(ctx, r.db).Exec(`
+		INSERT INTO scheduled_jobs (name, description, schedule, next_run_at, created_at, updated_at)
+		VALUES (?, ?, ?, ?, NOW(), NOW())
+		ON CONFLICT (name) DO UPDATE SET
+			description = EXCLUDED.description,
+			next_run_at = CASE WHEN scheduled_jobs.schedule = EXCLUDED.schedule
+				THEN scheduled_jobs.next_run_at ELSE EXCLUDED.next_run_at END,
+			schedule = EXCLUDED.schedule,
+			updated_at = NOW()`,
+		job.Name, job.Description, job.Schedule, job.NextRunAt).Error
+}
+
+func (r *ScheduledJobRepositoryImpl) GetByName(ctx context.Context, name string) (*entity.ScheduledJob, error) {
+	var job entity.ScheduledJob
+	err := database.Conn(ctx, r.db).Where("name = ?", name).First(&job).Error
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+func (r *ScheduledJobRepositoryImpl) List(ctx context.Context) ([]*entity.ScheduledJob, error) {
+	var jobs []*entity.ScheduledJob
+	err := database.Conn(ctx, r.db).Order("name ASC").Find(&jobs).Error
+	if err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+func (r *ScheduledJobRepositoryImpl) Claim(ctx context.Context, name, owner string, now, leaseUntil time.Time, dueOnly bool) (bool, error) {
+	query := database.Conn(ctx, r.db).Model(&entity.ScheduledJob{}).
+		Where("name = ?", name).
+		Where("(lease_until IS NULL OR lease_until < ?)", now)
+	if dueOnly {
+		query = query.Where("next_run_at <= ?", now)
+	}
+
+	result := query.Updates(map[string]interface{}{
+		"lease_owner": owner,
+		"lease_until": leaseUntil,
+	})
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *ScheduledJobRepositoryImpl) Release(ctx context.Context, name, owner string, nextRunAt *time.Time) error {
+	updates := map[string]interface{}{
+		"lease_owner": "",
+		"lease_until": nil,
+	}
+	if nextRunAt != nil {
+		updates["next_run_at"] = *nextRunAt
+	}
+
+	return database.Conn(ctx, r.db).Model(&entity.ScheduledJob{}).
+		Where("name = ? AND lease_owner = ?", name, owner).
+		Updates(updates).Error
+}
+
+type JobRunRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewJobRunRepository(db *gorm.DB) repository.JobRunRepository {
+	return &JobRunRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *JobRunRepositoryImpl) Create(ctx context.Context, run *entity.JobRun) error {
+	return database.Conn(ctx, r.db).Create(run).Error
+}
+
+func (r *JobRunRepositoryImpl) Update(ctx context.Context, run *entity.JobRun) error {
+	return database.Conn(ctx, r.db).Save(run).Error
+}
+
+func (r *JobRunRepositoryImpl) ListByJob(ctx context.Context, jobName string, limit, offset int) ([]*entity.JobRun, int, error) {
+	var runs []*entity.JobRun
+	var total int64
+
+	query := database.Conn(ctx, r.db).Model(&entity.JobRun{}).Where("job_name = ?", jobName)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Order("started_at DESC").Limit(limit).Offset(offset).Find(&runs).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return runs, int(total), nil
+}
+
+func (r *JobRunRepositoryImpl) LatestByJob(ctx context.Context) (map[string]*entity.JobRun, error) {
+	var runs []*entity.JobRun
+	err := database.Conn(ctx, r.db).Raw(`
+		SELECT DISTINCT ON (job_name) *
+		FROM job_runs
+		ORDER BY job_name, started_at DESC`).
+		Scan(&runs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	latest := make(map[string]*entity.JobRun, len(runs))
+	for _, run := range runs {
+		latest[run.JobName] = run
+	}
+	return latest, nil
+}
+
+func (r *JobRunRepositoryImpl) FailRunning(ctx context.Context, jobName, reason string, at time.Time) error {
+	return database.Conn(ctx, r.db).Model(&entity.JobRun{}).
+		Where("job_name = ? AND status = ?", jobName, string(enum.JobRunRunning)).
+		Updates(map[string]interface{}{
+			"status":      string(enum.JobRunFailed),
+			"error":       reason,
+			"finished_at": at,
+		}).Error
+}
+
+func (r *JobRunRepositoryImpl) DeleteFinishedBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := database.Conn(ctx, r.db).
+		Where("status <> ? AND started_at < ?", string(enum.JobRunRunning), before).
+		Delete(&entity.JobRun{})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,204 @@
+package repository
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/policy"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/pkg/database"
+)
+
+type KitRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewKitRepository(db *gorm.DB) repository.KitRepository {
+	return &KitRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *KitRepositoryImpl) Create(ctx context.Context, kit *entity.Kit) error {
+	if err := database.Conn(ctx, r.db).Omit(clause.Associations).Create(kit).Error; err != nil {
+		return err
+	}
+	return r.createComponents(ctx, kit)
+}
+
+func (r *KitRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.Kit, error) {
+	var kit entity.Kit
+	err := database.Conn(ctx, r.db).Preload("Components", orderByPosition).Preload("Components.Asset").
+		Where("id = ?", id).First(&kit).Error
+	if err != nil {
+		return nil, err
+	}
+	return &kit, nil
+}
+
+func (r *KitRepositoryImpl) GetByName(ctx context.Context, name string) (*entity.Kit, error) {
+	var kit entity.Kit
+	err := database.Conn(ctx, r.db).Where("LOWER(name) = LOWER(?)", name).First(&kit).Error
+	if err != nil {
+		return nil, err
+	}
+	return &kit, nil
+}
+
+func (r *KitRepositoryImpl) Update(ctx context.Context, kit *entity.Kit) error {
+	db := database.Conn(ctx, r.db)
+	if err := db.Omit(clause.Associations).Save(kit).Error; err != nil {
+		return err
+	}
+	if err := db.Where("kit_id = ?", kit.ID).Delete(&entity.KitComponent{}).Error; err != nil {
+		return err
+	}
+	return r.createComponents(ctx, kit)
+}
+
+func (r *KitRepositoryImpl) createComponents(ctx context.Context, kit *entity.Kit) error {
+	if len(kit.Components) == 0 {
+		return nil
+	}
+	for _, component := range kit.Components {
+		component.KitID = kit.ID
+	}
+	return database.Conn(ctx, r.db).Omit(clause.Associations).Create(&kit.Components).Error
+}
+
+func (r *KitRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return database.Conn(ctx, r.db).Delete(&entity.Kit{}, "id = ?", id).Error
+}
+
+func (r *KitRepositoryImpl) List(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.Kit, int, error) {
+	var kits []*entity.Kit
+	var total int64
+
+	query := database.Conn(ctx, r.db).Model(&entity.Kit{})
+	for key, value := range filters {
+		switch key {
+		case "name":
+			query = query.Where("name ILIKE ?", "%"+value.(string)+"%")
+		case "asset_id":
+			query = query.Where("id IN (SELECT kit_id FROM kit_components WHERE asset_id = ?)", value)
+		case "category":
+			query = query.Where("id IN (SELECT kit_id FROM kit_components WHERE LOWER(category) = LOWER(?))", value)
+		}
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Preload("Components", orderByPosition).Preload("Components.Asset").
+		Order("name ASC").Limit(limit).Offset(offset).Find(&kits).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return kits, int(total), nil
+}
+
+func (r *KitRepositoryImpl) ListCategoryAssets(ctx context.Context, categories []string, filters map[string]interface{}) ([]*entity.Asset, error) {
+	var assets []*entity.Asset
+	if len(categories) == 0 {
+		return assets, nil
+	}
+
+	lower := make([]string, len(categories))
+	for i, category := range categories {
+		lower[i] = strings.ToLower(category)
+	}
+	query := database.Conn(ctx, r.db).
+		Where("LOWER(category) IN ? AND status = ? AND qty > 0", lower, "available").
+		Where("id NOT IN (SELECT asset_id FROM asset_units)")
+	if conditions, ok := filters["scope"]; ok {
+		clause, args := scopeClause(conditions.([]policy.Condition), assetScopeColumns)
+		query = query.Where(clause, args...)
+	}
+
+	err := query.Order("name ASC, id ASC").Find(&assets).Error
+	if err != nil {
+		return nil, err
+	}
+	return assets, nil
+}
+
+type KitCheckoutRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewKitCheckoutRepository(db *gorm.DB) repository.KitCheckoutRepository {
+	return &KitCheckoutRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *KitCheckoutRepositoryImpl) Create(ctx context.Context, checkout *entity.KitCheckout) error {
+	return database.Conn(ctx, r.db).Omit(clause.Associations).Create(checkout).Error
+}
+
+func (r *KitCheckoutRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.KitCheckout, error) {
+	var checkout entity.KitCheckout
+	err := database.Conn(ctx, r.db).Preload("Kit").Preload("Items").Preload("Items.Asset").
+		Where("id = ?", id).First(&checkout).Error
+	if err != nil {
+		return nil, err
+	}
+	return &checkout, nil
+}
+
+func (r *KitCheckoutRepositoryImpl) Update(ctx context.Context, checkout *entity.KitCheckout) error {
+	return database.Conn(ctx, r.db).Omit(clause.Associations).Save(checkout).Error
+}
+
+func (r *KitCheckoutRepositoryImpl) List(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.KitCheckout, int, error) {
+	var checkouts []*entity.KitCheckout
+	var total int64
+
+	query := database.Conn(ctx, r.db).Model(&entity.KitCheckout{})
+	for key, value := range filters {
+		switch key {
+		case "kit_id":
+			query = query.Where("kit_id = ?", value)
+		case "user_id":
+			query = query.Where("user_id = ?", value)
+		case "open":
+			if value.(bool) {
+				query = query.Where("returned_at IS NULL")
+			} else {
+				query = query.Where("returned_at IS NOT NULL")
+			}
+		case "scope":
+			clause, args := scopeClause(value.([]policy.Condition), assetScopeColumns)
+			query = query.Where("id IN (SELECT kit_checkout_id FROM asset_checkouts WHERE asset_id IN (SELECT id FROM assets WHERE "+clause+"))", args...)
+		}
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Preload("Kit").Preload("Items").Preload("Items.Asset").
+		Order("checked_out_at DESC").Limit(limit).Offset(offset).Find(&checkouts).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return checkouts, int(total), nil
+}
+
+func (r *KitCheckoutRepositoryImpl) CountOpen(ctx context.Context, kitID uuid.UUID) (int, error) {
+	var count int64
+	err := database.Conn(ctx, r.db).Model(&entity.KitCheckout{}).
+		Where("kit_id = ? AND returned_at IS NULL", kitID).
+		Count(&count).Error
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
@@ -7,6 +7,7 @@ import (
 	"gorm.io/gorm"
 	"inventory-ticketing-system/domain/entity"
 	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/pkg/database"
 )
 
 type LocationRepositoryImpl struct {
@@ -20,12 +21,12 @@ func NewLocationRepository(db *gorm.DB) repository.LocationRepository {
 }
 
 func (r *LocationRepositoryImpl) Create(ctx context.Context, location *entity.Location) error {
-	return r.db.WithContext(ctx).Create(location).Error
+	return database.Conn(ctx, r.db).Create(location).Error
 }
 
 func (r *LocationRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.Location, error) {
 	var location entity.Location
-	err := r.db.WithContext(ctx).Where("id = ?", id).First(&location).Error
+	err := database.Conn(ctx, r.db).Where("id = ?", id).First(&location).Error
 	if err != nil {
 		return nil, err
 	}
@@ -33,18 +34,18 @@ func (r *LocationRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*en
 }
 
 func (r *LocationRepositoryImpl) Update(ctx context.Context, location *entity.Location) error {
-	return r.db.WithContext(ctx).Save(location).Error
+	return database.Conn(ctx, r.db).Save(location).Error
 }
 
 func (r *LocationRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
-	return r.db.WithContext(ctx).Delete(&entity.Location{}, "id = ?", id).Error
+	return database.Conn(ctx, r.db).Delete(&entity.Location{}, "id = ?", id).Error
 }
 
 func (r *LocationRepositoryImpl) List(ctx context.Context, limit, offset int) ([]*entity.Location, int, error) {
 	var locations []*entity.Location
 	var total int64
 
-	query := r.db.WithContext(ctx).Model(&entity.Location{})
+	query := database.Conn(ctx, r.db).Model(&entity.Location{})
 
 	if err := query.Count(&total).Error; err != nil {
 		return nil, 0, err
@@ -60,9 +61,25 @@ func (r *LocationRepositoryImpl) List(ctx context.Context, limit, offset int) ([
 
 func (r *LocationRepositoryImpl) GetByName(ctx context.Context, name string) (*entity.Location, error) {
 	var location entity.Location
-	err := r.db.WithContext(ctx).Where("name = ?", name).First(&location).Error
+	err := database.Conn(ctx, r.db).Where("name = ?", name).First(&location).Error
 	if err != nil {
 		return nil, err
 	}
 	return &location, nil
+}
+
+// ListDescendantIDs returns the location and every location below it.
+func (r *LocationRepositoryImpl) ListDescendantIDs(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := database.Conn(ctx, r.db).Raw(`
+		WITH RECURSIVE subtree AS (
+			SELECT id FROM locations WHERE id = ?
+			UNION
+			SELECT l.id FROM locations l JOIN subtree s ON l.parent_id = s.id
+		)
+		SELECT id FROM subtree`, id).Scan(&ids).Error
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
 }
\ No newline at end of file
@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/pkg/database"
+)
+
+type MaintenancePlanRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewMaintenancePlanRepository(db *gorm.DB) repository.MaintenancePlanRepository {
+	return &MaintenancePlanRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *MaintenancePlanRepositoryImpl) Create(ctx context.Context, plan *entity.MaintenancePlan) error {
+	return database.Conn(ctx, r.db).Create(plan).Error
+}
+
+func (r *MaintenancePlanRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.MaintenancePlan, error) {
+	var plan entity.MaintenancePlan
+	err := database.Conn(ctx, r.db).Where("id = ?", id).First(&plan).Error
+	if err != nil {
+		return nil, err
+	}
+	return &plan, nil
+}
+
+func (r *MaintenancePlanRepositoryImpl) Update(ctx context.Context, plan *entity.MaintenancePlan) error {
+	return database.Conn(ctx, r.db).Save(plan).Error
+}
+
+func (r *MaintenancePlanRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return database.Conn(ctx, r.db).Delete(&entity.MaintenancePlan{}, "id = ?", id).Error
+}
+
+func (r *MaintenancePlanRepositoryImpl) List(ctx context.Context, filters map[string]interface{}) ([]*entity.MaintenancePlan, error) {
+	query := database.Conn(ctx, r.db).Model(&entity.MaintenancePlan{})
+	for key, value := range filters {
+		switch key {
+		case "asset_id":
+			query = query.Where("asset_id = ?", value)
+		case "category":
+			query = query.Where("category = ?", value)
+		case "is_active":
+			query = query.Where("is_active = ?", value)
+		}
+	}
+
+	var plans []*entity.MaintenancePlan
+	err := query.Order("name ASC").Find(&plans).Error
+	if err != nil {
+		return nil, err
+	}
+	return plans, nil
+}
+
+type MaintenanceScheduleRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewMaintenanceScheduleRepository(db *gorm.DB) repository.MaintenanceScheduleRepository {
+	return &MaintenanceScheduleRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *MaintenanceScheduleRepositoryImpl) Create(ctx context.Context, schedule *entity.MaintenanceSchedule) error {
+	return database.Conn(ctx, r.db).
+		Omit(clause.Associations).
+		Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "plan_id"}, {Name: "asset_id"}}, DoNothing: true}).
+		Create(schedule).Error
+}
+
+func (r *MaintenanceScheduleRepositoryImpl) Update(ctx context.Context, schedule *entity.MaintenanceSchedule) error {
+	return database.Conn(ctx, r.db).Omit(clause.Associations).Save(schedule).Error
+}
+
+func (r *MaintenanceScheduleRepositoryImpl) LockByPlanAndAsset(ctx context.Context, planID, assetID uuid.UUID) (*entity.MaintenanceSchedule, error) {
+	var schedule entity.MaintenanceSchedule
+	err := database.Conn(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("plan_id = ? AND asset_id = ?", planID, assetID).
+		First(&schedule).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &schedule, nil
+}
+
+func (r *MaintenanceScheduleRepositoryImpl) GetByOpenTicket(ctx context.Context, ticketID uuid.UUID) (*entity.MaintenanceSchedule, error) {
+	var schedule entity.MaintenanceSchedule
+	err := database.Conn(ctx, r.db).
+		Preload("Plan").
+		Preload("Asset").
+		Where("open_ticket_id = ?", ticketID).
+		First(&schedule).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &schedule, nil
+}
+
+func (r *MaintenanceScheduleRepositoryImpl) ListCalendar(ctx context.Context) ([]*entity.MaintenanceSchedule, error) {
+	var schedules []*entity.MaintenanceSchedule
+	err := database.Conn(ctx, r.db).
+		Preload("Plan").
+		Preload("Asset").
+		Joins("JOIN maintenance_plans ON maintenance_plans.id = maintenance_schedules.plan_id").
+		Where("maintenance_plans.is_active = ? AND maintenance_plans.interval_days > 0", true).
+		Where("maintenance_schedules.next_due_at IS NOT NULL").
+		Order("maintenance_schedules.next_due_at ASC").
+		Find(&schedules).Error
+	if err != nil {
+		return nil, err
+	}
+	return schedules, nil
+}
+
+func (r *MaintenanceScheduleRepositoryImpl) DeleteStale(ctx context.Context, planID uuid.UUID, assetIDs []uuid.UUID) error {
+	query := database.Conn(ctx, r.db).Where("plan_id = ? AND open_ticket_id IS NULL", planID)
+	if len(assetIDs) > 0 {
+		query = query.Where("asset_id NOT IN ?", assetIDs)
+	}
+	return query.Delete(&entity.MaintenanceSchedule{}).Error
+}
+
+type MaintenanceRecordRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewMaintenanceRecordRepository(db *gorm.DB) repository.MaintenanceRecordRepository {
+	return &MaintenanceRecordRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *MaintenanceRecordRepositoryImpl) Create(ctx context.Context, record *entity.MaintenanceRecord) error {
+	return database.Conn(ctx, r.db).Create(record).Error
+}
+
+func (r *MaintenanceRecordRepositoryImpl) ListByPlan(ctx context.Context, planID uuid.UUID, limit, offset int) ([]*entity.MaintenanceRecord, int, error) {
+	var records []*entity.MaintenanceRecord
+	var total int64
+
+	query := database.Conn(ctx, r.db).Model(&entity.MaintenanceRecord{}).Where("plan_id = ?", planID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Order("performed_at DESC").Limit(limit).Offset(offset).Find(&records).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return records, int(total), nil
+}
@@ -0,0 +1,191 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/pkg/database"
+)
+
+type NotificationTemplateRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewNotificationTemplateRepository(db *gorm.DB) repository.NotificationTemplateRepository {
+	return &NotificationTemplateRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *NotificationTemplateRepositoryImpl) Create(ctx context.Context, template *entity.NotificationTemplate) error {
+	return database.Conn(ctx, r.db).Create(template).Error
+}
+
+func (r *NotificationTemplateRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.NotificationTemplate, error) {
+	var template entity.NotificationTemplate
+	err := database.Conn(ctx, r.db).Where("id = ?", id).First(&template).Error
+	if err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
+
+func (r *NotificationTemplateRepositoryImpl) GetByKindAndLocale(ctx context.Context, kind, locale string) (*entity.NotificationTemplate, error) {
+	var template entity.NotificationTemplate
+	err := database.Conn(ctx, r.db).Where("kind = ? AND locale = ?", kind, locale).First(&template).Error
+	if err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
+
+func (r *NotificationTemplateRepositoryImpl) Update(ctx context.Context, template *entity.NotificationTemplate) error {
+	return database.Conn(ctx, r.db).Save(template).Error
+}
+
+func (r *NotificationTemplateRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return database.Conn(ctx, r.db).Delete(&entity.NotificationTemplate{}, "id = ?", id).Error
+}
+
+func (r *NotificationTemplateRepositoryImpl) List(ctx context.Context) ([]*entity.NotificationTemplate, error) {
+	var templates []*entity.NotificationTemplate
+	err := database.Conn(ctx, r.db).Order("kind ASC, locale ASC").Find(&templates).Error
+	if err != nil {
+		return nil, err
+	}
+	return templates, nil
+}
+
+type NotificationPreferenceRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewNotificationPreferenceRepository(db *gorm.DB) repository.NotificationPreferenceRepository {
+	return &NotificationPreferenceRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *NotificationPreferenceRepositoryImpl) GetByUserID(ctx context.Context, userID uuid.UUID) (*entity.NotificationPreference, error) {
+	var preference entity.NotificationPreference
+	err := database.Conn(ctx, r.db).Where("user_id = ?", userID).First(&preference).Error
+	if err != nil {
+		return nil, err
+	}
+	return &preference, nil
+}
+
+func (r *NotificationPreferenceRepositoryImpl) Save(ctx context.Context, preference *entity.NotificationPreference) error {
+	return database.Conn(ctx, r.db).Save(preference).Error
+}
+
+type EmailMessageRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewEmailMessageRepository(db *gorm.DB) repository.EmailMessageRepository {
+	return &EmailMessageRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *EmailMessageRepositoryImpl) Create(ctx context.Context, message *entity.EmailMessage) error {
+	return database.Conn(ctx, r.db).Create(message).Error
+}
+
+func (r *EmailMessageRepositoryImpl) Update(ctx context.Context, message *entity.EmailMessage) error {
+	return database.Conn(ctx, r.db).Save(message).Error
+}
+
+func (r *EmailMessageRepositoryImpl) ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*entity.EmailMessage, error) {
+	var messages []*entity.EmailMessage
+	err := database.Conn(ctx, r.db).Raw(`
+		UPDATE email_messages SET next_attempt_at = ?
+		WHERE id IN (
+			SELECT id FROM email_messages
+			WHERE status = 'pending' AND next_attempt_at <= ?
+			ORDER BY next_attempt_at ASC
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`, leaseUntil, now, limit).
+		Scan(&messages).Error
+	if err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+func (r *EmailMessageRepositoryImpl) DeleteSentBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := database.Conn(ctx, r.db).
+		Where("status = ? AND sent_at < ?", "sent", before).
+		Delete(&entity.EmailMessage{})
+	return result.RowsAffected, result.Error
+}
+
+type NotificationRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewNotificationRepository(db *gorm.DB) repository.NotificationRepository {
+	return &NotificationRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *NotificationRepositoryImpl) Create(ctx context.Context, notification *entity.Notification) error {
+	return database.Conn(ctx, r.db).Create(notification).Error
+}
+
+func (r *NotificationRepositoryImpl) ListByRecipient(ctx context.Context, recipientID uuid.UUID, unreadOnly bool, after *entity.NotificationCursor, limit int) ([]*entity.Notification, error) {
+	query := database.Conn(ctx, r.db).Where("recipient_id = ?", recipientID)
+	if unreadOnly {
+		query = query.Where("read_at IS NULL")
+	}
+	if after != nil {
+		query = query.Where("(created_at, id) < (?, ?)", after.CreatedAt, after.ID)
+	}
+
+	var notifications []*entity.Notification
+	err := query.
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&notifications).Error
+	if err != nil {
+		return nil, err
+	}
+	return notifications, nil
+}
+
+func (r *NotificationRepositoryImpl) CountUnread(ctx context.Context, recipientID uuid.UUID) (int64, error) {
+	var count int64
+	err := database.Conn(ctx, r.db).Model(&entity.Notification{}).
+		Where("recipient_id = ? AND read_at IS NULL", recipientID).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *NotificationRepositoryImpl) MarkRead(ctx context.Context, recipientID, id uuid.UUID, at time.Time) (bool, error) {
+	result := database.Conn(ctx, r.db).Model(&entity.Notification{}).
+		Where("id = ? AND recipient_id = ?", id, recipientID).
+		Update("read_at", gorm.Expr("COALESCE(read_at, ?)", at))
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *NotificationRepositoryImpl) MarkAllRead(ctx context.Context, recipientID uuid.UUID, at time.Time) (int64, error) {
+	result := database.Conn(ctx, r.db).Model(&entity.Notification{}).
+		Where("recipient_id = ? AND read_at IS NULL", recipientID).
+		Update("read_at", at)
+	return result.RowsAffected, result.Error
+}
+
+func (r *NotificationRepositoryImpl) DeleteReadBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := database.Conn(ctx, r.db).
+		Where("read_at < ?", before).
+		Delete(&entity.Notification{})
+	return result.RowsAffected, result.Error
+}
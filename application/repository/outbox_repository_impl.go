@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/pkg/database"
+)
+
+type OutboxRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewOutboxRepository(db *gorm.DB) repository.OutboxRepository {
+	return &OutboxRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *OutboxRepositoryImpl) Create(ctx context.Context, event *entity.OutboxEvent) error {
+	return database.Conn(ctx, r.db).Create(event).Error
+}
+
+func (r *OutboxRepositoryImpl) ClaimNext(ctx context.Context, now time.Time) (*entity.OutboxEvent, error) {
+	var event entity.OutboxEvent
+	err := database.Conn(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("processed_at IS NULL AND available_at <= ?", now).
+		Order("occurred_at ASC").
+		First(&event).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+func (r *OutboxRepositoryImpl) MarkProcessed(ctx context.Context, id uuid.UUID, at time.Time) error {
+	return database.Conn(ctx, r.db).
+		Model(&entity.OutboxEvent{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"processed_at": at,
+			"attempts":     gorm.Expr("attempts + 1"),
+			"last_error":   "",
+		}).Error
+}
+
+func (r *OutboxRepositoryImpl) MarkFailed(ctx context.Context, id uuid.UUID, lastError string, retryAt time.Time) error {
+	return database.Conn(ctx, r.db).
+		Model(&entity.OutboxEvent{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"available_at": retryAt,
+			"attempts":     gorm.Expr("attempts + 1"),
+			"last_error":   lastError,
+		}).Error
+}
+
+func (r *OutboxRepositoryImpl) DeleteProcessedBefore(ctx context.Context, before time.Time) (int, error) {
+	result := database.Conn(ctx, r.db).
+		Where("processed_at IS NOT NULL AND processed_at < ?", before).
+		Delete(&entity.OutboxEvent{})
+	return int(result.RowsAffected), result.Error
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/pkg/database"
+)
+
+type PersonalAccessTokenRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewPersonalAccessTokenRepository(db *gorm.DB) repository.PersonalAccessTokenRepository {
+	return &PersonalAccessTokenRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *PersonalAccessTokenRepositoryImpl) Create(ctx context.Context, token *entity.PersonalAccessToken) error {
+	return database.Conn(ctx, r.db).Create(token).Error
+}
+
+func (r *PersonalAccessTokenRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.PersonalAccessToken, error) {
+	var token entity.PersonalAccessToken
+	err := database.Conn(ctx, r.db).Where("id = ?", id).First(&token).Error
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+func (r *PersonalAccessTokenRepositoryImpl) GetByHash(ctx context.Context, tokenHash string) (*entity.PersonalAccessToken, error) {
+	var token entity.PersonalAccessToken
+	err := database.Conn(ctx, r.db).Where("token_hash = ?", tokenHash).First(&token).Error
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+func (r *PersonalAccessTokenRepositoryImpl) ListByUser(ctx context.Context, userID uuid.UUID) ([]*entity.PersonalAccessToken, error) {
+	var tokens []*entity.PersonalAccessToken
+	err := database.Conn(ctx, r.db).
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Find(&tokens).Error
+	if err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+func (r *PersonalAccessTokenRepositoryImpl) Revoke(ctx context.Context, id uuid.UUID, revokedAt time.Time) error {
+	return database.Conn(ctx, r.db).
+		Model(&entity.PersonalAccessToken{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", revokedAt).Error
+}
+
+func (r *PersonalAccessTokenRepositoryImpl) TouchLastUsed(ctx context.Context, id uuid.UUID, usedAt time.Time) error {
+	return database.Conn(ctx, r.db).
+		Model(&entity.PersonalAccessToken{}).
+		Where("id = ?", id).
+		Update("last_used_at", usedAt).Error
+}
+
+// DeleteInactiveBefore removes tokens revoked or expired before the cutoff.
+func (r *PersonalAccessTokenRepositoryImpl) DeleteInactiveBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := database.Conn(ctx, r.db).
+		Where("revoked_at < ? OR expires_at < ?", before, before).
+		Delete(&entity.PersonalAccessToken{})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/policy"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/pkg/database"
+)
+
+type PurchaseRequestRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewPurchaseRequestRepository(db *gorm.DB) repository.PurchaseRequestRepository {
+	return &PurchaseRequestRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *PurchaseRequestRepositoryImpl) Create(ctx context.Context, request *entity.PurchaseRequest) error {
+	return database.Conn(ctx, r.db).Create(request).Error
+}
+
+func (r *PurchaseRequestRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.PurchaseRequest, error) {
+	var request entity.PurchaseRequest
+	err := database.Conn(ctx, r.db).Preload("Items", orderByPosition).Where("id = ?", id).First(&request).Error
+	if err != nil {
+		return nil, err
+	}
+	return &request, nil
+}
+
+func (r *PurchaseRequestRepositoryImpl) Update(ctx context.Context, request *entity.PurchaseRequest) error {
+	return database.Conn(ctx, r.db).Omit(clause.Associations).Save(request).Error
+}
+
+func (r *PurchaseRequestRepositoryImpl) List(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.PurchaseRequest, int, error) {
+	var requests []*entity.PurchaseRequest
+	var total int64
+
+	query := database.Conn(ctx, r.db).Model(&entity.PurchaseRequest{})
+	for key, value := range filters {
+		switch key {
+		case "status":
+			query = query.Where("status = ?", value)
+		case "department_id":
+			query = query.Where("department_id = ?", value)
+		case "requested_by":
+			query = query.Where("requested_by = ?", value)
+		case "scope":
+			// Requesters always see their own requests
+			clause, args := scopeClause(value.([]policy.Condition), purchaseRequestScopeColumns)
+			if requester, ok := filters["requester"]; ok {
+				clause = "(" + clause + " OR requested_by = ?)"
+				args = append(args, requester)
+			}
+			query = query.Where(clause, args...)
+		}
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Preload("Items", orderByPosition).Order("created_at DESC").Limit(limit).Offset(offset).Find(&requests).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return requests, int(total), nil
+}
+
+type PurchaseOrderRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewPurchaseOrderRepository(db *gorm.DB) repository.PurchaseOrderRepository {
+	return &PurchaseOrderRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *PurchaseOrderRepositoryImpl) Create(ctx context.Context, order *entity.PurchaseOrder) error {
+	return database.Conn(ctx, r.db).Omit("Vendor").Create(order).Error
+}
+
+func (r *PurchaseOrderRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.PurchaseOrder, error) {
+	var order entity.PurchaseOrder
+	err := database.Conn(ctx, r.db).Preload("Vendor").Preload("Lines", orderByPosition).
+		Where("id = ?", id).First(&order).Error
+	if err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+func (r *PurchaseOrderRepositoryImpl) Update(ctx context.Context, order *entity.PurchaseOrder) error {
+	return database.Conn(ctx, r.db).Omit(clause.Associations).Save(order).Error
+}
+
+func (r *PurchaseOrderRepositoryImpl) UpdateLine(ctx context.Context, line *entity.PurchaseOrderLine) error {
+	return database.Conn(ctx, r.db).Save(line).Error
+}
+
+func (r *PurchaseOrderRepositoryImpl) List(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.PurchaseOrder, int, error) {
+	var orders []*entity.PurchaseOrder
+	var total int64
+
+	query := database.Conn(ctx, r.db).Model(&entity.PurchaseOrder{})
+	for key, value := range filters {
+		switch key {
+		case "status":
+			query = query.Where("status = ?", value)
+		case "vendor_id":
+			query = query.Where("vendor_id = ?", value)
+		case "request_id":
+			query = query.Where("request_id = ?", value)
+		}
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Preload("Vendor").Preload("Lines", orderByPosition).
+		Order("created_at DESC").Limit(limit).Offset(offset).Find(&orders).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return orders, int(total), nil
+}
+
+type GoodsReceiptRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewGoodsReceiptRepository(db *gorm.DB) repository.GoodsReceiptRepository {
+	return &GoodsReceiptRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *GoodsReceiptRepositoryImpl) Create(ctx context.Context, receipt *entity.GoodsReceipt) error {
+	return database.Conn(ctx, r.db).Create(receipt).Error
+}
+
+func (r *GoodsReceiptRepositoryImpl) ListByOrder(ctx context.Context, orderID uuid.UUID) ([]*entity.GoodsReceipt, error) {
+	var receipts []*entity.GoodsReceipt
+	err := database.Conn(ctx, r.db).Preload("Lines").Where("order_id = ?", orderID).
+		Order("received_at ASC, created_at ASC").Find(&receipts).Error
+	if err != nil {
+		return nil, err
+	}
+	return receipts, nil
+}
+
+func orderByPosition(db *gorm.DB) *gorm.DB {
+	return db.Order("position ASC")
+}
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/policy"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/pkg/database"
+)
+
+type ReservationRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewReservationRepository(db *gorm.DB) repository.ReservationRepository {
+	return &ReservationRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *ReservationRepositoryImpl) Create(ctx context.Context, reservation *entity.Reservation) error {
+	return database.Conn(ctx, r.db).Omit(clause.Associations).Create(reservation).Error
+}
+
+func (r *ReservationRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.Reservation, error) {
+	var reservation entity.Reservation
+	err := database.Conn(ctx, r.db).Preload("Asset").Where("id = ?", id).First(&reservation).Error
+	if err != nil {
+		return nil, err
+	}
+	return &reservation, nil
+}
+
+func (r *ReservationRepositoryImpl) Update(ctx context.Context, reservation *entity.Reservation) error {
+	return database.Conn(ctx, r.db).Omit(clause.Associations).Save(reservation).Error
+}
+
+// List returns reservations by start time. "from" and "to" keep the ones
+// taking up time in that range.
+func (r *ReservationRepositoryImpl) List(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.Reservation, int, error) {
+	var reservations []*entity.Reservation
+	var total int64
+
+	query := database.Conn(ctx, r.db).Model(&entity.Reservation{})
+	for key, value := range filters {
+		switch key {
+		case "asset_id":
+			query = query.Where("asset_id = ?", value)
+		case "user_id":
+			query = query.Where("user_id = ?", value)
+		case "status":
+			query = query.Where("status = ?", value)
+		case "statuses":
+			query = query.Where("status IN ?", value)
+		case "from":
+			query = query.Where("ends_at > ?", value)
+		case "to":
+			query = query.Where("starts_at < ?", value)
+		case "scope":
+			clause, args := scopeClause(value.([]policy.Condition), assetScopeColumns)
+			query = query.Where("asset_id IN (SELECT id FROM assets WHERE "+clause+")", args...)
+		}
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	query = query.Preload("Asset").Preload("Asset.Location").Order("starts_at ASC")
+	if limit > 0 {
+		query = query.Limit(limit).Offset(offset)
+	}
+	if err := query.Find(&reservations).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return reservations, int(total), nil
+}
+
+func (r *ReservationRepositoryImpl) ListOverlapping(ctx context.Context, assetIDs []uuid.UUID, from, to time.Time, excludeID *uuid.UUID) ([]*entity.Reservation, error) {
+	var reservations []*entity.Reservation
+	if len(assetIDs) == 0 {
+		return reservations, nil
+	}
+
+	query := database.Conn(ctx, r.db).
+		Where("asset_id IN ? AND status IN ('confirmed', 'active') AND starts_at < ? AND ends_at > ?", assetIDs, to, from)
+	if excludeID != nil {
+		query = query.Where("id <> ?", *excludeID)
+	}
+	if err := query.Find(&reservations).Error; err != nil {
+		return nil, err
+	}
+	return reservations, nil
+}
+
+func (r *ReservationRepositoryImpl) ListDue(ctx context.Context, now time.Time) ([]*entity.Reservation, error) {
+	var reservations []*entity.Reservation
+	err := database.Conn(ctx, r.db).
+		Where("(status = 'confirmed' AND starts_at <= ?) OR (status = 'active' AND ends_at <= ?)", now, now).
+		Order("starts_at ASC").Find(&reservations).Error
+	if err != nil {
+		return nil, err
+	}
+	return reservations, nil
+}
@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/pkg/database"
+)
+
+type RoleRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewRoleRepository(db *gorm.DB) repository.RoleRepository {
+	return &RoleRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *RoleRepositoryImpl) Create(ctx context.Context, role *entity.Role) error {
+	return database.Conn(ctx, r.db).Create(role).Error
+}
+
+func (r *RoleRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.Role, error) {
+	var role entity.Role
+	err := database.Conn(ctx, r.db).Where("id = ?", id).First(&role).Error
+	if err != nil {
+		return nil, err
+	}
+	return &role, nil
+}
+
+func (r *RoleRepositoryImpl) GetByName(ctx context.Context, name string) (*entity.Role, error) {
+	var role entity.Role
+	err := database.Conn(ctx, r.db).Where("name = ?", name).First(&role).Error
+	if err != nil {
+		return nil, err
+	}
+	return &role, nil
+}
+
+func (r *RoleRepositoryImpl) Update(ctx context.Context, role *entity.Role) error {
+	return database.Conn(ctx, r.db).Save(role).Error
+}
+
+func (r *RoleRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return database.Conn(ctx, r.db).Delete(&entity.Role{}, "id = ?", id).Error
+}
+
+func (r *RoleRepositoryImpl) List(ctx context.Context) ([]*entity.Role, error) {
+	var roles []*entity.Role
+	err := database.Conn(ctx, r.db).Order("name ASC").Find(&roles).Error
+	if err != nil {
+		return nil, err
+	}
+	return roles, nil
+}
+
+// CountUsers counts users holding the role either as primary role or through
+// an assignment.
+func (r *RoleRepositoryImpl) CountUsers(ctx context.Context, role *entity.Role) (int, error) {
+	var primary, assigned int64
+	if err := database.Conn(ctx, r.db).Model(&entity.User{}).Where("role = ?", role.Name).Count(&primary).Error; err != nil {
+		return 0, err
+	}
+	if err := database.Conn(ctx, r.db).Model(&entity.RoleAssignment{}).Where("role_id = ?", role.ID).Count(&assigned).Error; err != nil {
+		return 0, err
+	}
+	return int(primary + assigned), nil
+}
+
+type RoleAssignmentRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewRoleAssignmentRepository(db *gorm.DB) repository.RoleAssignmentRepository {
+	return &RoleAssignmentRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *RoleAssignmentRepositoryImpl) Create(ctx context.Context, assignment *entity.RoleAssignment) error {
+	return database.Conn(ctx, r.db).Create(assignment).Error
+}
+
+func (r *RoleAssignmentRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.RoleAssignment, error) {
+	var assignment entity.RoleAssignment
+	err := database.Conn(ctx, r.db).Preload("Role").Where("id = ?", id).First(&assignment).Error
+	if err != nil {
+		return nil, err
+	}
+	return &assignment, nil
+}
+
+func (r *RoleAssignmentRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return database.Conn(ctx, r.db).Delete(&entity.RoleAssignment{}, "id = ?", id).Error
+}
+
+func (r *RoleAssignmentRepositoryImpl) ListByUser(ctx context.Context, userID uuid.UUID) ([]*entity.RoleAssignment, error) {
+	var assignments []*entity.RoleAssignment
+	err := database.Conn(ctx, r.db).
+		Preload("Role").
+		Where("user_id = ?", userID).
+		Order("created_at ASC").
+		Find(&assignments).Error
+	if err != nil {
+		return nil, err
+	}
+	return assignments, nil
+}
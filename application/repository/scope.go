@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"strings"
+
+	"inventory-ticketing-system/domain/policy"
+)
+
+// scopeColumns names the asset columns that policy conditions filter on.
+type scopeColumns struct {
+	location   string
+	department string
+	category   string
+}
+
+var assetScopeColumns = scopeColumns{location: "location_id", department: "department_id", category: "category"}
+
+// purchaseRequestScopeColumns filters purchase requests, which only have a
+// department, so location and category scoped conditions match nothing.
+var purchaseRequestScopeColumns = scopeColumns{location: "NULL", department: "department_id", category: "''"}
+
+// scopeClause turns policy conditions into a SQL predicate: a row matches if
+// it satisfies any condition, and a condition requires its location set,
+// department set and category when present.
+func scopeClause(conditions []policy.Condition, columns scopeColumns) (string, []interface{}) {
+	var parts []string
+	var args []interface{}
+
+	for _, condition := range conditions {
+		var terms []string
+		if condition.LocationIDs != nil {
+			terms = append(terms, columns.location+" IN ?")
+			args = append(args, condition.LocationIDs)
+		}
+		if condition.DepartmentIDs != nil {
+			terms = append(terms, columns.department+" IN ?")
+			args = append(args, condition.DepartmentIDs)
+		}
+		if condition.Category != "" {
+			terms = append(terms, "LOWER("+columns.category+") = LOWER(?)")
+			args = append(args, condition.Category)
+		}
+		if len(terms) == 0 {
+			terms = append(terms, "TRUE")
+		}
+		parts = append(parts, "("+strings.Join(terms, " AND ")+")")
+	}
+
+	if len(parts) == 0 {
+		return "FALSE", nil
+	}
+	return "(" + strings.Join(parts, " OR ") + ")", args
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/pkg/database"
+)
+
+// signingKeyRotationLock is the advisory lock key that serializes key
+// rotation across instances.
+const signingKeyRotationLock = 7_260_026
+
+type SigningKeyRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewSigningKeyRepository(db *gorm.DB) repository.SigningKeyRepository {
+	return &SigningKeyRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *SigningKeyRepositoryImpl) Create(ctx context.Context, key *entity.SigningKey) error {
+	return database.Conn(ctx, r.db).Create(key).Error
+}
+
+func (r *SigningKeyRepositoryImpl) ListUsable(ctx context.Context, algorithm string, at time.Time) ([]*entity.SigningKey, error) {
+	var keys []*entity.SigningKey
+	err := database.Conn(ctx, r.db).
+		Where("algorithm = ?", algorithm).
+		Where("retires_at IS NULL OR retires_at > ?", at).
+		Order("activated_at DESC").
+		Find(&keys).Error
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+func (r *SigningKeyRepositoryImpl) RetireActive(ctx context.Context, algorithm string, exceptID string, retiresAt time.Time) error {
+	return database.Conn(ctx, r.db).
+		Model(&entity.SigningKey{}).
+		Where("algorithm = ? AND id <> ? AND retires_at IS NULL", algorithm, exceptID).
+		Update("retires_at", retiresAt).Error
+}
+
+func (r *SigningKeyRepositoryImpl) LockRotation(ctx context.Context) error {
+	return database.Conn(ctx, r.db).Exec("SELECT pg_advisory_xact_lock(?)", signingKeyRotationLock).Error
+}
@@ -0,0 +1,314 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/enum"
+	"inventory-ticketing-system/domain/policy"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/pkg/database"
+)
+
+type ReorderPointRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewReorderPointRepository(db *gorm.DB) repository.ReorderPointRepository {
+	return &ReorderPointRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *ReorderPointRepositoryImpl) Create(ctx context.Context, point *entity.ReorderPoint) error {
+	return database.Conn(ctx, r.db).Omit(clause.Associations).Create(point).Error
+}
+
+func (r *ReorderPointRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.ReorderPoint, error) {
+	var point entity.ReorderPoint
+	err := database.Conn(ctx, r.db).Preload("Asset").Where("id = ?", id).First(&point).Error
+	if err != nil {
+		return nil, err
+	}
+	return &point, nil
+}
+
+func (r *ReorderPointRepositoryImpl) GetByAsset(ctx context.Context, assetID uuid.UUID) (*entity.ReorderPoint, error) {
+	var point entity.ReorderPoint
+	err := database.Conn(ctx, r.db).Where("asset_id = ?", assetID).First(&point).Error
+	if err != nil {
+		return nil, err
+	}
+	return &point, nil
+}
+
+func (r *ReorderPointRepositoryImpl) GetByCategory(ctx context.Context, category string) (*entity.ReorderPoint, error) {
+	var point entity.ReorderPoint
+	err := database.Conn(ctx, r.db).
+		Where("asset_id IS NULL AND LOWER(category) = LOWER(?)", category).
+		First(&point).Error
+	if err != nil {
+		return nil, err
+	}
+	return &point, nil
+}
+
+func (r *ReorderPointRepositoryImpl) Update(ctx context.Context, point *entity.ReorderPoint) error {
+	return database.Conn(ctx, r.db).Omit(clause.Associations).Save(point).Error
+}
+
+func (r *ReorderPointRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return database.Conn(ctx, r.db).Delete(&entity.ReorderPoint{}, "id = ?", id).Error
+}
+
+func (r *ReorderPointRepositoryImpl) List(ctx context.Context, filters map[string]interface{}) ([]*entity.ReorderPoint, error) {
+	var points []*entity.ReorderPoint
+
+	query := database.Conn(ctx, r.db).Model(&entity.ReorderPoint{})
+	for key, value := range filters {
+		switch key {
+		case "asset_id":
+			query = query.Where("asset_id = ?", value)
+		case "category":
+			query = query.Where("asset_id IS NULL AND LOWER(category) = LOWER(?)", value)
+		case "scope":
+			// Category reorder points apply everywhere and are always listed
+			clause, args := scopeClause(value.([]policy.Condition), assetScopeColumns)
+			query = query.Where("(asset_id IS NULL OR asset_id IN (SELECT id FROM assets WHERE "+clause+"))", args...)
+		}
+	}
+
+	err := query.Preload("Asset").Order("category ASC, created_at ASC").Find(&points).Error
+	if err != nil {
+		return nil, err
+	}
+	return points, nil
+}
+
+func (r *ReorderPointRepositoryImpl) ListAssets(ctx context.Context, filters map[string]interface{}) ([]*entity.Asset, error) {
+	var assets []*entity.Asset
+
+	query := database.Conn(ctx, r.db).Model(&entity.Asset{}).Preload("Location").
+		Where("status NOT IN ?", []string{"retired", "disposed", "lost"}).
+		Where("(id IN (SELECT asset_id FROM reorder_points WHERE asset_id IS NOT NULL) OR " +
+			"LOWER(category) IN (SELECT LOWER(category) FROM reorder_points WHERE asset_id IS NULL))")
+	for key, value := range filters {
+		switch key {
+		case "asset_id":
+			query = query.Where("id = ?", value)
+		case "category":
+			query = query.Where("LOWER(category) = LOWER(?)", value)
+		case "department_id":
+			query = query.Where("department_id IN ("+departmentSubtreeSQL+")", value)
+		case "scope":
+			clause, args := scopeClause(value.([]policy.Condition), assetScopeColumns)
+			query = query.Where(clause, args...)
+		}
+	}
+
+	err := query.Order("name ASC").Find(&assets).Error
+	if err != nil {
+		return nil, err
+	}
+	return assets, nil
+}
+
+type StockMovementRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewStockMovementRepository(db *gorm.DB) repository.StockMovementRepository {
+	return &StockMovementRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *StockMovementRepositoryImpl) Create(ctx context.Context, movement *entity.StockMovement) error {
+	return database.Conn(ctx, r.db).Create(movement).Error
+}
+
+func (r *StockMovementRepositoryImpl) SumConsumption(ctx context.Context, assetIDs []uuid.UUID, since time.Time) (map[uuid.UUID]int, error) {
+	consumed := make(map[uuid.UUID]int)
+	if len(assetIDs) == 0 {
+		return consumed, nil
+	}
+
+	var rows []struct {
+		AssetID  uuid.UUID
+		Consumed int
+	}
+	err := database.Conn(ctx, r.db).Model(&entity.StockMovement{}).
+		Select("asset_id, SUM(-delta) AS consumed").
+		Where("asset_id IN ? AND kind IN ? AND created_at >= ?", assetIDs,
+			[]string{string(enum.StockMovementIssue), string(enum.StockMovementReturn)}, since).
+		Group("asset_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		// More returned than issued in the period is no consumption
+		if row.Consumed > 0 {
+			consumed[row.AssetID] = row.Consumed
+		}
+	}
+	return consumed, nil
+}
+
+type StockAlertRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewStockAlertRepository(db *gorm.DB) repository.StockAlertRepository {
+	return &StockAlertRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *StockAlertRepositoryImpl) Create(ctx context.Context, alert *entity.StockAlert) error {
+	return database.Conn(ctx, r.db).Create(alert).Error
+}
+
+func (r *StockAlertRepositoryImpl) Update(ctx context.Context, alert *entity.StockAlert) error {
+	return database.Conn(ctx, r.db).Save(alert).Error
+}
+
+func (r *StockAlertRepositoryImpl) ListOpen(ctx context.Context, assetIDs []uuid.UUID) ([]*entity.StockAlert, error) {
+	var alerts []*entity.StockAlert
+
+	query := database.Conn(ctx, r.db).Where("resolved_at IS NULL")
+	if assetIDs != nil {
+		if len(assetIDs) == 0 {
+			return alerts, nil
+		}
+		query = query.Where("asset_id IN ?", assetIDs)
+	}
+
+	err := query.Find(&alerts).Error
+	if err != nil {
+		return nil, err
+	}
+	return alerts, nil
+}
+
+type StockLotRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewStockLotRepository(db *gorm.DB) repository.StockLotRepository {
+	return &StockLotRepositoryImpl{
+		db: db,
+	}
+}
+
+// fefoOrder is the order stock is issued from lots in.
+const fefoOrder = "expires_at ASC NULLS LAST, received_at ASC, lot_number ASC"
+
+func (r *StockLotRepositoryImpl) Create(ctx context.Context, lot *entity.StockLot) error {
+	return database.Conn(ctx, r.db).Omit(clause.Associations).Create(lot).Error
+}
+
+func (r *StockLotRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.StockLot, error) {
+	var lot entity.StockLot
+	err := database.Conn(ctx, r.db).Preload("Asset").Where("id = ?", id).First(&lot).Error
+	if err != nil {
+		return nil, err
+	}
+	return &lot, nil
+}
+
+func (r *StockLotRepositoryImpl) GetByNumber(ctx context.Context, assetID uuid.UUID, lotNumber string) (*entity.StockLot, error) {
+	var lot entity.StockLot
+	err := database.Conn(ctx, r.db).Where("asset_id = ? AND lot_number = ?", assetID, lotNumber).First(&lot).Error
+	if err != nil {
+		return nil, err
+	}
+	return &lot, nil
+}
+
+// Update saves the lot's attributes. The quantity only changes through
+// AdjustQty, so issuing stock and editing a lot cannot undo each other.
+func (r *StockLotRepositoryImpl) Update(ctx context.Context, lot *entity.StockLot) error {
+	return database.Conn(ctx, r.db).Omit(clause.Associations, "qty").Save(lot).Error
+}
+
+func (r *StockLotRepositoryImpl) AdjustQty(ctx context.Context, id uuid.UUID, delta int) (bool, error) {
+	result := database.Conn(ctx, r.db).Model(&entity.StockLot{}).
+		Where("id = ? AND qty + ? >= 0", id, delta).
+		Update("qty", gorm.Expr("qty + ?", delta))
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *StockLotRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return database.Conn(ctx, r.db).Delete(&entity.StockLot{}, "id = ?", id).Error
+}
+
+func (r *StockLotRepositoryImpl) ListByAsset(ctx context.Context, assetID uuid.UUID) ([]*entity.StockLot, error) {
+	var lots []*entity.StockLot
+	err := database.Conn(ctx, r.db).Where("asset_id = ?", assetID).Order(fefoOrder).Find(&lots).Error
+	if err != nil {
+		return nil, err
+	}
+	return lots, nil
+}
+
+func (r *StockLotRepositoryImpl) LockByAsset(ctx context.Context, assetID uuid.UUID) ([]*entity.StockLot, error) {
+	var lots []*entity.StockLot
+	err := database.Conn(ctx, r.db).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("asset_id = ?", assetID).Order(fefoOrder).Find(&lots).Error
+	if err != nil {
+		return nil, err
+	}
+	return lots, nil
+}
+
+func (r *StockLotRepositoryImpl) List(ctx context.Context, filters map[string]interface{}) ([]*entity.StockLot, error) {
+	var lots []*entity.StockLot
+
+	query := database.Conn(ctx, r.db).Model(&entity.StockLot{}).Where("qty > 0")
+	for key, value := range filters {
+		switch key {
+		case "asset_id":
+			query = query.Where("asset_id = ?", value)
+		case "status":
+			query = query.Where("status = ?", value)
+		case "expires_before":
+			query = query.Where("expires_at < ?", value)
+		case "scope":
+			clause, args := scopeClause(value.([]policy.Condition), assetScopeColumns)
+			query = query.Where("asset_id IN (SELECT id FROM assets WHERE "+clause+")", args...)
+		}
+	}
+
+	err := query.Preload("Asset").Order(fefoOrder).Find(&lots).Error
+	if err != nil {
+		return nil, err
+	}
+	return lots, nil
+}
+
+func (r *StockLotRepositoryImpl) CountByAsset(ctx context.Context, assetID uuid.UUID) (int, error) {
+	var count int64
+	err := database.Conn(ctx, r.db).Model(&entity.StockLot{}).Where("asset_id = ?", assetID).Count(&count).Error
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+func (r *StockLotRepositoryImpl) ListExpired(ctx context.Context, day time.Time) ([]*entity.StockLot, error) {
+	var lots []*entity.StockLot
+	err := database.Conn(ctx, r.db).
+		Where("status = ? AND expires_at < ?", "active", day).
+		Order(fefoOrder).
+		Find(&lots).Error
+	if err != nil {
+		return nil, err
+	}
+	return lots, nil
+}
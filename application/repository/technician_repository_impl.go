@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/pkg/database"
+)
+
+// ticketAssignmentLock is the advisory lock key that serializes automatic
+// ticket assignment across instances, so technicians' open ticket counts
+// cannot change between reading and assigning.
+const ticketAssignmentLock = 7_260_029
+
+type TechnicianRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewTechnicianRepository(db *gorm.DB) repository.TechnicianRepository {
+	return &TechnicianRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *TechnicianRepositoryImpl) Create(ctx context.Context, technician *entity.Technician) error {
+	return database.Conn(ctx, r.db).Omit(clause.Associations).Create(technician).Error
+}
+
+func (r *TechnicianRepositoryImpl) GetByUserID(ctx context.Context, userID uuid.UUID) (*entity.Technician, error) {
+	var technician entity.Technician
+	err := database.Conn(ctx, r.db).
+		Preload("User").
+		Preload("Team").
+		Where("user_id = ?", userID).
+		First(&technician).Error
+	if err != nil {
+		return nil, err
+	}
+	return &technician, nil
+}
+
+func (r *TechnicianRepositoryImpl) Update(ctx context.Context, technician *entity.Technician) error {
+	return database.Conn(ctx, r.db).Omit(clause.Associations).Save(technician).Error
+}
+
+func (r *TechnicianRepositoryImpl) Delete(ctx context.Context, userID uuid.UUID) error {
+	return database.Conn(ctx, r.db).Delete(&entity.Technician{}, "user_id = ?", userID).Error
+}
+
+func (r *TechnicianRepositoryImpl) List(ctx context.Context, teamID *uuid.UUID) ([]*entity.Technician, error) {
+	var technicians []*entity.Technician
+	query := database.Conn(ctx, r.db).Preload("User")
+	if teamID != nil {
+		query = query.Where("team_id = ?", *teamID)
+	}
+	err := query.Order("created_at ASC").Find(&technicians).Error
+	if err != nil {
+		return nil, err
+	}
+	return technicians, nil
+}
+
+func (r *TechnicianRepositoryImpl) ListActive(ctx context.Context, teamID *uuid.UUID) ([]*entity.Technician, error) {
+	var technicians []*entity.Technician
+	query := database.Conn(ctx, r.db).Where("is_active = ?", true)
+	if teamID != nil {
+		query = query.Where("team_id = ?", *teamID)
+	}
+	err := query.Order("created_at ASC").Find(&technicians).Error
+	if err != nil {
+		return nil, err
+	}
+	return technicians, nil
+}
+
+func (r *TechnicianRepositoryImpl) TouchLastAssigned(ctx context.Context, userID uuid.UUID, at time.Time) error {
+	return database.Conn(ctx, r.db).
+		Model(&entity.Technician{}).
+		Where("user_id = ?", userID).
+		Update("last_assigned_at", at).Error
+}
+
+func (r *TechnicianRepositoryImpl) LockAssignment(ctx context.Context) error {
+	return database.Conn(ctx, r.db).Exec("SELECT pg_advisory_xact_lock(?)", ticketAssignmentLock).Error
+}
+
+type TeamRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewTeamRepository(db *gorm.DB) repository.TeamRepository {
+	return &TeamRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *TeamRepositoryImpl) Create(ctx context.Context, team *entity.Team) error {
+	return database.Conn(ctx, r.db).Create(team).Error
+}
+
+func (r *TeamRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.Team, error) {
+	var team entity.Team
+	err := database.Conn(ctx, r.db).Where("id = ?", id).First(&team).Error
+	if err != nil {
+		return nil, err
+	}
+	return &team, nil
+}
+
+func (r *TeamRepositoryImpl) GetByName(ctx context.Context, name string) (*entity.Team, error) {
+	var team entity.Team
+	err := database.Conn(ctx, r.db).Where("name = ?", name).First(&team).Error
+	if err != nil {
+		return nil, err
+	}
+	return &team, nil
+}
+
+func (r *TeamRepositoryImpl) Update(ctx context.Context, team *entity.Team) error {
+	return database.Conn(ctx, r.db).Save(team).Error
+}
+
+func (r *TeamRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return database.Conn(ctx, r.db).Delete(&entity.Team{}, "id = ?", id).Error
+}
+
+func (r *TeamRepositoryImpl) List(ctx context.Context) ([]*entity.Team, error) {
+	var teams []*entity.Team
+	err := database.Conn(ctx, r.db).Order("name ASC").Find(&teams).Error
+	if err != nil {
+		return nil, err
+	}
+	return teams, nil
+}
+
+func (r *TeamRepositoryImpl) CountTechnicians(ctx context.Context, id uuid.UUID) (int, error) {
+	var count int64
+	err := database.Conn(ctx, r.db).
+		Model(&entity.Technician{}).
+		Where("team_id = ?", id).
+		Count(&count).Error
+	return int(count), err
+}
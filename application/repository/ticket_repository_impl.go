@@ -2,11 +2,15 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/policy"
 	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/pkg/database"
 )
 
 type TicketRepositoryImpl struct {
@@ -20,12 +24,12 @@ func NewTicketRepository(db *gorm.DB) repository.TicketRepository {
 }
 
 func (r *TicketRepositoryImpl) Create(ctx context.Context, ticket *entity.Ticket) error {
-	return r.db.WithContext(ctx).Create(ticket).Error
+	return database.Conn(ctx, r.db).Create(ticket).Error
 }
 
 func (r *TicketRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.Ticket, error) {
 	var ticket entity.Ticket
-	err := r.db.WithContext(ctx).
+	err := database.Conn(ctx, r.db).
 		Preload("Asset").
 		Where("id = ?", id).
 		First(&ticket).Error
@@ -36,29 +40,48 @@ func (r *TicketRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*enti
 }
 
 func (r *TicketRepositoryImpl) Update(ctx context.Context, ticket *entity.Ticket) error {
-	return r.db.WithContext(ctx).Save(ticket).Error
+	return database.Conn(ctx, r.db).Omit(clause.Associations).Save(ticket).Error
 }
 
 func (r *TicketRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
-	return r.db.WithContext(ctx).Delete(&entity.Ticket{}, "id = ?", id).Error
+	return database.Conn(ctx, r.db).Delete(&entity.Ticket{}, "id = ?", id).Error
 }
 
 func (r *TicketRepositoryImpl) List(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.Ticket, int, error) {
 	var tickets []*entity.Ticket
 	var total int64
 
-	query := r.db.WithContext(ctx).Model(&entity.Ticket{})
+	query := database.Conn(ctx, r.db).Model(&entity.Ticket{})
 
 	for key, value := range filters {
 		switch key {
 		case "status":
 			query = query.Where("status = ?", value)
+		case "unfinished":
+			if value.(bool) {
+				query = query.Where("status IN ?", []string{"open", "in_progress"})
+			}
 		case "asset_id":
 			query = query.Where("asset_id = ?", value)
+		case "asset_tree":
+			query = query.Where("asset_id IN ("+assetComponentsSQL+")", value)
+		case "unit_id":
+			query = query.Where("unit_id = ?", value)
 		case "severity":
 			query = query.Where("severity = ?", value)
 		case "category":
 			query = query.Where("category ILIKE ?", "%"+value.(string)+"%")
+		case "assigned_to":
+			query = query.Where("assigned_to = ?", value)
+		case "team_id":
+			query = query.Where("team_id = ?", value)
+		case "department_id":
+			query = query.Where("asset_id IN (SELECT id FROM assets WHERE department_id IN ("+departmentSubtreeSQL+"))", value)
+		case "service_vendor_id":
+			query = query.Where("asset_id IN (SELECT id FROM assets WHERE service_vendor_id = ?)", value)
+		case "scope":
+			clause, args := scopeClause(value.([]policy.Condition), assetScopeColumns)
+			query = query.Where("asset_id IN (SELECT id FROM assets WHERE "+clause+")", args...)
 		}
 	}
 
@@ -76,7 +99,7 @@ func (r *TicketRepositoryImpl) List(ctx context.Context, limit, offset int, filt
 
 func (r *TicketRepositoryImpl) GetByAssetID(ctx context.Context, assetID uuid.UUID) ([]*entity.Ticket, error) {
 	var tickets []*entity.Ticket
-	err := r.db.WithContext(ctx).
+	err := database.Conn(ctx, r.db).
 		Preload("Asset").
 		Where("asset_id = ?", assetID).
 		Order("created_at DESC").
@@ -89,7 +112,7 @@ func (r *TicketRepositoryImpl) GetByAssetID(ctx context.Context, assetID uuid.UU
 
 func (r *TicketRepositoryImpl) GetByReporter(ctx context.Context, reporterID uuid.UUID) ([]*entity.Ticket, error) {
 	var tickets []*entity.Ticket
-	err := r.db.WithContext(ctx).
+	err := database.Conn(ctx, r.db).
 		Preload("Asset").
 		Where("reporting = ?", reporterID).
 		Order("created_at DESC").
@@ -98,4 +121,88 @@ func (r *TicketRepositoryImpl) GetByReporter(ctx context.Context, reporterID uui
 		return nil, err
 	}
 	return tickets, nil
-}
\ No newline at end of file
+}
+
+// ListQueue returns unfinished tickets assigned to the technician plus
+// unassigned tickets waiting in the given team queues, most urgent first.
+func (r *TicketRepositoryImpl) ListQueue(ctx context.Context, assigneeID uuid.UUID, teamIDs []uuid.UUID) ([]*entity.Ticket, error) {
+	var tickets []*entity.Ticket
+	query := database.Conn(ctx, r.db).
+		Preload("Asset").
+		Where("status IN ?", []string{"open", "in_progress"})
+
+	if len(teamIDs) > 0 {
+		query = query.Where("(assigned_to = ? OR (assigned_to IS NULL AND team_id IN ?))", assigneeID, teamIDs)
+	} else {
+		query = query.Where("assigned_to = ?", assigneeID)
+	}
+
+	err := query.Order("due_date ASC").Order("created_at ASC").Find(&tickets).Error
+	if err != nil {
+		return nil, err
+	}
+	return tickets, nil
+}
+
+func (r *TicketRepositoryImpl) CountOpenByAssignee(ctx context.Context, assigneeIDs []uuid.UUID) (map[uuid.UUID]int, error) {
+	counts := make(map[uuid.UUID]int, len(assigneeIDs))
+	if len(assigneeIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		AssignedTo uuid.UUID
+		Count      int
+	}
+	err := database.Conn(ctx, r.db).
+		Model(&entity.Ticket{}).
+		Select("assigned_to, COUNT(*) AS count").
+		Where("assigned_to IN ? AND status IN ?", assigneeIDs, []string{"open", "in_progress"}).
+		Group("assigned_to").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		counts[row.AssignedTo] = row.Count
+	}
+	return counts, nil
+}
+
+// ListDueWithoutWarning returns unfinished tickets due before dueBefore that
+// have not had an SLA warning yet.
+func (r *TicketRepositoryImpl) ListDueWithoutWarning(ctx context.Context, dueBefore time.Time) ([]*entity.Ticket, error) {
+	var tickets []*entity.Ticket
+	err := database.Conn(ctx, r.db).
+		Preload("Asset").
+		Where("status IN ?", []string{"open", "in_progress"}).
+		Where("due_date <= ? AND sla_warning_sent_at IS NULL", dueBefore).
+		Order("due_date ASC").
+		Find(&tickets).Error
+	if err != nil {
+		return nil, err
+	}
+	return tickets, nil
+}
+
+// ListResolvedBefore returns resolved tickets not updated since before.
+func (r *TicketRepositoryImpl) ListResolvedBefore(ctx context.Context, before time.Time) ([]*entity.Ticket, error) {
+	var tickets []*entity.Ticket
+	err := database.Conn(ctx, r.db).
+		Preload("Asset").
+		Where("status = ? AND updated_at < ?", "resolved", before).
+		Order("updated_at ASC").
+		Find(&tickets).Error
+	if err != nil {
+		return nil, err
+	}
+	return tickets, nil
+}
+
+func (r *TicketRepositoryImpl) MarkSLAWarningSent(ctx context.Context, id uuid.UUID, at time.Time) error {
+	return database.Conn(ctx, r.db).
+		Model(&entity.Ticket{}).
+		Where("id = ?", id).
+		Update("sla_warning_sent_at", at).Error
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/pkg/database"
+)
+
+type TransactionManagerImpl struct {
+	db *gorm.DB
+}
+
+func NewTransactionManager(db *gorm.DB) repository.TransactionManager {
+	return &TransactionManagerImpl{
+		db: db,
+	}
+}
+
+func (m *TransactionManagerImpl) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return database.Transaction(ctx, m.db, fn)
+}
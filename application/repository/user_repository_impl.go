@@ -7,6 +7,7 @@ import (
 	"gorm.io/gorm"
 	"inventory-ticketing-system/domain/entity"
 	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/pkg/database"
 )
 
 type UserRepositoryImpl struct {
@@ -20,12 +21,12 @@ func NewUserRepository(db *gorm.DB) repository.UserRepository {
 }
 
 func (r *UserRepositoryImpl) Create(ctx context.Context, user *entity.User) error {
-	return r.db.WithContext(ctx).Create(user).Error
+	return database.Conn(ctx, r.db).Create(user).Error
 }
 
 func (r *UserRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.User, error) {
 	var user entity.User
-	err := r.db.WithContext(ctx).Where("id = ?", id).First(&user).Error
+	err := database.Conn(ctx, r.db).Where("id = ?", id).First(&user).Error
 	if err != nil {
 		return nil, err
 	}
@@ -34,7 +35,7 @@ func (r *UserRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity
 
 func (r *UserRepositoryImpl) GetByEmail(ctx context.Context, email string) (*entity.User, error) {
 	var user entity.User
-	err := r.db.WithContext(ctx).Where("email = ?", email).First(&user).Error
+	err := database.Conn(ctx, r.db).Where("email = ?", email).First(&user).Error
 	if err != nil {
 		return nil, err
 	}
@@ -42,16 +43,37 @@ func (r *UserRepositoryImpl) GetByEmail(ctx context.Context, email string) (*ent
 }
 
 func (r *UserRepositoryImpl) Update(ctx context.Context, user *entity.User) error {
-	return r.db.WithContext(ctx).Save(user).Error
+	return database.Conn(ctx, r.db).Save(user).Error
 }
 
 func (r *UserRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
-	return r.db.WithContext(ctx).Delete(&entity.User{}, "id = ?", id).Error
+	return database.Conn(ctx, r.db).Delete(&entity.User{}, "id = ?", id).Error
 }
 
 func (r *UserRepositoryImpl) List(ctx context.Context, limit, offset int) ([]*entity.User, error) {
 	var users []*entity.User
-	err := r.db.WithContext(ctx).Limit(limit).Offset(offset).Find(&users).Error
+	err := database.Conn(ctx, r.db).Limit(limit).Offset(offset).Find(&users).Error
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+func (r *UserRepositoryImpl) ListServiceAccounts(ctx context.Context) ([]*entity.User, error) {
+	var users []*entity.User
+	err := database.Conn(ctx, r.db).Where("is_service_account = ?", true).Order("name ASC").Find(&users).Error
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+func (r *UserRepositoryImpl) ListByRole(ctx context.Context, role string) ([]*entity.User, error) {
+	var users []*entity.User
+	err := database.Conn(ctx, r.db).
+		Where("role = ? AND is_service_account = ?", role, false).
+		Order("name ASC").
+		Find(&users).Error
 	if err != nil {
 		return nil, err
 	}
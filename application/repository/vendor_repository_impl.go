@@ -0,0 +1,201 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/policy"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/pkg/database"
+)
+
+type VendorRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewVendorRepository(db *gorm.DB) repository.VendorRepository {
+	return &VendorRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *VendorRepositoryImpl) Create(ctx context.Context, vendor *entity.Vendor) error {
+	return database.Conn(ctx, r.db).Create(vendor).Error
+}
+
+func (r *VendorRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.Vendor, error) {
+	var vendor entity.Vendor
+	err := database.Conn(ctx, r.db).Where("id = ?", id).First(&vendor).Error
+	if err != nil {
+		return nil, err
+	}
+	return &vendor, nil
+}
+
+func (r *VendorRepositoryImpl) GetByName(ctx context.Context, name string) (*entity.Vendor, error) {
+	var vendor entity.Vendor
+	err := database.Conn(ctx, r.db).Where("LOWER(name) = LOWER(?)", name).First(&vendor).Error
+	if err != nil {
+		return nil, err
+	}
+	return &vendor, nil
+}
+
+func (r *VendorRepositoryImpl) Update(ctx context.Context, vendor *entity.Vendor) error {
+	return database.Conn(ctx, r.db).Save(vendor).Error
+}
+
+func (r *VendorRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	conn := database.Conn(ctx, r.db)
+	if err := conn.Model(&entity.Asset{}).Where("purchase_vendor_id = ?", id).Update("purchase_vendor_id", nil).Error; err != nil {
+		return err
+	}
+	if err := conn.Model(&entity.Asset{}).Where("service_vendor_id = ?", id).Update("service_vendor_id", nil).Error; err != nil {
+		return err
+	}
+	return conn.Delete(&entity.Vendor{}, "id = ?", id).Error
+}
+
+func (r *VendorRepositoryImpl) List(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.Vendor, int, error) {
+	var vendors []*entity.Vendor
+	var total int64
+
+	query := database.Conn(ctx, r.db).Model(&entity.Vendor{})
+
+	for key, value := range filters {
+		switch key {
+		case "search":
+			pattern := "%" + value.(string) + "%"
+			query = query.Where("name ILIKE ? OR email ILIKE ? OR website ILIKE ?", pattern, pattern, pattern)
+		}
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Order("name ASC").Limit(limit).Offset(offset).Find(&vendors).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return vendors, int(total), nil
+}
+
+func (r *VendorRepositoryImpl) Spend(ctx context.Context, id uuid.UUID, filters map[string]interface{}) ([]*entity.VendorSpend, error) {
+	query := database.Conn(ctx, r.db).Model(&entity.Asset{}).
+		Select("currency, COUNT(*) AS asset_count, COALESCE(SUM(purchase_cost), 0) AS total").
+		Where("purchase_vendor_id = ?", id)
+
+	for key, value := range filters {
+		switch key {
+		case "scope":
+			clause, args := scopeClause(value.([]policy.Condition), assetScopeColumns)
+			query = query.Where(clause, args...)
+		}
+	}
+
+	var spend []*entity.VendorSpend
+	err := query.Group("currency").Order("currency").Scan(&spend).Error
+	if err != nil {
+		return nil, err
+	}
+	return spend, nil
+}
+
+type ManufacturerRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewManufacturerRepository(db *gorm.DB) repository.ManufacturerRepository {
+	return &ManufacturerRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *ManufacturerRepositoryImpl) Create(ctx context.Context, manufacturer *entity.Manufacturer) error {
+	return database.Conn(ctx, r.db).Create(manufacturer).Error
+}
+
+func (r *ManufacturerRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.Manufacturer, error) {
+	var manufacturer entity.Manufacturer
+	err := r.withAssetCount(ctx).Where("id = ?", id).First(&manufacturer).Error
+	if err != nil {
+		return nil, err
+	}
+	return &manufacturer, nil
+}
+
+func (r *ManufacturerRepositoryImpl) FindByName(ctx context.Context, name string) (*entity.Manufacturer, error) {
+	var manufacturer entity.Manufacturer
+	err := database.Conn(ctx, r.db).
+		Where("LOWER(name) = LOWER(?) OR EXISTS (SELECT 1 FROM jsonb_array_elements_text(aliases) alias WHERE LOWER(alias) = LOWER(?))", name, name).
+		First(&manufacturer).Error
+	if err != nil {
+		return nil, err
+	}
+	return &manufacturer, nil
+}
+
+func (r *ManufacturerRepositoryImpl) Update(ctx context.Context, manufacturer *entity.Manufacturer) error {
+	return database.Conn(ctx, r.db).Save(manufacturer).Error
+}
+
+func (r *ManufacturerRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	conn := database.Conn(ctx, r.db)
+	if err := conn.Model(&entity.Asset{}).Where("manufacturer_id = ?", id).Update("manufacturer_id", nil).Error; err != nil {
+		return err
+	}
+	return conn.Delete(&entity.Manufacturer{}, "id = ?", id).Error
+}
+
+func (r *ManufacturerRepositoryImpl) List(ctx context.Context, search string) ([]*entity.Manufacturer, error) {
+	query := r.withAssetCount(ctx)
+	if search != "" {
+		query = query.Where("name ILIKE ?", "%"+search+"%")
+	}
+
+	var manufacturers []*entity.Manufacturer
+	err := query.Order("name ASC").Find(&manufacturers).Error
+	if err != nil {
+		return nil, err
+	}
+	return manufacturers, nil
+}
+
+func (r *ManufacturerRepositoryImpl) ReassignAssets(ctx context.Context, fromIDs []uuid.UUID, toID uuid.UUID, brand string) (int, error) {
+	if len(fromIDs) == 0 {
+		return 0, nil
+	}
+	result := database.Conn(ctx, r.db).Model(&entity.Asset{}).
+		Where("manufacturer_id IN ?", fromIDs).
+		Updates(map[string]interface{}{"manufacturer_id": toID, "brand": brand})
+	return int(result.RowsAffected), result.Error
+}
+
+func (r *ManufacturerRepositoryImpl) ListUnlinkedBrands(ctx context.Context) ([]string, error) {
+	var brands []string
+	err := database.Conn(ctx, r.db).Model(&entity.Asset{}).
+		Distinct("brand").
+		Where("manufacturer_id IS NULL AND TRIM(COALESCE(brand, '')) <> ''").
+		Order("brand").
+		Pluck("brand", &brands).Error
+	if err != nil {
+		return nil, err
+	}
+	return brands, nil
+}
+
+func (r *ManufacturerRepositoryImpl) LinkBrand(ctx context.Context, brand string, manufacturerID uuid.UUID, name string) (int, error) {
+	result := database.Conn(ctx, r.db).Model(&entity.Asset{}).
+		Where("manufacturer_id IS NULL AND brand = ?", brand).
+		Updates(map[string]interface{}{"manufacturer_id": manufacturerID, "brand": name})
+	return int(result.RowsAffected), result.Error
+}
+
+func (r *ManufacturerRepositoryImpl) withAssetCount(ctx context.Context) *gorm.DB {
+	return database.Conn(ctx, r.db).Model(&entity.Manufacturer{}).
+		Select("manufacturers.*, (SELECT COUNT(*) FROM assets WHERE assets.manufacturer_id = manufacturers.id) AS asset_count")
+}
@@ -0,0 +1,250 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/pkg/database"
+)
+
+type WarrantyRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewWarrantyRepository(db *gorm.DB) repository.WarrantyRepository {
+	return &WarrantyRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *WarrantyRepositoryImpl) Create(ctx context.Context, warranty *entity.Warranty) error {
+	return database.Conn(ctx, r.db).Omit(clause.Associations).Create(warranty).Error
+}
+
+func (r *WarrantyRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.Warranty, error) {
+	var warranty entity.Warranty
+	err := database.Conn(ctx, r.db).Preload("Asset").Where("id = ?", id).First(&warranty).Error
+	if err != nil {
+		return nil, err
+	}
+	return &warranty, nil
+}
+
+func (r *WarrantyRepositoryImpl) Update(ctx context.Context, warranty *entity.Warranty) error {
+	return database.Conn(ctx, r.db).Omit(clause.Associations).Save(warranty).Error
+}
+
+func (r *WarrantyRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return database.Conn(ctx, r.db).Delete(&entity.Warranty{}, "id = ?", id).Error
+}
+
+func (r *WarrantyRepositoryImpl) ListByAsset(ctx context.Context, assetID uuid.UUID) ([]*entity.Warranty, error) {
+	var warranties []*entity.Warranty
+	err := database.Conn(ctx, r.db).Where("asset_id = ?", assetID).Order("end_date DESC").Find(&warranties).Error
+	if err != nil {
+		return nil, err
+	}
+	return warranties, nil
+}
+
+func (r *WarrantyRepositoryImpl) ListExpiring(ctx context.Context, from, until time.Time, unnotifiedOnly bool) ([]*entity.Warranty, error) {
+	query := database.Conn(ctx, r.db).Preload("Asset").
+		Where("end_date >= ? AND end_date <= ?", from, until)
+	if unnotifiedOnly {
+		query = query.Where("expiry_notified_at IS NULL")
+	}
+
+	var warranties []*entity.Warranty
+	err := query.Order("end_date ASC").Find(&warranties).Error
+	if err != nil {
+		return nil, err
+	}
+	return warranties, nil
+}
+
+func (r *WarrantyRepositoryImpl) MarkExpiryNotified(ctx context.Context, ids []uuid.UUID, at time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return database.Conn(ctx, r.db).Model(&entity.Warranty{}).
+		Where("id IN ?", ids).
+		Update("expiry_notified_at", at).Error
+}
+
+type SupportContractRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewSupportContractRepository(db *gorm.DB) repository.SupportContractRepository {
+	return &SupportContractRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *SupportContractRepositoryImpl) Create(ctx context.Context, contract *entity.SupportContract) error {
+	return database.Conn(ctx, r.db).Create(contract).Error
+}
+
+func (r *SupportContractRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.SupportContract, error) {
+	var contract entity.SupportContract
+	err := database.Conn(ctx, r.db).Where("id = ?", id).First(&contract).Error
+	if err != nil {
+		return nil, err
+	}
+	if err := r.loadAssetIDs(ctx, []*entity.SupportContract{&contract}); err != nil {
+		return nil, err
+	}
+	return &contract, nil
+}
+
+func (r *SupportContractRepositoryImpl) Update(ctx context.Context, contract *entity.SupportContract) error {
+	return database.Conn(ctx, r.db).Save(contract).Error
+}
+
+func (r *SupportContractRepositoryImpl) SetAssets(ctx context.Context, contractID uuid.UUID, assetIDs []uuid.UUID) error {
+	err := database.Conn(ctx, r.db).Where("contract_id = ?", contractID).Delete(&entity.SupportContractAsset{}).Error
+	if err != nil || len(assetIDs) == 0 {
+		return err
+	}
+
+	links := make([]*entity.SupportContractAsset, len(assetIDs))
+	for i, assetID := range assetIDs {
+		links[i] = &entity.SupportContractAsset{ContractID: contractID, AssetID: assetID}
+	}
+	return database.Conn(ctx, r.db).Omit(clause.Associations).Create(&links).Error
+}
+
+func (r *SupportContractRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return database.Conn(ctx, r.db).Delete(&entity.SupportContract{}, "id = ?", id).Error
+}
+
+func (r *SupportContractRepositoryImpl) List(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.SupportContract, int, error) {
+	var contracts []*entity.SupportContract
+	var total int64
+
+	query := database.Conn(ctx, r.db).Model(&entity.SupportContract{})
+	for key, value := range filters {
+		switch key {
+		case "asset_id":
+			query = query.Where("id IN (SELECT contract_id FROM support_contract_assets WHERE asset_id = ?)", value)
+		case "provider":
+			query = query.Where("provider ILIKE ?", "%"+value.(string)+"%")
+		case "active_on":
+			query = query.Where("start_date <= ? AND end_date >= ?", value, value)
+		}
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Order("end_date DESC").Limit(limit).Offset(offset).Find(&contracts).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := r.loadAssetIDs(ctx, contracts); err != nil {
+		return nil, 0, err
+	}
+
+	return contracts, int(total), nil
+}
+
+func (r *SupportContractRepositoryImpl) ListExpiring(ctx context.Context, from, until time.Time, unnotifiedOnly bool) ([]*entity.SupportContract, error) {
+	query := database.Conn(ctx, r.db).Where("end_date >= ? AND end_date <= ?", from, until)
+	if unnotifiedOnly {
+		query = query.Where("expiry_notified_at IS NULL")
+	}
+
+	var contracts []*entity.SupportContract
+	if err := query.Order("end_date ASC").Find(&contracts).Error; err != nil {
+		return nil, err
+	}
+	if err := r.loadAssetIDs(ctx, contracts); err != nil {
+		return nil, err
+	}
+	return contracts, nil
+}
+
+func (r *SupportContractRepositoryImpl) MarkExpiryNotified(ctx context.Context, ids []uuid.UUID, at time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return database.Conn(ctx, r.db).Model(&entity.SupportContract{}).
+		Where("id IN ?", ids).
+		Update("expiry_notified_at", at).Error
+}
+
+// loadAssetIDs fills in the AssetIDs of the contracts with one query.
+func (r *SupportContractRepositoryImpl) loadAssetIDs(ctx context.Context, contracts []*entity.SupportContract) error {
+	if len(contracts) == 0 {
+		return nil
+	}
+	byID := make(map[uuid.UUID]*entity.SupportContract, len(contracts))
+	ids := make([]uuid.UUID, len(contracts))
+	for i, contract := range contracts {
+		contract.AssetIDs = []uuid.UUID{}
+		byID[contract.ID] = contract
+		ids[i] = contract.ID
+	}
+
+	var links []*entity.SupportContractAsset
+	err := database.Conn(ctx, r.db).Where("contract_id IN ?", ids).Find(&links).Error
+	if err != nil {
+		return err
+	}
+	for _, link := range links {
+		contract := byID[link.ContractID]
+		contract.AssetIDs = append(contract.AssetIDs, link.AssetID)
+	}
+	return nil
+}
+
+type WarrantyClaimRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewWarrantyClaimRepository(db *gorm.DB) repository.WarrantyClaimRepository {
+	return &WarrantyClaimRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *WarrantyClaimRepositoryImpl) Create(ctx context.Context, claim *entity.WarrantyClaim) error {
+	return database.Conn(ctx, r.db).Omit(clause.Associations).Create(claim).Error
+}
+
+func (r *WarrantyClaimRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.WarrantyClaim, error) {
+	var claim entity.WarrantyClaim
+	err := database.Conn(ctx, r.db).Preload("Warranty.Asset").Where("id = ?", id).First(&claim).Error
+	if err != nil {
+		return nil, err
+	}
+	return &claim, nil
+}
+
+func (r *WarrantyClaimRepositoryImpl) Update(ctx context.Context, claim *entity.WarrantyClaim) error {
+	return database.Conn(ctx, r.db).Omit(clause.Associations).Save(claim).Error
+}
+
+func (r *WarrantyClaimRepositoryImpl) ListByWarranty(ctx context.Context, warrantyID uuid.UUID) ([]*entity.WarrantyClaim, error) {
+	var claims []*entity.WarrantyClaim
+	err := database.Conn(ctx, r.db).Where("warranty_id = ?", warrantyID).Order("created_at DESC").Find(&claims).Error
+	if err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+func (r *WarrantyClaimRepositoryImpl) ListByTicket(ctx context.Context, ticketID uuid.UUID) ([]*entity.WarrantyClaim, error) {
+	var claims []*entity.WarrantyClaim
+	err := database.Conn(ctx, r.db).Where("ticket_id = ?", ticketID).Order("created_at DESC").Find(&claims).Error
+	if err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
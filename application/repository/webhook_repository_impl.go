@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/pkg/database"
+)
+
+type WebhookEndpointRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewWebhookEndpointRepository(db *gorm.DB) repository.WebhookEndpointRepository {
+	return &WebhookEndpointRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *WebhookEndpointRepositoryImpl) Create(ctx context.Context, endpoint *entity.WebhookEndpoint) error {
+	return database.Conn(ctx, r.db).Create(endpoint).Error
+}
+
+func (r *WebhookEndpointRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.WebhookEndpoint, error) {
+	var endpoint entity.WebhookEndpoint
+	err := database.Conn(ctx, r.db).Where("id = ?", id).First(&endpoint).Error
+	if err != nil {
+		return nil, err
+	}
+	return &endpoint, nil
+}
+
+func (r *WebhookEndpointRepositoryImpl) Update(ctx context.Context, endpoint *entity.WebhookEndpoint) error {
+	return database.Conn(ctx, r.db).Save(endpoint).Error
+}
+
+func (r *WebhookEndpointRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return database.Conn(ctx, r.db).Delete(&entity.WebhookEndpoint{}, "id = ?", id).Error
+}
+
+func (r *WebhookEndpointRepositoryImpl) List(ctx context.Context) ([]*entity.WebhookEndpoint, error) {
+	var endpoints []*entity.WebhookEndpoint
+	err := database.Conn(ctx, r.db).Order("created_at ASC").Find(&endpoints).Error
+	if err != nil {
+		return nil, err
+	}
+	return endpoints, nil
+}
+
+func (r *WebhookEndpointRepositoryImpl) ListActive(ctx context.Context) ([]*entity.WebhookEndpoint, error) {
+	var endpoints []*entity.WebhookEndpoint
+	err := database.Conn(ctx, r.db).Where("is_active = ?", true).Find(&endpoints).Error
+	if err != nil {
+		return nil, err
+	}
+	return endpoints, nil
+}
+
+type WebhookDeliveryRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewWebhookDeliveryRepository(db *gorm.DB) repository.WebhookDeliveryRepository {
+	return &WebhookDeliveryRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *WebhookDeliveryRepositoryImpl) Create(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	return database.Conn(ctx, r.db).Omit(clause.Associations).Create(delivery).Error
+}
+
+func (r *WebhookDeliveryRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*entity.WebhookDelivery, error) {
+	var delivery entity.WebhookDelivery
+	err := database.Conn(ctx, r.db).
+		Preload("Attempts", func(db *gorm.DB) *gorm.DB {
+			return db.Order("attempt_number ASC")
+		}).
+		Where("id = ?", id).
+		First(&delivery).Error
+	if err != nil {
+		return nil, err
+	}
+	return &delivery, nil
+}
+
+func (r *WebhookDeliveryRepositoryImpl) Update(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	return database.Conn(ctx, r.db).Omit(clause.Associations).Save(delivery).Error
+}
+
+func (r *WebhookDeliveryRepositoryImpl) ListByEndpoint(ctx context.Context, endpointID uuid.UUID, limit, offset int, status string) ([]*entity.WebhookDelivery, int, error) {
+	var deliveries []*entity.WebhookDelivery
+	var total int64
+
+	query := database.Conn(ctx, r.db).Model(&entity.WebhookDelivery{}).Where("endpoint_id = ?", endpointID)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Order("created_at DESC").Limit(limit).Offset(offset).Find(&deliveries).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return deliveries, int(total), nil
+}
+
+func (r *WebhookDeliveryRepositoryImpl) ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*entity.WebhookDelivery, error) {
+	var deliveries []*entity.WebhookDelivery
+	err := database.Conn(ctx, r.db).Raw(`
+		UPDATE webhook_deliveries SET next_attempt_at = ?
+		WHERE id IN (
+			SELECT id FROM webhook_deliveries
+			WHERE status = 'pending' AND next_attempt_at <= ?
+			ORDER BY next_attempt_at ASC
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`, leaseUntil, now, limit).
+		Scan(&deliveries).Error
+	if err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+func (r *WebhookDeliveryRepositoryImpl) CreateAttempt(ctx context.Context, attempt *entity.WebhookDeliveryAttempt) error {
+	return database.Conn(ctx, r.db).Create(attempt).Error
+}
@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/enum"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/domain/service"
+)
+
+const (
+	// accessTokenPrefix marks personal access tokens so the auth middleware can
+	// tell them apart from JWTs without trying to parse them.
+	accessTokenPrefix = "itk_"
+	// accessTokenDisplayLength is how much of the token is kept in clear text
+	// so users can recognise it in listings.
+	accessTokenDisplayLength = 12
+	// lastUsedResolution limits last-used writes to one per token per minute.
+	lastUsedResolution = time.Minute
+	// inactiveTokenRetention is how long revoked and expired tokens stay
+	// listed before they are deleted.
+	inactiveTokenRetention = 30 * 24 * time.Hour
+)
+
+type AccessTokenServiceImpl struct {
+	tokenRepo repository.PersonalAccessTokenRepository
+	userRepo  repository.UserRepository
+	roleRepo  repository.RoleRepository
+}
+
+func NewAccessTokenService(
+	tokenRepo repository.PersonalAccessTokenRepository,
+	userRepo repository.UserRepository,
+	roleRepo repository.RoleRepository,
+) service.AccessTokenService {
+	return &AccessTokenServiceImpl{
+		tokenRepo: tokenRepo,
+		userRepo:  userRepo,
+		roleRepo:  roleRepo,
+	}
+}
+
+func (s *AccessTokenServiceImpl) Jobs() []service.Job {
+	return []service.Job{
+		{
+			Name:        "token_cleanup",
+			Description: "Delete access tokens revoked or expired more than 30 days ago",
+			Schedule:    "@daily",
+			Run: func(ctx context.Context) (string, error) {
+				deleted, err := s.tokenRepo.DeleteInactiveBefore(ctx, time.Now().Add(-inactiveTokenRetention))
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprintf("deleted %d tokens", deleted), nil
+			},
+		},
+	}
+}
+
+// CreateToken stores a hashed token and returns the plaintext value. The
+// plaintext is never persisted and cannot be retrieved again.
+func (s *AccessTokenServiceImpl) CreateToken(ctx context.Context, token *entity.PersonalAccessToken) (string, error) {
+	owner, err := s.userRepo.GetByID(ctx, token.UserID)
+	if err != nil {
+		return "", errors.New("user not found")
+	}
+
+	// Tokens may only be issued on behalf of someone else for service accounts
+	if token.CreatedBy != token.UserID && !owner.IsServiceAccount {
+		return "", errors.New("tokens can only be issued for your own account or a service account")
+	}
+
+	if len(token.Scopes) == 0 {
+		return "", errors.New("at least one scope is required")
+	}
+	for _, scope := range token.Scopes {
+		if !enum.Permission(scope).IsValid() {
+			return "", errors.New("invalid scope: " + scope)
+		}
+	}
+
+	if token.ExpiresAt != nil && !token.ExpiresAt.After(time.Now()) {
+		return "", errors.New("expiry must be in the future")
+	}
+
+	plaintext, err := generateAccessToken()
+	if err != nil {
+		return "", err
+	}
+
+	token.TokenPrefix = plaintext[:accessTokenDisplayLength]
+	token.TokenHash = hashAccessToken(plaintext)
+
+	if err := s.tokenRepo.Create(ctx, token); err != nil {
+		return "", err
+	}
+
+	return plaintext, nil
+}
+
+func (s *AccessTokenServiceImpl) GetToken(ctx context.Context, id uuid.UUID) (*entity.PersonalAccessToken, error) {
+	return s.tokenRepo.GetByID(ctx, id)
+}
+
+func (s *AccessTokenServiceImpl) ListTokens(ctx context.Context, userID uuid.UUID) ([]*entity.PersonalAccessToken, error) {
+	return s.tokenRepo.ListByUser(ctx, userID)
+}
+
+func (s *AccessTokenServiceImpl) RevokeToken(ctx context.Context, id uuid.UUID) error {
+	token, err := s.tokenRepo.GetByID(ctx, id)
+	if err != nil {
+		return errors.New("token not found")
+	}
+
+	if token.RevokedAt != nil {
+		return nil
+	}
+
+	return s.tokenRepo.Revoke(ctx, id, time.Now())
+}
+
+func (s *AccessTokenServiceImpl) Authenticate(ctx context.Context, plaintext string) (*entity.PersonalAccessToken, *entity.User, error) {
+	token, err := s.tokenRepo.GetByHash(ctx, hashAccessToken(plaintext))
+	if err != nil {
+		return nil, nil, errors.New("invalid token")
+	}
+
+	now := time.Now()
+	if !token.IsActive(now) {
+		return nil, nil, errors.New("token expired or revoked")
+	}
+
+	user, err := s.userRepo.GetByID(ctx, token.UserID)
+	if err != nil {
+		return nil, nil, errors.New("invalid token")
+	}
+
+	if token.LastUsedAt == nil || now.Sub(*token.LastUsedAt) >= lastUsedResolution {
+		if err := s.tokenRepo.TouchLastUsed(ctx, token.ID, now); err == nil {
+			token.LastUsedAt = &now
+		}
+	}
+
+	return token, user, nil
+}
+
+func (s *AccessTokenServiceImpl) IsAccessToken(value string) bool {
+	return strings.HasPrefix(value, accessTokenPrefix)
+}
+
+// CreateServiceAccount registers a non-human user. Service accounts have no
+// password and can only authenticate with personal access tokens.
+func (s *AccessTokenServiceImpl) CreateServiceAccount(ctx context.Context, user *entity.User) error {
+	existingUser, err := s.userRepo.GetByEmail(ctx, user.Email)
+	if err == nil && existingUser != nil {
+		return errors.New("user with this email already exists")
+	}
+
+	if user.Role == "" {
+		user.Role = string(enum.RoleEmployee)
+	}
+	if _, err := s.roleRepo.GetByName(ctx, user.Role); err != nil {
+		return errors.New("role not found")
+	}
+
+	user.IsServiceAccount = true
+	user.PasswordHash = ""
+
+	return s.userRepo.Create(ctx, user)
+}
+
+func (s *AccessTokenServiceImpl) ListServiceAccounts(ctx context.Context) ([]*entity.User, error) {
+	return s.userRepo.ListServiceAccounts(ctx)
+}
+
+func generateAccessToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return accessTokenPrefix + base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func hashAccessToken(plaintext string) string {
+	sum := sha256.Sum256([]byte(plaintext))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,298 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/enum"
+	"inventory-ticketing-system/domain/policy"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/domain/service"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultGraphDepth = 2
+	maxGraphDepth     = 5
+	// maxGraphAssets bounds a graph around a heavily connected asset, such
+	// as a core switch.
+	maxGraphAssets = 200
+)
+
+type AssetRelationshipServiceImpl struct {
+	relationshipRepo repository.AssetRelationshipRepository
+	assetRepo        repository.AssetRepository
+	assetService     service.AssetService
+	txManager        repository.TransactionManager
+}
+
+func NewAssetRelationshipService(
+	relationshipRepo repository.AssetRelationshipRepository,
+	assetRepo repository.AssetRepository,
+	assetService service.AssetService,
+	txManager repository.TransactionManager,
+) service.AssetRelationshipService {
+	return &AssetRelationshipServiceImpl{
+		relationshipRepo: relationshipRepo,
+		assetRepo:        assetRepo,
+		assetService:     assetService,
+		txManager:        txManager,
+	}
+}
+
+// Attach needs write access to both assets. A loop can be closed by links
+// between entirely different assets, so the checks and the insert run under
+// one lock on the whole graph.
+func (s *AssetRelationshipServiceImpl) Attach(ctx context.Context, relationship *entity.AssetRelationship) error {
+	relType := enum.RelationshipType(relationship.Type)
+	if !relType.IsValid() {
+		return errors.New("invalid relationship type")
+	}
+	if relationship.FromAssetID == relationship.ToAssetID {
+		return errors.New("an asset cannot be related to itself")
+	}
+
+	from, err := s.assetRepo.GetByID(ctx, relationship.FromAssetID)
+	if err != nil {
+		return errors.New("asset not found")
+	}
+	to, err := s.assetRepo.GetByID(ctx, relationship.ToAssetID)
+	if err != nil {
+		return errors.New("related asset not found")
+	}
+	if err := policy.Authorize(ctx, enum.PermissionAssetsWrite, assetResource(from)); err != nil {
+		return err
+	}
+	if err := policy.Authorize(ctx, enum.PermissionAssetsWrite, assetResource(to)); err != nil {
+		return err
+	}
+
+	if relationship.ID == uuid.Nil {
+		relationship.ID = uuid.New()
+	}
+	relationship.CreatedBy = callerID(ctx)
+
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.relationshipRepo.LockGraph(ctx); err != nil {
+			return err
+		}
+		if err := s.validateLink(ctx, relationship, from, to); err != nil {
+			return err
+		}
+		return s.relationshipRepo.Create(ctx, relationship)
+	})
+	if err != nil {
+		return err
+	}
+
+	relationship.FromAsset = from
+	relationship.ToAsset = to
+	return nil
+}
+
+func (s *AssetRelationshipServiceImpl) validateLink(ctx context.Context, relationship *entity.AssetRelationship, from, to *entity.Asset) error {
+	if _, err := s.relationshipRepo.GetLink(ctx, from.ID, to.ID, relationship.Type); err == nil {
+		return errors.New("the assets are already related this way")
+	}
+
+	switch enum.RelationshipType(relationship.Type) {
+	case enum.RelationshipConnectedTo:
+		if _, err := s.relationshipRepo.GetLink(ctx, to.ID, from.ID, relationship.Type); err == nil {
+			return errors.New("the assets are already related this way")
+		}
+
+	case enum.RelationshipDependsOn:
+		dependencies, err := s.relationshipRepo.ListDependencyIDs(ctx, to.ID)
+		if err != nil {
+			return err
+		}
+		if containsUUID(dependencies, from.ID) {
+			return fmt.Errorf("%s already depends on %s, so the dependency would be circular", to.Name, from.Name)
+		}
+
+	case enum.RelationshipContains, enum.RelationshipInstalledIn:
+		parent, child := from, to
+		if relationship.ParentID() == to.ID {
+			parent, child = to, from
+		}
+		if link, err := s.relationshipRepo.GetParentLink(ctx, child.ID); err == nil {
+			return fmt.Errorf("%s is already a component of %s; detach it first", child.Name, parentName(link))
+		}
+		components, err := s.relationshipRepo.ListComponentIDs(ctx, child.ID)
+		if err != nil {
+			return err
+		}
+		if containsUUID(components, parent.ID) {
+			return fmt.Errorf("%s is a component of %s, so it cannot contain it", parent.Name, child.Name)
+		}
+	}
+	return nil
+}
+
+// Detach needs write access to both assets, like Attach.
+func (s *AssetRelationshipServiceImpl) Detach(ctx context.Context, id uuid.UUID) error {
+	relationship, err := s.relationshipRepo.GetByID(ctx, id)
+	if err != nil {
+		return errors.New("relationship not found")
+	}
+	if err := policy.Authorize(ctx, enum.PermissionAssetsWrite, assetResource(relationship.FromAsset)); err != nil {
+		return err
+	}
+	if err := policy.Authorize(ctx, enum.PermissionAssetsWrite, assetResource(relationship.ToAsset)); err != nil {
+		return err
+	}
+	return s.relationshipRepo.Delete(ctx, id)
+}
+
+// ListRelationships leaves out the relationships to assets the caller
+// cannot read.
+func (s *AssetRelationshipServiceImpl) ListRelationships(ctx context.Context, assetID uuid.UUID) ([]*entity.AssetRelationship, error) {
+	if _, err := s.assetService.GetAsset(ctx, assetID); err != nil {
+		return nil, err
+	}
+	relationships, err := s.relationshipRepo.ListByAsset(ctx, assetID)
+	if err != nil {
+		return nil, err
+	}
+
+	visible := make([]*entity.AssetRelationship, 0, len(relationships))
+	for _, relationship := range relationships {
+		if canRead(ctx, otherAsset(relationship, assetID)) {
+			visible = append(visible, relationship)
+		}
+	}
+	return visible, nil
+}
+
+// GetGraph stops at assets the caller cannot read, and once the graph holds
+// maxGraphAssets assets.
+func (s *AssetRelationshipServiceImpl) GetGraph(ctx context.Context, assetID uuid.UUID, depth int) (*entity.AssetGraph, error) {
+	root, err := s.assetService.GetAsset(ctx, assetID)
+	if err != nil {
+		return nil, err
+	}
+	if depth <= 0 {
+		depth = defaultGraphDepth
+	}
+	if depth > maxGraphDepth {
+		depth = maxGraphDepth
+	}
+
+	graph := &entity.AssetGraph{Root: root.ID, Depth: depth, Assets: []*entity.Asset{root}}
+	seenAssets := map[uuid.UUID]bool{root.ID: true}
+	seenLinks := make(map[uuid.UUID]bool)
+	frontier := []uuid.UUID{root.ID}
+
+	for hop := 0; hop < depth && len(frontier) > 0; hop++ {
+		var next []uuid.UUID
+		for _, id := range frontier {
+			relationships, err := s.relationshipRepo.ListByAsset(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			for _, relationship := range relationships {
+				if seenLinks[relationship.ID] {
+					continue
+				}
+				other := otherAsset(relationship, id)
+				if other == nil || !canRead(ctx, other) {
+					continue
+				}
+				if !seenAssets[other.ID] {
+					if len(graph.Assets) >= maxGraphAssets {
+						graph.Truncated = true
+						continue
+					}
+					seenAssets[other.ID] = true
+					graph.Assets = append(graph.Assets, other)
+					next = append(next, other.ID)
+				}
+
+				// The assets are listed once, so the links only carry their IDs
+				link := *relationship
+				link.FromAsset = nil
+				link.ToAsset = nil
+				seenLinks[link.ID] = true
+				graph.Relationships = append(graph.Relationships, &link)
+			}
+		}
+		frontier = next
+	}
+	return graph, nil
+}
+
+// UpdateWithComponents moves the components through the asset service, so
+// the caller needs write access to each of them and each move is recorded
+// like any other update. When any component cannot move nothing changes.
+func (s *AssetRelationshipServiceImpl) UpdateWithComponents(ctx context.Context, id uuid.UUID, asset *entity.Asset) error {
+	existing, err := s.assetRepo.GetByID(ctx, id)
+	if err != nil {
+		return errors.New("asset not found")
+	}
+
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.assetService.UpdateAsset(ctx, id, asset); err != nil {
+			return err
+		}
+		if sameLocation(existing.LocationID, asset.LocationID) {
+			return nil
+		}
+
+		components, err := s.relationshipRepo.ListComponentIDs(ctx, id)
+		if err != nil {
+			return err
+		}
+		for _, componentID := range components {
+			if componentID == id {
+				continue
+			}
+			component, err := s.assetRepo.GetByID(ctx, componentID)
+			if err != nil {
+				return err
+			}
+			if sameLocation(component.LocationID, asset.LocationID) {
+				continue
+			}
+			component.LocationID = asset.LocationID
+			component.Location = nil
+			if err := s.assetService.UpdateAsset(ctx, componentID, component); err != nil {
+				return fmt.Errorf("%s: %w", component.Name, err)
+			}
+		}
+		return nil
+	})
+}
+
+// otherAsset returns the asset on the far side of the relationship from
+// assetID.
+func otherAsset(relationship *entity.AssetRelationship, assetID uuid.UUID) *entity.Asset {
+	if relationship.FromAssetID == assetID {
+		return relationship.ToAsset
+	}
+	return relationship.FromAsset
+}
+
+func parentName(relationship *entity.AssetRelationship) string {
+	parent := relationship.FromAsset
+	if relationship.ParentID() == relationship.ToAssetID {
+		parent = relationship.ToAsset
+	}
+	if parent == nil {
+		return "another asset"
+	}
+	return parent.Name
+}
+
+func canRead(ctx context.Context, asset *entity.Asset) bool {
+	return policy.Authorize(ctx, enum.PermissionAssetsRead, assetResource(asset)) == nil
+}
+
+func sameLocation(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,737 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/enum"
+	"inventory-ticketing-system/domain/policy"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/domain/service"
+
+	"github.com/google/uuid"
+)
+
+type AssetRequestServiceImpl struct {
+	requestRepo         repository.AssetRequestRepository
+	delegationRepo      repository.ApprovalDelegationRepository
+	checkoutRepo        repository.AssetCheckoutRepository
+	assetRepo           repository.AssetRepository
+	reservationRepo     repository.ReservationRepository
+	userRepo            repository.UserRepository
+	departmentRepo      repository.DepartmentRepository
+	assetService        service.AssetService
+	notificationService service.NotificationService
+	txManager           repository.TransactionManager
+	approvalChain       []string
+	approvalSLA         time.Duration
+}
+
+func NewAssetRequestService(
+	requestRepo repository.AssetRequestRepository,
+	delegationRepo repository.ApprovalDelegationRepository,
+	checkoutRepo repository.AssetCheckoutRepository,
+	assetRepo repository.AssetRepository,
+	reservationRepo repository.ReservationRepository,
+	userRepo repository.UserRepository,
+	departmentRepo repository.DepartmentRepository,
+	assetService service.AssetService,
+	notificationService service.NotificationService,
+	txManager repository.TransactionManager,
+	approvalChain []string,
+	approvalSLA time.Duration,
+) service.AssetRequestService {
+	return &AssetRequestServiceImpl{
+		requestRepo:         requestRepo,
+		delegationRepo:      delegationRepo,
+		checkoutRepo:        checkoutRepo,
+		assetRepo:           assetRepo,
+		reservationRepo:     reservationRepo,
+		userRepo:            userRepo,
+		departmentRepo:      departmentRepo,
+		assetService:        assetService,
+		notificationService: notificationService,
+		txManager:           txManager,
+		approvalChain:       approvalChain,
+		approvalSLA:         approvalSLA,
+	}
+}
+
+func (s *AssetRequestServiceImpl) Jobs() []service.Job {
+	return []service.Job{
+		{
+			Name:        "asset_request_approval_reminders",
+			Description: fmt.Sprintf("Remind approvers of asset request steps undecided after %s", s.approvalSLA),
+			Schedule:    "@hourly",
+			Run: func(ctx context.Context) (string, error) {
+				reminded, err := s.RemindOverdue(ctx)
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprintf("reminded about %s", countNoun(reminded, "overdue approval", "overdue approvals")), nil
+			},
+		},
+		{
+			Name:        "checkout_overdue_reminders",
+			Description: "Remind users of checkouts they have not returned by their due date",
+			Schedule:    "@hourly",
+			Run: func(ctx context.Context) (string, error) {
+				reminded, err := s.RemindOverdueCheckouts(ctx)
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprintf("reminded about %s", countNoun(reminded, "overdue checkout", "overdue checkouts")), nil
+			},
+		},
+	}
+}
+
+func (s *AssetRequestServiceImpl) CreateRequest(ctx context.Context, request *entity.AssetRequest) error {
+	if request.Quantity == 0 {
+		request.Quantity = 1
+	}
+	if request.Quantity < 1 {
+		return errors.New("the quantity must be at least 1")
+	}
+	if request.ReturnBy != nil && !request.ReturnBy.After(time.Now()) {
+		return errors.New("the return date must be in the future")
+	}
+
+	asset, err := s.assetRepo.GetByID(ctx, request.AssetID)
+	if err != nil {
+		return errors.New("asset not found")
+	}
+	if err := policy.Authorize(ctx, enum.PermissionAssetsRead, assetResource(asset)); err != nil {
+		return err
+	}
+	if asset.Status != string(enum.AssetStatusAvailable) {
+		return fmt.Errorf("a %s asset cannot be requested", asset.Status)
+	}
+	if request.Quantity > asset.Qty {
+		return fmt.Errorf("only %d in stock", asset.Qty)
+	}
+
+	principal, ok := policy.FromContext(ctx)
+	if !ok {
+		return policy.ErrForbidden
+	}
+	requester, err := s.userRepo.GetByID(ctx, principal.UserID)
+	if err != nil {
+		return errors.New("user not found")
+	}
+
+	if request.ID == uuid.Nil {
+		request.ID = uuid.New()
+	}
+	request.RequestedBy = requester.ID
+	request.DepartmentID = requester.DepartmentID
+	request.Status = string(enum.AssetRequestPending)
+	request.Approvals = nil
+	for i, approver := range s.approvalChain {
+		approval := &entity.AssetRequestApproval{
+			ID:        uuid.New(),
+			RequestID: request.ID,
+			Step:      i + 1,
+			Approver:  approver,
+			Status:    string(enum.ApprovalWaiting),
+		}
+		if approver == enum.ApproverLineManager {
+			approval.ApproverID = s.lineManager(ctx, requester)
+		}
+		// Steps nobody but the requester could decide are skipped
+		if len(s.eligibleApprovers(ctx, request, approval)) == 0 {
+			approval.Status = string(enum.ApprovalSkipped)
+		}
+		request.Approvals = append(request.Approvals, approval)
+	}
+
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.requestRepo.Create(ctx, request); err != nil {
+			return err
+		}
+		request.Asset = asset
+		return s.advance(ctx, request, time.Now())
+	})
+	if err != nil {
+		return err
+	}
+
+	markOverdueApprovals(request, time.Now())
+	return nil
+}
+
+func (s *AssetRequestServiceImpl) GetRequest(ctx context.Context, id uuid.UUID) (*entity.AssetRequest, error) {
+	request, err := s.requestRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("asset request not found")
+	}
+	if err := s.authorizeRead(ctx, request); err != nil {
+		return nil, err
+	}
+
+	markOverdueApprovals(request, time.Now())
+	return request, nil
+}
+
+func (s *AssetRequestServiceImpl) ListRequests(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.AssetRequest, int, error) {
+	awaiting, _ := filters["awaiting"].(bool)
+	delete(filters, "awaiting")
+
+	principal, err := policy.Caller(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	if principal != nil {
+		if awaiting {
+			approverIDs, roles, err := s.approverIdentities(ctx, principal.UserID)
+			if err != nil {
+				return nil, 0, err
+			}
+			filters["awaiting_approver_ids"] = approverIDs
+			filters["awaiting_roles"] = roles
+			filters["not_requested_by"] = principal.UserID
+		} else {
+			conditions, unrestricted := principal.Conditions(enum.PermissionAssetsWrite)
+			switch {
+			case unrestricted:
+			case len(conditions) > 0:
+				filters["scope"] = conditions
+			default:
+				filters["requested_by"] = principal.UserID
+			}
+		}
+	}
+
+	requests, total, err := s.requestRepo.List(ctx, limit, offset, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	now := time.Now()
+	for _, request := range requests {
+		markOverdueApprovals(request, now)
+	}
+	return requests, total, nil
+}
+
+func (s *AssetRequestServiceImpl) ApproveRequest(ctx context.Context, id uuid.UUID, comment string) (*entity.AssetRequest, error) {
+	now := time.Now()
+	var request *entity.AssetRequest
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		pending, approval, err := s.pendingForDecision(ctx, id)
+		if err != nil {
+			return err
+		}
+		request = pending
+
+		approval.Status = string(enum.ApprovalApproved)
+		approval.DecidedAt = &now
+		approval.Comment = comment
+		if err := s.requestRepo.UpdateApproval(ctx, approval); err != nil {
+			return err
+		}
+		return s.advance(ctx, request, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	markOverdueApprovals(request, now)
+	return request, nil
+}
+
+func (s *AssetRequestServiceImpl) RejectRequest(ctx context.Context, id uuid.UUID, comment string) (*entity.AssetRequest, error) {
+	if strings.TrimSpace(comment) == "" {
+		return nil, errors.New("a comment is required to reject an asset request")
+	}
+
+	now := time.Now()
+	var request *entity.AssetRequest
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		pending, approval, err := s.pendingForDecision(ctx, id)
+		if err != nil {
+			return err
+		}
+		request = pending
+
+		approval.Status = string(enum.ApprovalRejected)
+		approval.DecidedAt = &now
+		approval.Comment = comment
+		request.Status = string(enum.AssetRequestRejected)
+		if err := s.requestRepo.UpdateApproval(ctx, approval); err != nil {
+			return err
+		}
+		if err := s.requestRepo.Update(ctx, request); err != nil {
+			return err
+		}
+		title := fmt.Sprintf("Your request for %s was rejected: %s", request.Asset.Name, comment)
+		return s.notifyRequester(ctx, request, title)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	markOverdueApprovals(request, now)
+	return request, nil
+}
+
+func (s *AssetRequestServiceImpl) CancelRequest(ctx context.Context, id uuid.UUID) (*entity.AssetRequest, error) {
+	var request *entity.AssetRequest
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		locked, err := s.requestRepo.LockByID(ctx, id)
+		if err != nil {
+			return errors.New("asset request not found")
+		}
+		request = locked
+		if err := policy.AuthorizeOwner(ctx, request.RequestedBy); err != nil {
+			return err
+		}
+		if request.Status != string(enum.AssetRequestPending) {
+			return fmt.Errorf("asset request is already %s", request.Status)
+		}
+
+		request.Status = string(enum.AssetRequestCancelled)
+		// The open step no longer needs a decision
+		if approval := request.CurrentApproval(); approval != nil {
+			approval.Status = string(enum.ApprovalSkipped)
+			if err := s.requestRepo.UpdateApproval(ctx, approval); err != nil {
+				return err
+			}
+		}
+		return s.requestRepo.Update(ctx, request)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func (s *AssetRequestServiceImpl) RemindOverdue(ctx context.Context) (int, error) {
+	now := time.Now()
+	approvals, err := s.requestRepo.ListOverdueApprovals(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	reminded := 0
+	for _, approval := range approvals {
+		request, err := s.requestRepo.GetByID(ctx, approval.RequestID)
+		if err != nil {
+			return reminded, err
+		}
+
+		err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+			if request.Status == string(enum.AssetRequestPending) {
+				title := fmt.Sprintf("Overdue: approve %d x %s, waiting since %s",
+					request.Quantity, request.Asset.Name, approval.ActivatedAt.UTC().Format("2006-01-02 15:04"))
+				if err := s.notifyApprovers(ctx, request, approval, title, now); err != nil {
+					return err
+				}
+			}
+			approval.ReminderSentAt = &now
+			return s.requestRepo.UpdateApproval(ctx, approval)
+		})
+		if err != nil {
+			return reminded, err
+		}
+		reminded++
+	}
+	return reminded, nil
+}
+
+// RemindOverdueCheckouts reminds each user once of a checkout still out
+// after its due date.
+func (s *AssetRequestServiceImpl) RemindOverdueCheckouts(ctx context.Context) (int, error) {
+	now := time.Now()
+	checkouts, err := s.checkoutRepo.ListOverdue(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	reminded := 0
+	for _, checkout := range checkouts {
+		err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+			name := checkout.AssetID.String()
+			if checkout.Asset != nil {
+				name = checkout.Asset.Name
+			}
+			title := fmt.Sprintf("Overdue: return %d x %s, due %s", checkout.Quantity, name, checkout.DueAt.UTC().Format("2006-01-02 15:04"))
+			payload := map[string]interface{}{
+				"checkoutId":    checkout.ID,
+				"assetId":       checkout.AssetID,
+				"quantity":      checkout.Quantity,
+				"kitCheckoutId": checkout.KitCheckoutID,
+				"dueAt":         checkout.DueAt,
+			}
+			if err := s.notificationService.NotifyInbox(ctx, enum.NotificationCheckoutOverdue, []uuid.UUID{checkout.UserID}, title, payload); err != nil {
+				return err
+			}
+			checkout.ReminderSentAt = &now
+			return s.checkoutRepo.Update(ctx, checkout)
+		})
+		if err != nil {
+			return reminded, err
+		}
+		reminded++
+	}
+	return reminded, nil
+}
+
+func (s *AssetRequestServiceImpl) CreateDelegation(ctx context.Context, delegation *entity.ApprovalDelegation) error {
+	principal, ok := policy.FromContext(ctx)
+	if !ok {
+		return policy.ErrForbidden
+	}
+	if delegation.DelegateID == principal.UserID {
+		return errors.New("you cannot delegate to yourself")
+	}
+	if _, err := s.userRepo.GetByID(ctx, delegation.DelegateID); err != nil {
+		return errors.New("delegate not found")
+	}
+
+	now := time.Now()
+	if delegation.StartsAt.IsZero() {
+		delegation.StartsAt = now
+	}
+	if !delegation.EndsAt.After(delegation.StartsAt) {
+		return errors.New("the delegation must end after it starts")
+	}
+	if !delegation.EndsAt.After(now) {
+		return errors.New("the delegation must end in the future")
+	}
+
+	if delegation.ID == uuid.Nil {
+		delegation.ID = uuid.New()
+	}
+	delegation.DelegatorID = principal.UserID
+	return s.delegationRepo.Create(ctx, delegation)
+}
+
+func (s *AssetRequestServiceImpl) ListDelegations(ctx context.Context) ([]*entity.ApprovalDelegation, error) {
+	principal, ok := policy.FromContext(ctx)
+	if !ok {
+		return nil, policy.ErrForbidden
+	}
+	return s.delegationRepo.ListByUser(ctx, principal.UserID)
+}
+
+func (s *AssetRequestServiceImpl) DeleteDelegation(ctx context.Context, id uuid.UUID) error {
+	delegation, err := s.delegationRepo.GetByID(ctx, id)
+	if err != nil {
+		return errors.New("delegation not found")
+	}
+	if err := policy.AuthorizeOwner(ctx, delegation.DelegatorID); err != nil {
+		return err
+	}
+	return s.delegationRepo.Delete(ctx, id)
+}
+
+func (s *AssetRequestServiceImpl) ListCheckouts(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.AssetCheckout, int, error) {
+	principal, err := policy.Caller(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	if principal != nil {
+		conditions, unrestricted := principal.Conditions(enum.PermissionAssetsWrite)
+		switch {
+		case unrestricted:
+		case len(conditions) > 0:
+			filters["scope"] = conditions
+		default:
+			filters["user_id"] = principal.UserID
+		}
+	}
+	return s.checkoutRepo.List(ctx, limit, offset, filters)
+}
+
+// ReturnCheckout restocks the asset through the asset service, so the caller
+// needs write access to it.
+func (s *AssetRequestServiceImpl) ReturnCheckout(ctx context.Context, id uuid.UUID) (*entity.AssetCheckout, error) {
+	checkout, err := s.checkoutRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("checkout not found")
+	}
+	if checkout.ReturnedAt != nil {
+		return nil, errors.New("checkout has already been returned")
+	}
+	if checkout.KitCheckoutID != nil {
+		return nil, errors.New("the checkout is part of a kit; return the kit instead")
+	}
+
+	now := time.Now()
+	checkout.ReturnedAt = &now
+	checkout.ReturnedBy = callerID(ctx)
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.assetService.IncreaseAssetQuantity(ctx, checkout.AssetID, checkout.Quantity, enum.StockMovementReturn); err != nil {
+			return err
+		}
+		return s.checkoutRepo.Update(ctx, checkout)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return checkout, nil
+}
+
+// advance opens the next step that waits for a decision and notifies its
+// approvers, or fulfils the request once no step is left.
+func (s *AssetRequestServiceImpl) advance(ctx context.Context, request *entity.AssetRequest, now time.Time) error {
+	for _, approval := range request.Approvals {
+		if approval.Status != string(enum.ApprovalWaiting) {
+			continue
+		}
+
+		dueAt := now.Add(s.approvalSLA)
+		approval.Status = string(enum.ApprovalPending)
+		approval.ActivatedAt = &now
+		approval.DueAt = &dueAt
+		if err := s.requestRepo.UpdateApproval(ctx, approval); err != nil {
+			return err
+		}
+		title := fmt.Sprintf("Approve %d x %s, due %s", request.Quantity, request.Asset.Name, dueAt.UTC().Format("2006-01-02 15:04"))
+		return s.notifyApprovers(ctx, request, approval, title, now)
+	}
+	return s.fulfil(ctx, request, now)
+}
+
+// fulfil checks the requested units out to the requester. The stock is
+// taken as the system: approvers need not be able to edit the asset. Units
+// reserved right now are not free, and the asset stays locked until the
+// checkout is recorded.
+func (s *AssetRequestServiceImpl) fulfil(ctx context.Context, request *entity.AssetRequest, now time.Time) error {
+	asset, err := s.assetRepo.LockByID(ctx, request.AssetID)
+	if err != nil {
+		return errors.New("asset not found")
+	}
+	if asset.Status != string(enum.AssetStatusAvailable) {
+		return fmt.Errorf("the asset is %s and cannot be checked out", asset.Status)
+	}
+	reservations, err := s.reservationRepo.ListOverlapping(ctx, []uuid.UUID{asset.ID}, now, now.Add(time.Second), nil)
+	if err != nil {
+		return err
+	}
+	reserved := 0
+	for _, reservation := range reservations {
+		reserved += reservation.Quantity
+	}
+	if asset.Qty-reserved < request.Quantity {
+		return fmt.Errorf("only %d units of %s are free, %d are reserved", max(asset.Qty-reserved, 0), asset.Name, reserved)
+	}
+	if err := s.assetService.DecreaseAssetQuantity(policy.AsSystem(ctx), request.AssetID, request.Quantity, enum.StockMovementIssue); err != nil {
+		return err
+	}
+
+	checkout := &entity.AssetCheckout{
+		ID:           uuid.New(),
+		AssetID:      request.AssetID,
+		UserID:       request.RequestedBy,
+		RequestID:    &request.ID,
+		Quantity:     request.Quantity,
+		CheckedOutAt: now,
+		DueAt:        request.ReturnBy,
+	}
+	if err := s.checkoutRepo.Create(ctx, checkout); err != nil {
+		return err
+	}
+
+	request.Status = string(enum.AssetRequestFulfilled)
+	request.CheckoutID = &checkout.ID
+	request.FulfilledAt = &now
+	if err := s.requestRepo.Update(ctx, request); err != nil {
+		return err
+	}
+	title := fmt.Sprintf("Your request for %d x %s was approved and checked out to you", request.Quantity, asset.Name)
+	return s.notifyRequester(ctx, request, title)
+}
+
+// pendingForDecision locks a pending request whose current step the caller
+// may decide, and records on the step who decides it for whom. It must run
+// in the transaction that saves the decision, so a concurrent decision sees
+// the request once this one is made.
+func (s *AssetRequestServiceImpl) pendingForDecision(ctx context.Context, id uuid.UUID) (*entity.AssetRequest, *entity.AssetRequestApproval, error) {
+	request, err := s.requestRepo.LockByID(ctx, id)
+	if err != nil {
+		return nil, nil, errors.New("asset request not found")
+	}
+	if request.Status != string(enum.AssetRequestPending) {
+		return nil, nil, fmt.Errorf("asset request is already %s", request.Status)
+	}
+	approval := request.CurrentApproval()
+	if approval == nil {
+		return nil, nil, errors.New("asset request has no step awaiting a decision")
+	}
+
+	principal, ok := policy.FromContext(ctx)
+	if !ok {
+		return nil, nil, policy.ErrForbidden
+	}
+	if principal.UserID == request.RequestedBy {
+		return nil, nil, errors.New("you cannot decide on your own asset request")
+	}
+
+	onBehalfOf, err := s.decidesFor(ctx, principal.UserID, s.eligibleApprovers(ctx, request, approval))
+	if err != nil {
+		return nil, nil, err
+	}
+	approval.DecidedBy = &principal.UserID
+	approval.OnBehalfOf = onBehalfOf
+	return request, approval, nil
+}
+
+// decidesFor returns nil if the user is one of the approvers, or the
+// approver who delegated to the user. Anyone else is forbidden.
+func (s *AssetRequestServiceImpl) decidesFor(ctx context.Context, userID uuid.UUID, approverIDs []uuid.UUID) (*uuid.UUID, error) {
+	for _, approverID := range approverIDs {
+		if approverID == userID {
+			return nil, nil
+		}
+	}
+
+	delegations, err := s.delegationRepo.ListActiveForDelegate(ctx, userID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	for _, delegation := range delegations {
+		for _, approverID := range approverIDs {
+			if delegation.DelegatorID == approverID {
+				delegatorID := delegation.DelegatorID
+				return &delegatorID, nil
+			}
+		}
+	}
+	return nil, policy.ErrForbidden
+}
+
+// authorizeRead lets the requester, anyone who decided or may decide a step
+// and whoever may write the asset see a request.
+func (s *AssetRequestServiceImpl) authorizeRead(ctx context.Context, request *entity.AssetRequest) error {
+	principal, err := policy.Caller(ctx)
+	if err != nil {
+		return err
+	}
+	if principal == nil || principal.UserID == request.RequestedBy {
+		return nil
+	}
+	for _, approval := range request.Approvals {
+		if approval.DecidedBy != nil && *approval.DecidedBy == principal.UserID {
+			return nil
+		}
+	}
+	if approval := request.CurrentApproval(); approval != nil {
+		if _, err := s.decidesFor(ctx, principal.UserID, s.eligibleApprovers(ctx, request, approval)); err == nil {
+			return nil
+		}
+	}
+	return policy.Authorize(ctx, enum.PermissionAssetsWrite, assetResource(request.Asset))
+}
+
+// eligibleApprovers returns who may decide a step: the line manager, or the
+// members of the step's role, never the requester.
+func (s *AssetRequestServiceImpl) eligibleApprovers(ctx context.Context, request *entity.AssetRequest, approval *entity.AssetRequestApproval) []uuid.UUID {
+	if approval.Approver == enum.ApproverLineManager {
+		if approval.ApproverID == nil {
+			return nil
+		}
+		return []uuid.UUID{*approval.ApproverID}
+	}
+
+	members, err := s.userRepo.ListByRole(ctx, approval.Approver)
+	if err != nil {
+		return nil
+	}
+	var ids []uuid.UUID
+	for _, member := range members {
+		if member.ID != request.RequestedBy && !member.IsServiceAccount {
+			ids = append(ids, member.ID)
+		}
+	}
+	return ids
+}
+
+// lineManager returns the manager of the user's department, or of the
+// closest parent department with a manager other than the user.
+func (s *AssetRequestServiceImpl) lineManager(ctx context.Context, user *entity.User) *uuid.UUID {
+	seen := make(map[uuid.UUID]bool)
+	departmentID := user.DepartmentID
+	for departmentID != nil && !seen[*departmentID] {
+		seen[*departmentID] = true
+		department, err := s.departmentRepo.GetByID(ctx, *departmentID)
+		if err != nil {
+			return nil
+		}
+		if department.ManagerID != nil && *department.ManagerID != user.ID {
+			return department.ManagerID
+		}
+		departmentID = department.ParentID
+	}
+	return nil
+}
+
+// approverIdentities returns the user and the users who currently delegate
+// to them, with their roles, to find the steps they may decide.
+func (s *AssetRequestServiceImpl) approverIdentities(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, []string, error) {
+	ids := []uuid.UUID{userID}
+	delegations, err := s.delegationRepo.ListActiveForDelegate(ctx, userID, time.Now())
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, delegation := range delegations {
+		ids = append(ids, delegation.DelegatorID)
+	}
+	ids = uniqueIDs(ids)
+
+	var roles []string
+	for _, id := range ids {
+		if user, err := s.userRepo.GetByID(ctx, id); err == nil {
+			roles = append(roles, user.Role)
+		}
+	}
+	return ids, roles, nil
+}
+
+// notifyApprovers tells a step's approvers, and whoever stands in for them
+// today, that the request awaits their decision.
+func (s *AssetRequestServiceImpl) notifyApprovers(ctx context.Context, request *entity.AssetRequest, approval *entity.AssetRequestApproval, title string, now time.Time) error {
+	recipients := s.eligibleApprovers(ctx, request, approval)
+	delegations, err := s.delegationRepo.ListActiveByDelegators(ctx, recipients, now)
+	if err != nil {
+		return err
+	}
+	for _, delegation := range delegations {
+		if delegation.DelegateID != request.RequestedBy {
+			recipients = append(recipients, delegation.DelegateID)
+		}
+	}
+	if len(recipients) == 0 {
+		return nil
+	}
+
+	payload := map[string]interface{}{
+		"requestId": request.ID,
+		"assetId":   request.AssetID,
+		"quantity":  request.Quantity,
+		"step":      approval.Step,
+		"approver":  approval.Approver,
+		"dueAt":     approval.DueAt,
+	}
+	return s.notificationService.NotifyInbox(ctx, enum.NotificationApprovalRequested, uniqueIDs(recipients), title, payload)
+}
+
+func (s *AssetRequestServiceImpl) notifyRequester(ctx context.Context, request *entity.AssetRequest, title string) error {
+	payload := map[string]interface{}{
+		"requestId": request.ID,
+		"assetId":   request.AssetID,
+		"status":    request.Status,
+	}
+	return s.notificationService.NotifyInbox(ctx, enum.NotificationAssetRequestUpdate, []uuid.UUID{request.RequestedBy}, title, payload)
+}
+
+func markOverdueApprovals(request *entity.AssetRequest, now time.Time) {
+	for _, approval := range request.Approvals {
+		approval.Overdue = approval.IsOverdue(now)
+	}
+}
@@ -3,21 +3,79 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/enum"
+	"inventory-ticketing-system/domain/policy"
 	"inventory-ticketing-system/domain/repository"
 	"inventory-ticketing-system/domain/service"
 )
 
+// AssetStatusChangedPayload is the payload of asset.status_changed events.
+// TicketID is set when a ticket status rule made the change.
+type AssetStatusChangedPayload struct {
+	Asset          *entity.Asset `json:"asset"`
+	PreviousStatus string        `json:"previousStatus"`
+	TicketID       *uuid.UUID    `json:"ticketId,omitempty"`
+}
+
+// AssetQuantityChangedPayload is the payload of asset.quantity_changed
+// events.
+type AssetQuantityChangedPayload struct {
+	Asset       *entity.Asset `json:"asset"`
+	PreviousQty int           `json:"previousQty"`
+	Delta       int           `json:"delta"`
+}
+
 type AssetServiceImpl struct {
-	assetRepo repository.AssetRepository
+	assetRepo        repository.AssetRepository
+	departmentRepo   repository.DepartmentRepository
+	statusChangeRepo repository.AssetStatusChangeRepository
+	unitRepo         repository.AssetUnitRepository
+	unitChangeRepo   repository.AssetUnitStatusChangeRepository
+	movementRepo     repository.StockMovementRepository
+	lotRepo          repository.StockLotRepository
+	ticketRepo       repository.TicketRepository
+	vendorRepo       repository.VendorRepository
+	manufacturerRepo repository.ManufacturerRepository
+	txManager        repository.TransactionManager
+	events           service.EventPublisher
+	statusRules      service.AssetStatusRules
 }
 
-func NewAssetService(assetRepo repository.AssetRepository) service.AssetService {
+func NewAssetService(
+	assetRepo repository.AssetRepository,
+	departmentRepo repository.DepartmentRepository,
+	statusChangeRepo repository.AssetStatusChangeRepository,
+	unitRepo repository.AssetUnitRepository,
+	unitChangeRepo repository.AssetUnitStatusChangeRepository,
+	movementRepo repository.StockMovementRepository,
+	lotRepo repository.StockLotRepository,
+	ticketRepo repository.TicketRepository,
+	vendorRepo repository.VendorRepository,
+	manufacturerRepo repository.ManufacturerRepository,
+	txManager repository.TransactionManager,
+	events service.EventPublisher,
+	statusRules service.AssetStatusRules,
+) service.AssetService {
 	return &AssetServiceImpl{
-		assetRepo: assetRepo,
+		assetRepo:        assetRepo,
+		departmentRepo:   departmentRepo,
+		statusChangeRepo: statusChangeRepo,
+		unitRepo:         unitRepo,
+		unitChangeRepo:   unitChangeRepo,
+		movementRepo:     movementRepo,
+		lotRepo:          lotRepo,
+		ticketRepo:       ticketRepo,
+		vendorRepo:       vendorRepo,
+		manufacturerRepo: manufacturerRepo,
+		txManager:        txManager,
+		events:           events,
+		statusRules:      statusRules,
 	}
 }
 
@@ -31,17 +89,59 @@ func (s *AssetServiceImpl) CreateAsset(ctx context.Context, asset *entity.Asset)
 	if asset.Qty <= 0 {
 		asset.Qty = 1 // Default quantity
 	}
+	if asset.Currency == "" {
+		asset.Currency = "USD" // Default currency
+	}
+	if asset.PurchaseCost < 0 {
+		return errors.New("purchase cost cannot be negative")
+	}
+
+	if err := policy.Authorize(ctx, enum.PermissionAssetsWrite, assetResource(asset)); err != nil {
+		return err
+	}
 
 	existingAsset, err := s.assetRepo.GetByUniqueID(ctx, asset.UniqueID)
 	if err == nil && existingAsset != nil {
 		return errors.New("asset with this unique ID already exists")
 	}
 
-	return s.assetRepo.Create(ctx, asset)
+	if err := s.validateDepartment(ctx, asset); err != nil {
+		return err
+	}
+	if err := s.validateVendors(ctx, asset); err != nil {
+		return err
+	}
+
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.linkManufacturer(ctx, asset); err != nil {
+			return err
+		}
+		if err := s.assetRepo.Create(ctx, asset); err != nil {
+			return err
+		}
+		// The history starts with the initial status
+		change := &entity.AssetStatusChange{AssetID: asset.ID, ToStatus: asset.Status}
+		if principal, ok := policy.FromContext(ctx); ok {
+			change.ChangedBy = &principal.UserID
+		}
+		if err := s.statusChangeRepo.Create(ctx, change); err != nil {
+			return err
+		}
+		return s.events.Publish(ctx, enum.EventAssetCreated, asset.ID, asset)
+	})
 }
 
 func (s *AssetServiceImpl) GetAsset(ctx context.Context, id uuid.UUID) (*entity.Asset, error) {
-	return s.assetRepo.GetByID(ctx, id)
+	asset, err := s.assetRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("asset not found")
+	}
+
+	if err := policy.Authorize(ctx, enum.PermissionAssetsRead, assetResource(asset)); err != nil {
+		return nil, err
+	}
+
+	return asset, nil
 }
 
 func (s *AssetServiceImpl) UpdateAsset(ctx context.Context, id uuid.UUID, asset *entity.Asset) error {
@@ -50,56 +150,658 @@ func (s *AssetServiceImpl) UpdateAsset(ctx context.Context, id uuid.UUID, asset
 		return errors.New("asset not found")
 	}
 
+	// The caller needs write access both where the asset is and where it goes
+	if err := policy.Authorize(ctx, enum.PermissionAssetsWrite, assetResource(existingAsset)); err != nil {
+		return err
+	}
+	if err := policy.Authorize(ctx, enum.PermissionAssetsWrite, assetResource(asset)); err != nil {
+		return err
+	}
+
+	if err := s.validateDepartment(ctx, asset); err != nil {
+		return err
+	}
+	if err := s.validateVendors(ctx, asset); err != nil {
+		return err
+	}
+	if asset.PurchaseCost < 0 {
+		return errors.New("purchase cost cannot be negative")
+	}
+
+	if asset.Status != existingAsset.Status {
+		if err := validateStatusChange(existingAsset.Status, asset.Status, ""); err != nil {
+			return err
+		}
+	}
+
+	// The quantity of a serialized or lot-tracked asset follows its units or
+	// lots
+	units, err := s.unitRepo.CountByAsset(ctx, id, "")
+	if err != nil {
+		return err
+	}
+	lots, err := s.lotRepo.CountByAsset(ctx, id)
+	if err != nil {
+		return err
+	}
+
 	asset.ID = id
 	asset.CreatedAt = existingAsset.CreatedAt
 	asset.UpdatedAt = time.Now()
 
-	return s.assetRepo.Update(ctx, asset)
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		// A quantity the caller left alone stays whatever it is by now
+		current, err := s.assetRepo.LockByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if units > 0 || lots > 0 || asset.Qty == existingAsset.Qty {
+			asset.Qty = current.Qty
+		}
+
+		if err := s.linkManufacturer(ctx, asset); err != nil {
+			return err
+		}
+		return s.saveQuantity(ctx, asset, existingAsset.Status, current.Qty, enum.StockMovementAdjustment)
+	})
 }
 
 func (s *AssetServiceImpl) DeleteAsset(ctx context.Context, id uuid.UUID) error {
-	_, err := s.assetRepo.GetByID(ctx, id)
+	asset, err := s.assetRepo.GetByID(ctx, id)
 	if err != nil {
 		return errors.New("asset not found")
 	}
 
-	return s.assetRepo.Delete(ctx, id)
+	if err := policy.Authorize(ctx, enum.PermissionAssetsDelete, assetResource(asset)); err != nil {
+		return err
+	}
+
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.assetRepo.Delete(ctx, id); err != nil {
+			return err
+		}
+		return s.events.Publish(ctx, enum.EventAssetDeleted, asset.ID, asset)
+	})
 }
 
 func (s *AssetServiceImpl) ListAssets(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.Asset, int, error) {
+	conditions, restricted, err := policy.ListConditions(ctx, enum.PermissionAssetsRead)
+	if err != nil {
+		return nil, 0, err
+	}
+	if restricted {
+		if filters == nil {
+			filters = make(map[string]interface{})
+		}
+		filters["scope"] = conditions
+	}
+
 	return s.assetRepo.List(ctx, limit, offset, filters)
 }
 
-func (s *AssetServiceImpl) UpdateAssetStatus(ctx context.Context, id uuid.UUID, status string) error {
+func (s *AssetServiceImpl) UpdateAssetStatus(ctx context.Context, id uuid.UUID, status, reason string) error {
 	asset, err := s.assetRepo.GetByID(ctx, id)
 	if err != nil {
 		return errors.New("asset not found")
 	}
 
+	if err := policy.Authorize(ctx, enum.PermissionAssetsWrite, assetResource(asset)); err != nil {
+		return err
+	}
+
+	if status == asset.Status {
+		return nil
+	}
+	if err := validateStatusChange(asset.Status, status, reason); err != nil {
+		return err
+	}
+
+	change := &entity.AssetStatusChange{FromStatus: asset.Status, Reason: reason}
+	if principal, ok := policy.FromContext(ctx); ok {
+		change.ChangedBy = &principal.UserID
+	}
 	asset.Status = status
-	return s.assetRepo.Update(ctx, asset)
+	return s.saveStatus(ctx, asset, change)
 }
 
-func (s *AssetServiceImpl) DecreaseAssetQuantity(ctx context.Context, id uuid.UUID, qty int) error {
+func (s *AssetServiceImpl) GetStatusHistory(ctx context.Context, id uuid.UUID) ([]*entity.AssetStatusChange, error) {
+	asset, err := s.assetRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("asset not found")
+	}
+
+	if err := policy.Authorize(ctx, enum.PermissionAssetsRead, assetResource(asset)); err != nil {
+		return nil, err
+	}
+
+	return s.statusChangeRepo.ListByAsset(ctx, id)
+}
+
+func (s *AssetServiceImpl) GetStatusReport(ctx context.Context, from, to *time.Time) ([]*entity.AssetStatusTime, error) {
+	end := time.Now()
+	if to != nil && to.Before(end) {
+		end = *to
+	}
+	var start time.Time
+	if from != nil {
+		start = *from
+	}
+	if !start.Before(end) {
+		return nil, errors.New("from must be before to")
+	}
+
+	return s.statusChangeRepo.SummarizeDurations(ctx, start, end)
+}
+
+func (s *AssetServiceImpl) DecreaseAssetQuantity(ctx context.Context, id uuid.UUID, qty int, kind enum.StockMovementKind) error {
 	asset, err := s.assetRepo.GetByID(ctx, id)
 	if err != nil {
 		return errors.New("asset not found")
 	}
 
-	if asset.Qty < qty {
-		return errors.New("insufficient quantity")
+	if err := policy.Authorize(ctx, enum.PermissionAssetsWrite, assetResource(asset)); err != nil {
+		return err
+	}
+	if err := s.requirePooled(ctx, asset); err != nil {
+		return err
 	}
 
-	asset.Qty -= qty
-	return s.assetRepo.Update(ctx, asset)
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		locked, lots, err := s.lockStock(ctx, id)
+		if err != nil {
+			return err
+		}
+		if len(lots) > 0 {
+			return s.issueFromLots(ctx, locked, lots, qty, kind)
+		}
+
+		if locked.Qty < qty {
+			return errors.New("insufficient quantity")
+		}
+		locked.Qty -= qty
+		return s.saveQuantity(ctx, locked, locked.Status, locked.Qty+qty, kind)
+	})
 }
 
-func (s *AssetServiceImpl) IncreaseAssetQuantity(ctx context.Context, id uuid.UUID, qty int) error {
+func (s *AssetServiceImpl) IncreaseAssetQuantity(ctx context.Context, id uuid.UUID, qty int, kind enum.StockMovementKind) error {
 	asset, err := s.assetRepo.GetByID(ctx, id)
 	if err != nil {
 		return errors.New("asset not found")
 	}
 
-	asset.Qty += qty
-	return s.assetRepo.Update(ctx, asset)
+	if err := policy.Authorize(ctx, enum.PermissionAssetsWrite, assetResource(asset)); err != nil {
+		return err
+	}
+	if err := s.requirePooled(ctx, asset); err != nil {
+		return err
+	}
+
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		locked, lots, err := s.lockStock(ctx, id)
+		if err != nil {
+			return err
+		}
+		if len(lots) > 0 {
+			return s.returnToLot(ctx, locked, lots, qty, kind)
+		}
+
+		locked.Qty += qty
+		return s.saveQuantity(ctx, locked, locked.Status, locked.Qty-qty, kind)
+	})
+}
+
+// SyncStatusWithTicket applies the rules to the ticket's unit instead when
+// the ticket is about one unit of a serialized asset.
+func (s *AssetServiceImpl) SyncStatusWithTicket(ctx context.Context, ticket *entity.Ticket, event enum.EventType) error {
+	changedBy := callerID(ctx)
+	// The rules act for the system; filing a ticket needs no asset write access
+	ctx = policy.AsSystem(ctx)
+
+	if ticket.UnitID != nil {
+		return s.syncUnitStatusWithTicket(ctx, ticket, event, changedBy)
+	}
+
+	asset, err := s.assetRepo.GetByID(ctx, ticket.AssetID)
+	if err != nil {
+		return errors.New("asset not found")
+	}
+
+	status, err := s.ruleStatus(ticket, event, asset.Status, func() (string, error) {
+		return s.statusBeforeTickets(ctx, asset, ticket.ID)
+	})
+	if err != nil || status == "" {
+		return err
+	}
+
+	change := &entity.AssetStatusChange{TicketID: &ticket.ID, ChangedBy: changedBy, FromStatus: asset.Status}
+	asset.Status = status
+	return s.saveStatus(ctx, asset, change)
+}
+
+func (s *AssetServiceImpl) RecountUnits(ctx context.Context, id uuid.UUID) error {
+	ctx = policy.AsSystem(ctx)
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		asset, err := s.lockAsset(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		available, err := s.unitRepo.CountByAsset(ctx, id, string(enum.AssetStatusAvailable))
+		if err != nil {
+			return err
+		}
+		if asset.Qty == available {
+			return nil
+		}
+		previousQty := asset.Qty
+		asset.Qty = available
+		return s.saveQuantity(ctx, asset, asset.Status, previousQty, enum.StockMovementRecount)
+	})
+}
+
+func (s *AssetServiceImpl) syncUnitStatusWithTicket(ctx context.Context, ticket *entity.Ticket, event enum.EventType, changedBy *uuid.UUID) error {
+	unit, err := s.unitRepo.GetByID(ctx, *ticket.UnitID)
+	if err != nil {
+		return errors.New("unit not found")
+	}
+
+	status, err := s.ruleStatus(ticket, event, unit.Status, func() (string, error) {
+		return s.unitStatusBeforeTickets(ctx, unit, ticket.ID)
+	})
+	if err != nil || status == "" {
+		return err
+	}
+
+	change := &entity.AssetUnitStatusChange{
+		UnitID:     unit.ID,
+		FromStatus: unit.Status,
+		ToStatus:   status,
+		TicketID:   &ticket.ID,
+		ChangedBy:  changedBy,
+	}
+	unit.Status = status
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.unitRepo.Update(ctx, unit); err != nil {
+			return err
+		}
+		if err := s.unitChangeRepo.Create(ctx, change); err != nil {
+			return err
+		}
+		return s.RecountUnits(ctx, unit.AssetID)
+	})
+}
+
+// ruleStatus returns the status the ticket status rules give an asset or
+// unit in status current after event, or "" to leave it. restore looks up
+// the status to go back to when the ticket is finished.
+func (s *AssetServiceImpl) ruleStatus(ticket *entity.Ticket, event enum.EventType, current string, restore func() (string, error)) (string, error) {
+	var status string
+	var err error
+	switch event {
+	case enum.EventTicketCreated:
+		if isAssetInService(current) {
+			status = s.statusRules.OnCreated[ticket.Severity]
+		}
+	case enum.EventTicketAssigned:
+		if ticket.AssignedTo != nil {
+			status = s.statusRules.OnAssigned
+		}
+	case enum.EventTicketStatusChanged:
+		if s.statusRules.RestoreOnResolve && (ticket.Status == "resolved" || ticket.Status == "closed") {
+			status, err = restore()
+			if err != nil {
+				return "", err
+			}
+		}
+	}
+	// Rules never move an asset along a transition a user could not make,
+	// such as out of retirement
+	if status == "" || status == current || !enum.AssetStatus(current).CanTransitionTo(enum.AssetStatus(status)) {
+		return "", nil
+	}
+	return status, nil
+}
+
+// statusBeforeTickets returns the status the asset had before ticket rules
+// changed it, or "" when it should not be restored: another ticket on the
+// asset is unfinished, or the status was last set by hand. Tickets about one
+// of its units don't count.
+func (s *AssetServiceImpl) statusBeforeTickets(ctx context.Context, asset *entity.Asset, ticketID uuid.UUID) (string, error) {
+	tickets, err := s.ticketRepo.GetByAssetID(ctx, asset.ID)
+	if err != nil {
+		return "", err
+	}
+	for _, ticket := range tickets {
+		if ticket.UnitID == nil && ticket.ID != ticketID && (ticket.Status == "open" || ticket.Status == "in_progress") {
+			return "", nil
+		}
+	}
+
+	changes, err := s.statusChangeRepo.ListByAsset(ctx, asset.ID)
+	if err != nil {
+		return "", err
+	}
+
+	// Walk back through the changes tickets made since the asset was last in
+	// service
+	var status string
+	for _, change := range changes {
+		if change.TicketID == nil || isAssetInService(change.ToStatus) {
+			break
+		}
+		status = change.FromStatus
+	}
+	return status, nil
+}
+
+// unitStatusBeforeTickets is statusBeforeTickets for a unit and the tickets
+// about it.
+func (s *AssetServiceImpl) unitStatusBeforeTickets(ctx context.Context, unit *entity.AssetUnit, ticketID uuid.UUID) (string, error) {
+	tickets, err := s.ticketRepo.GetByAssetID(ctx, unit.AssetID)
+	if err != nil {
+		return "", err
+	}
+	for _, ticket := range tickets {
+		if ticket.UnitID != nil && *ticket.UnitID == unit.ID && ticket.ID != ticketID &&
+			(ticket.Status == "open" || ticket.Status == "in_progress") {
+			return "", nil
+		}
+	}
+
+	changes, err := s.unitChangeRepo.ListByUnit(ctx, unit.ID)
+	if err != nil {
+		return "", err
+	}
+
+	var status string
+	for _, change := range changes {
+		if change.TicketID == nil || isAssetInService(change.ToStatus) {
+			break
+		}
+		status = change.FromStatus
+	}
+	return status, nil
+}
+
+// RecountLots expects the lots to have been changed with the asset locked,
+// as the stock service does.
+func (s *AssetServiceImpl) RecountLots(ctx context.Context, id uuid.UUID, kind enum.StockMovementKind) error {
+	ctx = policy.AsSystem(ctx)
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		asset, lots, err := s.lockStock(ctx, id)
+		if err != nil {
+			return err
+		}
+		issuable := issuableQty(lots)
+		if asset.Qty == issuable {
+			return nil
+		}
+		previousQty := asset.Qty
+		asset.Qty = issuable
+		return s.saveQuantity(ctx, asset, asset.Status, previousQty, kind)
+	})
+}
+
+// lockAsset locks the asset's row until the transaction ends and returns the
+// asset as it is now. Every change to its stock starts here, so concurrent
+// changes are applied one after the other instead of from the same count.
+func (s *AssetServiceImpl) lockAsset(ctx context.Context, id uuid.UUID) (*entity.Asset, error) {
+	if _, err := s.assetRepo.LockByID(ctx, id); err != nil {
+		return nil, errors.New("asset not found")
+	}
+	return s.assetRepo.GetByID(ctx, id)
+}
+
+// lockStock is lockAsset together with the asset's lots, also locked.
+func (s *AssetServiceImpl) lockStock(ctx context.Context, id uuid.UUID) (*entity.Asset, []*entity.StockLot, error) {
+	asset, err := s.lockAsset(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	lots, err := s.lotRepo.LockByAsset(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return asset, lots, nil
+}
+
+// issueFromLots takes qty from the asset's locked lots first-expired-first-out.
+// Quarantined and expired lots are skipped, so they are never issued.
+func (s *AssetServiceImpl) issueFromLots(ctx context.Context, asset *entity.Asset, lots []*entity.StockLot, qty int, kind enum.StockMovementKind) error {
+	if issuableQty(lots) < qty {
+		return errors.New("insufficient quantity")
+	}
+
+	remaining := qty
+	for _, lot := range lots {
+		if remaining == 0 {
+			break
+		}
+		if !lot.IsIssuable(currentDay()) {
+			continue
+		}
+		taken := min(lot.Qty, remaining)
+		if err := s.adjustLot(ctx, lot, -taken); err != nil {
+			return err
+		}
+		remaining -= taken
+	}
+
+	previousQty := asset.Qty
+	asset.Qty = issuableQty(lots)
+	return s.saveQuantity(ctx, asset, asset.Status, previousQty, kind)
+}
+
+// returnToLot adds qty back to the locked lot that is issued next. Stock with
+// an expiry of its own is received as a new lot instead.
+func (s *AssetServiceImpl) returnToLot(ctx context.Context, asset *entity.Asset, lots []*entity.StockLot, qty int, kind enum.StockMovementKind) error {
+	for _, lot := range lots {
+		if !lot.IsIssuable(currentDay()) {
+			continue
+		}
+		if err := s.adjustLot(ctx, lot, qty); err != nil {
+			return err
+		}
+
+		previousQty := asset.Qty
+		asset.Qty = issuableQty(lots)
+		return s.saveQuantity(ctx, asset, asset.Status, previousQty, kind)
+	}
+	return errors.New("the asset has no active, unexpired lot to add stock to; add a lot instead")
+}
+
+func (s *AssetServiceImpl) adjustLot(ctx context.Context, lot *entity.StockLot, delta int) error {
+	adjusted, err := s.lotRepo.AdjustQty(ctx, lot.ID, delta)
+	if err != nil {
+		return err
+	}
+	if !adjusted {
+		return errors.New("insufficient quantity")
+	}
+	lot.Qty += delta
+	return nil
+}
+
+// issuableQty is the stock of the lots that may be issued today.
+func issuableQty(lots []*entity.StockLot) int {
+	total := 0
+	for _, lot := range lots {
+		if lot.IsIssuable(currentDay()) {
+			total += lot.Qty
+		}
+	}
+	return total
+}
+
+// requirePooled rejects quantity changes on serialized assets, whose
+// quantity is counted from their units.
+func (s *AssetServiceImpl) requirePooled(ctx context.Context, asset *entity.Asset) error {
+	units, err := s.unitRepo.CountByAsset(ctx, asset.ID, "")
+	if err != nil {
+		return err
+	}
+	if units > 0 {
+		return errors.New("the quantity of a serialized asset follows its units; change the units instead")
+	}
+	return nil
+}
+
+// save updates the asset and publishes asset.updated. When its status differs
+// from previousStatus, the change is recorded in the status history and
+// asset.status_changed is published.
+func (s *AssetServiceImpl) save(ctx context.Context, asset *entity.Asset, previousStatus string) error {
+	change := &entity.AssetStatusChange{FromStatus: previousStatus}
+	if principal, ok := policy.FromContext(ctx); ok {
+		change.ChangedBy = &principal.UserID
+	}
+	return s.saveStatus(ctx, asset, change)
+}
+
+// saveQuantity is save for an asset whose quantity was previousQty. A
+// changed quantity is recorded as a stock movement of the given kind and
+// asset.quantity_changed is published.
+func (s *AssetServiceImpl) saveQuantity(ctx context.Context, asset *entity.Asset, previousStatus string, previousQty int, kind enum.StockMovementKind) error {
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		delta := asset.Qty - previousQty
+		if delta != 0 {
+			// Applied relative to the stored quantity, which the callers hold
+			// locked, so it can never go negative
+			qty, adjusted, err := s.assetRepo.AdjustQty(ctx, asset.ID, delta)
+			if err != nil {
+				return err
+			}
+			if !adjusted {
+				return errors.New("insufficient quantity")
+			}
+			asset.Qty = qty
+		}
+
+		if err := s.save(ctx, asset, previousStatus); err != nil {
+			return err
+		}
+		if delta == 0 {
+			return nil
+		}
+
+		movement := &entity.StockMovement{
+			AssetID:   asset.ID,
+			Kind:      string(kind),
+			Delta:     delta,
+			QtyAfter:  asset.Qty,
+			ChangedBy: callerID(ctx),
+		}
+		if err := s.movementRepo.Create(ctx, movement); err != nil {
+			return err
+		}
+		return s.events.Publish(ctx, enum.EventAssetQtyChanged, asset.ID, AssetQuantityChangedPayload{
+			Asset:       asset,
+			PreviousQty: previousQty,
+			Delta:       movement.Delta,
+		})
+	})
+}
+
+// saveStatus is save with the history entry to record, whose FromStatus is
+// the previous status.
+func (s *AssetServiceImpl) saveStatus(ctx context.Context, asset *entity.Asset, change *entity.AssetStatusChange) error {
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.assetRepo.Update(ctx, asset); err != nil {
+			return err
+		}
+		if err := s.events.Publish(ctx, enum.EventAssetUpdated, asset.ID, asset); err != nil {
+			return err
+		}
+		if asset.Status == change.FromStatus {
+			return nil
+		}
+
+		change.AssetID = asset.ID
+		change.ToStatus = asset.Status
+		if err := s.statusChangeRepo.Create(ctx, change); err != nil {
+			return err
+		}
+		return s.events.Publish(ctx, enum.EventAssetStatusChanged, asset.ID, AssetStatusChangedPayload{
+			Asset:          asset,
+			PreviousStatus: change.FromStatus,
+			TicketID:       change.TicketID,
+		})
+	})
+}
+
+// validateStatusChange checks that an asset may change from one status to
+// the other, with a reason where one is required.
+func validateStatusChange(from, to, reason string) error {
+	next := enum.AssetStatus(to)
+	if !next.IsValid() {
+		return fmt.Errorf("invalid status %q", to)
+	}
+	if !enum.AssetStatus(from).CanTransitionTo(next) {
+		return fmt.Errorf("asset status cannot change from %s to %s", from, to)
+	}
+	if next.RequiresReason() && strings.TrimSpace(reason) == "" {
+		return fmt.Errorf("a reason is required to mark an asset %s", to)
+	}
+	return nil
+}
+
+// isAssetInService reports whether the asset can be used, as opposed to
+// being broken or in repair.
+func isAssetInService(status string) bool {
+	return status == "available" || status == "booked"
+}
+
+func (s *AssetServiceImpl) validateDepartment(ctx context.Context, asset *entity.Asset) error {
+	if asset.DepartmentID == nil {
+		return nil
+	}
+	if _, err := s.departmentRepo.GetByID(ctx, *asset.DepartmentID); err != nil {
+		return errors.New("department not found")
+	}
+	return nil
+}
+
+// validateVendors checks the asset's vendors exist. The free text vendor
+// follows the name of the vendor the asset was bought from.
+func (s *AssetServiceImpl) validateVendors(ctx context.Context, asset *entity.Asset) error {
+	if asset.PurchaseVendorID != nil {
+		vendor, err := s.vendorRepo.GetByID(ctx, *asset.PurchaseVendorID)
+		if err != nil {
+			return errors.New("purchase vendor not found")
+		}
+		asset.Vendor = vendor.Name
+	}
+	if asset.ServiceVendorID != nil {
+		if _, err := s.vendorRepo.GetByID(ctx, *asset.ServiceVendorID); err != nil {
+			return errors.New("service vendor not found")
+		}
+	}
+	return nil
+}
+
+// linkManufacturer links the asset to the manufacturer of its brand, creating
+// one for a new brand, and spells the brand the way the manufacturer does.
+func (s *AssetServiceImpl) linkManufacturer(ctx context.Context, asset *entity.Asset) error {
+	manufacturer, err := resolveManufacturer(ctx, s.manufacturerRepo, asset.Brand)
+	if err != nil {
+		return err
+	}
+	if manufacturer == nil {
+		asset.Brand = ""
+		asset.ManufacturerID = nil
+		return nil
+	}
+	asset.Brand = manufacturer.Name
+	asset.ManufacturerID = &manufacturer.ID
+	return nil
+}
+
+func assetResource(asset *entity.Asset) *policy.Resource {
+	if asset == nil {
+		return &policy.Resource{}
+	}
+	return &policy.Resource{
+		LocationID:   asset.LocationID,
+		DepartmentID: asset.DepartmentID,
+		Category:     asset.Category,
+	}
 }
\ No newline at end of file
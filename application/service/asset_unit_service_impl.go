@@ -0,0 +1,344 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/enum"
+	"inventory-ticketing-system/domain/policy"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/domain/service"
+
+	"github.com/google/uuid"
+)
+
+type AssetUnitServiceImpl struct {
+	unitRepo       repository.AssetUnitRepository
+	unitChangeRepo repository.AssetUnitStatusChangeRepository
+	trackingRepo   repository.CategoryTrackingModeRepository
+	lotRepo        repository.StockLotRepository
+	assetRepo      repository.AssetRepository
+	userRepo       repository.UserRepository
+	locationRepo   repository.LocationRepository
+	assetService   service.AssetService
+	txManager      repository.TransactionManager
+}
+
+func NewAssetUnitService(
+	unitRepo repository.AssetUnitRepository,
+	unitChangeRepo repository.AssetUnitStatusChangeRepository,
+	trackingRepo repository.CategoryTrackingModeRepository,
+	lotRepo repository.StockLotRepository,
+	assetRepo repository.AssetRepository,
+	userRepo repository.UserRepository,
+	locationRepo repository.LocationRepository,
+	assetService service.AssetService,
+	txManager repository.TransactionManager,
+) service.AssetUnitService {
+	return &AssetUnitServiceImpl{
+		unitRepo:       unitRepo,
+		unitChangeRepo: unitChangeRepo,
+		trackingRepo:   trackingRepo,
+		lotRepo:        lotRepo,
+		assetRepo:      assetRepo,
+		userRepo:       userRepo,
+		locationRepo:   locationRepo,
+		assetService:   assetService,
+		txManager:      txManager,
+	}
+}
+
+func (s *AssetUnitServiceImpl) CreateUnit(ctx context.Context, unit *entity.AssetUnit) error {
+	asset, err := s.assetRepo.GetByID(ctx, unit.AssetID)
+	if err != nil {
+		return errors.New("asset not found")
+	}
+	if unit.LocationID == nil {
+		unit.LocationID = asset.LocationID
+	}
+	if err := policy.Authorize(ctx, enum.PermissionAssetsWrite, unitResource(asset, unit)); err != nil {
+		return err
+	}
+
+	mode, err := s.trackingRepo.GetByCategory(ctx, asset.Category)
+	if err != nil || mode.Mode != string(enum.TrackingSerialized) {
+		return fmt.Errorf("assets in category %q are pooled; make the category serialized to add units", asset.Category)
+	}
+	if enum.AssetStatus(asset.Status).IsTerminal() {
+		return fmt.Errorf("a %s asset cannot get new units", asset.Status)
+	}
+	lots, err := s.lotRepo.CountByAsset(ctx, asset.ID)
+	if err != nil {
+		return err
+	}
+	if lots > 0 {
+		return errors.New("an asset tracked by lots cannot have units")
+	}
+
+	if unit.Status == "" {
+		unit.Status = string(enum.AssetStatusAvailable)
+	}
+	if !enum.AssetStatus(unit.Status).IsValid() {
+		return fmt.Errorf("invalid status %q", unit.Status)
+	}
+	if err := s.validateUnit(ctx, uuid.Nil, unit); err != nil {
+		return err
+	}
+
+	if unit.ID == uuid.Nil {
+		unit.ID = uuid.New()
+	}
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.unitRepo.Create(ctx, unit); err != nil {
+			return err
+		}
+		// The history starts with the initial status
+		change := &entity.AssetUnitStatusChange{UnitID: unit.ID, ToStatus: unit.Status, ChangedBy: callerID(ctx)}
+		if err := s.unitChangeRepo.Create(ctx, change); err != nil {
+			return err
+		}
+		return s.assetService.RecountUnits(ctx, asset.ID)
+	})
+	if err != nil {
+		return err
+	}
+
+	unit.Asset = asset
+	return nil
+}
+
+func (s *AssetUnitServiceImpl) GetUnit(ctx context.Context, id uuid.UUID) (*entity.AssetUnit, error) {
+	unit, err := s.unitRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("unit not found")
+	}
+	if err := policy.Authorize(ctx, enum.PermissionAssetsRead, unitResource(unit.Asset, unit)); err != nil {
+		return nil, err
+	}
+	return unit, nil
+}
+
+func (s *AssetUnitServiceImpl) UpdateUnit(ctx context.Context, id uuid.UUID, changes *entity.AssetUnit) (*entity.AssetUnit, error) {
+	unit, err := s.unitRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("unit not found")
+	}
+
+	// The caller needs write access both where the unit is and where it goes
+	if err := policy.Authorize(ctx, enum.PermissionAssetsWrite, unitResource(unit.Asset, unit)); err != nil {
+		return nil, err
+	}
+	if err := policy.Authorize(ctx, enum.PermissionAssetsWrite, unitResource(unit.Asset, changes)); err != nil {
+		return nil, err
+	}
+	if err := s.validateUnit(ctx, id, changes); err != nil {
+		return nil, err
+	}
+
+	unit.SerialNumber = changes.SerialNumber
+	unit.LocationID = changes.LocationID
+	unit.CustodianID = changes.CustodianID
+	unit.Notes = changes.Notes
+	if err := s.unitRepo.Update(ctx, unit); err != nil {
+		return nil, err
+	}
+	return unit, nil
+}
+
+func (s *AssetUnitServiceImpl) UpdateUnitStatus(ctx context.Context, id uuid.UUID, status, reason string) (*entity.AssetUnit, error) {
+	unit, err := s.unitRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("unit not found")
+	}
+	if err := policy.Authorize(ctx, enum.PermissionAssetsWrite, unitResource(unit.Asset, unit)); err != nil {
+		return nil, err
+	}
+
+	if status == unit.Status {
+		return unit, nil
+	}
+	if err := validateStatusChange(unit.Status, status, reason); err != nil {
+		return nil, err
+	}
+
+	change := &entity.AssetUnitStatusChange{
+		UnitID:     unit.ID,
+		FromStatus: unit.Status,
+		ToStatus:   status,
+		ChangedBy:  callerID(ctx),
+		Reason:     reason,
+	}
+	unit.Status = status
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.unitRepo.Update(ctx, unit); err != nil {
+			return err
+		}
+		if err := s.unitChangeRepo.Create(ctx, change); err != nil {
+			return err
+		}
+		return s.assetService.RecountUnits(ctx, unit.AssetID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return unit, nil
+}
+
+func (s *AssetUnitServiceImpl) DeleteUnit(ctx context.Context, id uuid.UUID) error {
+	unit, err := s.unitRepo.GetByID(ctx, id)
+	if err != nil {
+		return errors.New("unit not found")
+	}
+	if err := policy.Authorize(ctx, enum.PermissionAssetsDelete, unitResource(unit.Asset, unit)); err != nil {
+		return err
+	}
+
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.unitRepo.Delete(ctx, id); err != nil {
+			return err
+		}
+		return s.assetService.RecountUnits(ctx, unit.AssetID)
+	})
+}
+
+// ListUnits scopes units by their asset, like the asset list.
+func (s *AssetUnitServiceImpl) ListUnits(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.AssetUnit, int, error) {
+	conditions, restricted, err := policy.ListConditions(ctx, enum.PermissionAssetsRead)
+	if err != nil {
+		return nil, 0, err
+	}
+	if restricted {
+		filters["scope"] = conditions
+	}
+	return s.unitRepo.List(ctx, limit, offset, filters)
+}
+
+func (s *AssetUnitServiceImpl) GetUnitHistory(ctx context.Context, id uuid.UUID) ([]*entity.AssetUnitStatusChange, error) {
+	if _, err := s.GetUnit(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.unitChangeRepo.ListByUnit(ctx, id)
+}
+
+func (s *AssetUnitServiceImpl) CreateTrackingMode(ctx context.Context, mode *entity.CategoryTrackingMode) error {
+	if err := authorizeAllAssets(ctx, enum.PermissionAssetsWrite); err != nil {
+		return err
+	}
+	if err := s.validateTrackingMode(ctx, uuid.Nil, mode); err != nil {
+		return err
+	}
+	return s.trackingRepo.Create(ctx, mode)
+}
+
+func (s *AssetUnitServiceImpl) UpdateTrackingMode(ctx context.Context, id uuid.UUID, mode *entity.CategoryTrackingMode) error {
+	if err := authorizeAllAssets(ctx, enum.PermissionAssetsWrite); err != nil {
+		return err
+	}
+	existing, err := s.trackingRepo.GetByID(ctx, id)
+	if err != nil {
+		return errors.New("tracking mode not found")
+	}
+	if err := s.validateTrackingMode(ctx, id, mode); err != nil {
+		return err
+	}
+	// Units of the category would otherwise be left under a pooled asset
+	if !strings.EqualFold(existing.Category, mode.Category) || mode.Mode != string(enum.TrackingSerialized) {
+		if err := s.requireNoUnits(ctx, existing.Category); err != nil {
+			return err
+		}
+	}
+
+	mode.ID = id
+	mode.CreatedAt = existing.CreatedAt
+	return s.trackingRepo.Update(ctx, mode)
+}
+
+func (s *AssetUnitServiceImpl) DeleteTrackingMode(ctx context.Context, id uuid.UUID) error {
+	if err := authorizeAllAssets(ctx, enum.PermissionAssetsWrite); err != nil {
+		return err
+	}
+	existing, err := s.trackingRepo.GetByID(ctx, id)
+	if err != nil {
+		return errors.New("tracking mode not found")
+	}
+	if err := s.requireNoUnits(ctx, existing.Category); err != nil {
+		return err
+	}
+	return s.trackingRepo.Delete(ctx, id)
+}
+
+func (s *AssetUnitServiceImpl) ListTrackingModes(ctx context.Context) ([]*entity.CategoryTrackingMode, error) {
+	return s.trackingRepo.List(ctx)
+}
+
+func (s *AssetUnitServiceImpl) validateUnit(ctx context.Context, id uuid.UUID, unit *entity.AssetUnit) error {
+	unit.SerialNumber = strings.TrimSpace(unit.SerialNumber)
+	if unit.SerialNumber == "" {
+		return errors.New("serial number is required")
+	}
+	if existing, err := s.unitRepo.GetBySerialNumber(ctx, unit.SerialNumber); err == nil && existing.ID != id {
+		return errors.New("a unit with this serial number already exists")
+	}
+	if unit.LocationID != nil {
+		if _, err := s.locationRepo.GetByID(ctx, *unit.LocationID); err != nil {
+			return errors.New("location not found")
+		}
+	}
+	if unit.CustodianID != nil {
+		if _, err := s.userRepo.GetByID(ctx, *unit.CustodianID); err != nil {
+			return errors.New("custodian not found")
+		}
+	}
+	return nil
+}
+
+func (s *AssetUnitServiceImpl) validateTrackingMode(ctx context.Context, id uuid.UUID, mode *entity.CategoryTrackingMode) error {
+	mode.Category = strings.TrimSpace(mode.Category)
+	if mode.Category == "" {
+		return errors.New("category is required")
+	}
+	if !enum.TrackingMode(mode.Mode).IsValid() {
+		return fmt.Errorf("invalid tracking mode %q", mode.Mode)
+	}
+	existing, err := s.trackingRepo.GetByCategory(ctx, mode.Category)
+	if err == nil && existing.ID != id {
+		return errors.New("category already has a tracking mode")
+	}
+	return nil
+}
+
+func (s *AssetUnitServiceImpl) requireNoUnits(ctx context.Context, category string) error {
+	units, err := s.unitRepo.CountByCategory(ctx, category)
+	if err != nil {
+		return err
+	}
+	if units > 0 {
+		return fmt.Errorf("assets in category %q still have %s", category, countNoun(units, "unit", "units"))
+	}
+	return nil
+}
+
+// authorizeAllAssets allows settings that apply to a whole category, such as
+// tracking modes, only to callers who hold perm on every asset.
+func authorizeAllAssets(ctx context.Context, perm enum.Permission) error {
+	principal, err := policy.Caller(ctx)
+	if err != nil || principal == nil {
+		return err
+	}
+	if _, unrestricted := principal.Conditions(perm); !unrestricted {
+		return policy.ErrForbidden
+	}
+	return nil
+}
+
+// unitResource is the asset's resource at the unit's own location.
+func unitResource(asset *entity.Asset, unit *entity.AssetUnit) *policy.Resource {
+	resource := assetResource(asset)
+	if unit.LocationID != nil {
+		resource.LocationID = unit.LocationID
+	}
+	return resource
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/enum"
+)
+
+// assignmentCandidate is an active technician with their current number of
+// open tickets.
+type assignmentCandidate struct {
+	technician *entity.Technician
+	load       int
+}
+
+// pickTechnician chooses a technician for a ticket using the strategy.
+// Technicians at their open ticket limit are never picked. It returns nil
+// when nobody can take the ticket.
+func pickTechnician(strategy enum.AssignmentStrategy, candidates []assignmentCandidate, category, assetType string) *entity.Technician {
+	available := make([]assignmentCandidate, 0, len(candidates))
+	for _, candidate := range candidates {
+		limit := candidate.technician.MaxOpenTickets
+		if limit > 0 && candidate.load >= limit {
+			continue
+		}
+		available = append(available, candidate)
+	}
+	if len(available) == 0 {
+		return nil
+	}
+
+	switch strategy {
+	case enum.AssignmentRoundRobin:
+		return pickRoundRobin(available)
+	case enum.AssignmentSkillMatch:
+		return pickSkillMatch(available, category, assetType)
+	default:
+		return pickLeastLoaded(available)
+	}
+}
+
+// pickRoundRobin picks whoever was assigned a ticket longest ago, starting
+// with technicians that have never been assigned one.
+func pickRoundRobin(candidates []assignmentCandidate) *entity.Technician {
+	var best *entity.Technician
+	for _, candidate := range candidates {
+		if best == nil || assignedEarlier(candidate.technician, best) {
+			best = candidate.technician
+		}
+	}
+	return best
+}
+
+func pickLeastLoaded(candidates []assignmentCandidate) *entity.Technician {
+	best := -1
+	for i, candidate := range candidates {
+		if best < 0 || candidate.load < candidates[best].load ||
+			(candidate.load == candidates[best].load && assignedEarlier(candidate.technician, candidates[best].technician)) {
+			best = i
+		}
+	}
+	return candidates[best].technician
+}
+
+// pickSkillMatch scores a matching ticket category above a matching asset
+// type and picks the least loaded of the best scoring technicians. Without
+// any match it falls back to least loaded.
+func pickSkillMatch(candidates []assignmentCandidate, category, assetType string) *entity.Technician {
+	bestScore := 0
+	var matched []assignmentCandidate
+	for _, candidate := range candidates {
+		score := 0
+		if candidate.technician.HasSkill(category) {
+			score += 2
+		}
+		if assetType != "" && candidate.technician.HandlesAssetType(assetType) {
+			score++
+		}
+
+		switch {
+		case score > bestScore:
+			bestScore = score
+			matched = []assignmentCandidate{candidate}
+		case score == bestScore && score > 0:
+			matched = append(matched, candidate)
+		}
+	}
+
+	if len(matched) == 0 {
+		return pickLeastLoaded(candidates)
+	}
+	return pickLeastLoaded(matched)
+}
+
+func assignedEarlier(a, b *entity.Technician) bool {
+	if a.LastAssignedAt == nil {
+		return b.LastAssignedAt != nil
+	}
+	if b.LastAssignedAt == nil {
+		return false
+	}
+	return a.LastAssignedAt.Before(*b.LastAssignedAt)
+}
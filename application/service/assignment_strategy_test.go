@@ -0,0 +1,228 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/enum"
+)
+
+func TestPickTechnician(t *testing.T) {
+	tests := []struct {
+		name       string
+		strategy   enum.AssignmentStrategy
+		candidates []assignmentCandidate
+		category   string
+		assetType  string
+		want       string // technician name, "" for nobody
+	}{
+		// Round robin
+		{
+			name:     "round robin prefers never assigned",
+			strategy: enum.AssignmentRoundRobin,
+			candidates: []assignmentCandidate{
+				candidate("ana", assignedOn(date(2025, 1, 2)), 0),
+				candidate("ben", nil, 5),
+			},
+			want: "ben",
+		},
+		{
+			name:     "round robin picks assigned longest ago",
+			strategy: enum.AssignmentRoundRobin,
+			candidates: []assignmentCandidate{
+				candidate("ana", assignedOn(date(2025, 1, 3)), 0),
+				candidate("ben", assignedOn(date(2025, 1, 1)), 4),
+				candidate("cai", assignedOn(date(2025, 1, 2)), 0),
+			},
+			want: "ben",
+		},
+		{
+			name:     "round robin keeps list order on ties",
+			strategy: enum.AssignmentRoundRobin,
+			candidates: []assignmentCandidate{
+				candidate("ana", nil, 0),
+				candidate("ben", nil, 0),
+			},
+			want: "ana",
+		},
+
+		// Least loaded
+		{
+			name:     "least loaded picks fewest open tickets",
+			strategy: enum.AssignmentLeastLoaded,
+			candidates: []assignmentCandidate{
+				candidate("ana", nil, 3),
+				candidate("ben", assignedOn(date(2025, 1, 5)), 1),
+				candidate("cai", nil, 2),
+			},
+			want: "ben",
+		},
+		{
+			name:     "least loaded breaks ties by last assignment",
+			strategy: enum.AssignmentLeastLoaded,
+			candidates: []assignmentCandidate{
+				candidate("ana", assignedOn(date(2025, 1, 5)), 1),
+				candidate("ben", assignedOn(date(2025, 1, 4)), 1),
+			},
+			want: "ben",
+		},
+		{
+			name:     "unknown strategy is least loaded",
+			strategy: "",
+			candidates: []assignmentCandidate{
+				candidate("ana", nil, 2),
+				candidate("ben", nil, 1),
+			},
+			want: "ben",
+		},
+
+		// Capacity
+		{
+			name:     "skips technicians at their limit",
+			strategy: enum.AssignmentLeastLoaded,
+			candidates: []assignmentCandidate{
+				limited(candidate("ana", nil, 2), 2),
+				candidate("ben", nil, 7),
+			},
+			want: "ben",
+		},
+		{
+			name:     "skips technicians over their limit",
+			strategy: enum.AssignmentRoundRobin,
+			candidates: []assignmentCandidate{
+				limited(candidate("ana", nil, 4), 3),
+				candidate("ben", assignedOn(date(2025, 1, 1)), 0),
+			},
+			want: "ben",
+		},
+		{
+			name:     "below the limit is available",
+			strategy: enum.AssignmentLeastLoaded,
+			candidates: []assignmentCandidate{
+				limited(candidate("ana", nil, 1), 2),
+				candidate("ben", nil, 3),
+			},
+			want: "ana",
+		},
+		{
+			name:     "zero limit is unlimited",
+			strategy: enum.AssignmentLeastLoaded,
+			candidates: []assignmentCandidate{
+				limited(candidate("ana", nil, 50), 0),
+			},
+			want: "ana",
+		},
+		{
+			name:     "everyone at their limit",
+			strategy: enum.AssignmentSkillMatch,
+			candidates: []assignmentCandidate{
+				limited(candidate("ana", nil, 1), 1),
+				limited(candidate("ben", nil, 2), 2),
+			},
+			category: "network",
+			want:     "",
+		},
+		{
+			name:     "no candidates",
+			strategy: enum.AssignmentRoundRobin,
+			want:     "",
+		},
+
+		// Skill match
+		{
+			name:     "skill match prefers the category",
+			strategy: enum.AssignmentSkillMatch,
+			candidates: []assignmentCandidate{
+				skilled(candidate("ana", nil, 0), nil, []string{"laptop"}),
+				skilled(candidate("ben", nil, 4), []string{"Network"}, nil),
+			},
+			category:  "network",
+			assetType: "laptop",
+			want:      "ben",
+		},
+		{
+			name:     "skill match counts the asset type",
+			strategy: enum.AssignmentSkillMatch,
+			candidates: []assignmentCandidate{
+				skilled(candidate("ana", nil, 0), []string{"printing"}, nil),
+				skilled(candidate("ben", nil, 4), nil, []string{"laptop"}),
+			},
+			category:  "network",
+			assetType: "laptop",
+			want:      "ben",
+		},
+		{
+			name:     "skill match picks least loaded of the best",
+			strategy: enum.AssignmentSkillMatch,
+			candidates: []assignmentCandidate{
+				skilled(candidate("ana", nil, 3), []string{"network"}, []string{"laptop"}),
+				skilled(candidate("ben", nil, 1), []string{"network"}, []string{"laptop"}),
+				skilled(candidate("cai", nil, 0), []string{"network"}, nil),
+			},
+			category:  "network",
+			assetType: "laptop",
+			want:      "ben",
+		},
+		{
+			name:     "skill match falls back to least loaded",
+			strategy: enum.AssignmentSkillMatch,
+			candidates: []assignmentCandidate{
+				skilled(candidate("ana", nil, 2), []string{"printing"}, nil),
+				candidate("ben", nil, 1),
+			},
+			category: "network",
+			want:     "ben",
+		},
+		{
+			name:     "skill match skips a full specialist",
+			strategy: enum.AssignmentSkillMatch,
+			candidates: []assignmentCandidate{
+				limited(skilled(candidate("ana", nil, 2), []string{"network"}, nil), 2),
+				candidate("ben", nil, 5),
+			},
+			category: "network",
+			want:     "ben",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := pickTechnician(tt.strategy, tt.candidates, tt.category, tt.assetType)
+			if name := technicianName(got); name != tt.want {
+				t.Errorf("picked %q, want %q", name, tt.want)
+			}
+		})
+	}
+}
+
+func candidate(name string, lastAssignedAt *time.Time, load int) assignmentCandidate {
+	user := &entity.User{ID: uuid.New(), Name: name}
+	return assignmentCandidate{
+		technician: &entity.Technician{UserID: user.ID, User: user, IsActive: true, LastAssignedAt: lastAssignedAt},
+		load:       load,
+	}
+}
+
+func limited(c assignmentCandidate, maxOpenTickets int) assignmentCandidate {
+	c.technician.MaxOpenTickets = maxOpenTickets
+	return c
+}
+
+func skilled(c assignmentCandidate, skills, assetTypes []string) assignmentCandidate {
+	c.technician.Skills = skills
+	c.technician.AssetTypes = assetTypes
+	return c
+}
+
+func assignedOn(at time.Time) *time.Time {
+	return &at
+}
+
+func technicianName(technician *entity.Technician) string {
+	if technician == nil {
+		return ""
+	}
+	return technician.User.Name
+}
@@ -19,11 +19,11 @@ type AuthServiceImpl struct {
 	tokenExpiry time.Duration
 }
 
-func NewAuthService(userRepo repository.UserRepository, jwtManager *jwt.JWTManager) service.AuthService {
+func NewAuthService(userRepo repository.UserRepository, jwtManager *jwt.JWTManager, tokenExpiry time.Duration) service.AuthService {
 	return &AuthServiceImpl{
 		userRepo:    userRepo,
 		jwtManager:  jwtManager,
-		tokenExpiry: 24 * time.Hour, // 24 hours
+		tokenExpiry: tokenExpiry,
 	}
 }
 
@@ -33,6 +33,11 @@ func (s *AuthServiceImpl) Login(ctx context.Context, email, password string) (st
 		return "", nil, errors.New("invalid credentials")
 	}
 
+	// Service accounts have no password and only authenticate with access tokens
+	if user.IsServiceAccount {
+		return "", nil, errors.New("invalid credentials")
+	}
+
 	err = s.CheckPassword(user.PasswordHash, password)
 	if err != nil {
 		return "", nil, errors.New("invalid credentials")
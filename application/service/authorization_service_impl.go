@@ -0,0 +1,346 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"regexp"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/enum"
+	"inventory-ticketing-system/domain/policy"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/domain/service"
+)
+
+var roleNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{1,49}$`)
+
+// systemRoles are created on startup when missing. Their permissions can be
+// edited afterwards, except for admin which always holds every permission.
+var systemRoles = []entity.Role{
+	{
+		Name:        string(enum.RoleAdmin),
+		Description: "Full access to every resource",
+	},
+	{
+		Name:        string(enum.RoleEmployee),
+		Description: "Browse assets and report tickets",
+		Permissions: permissionNames(
+			enum.PermissionAssetsRead,
+			enum.PermissionTicketsRead,
+			enum.PermissionTicketsWrite,
+			enum.PermissionLocationsRead,
+			enum.PermissionTokensWrite,
+		),
+	},
+	{
+		Name:        string(enum.RoleTechnician),
+		Description: "Work tickets without managing assets",
+		Permissions: permissionNames(
+			enum.PermissionAssetsRead,
+			enum.PermissionTicketsRead,
+			enum.PermissionTicketsWrite,
+			enum.PermissionTicketsWork,
+			enum.PermissionLocationsRead,
+			enum.PermissionTokensWrite,
+		),
+	},
+	{
+		Name:        string(enum.RoleLocationManager),
+		Description: "Edit assets; assign with a location scope to limit it to a building",
+		Permissions: permissionNames(
+			enum.PermissionAssetsRead,
+			enum.PermissionAssetsWrite,
+			enum.PermissionTicketsRead,
+			enum.PermissionTicketsWrite,
+			enum.PermissionLocationsRead,
+		),
+	},
+}
+
+// departmentManagerPermissions are granted to a department's manager over the
+// department and its child departments.
+var departmentManagerPermissions = []enum.Permission{
+	enum.PermissionAssetsRead,
+	enum.PermissionTicketsRead,
+	enum.PermissionPurchasesApprove,
+}
+
+type AuthorizationServiceImpl struct {
+	roleRepo       repository.RoleRepository
+	assignmentRepo repository.RoleAssignmentRepository
+	userRepo       repository.UserRepository
+	locationRepo   repository.LocationRepository
+	departmentRepo repository.DepartmentRepository
+}
+
+func NewAuthorizationService(
+	roleRepo repository.RoleRepository,
+	assignmentRepo repository.RoleAssignmentRepository,
+	userRepo repository.UserRepository,
+	locationRepo repository.LocationRepository,
+	departmentRepo repository.DepartmentRepository,
+) service.AuthorizationService {
+	return &AuthorizationServiceImpl{
+		roleRepo:       roleRepo,
+		assignmentRepo: assignmentRepo,
+		userRepo:       userRepo,
+		locationRepo:   locationRepo,
+		departmentRepo: departmentRepo,
+	}
+}
+
+// BuildPrincipal resolves the user's primary role into unrestricted grants and
+// each role assignment into grants limited to its location subtree and
+// category. Department managers can also read the assets and tickets of the
+// departments they manage. Scopes, when non-nil, come from a personal access
+// token.
+func (s *AuthorizationServiceImpl) BuildPrincipal(ctx context.Context, userID uuid.UUID, scopes []string) (*policy.Principal, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	principal := &policy.Principal{
+		UserID: user.ID,
+		Role:   user.Role,
+		Scopes: scopes,
+	}
+
+	if role, err := s.roleRepo.GetByName(ctx, user.Role); err == nil {
+		for _, permission := range role.Permissions {
+			principal.Grants = append(principal.Grants, policy.Grant{Permission: enum.Permission(permission)})
+		}
+	}
+
+	assignments, err := s.assignmentRepo.ListByUser(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, assignment := range assignments {
+		if assignment.Role == nil {
+			continue
+		}
+
+		var locationIDs []uuid.UUID
+		if assignment.LocationID != nil {
+			locationIDs, err = s.locationRepo.ListDescendantIDs(ctx, *assignment.LocationID)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for _, permission := range assignment.Role.Permissions {
+			principal.Grants = append(principal.Grants, policy.Grant{
+				Permission:  enum.Permission(permission),
+				LocationIDs: locationIDs,
+				Category:    assignment.Category,
+			})
+		}
+	}
+
+	managed, err := s.departmentRepo.ListManagedBy(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, department := range managed {
+		departmentIDs, err := s.departmentRepo.ListDescendantIDs(ctx, department.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, permission := range departmentManagerPermissions {
+			principal.Grants = append(principal.Grants, policy.Grant{
+				Permission:    permission,
+				DepartmentIDs: departmentIDs,
+			})
+		}
+	}
+
+	return principal, nil
+}
+
+func (s *AuthorizationServiceImpl) EnsureSystemRoles(ctx context.Context) error {
+	for _, systemRole := range systemRoles {
+		role := systemRole
+		if role.Name == string(enum.RoleAdmin) {
+			role.Permissions = permissionNames(enum.AllPermissions()...)
+		}
+
+		existing, err := s.roleRepo.GetByName(ctx, role.Name)
+		if err != nil {
+			role.ID = uuid.New()
+			role.IsSystem = true
+			if err := s.roleRepo.Create(ctx, &role); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if role.Name == string(enum.RoleAdmin) && !samePermissions(existing.Permissions, role.Permissions) {
+			existing.Permissions = role.Permissions
+			if err := s.roleRepo.Update(ctx, existing); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *AuthorizationServiceImpl) CreateRole(ctx context.Context, role *entity.Role) error {
+	if !roleNamePattern.MatchString(role.Name) {
+		return errors.New("role name must be lowercase letters, digits or underscores")
+	}
+	if err := validatePermissions(role.Permissions); err != nil {
+		return err
+	}
+
+	existingRole, err := s.roleRepo.GetByName(ctx, role.Name)
+	if err == nil && existingRole != nil {
+		return errors.New("role with this name already exists")
+	}
+
+	role.IsSystem = false
+	return s.roleRepo.Create(ctx, role)
+}
+
+func (s *AuthorizationServiceImpl) GetRole(ctx context.Context, id uuid.UUID) (*entity.Role, error) {
+	return s.roleRepo.GetByID(ctx, id)
+}
+
+// UpdateRole changes a role's description and permissions. Role names are
+// immutable because users reference their primary role by name.
+func (s *AuthorizationServiceImpl) UpdateRole(ctx context.Context, id uuid.UUID, role *entity.Role) error {
+	existingRole, err := s.roleRepo.GetByID(ctx, id)
+	if err != nil {
+		return errors.New("role not found")
+	}
+
+	if existingRole.Name == string(enum.RoleAdmin) {
+		return errors.New("the admin role cannot be modified")
+	}
+	if err := validatePermissions(role.Permissions); err != nil {
+		return err
+	}
+
+	existingRole.Description = role.Description
+	existingRole.Permissions = role.Permissions
+
+	if err := s.roleRepo.Update(ctx, existingRole); err != nil {
+		return err
+	}
+
+	*role = *existingRole
+	return nil
+}
+
+func (s *AuthorizationServiceImpl) DeleteRole(ctx context.Context, id uuid.UUID) error {
+	role, err := s.roleRepo.GetByID(ctx, id)
+	if err != nil {
+		return errors.New("role not found")
+	}
+
+	if role.IsSystem {
+		return errors.New("system roles cannot be deleted")
+	}
+
+	inUse, err := s.roleRepo.CountUsers(ctx, role)
+	if err != nil {
+		return err
+	}
+	if inUse > 0 {
+		return errors.New("role is still assigned to users")
+	}
+
+	return s.roleRepo.Delete(ctx, id)
+}
+
+func (s *AuthorizationServiceImpl) ListRoles(ctx context.Context) ([]*entity.Role, error) {
+	return s.roleRepo.List(ctx)
+}
+
+func (s *AuthorizationServiceImpl) SetUserRole(ctx context.Context, userID uuid.UUID, roleName string) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return errors.New("user not found")
+	}
+
+	if _, err := s.roleRepo.GetByName(ctx, roleName); err != nil {
+		return errors.New("role not found")
+	}
+
+	user.Role = roleName
+	return s.userRepo.Update(ctx, user)
+}
+
+func (s *AuthorizationServiceImpl) AssignRole(ctx context.Context, assignment *entity.RoleAssignment) error {
+	if _, err := s.userRepo.GetByID(ctx, assignment.UserID); err != nil {
+		return errors.New("user not found")
+	}
+
+	role, err := s.roleRepo.GetByID(ctx, assignment.RoleID)
+	if err != nil {
+		return errors.New("role not found")
+	}
+
+	if assignment.LocationID != nil {
+		if _, err := s.locationRepo.GetByID(ctx, *assignment.LocationID); err != nil {
+			return errors.New("location not found")
+		}
+	}
+
+	if err := s.assignmentRepo.Create(ctx, assignment); err != nil {
+		return err
+	}
+
+	assignment.Role = role
+	return nil
+}
+
+func (s *AuthorizationServiceImpl) RemoveRoleAssignment(ctx context.Context, userID, assignmentID uuid.UUID) error {
+	assignment, err := s.assignmentRepo.GetByID(ctx, assignmentID)
+	if err != nil || assignment.UserID != userID {
+		return errors.New("role assignment not found")
+	}
+
+	return s.assignmentRepo.Delete(ctx, assignmentID)
+}
+
+func (s *AuthorizationServiceImpl) ListRoleAssignments(ctx context.Context, userID uuid.UUID) ([]*entity.RoleAssignment, error) {
+	return s.assignmentRepo.ListByUser(ctx, userID)
+}
+
+func validatePermissions(permissions []string) error {
+	for _, permission := range permissions {
+		if !enum.Permission(permission).IsValid() {
+			return errors.New("invalid permission: " + permission)
+		}
+	}
+	return nil
+}
+
+func samePermissions(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	set := make(map[string]bool, len(a))
+	for _, permission := range a {
+		set[permission] = true
+	}
+	for _, permission := range b {
+		if !set[permission] {
+			return false
+		}
+	}
+	return true
+}
+
+func permissionNames(permissions ...enum.Permission) []string {
+	names := make([]string, len(permissions))
+	for i, permission := range permissions {
+		names[i] = string(permission)
+	}
+	return names
+}
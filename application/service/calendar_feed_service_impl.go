@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/enum"
+	"inventory-ticketing-system/domain/policy"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/domain/service"
+)
+
+// feedTokenPrefix marks calendar feed tokens, which are never accepted as
+// bearer tokens.
+const feedTokenPrefix = "itf_"
+
+type CalendarFeedServiceImpl struct {
+	feedRepo  repository.CalendarFeedTokenRepository
+	assetRepo repository.AssetRepository
+	userRepo  repository.UserRepository
+}
+
+func NewCalendarFeedService(
+	feedRepo repository.CalendarFeedTokenRepository,
+	assetRepo repository.AssetRepository,
+	userRepo repository.UserRepository,
+) service.CalendarFeedService {
+	return &CalendarFeedServiceImpl{
+		feedRepo:  feedRepo,
+		assetRepo: assetRepo,
+		userRepo:  userRepo,
+	}
+}
+
+// CreateFeedToken issues a token for an asset the caller may read, for the
+// caller's own reservations, or, with users:manage, for another user's.
+func (s *CalendarFeedServiceImpl) CreateFeedToken(ctx context.Context, token *entity.CalendarFeedToken) (string, error) {
+	principal, ok := policy.FromContext(ctx)
+	if !ok {
+		return "", policy.ErrForbidden
+	}
+
+	switch enum.CalendarFeed(token.Feed) {
+	case enum.CalendarFeedAsset:
+		asset, err := s.assetRepo.GetByID(ctx, token.ResourceID)
+		if err != nil {
+			return "", errors.New("asset not found")
+		}
+		if err := policy.Authorize(ctx, enum.PermissionAssetsRead, assetResource(asset)); err != nil {
+			return "", err
+		}
+	case enum.CalendarFeedUser:
+		if _, err := s.userRepo.GetByID(ctx, token.ResourceID); err != nil {
+			return "", errors.New("user not found")
+		}
+		if policy.AuthorizeOwner(ctx, token.ResourceID) != nil {
+			if err := policy.Authorize(ctx, enum.PermissionUsersManage, nil); err != nil {
+				return "", err
+			}
+		}
+	default:
+		return "", errors.New("feed must be asset or user")
+	}
+
+	plaintext, err := generateFeedToken()
+	if err != nil {
+		return "", err
+	}
+
+	token.UserID = principal.UserID
+	token.TokenPrefix = plaintext[:accessTokenDisplayLength]
+	token.TokenHash = hashAccessToken(plaintext)
+	if err := s.feedRepo.Create(ctx, token); err != nil {
+		return "", err
+	}
+	return plaintext, nil
+}
+
+func (s *CalendarFeedServiceImpl) GetFeedToken(ctx context.Context, id uuid.UUID) (*entity.CalendarFeedToken, error) {
+	return s.feedRepo.GetByID(ctx, id)
+}
+
+func (s *CalendarFeedServiceImpl) ListFeedTokens(ctx context.Context, userID uuid.UUID) ([]*entity.CalendarFeedToken, error) {
+	return s.feedRepo.ListByUser(ctx, userID)
+}
+
+// RevokeFeedToken lets owners revoke their tokens and user managers anyone's.
+func (s *CalendarFeedServiceImpl) RevokeFeedToken(ctx context.Context, id uuid.UUID) error {
+	token, err := s.feedRepo.GetByID(ctx, id)
+	if err != nil {
+		return errors.New("feed token not found")
+	}
+	if policy.AuthorizeOwner(ctx, token.UserID) != nil {
+		if err := policy.Authorize(ctx, enum.PermissionUsersManage, nil); err != nil {
+			return err
+		}
+	}
+	return s.feedRepo.Delete(ctx, id)
+}
+
+func (s *CalendarFeedServiceImpl) Authenticate(ctx context.Context, plaintext string) (*entity.CalendarFeedToken, error) {
+	token, err := s.feedRepo.GetByHash(ctx, hashAccessToken(plaintext))
+	if err != nil {
+		return nil, errors.New("invalid token")
+	}
+
+	now := time.Now()
+	if token.LastUsedAt == nil || now.Sub(*token.LastUsedAt) >= lastUsedResolution {
+		if err := s.feedRepo.TouchLastUsed(ctx, token.ID, now); err == nil {
+			token.LastUsedAt = &now
+		}
+	}
+	return token, nil
+}
+
+func generateFeedToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return feedTokenPrefix + base64.RawURLEncoding.EncodeToString(buf), nil
+}
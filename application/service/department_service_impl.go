@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/domain/service"
+)
+
+type DepartmentServiceImpl struct {
+	departmentRepo repository.DepartmentRepository
+	userRepo       repository.UserRepository
+}
+
+func NewDepartmentService(departmentRepo repository.DepartmentRepository, userRepo repository.UserRepository) service.DepartmentService {
+	return &DepartmentServiceImpl{
+		departmentRepo: departmentRepo,
+		userRepo:       userRepo,
+	}
+}
+
+func (s *DepartmentServiceImpl) CreateDepartment(ctx context.Context, department *entity.Department) error {
+	department.CostCenterCode = strings.ToUpper(strings.TrimSpace(department.CostCenterCode))
+
+	if err := s.validateReferences(ctx, department); err != nil {
+		return err
+	}
+
+	if existing, err := s.departmentRepo.GetByName(ctx, department.Name); err == nil && existing != nil {
+		return errors.New("department with this name already exists")
+	}
+	if existing, err := s.departmentRepo.GetByCostCenterCode(ctx, department.CostCenterCode); err == nil && existing != nil {
+		return errors.New("department with this cost center code already exists")
+	}
+
+	return s.departmentRepo.Create(ctx, department)
+}
+
+func (s *DepartmentServiceImpl) GetDepartment(ctx context.Context, id uuid.UUID) (*entity.Department, error) {
+	department, err := s.departmentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("department not found")
+	}
+	return department, nil
+}
+
+func (s *DepartmentServiceImpl) UpdateDepartment(ctx context.Context, id uuid.UUID, department *entity.Department) error {
+	existing, err := s.departmentRepo.GetByID(ctx, id)
+	if err != nil {
+		return errors.New("department not found")
+	}
+
+	department.CostCenterCode = strings.ToUpper(strings.TrimSpace(department.CostCenterCode))
+
+	if err := s.validateReferences(ctx, department); err != nil {
+		return err
+	}
+
+	if department.ParentID != nil {
+		subtree, err := s.departmentRepo.ListDescendantIDs(ctx, id)
+		if err != nil {
+			return err
+		}
+		for _, descendantID := range subtree {
+			if descendantID == *department.ParentID {
+				return errors.New("a department cannot be moved under itself or one of its descendants")
+			}
+		}
+	}
+
+	if department.Name != existing.Name {
+		if other, err := s.departmentRepo.GetByName(ctx, department.Name); err == nil && other != nil {
+			return errors.New("department with this name already exists")
+		}
+	}
+	if department.CostCenterCode != existing.CostCenterCode {
+		if other, err := s.departmentRepo.GetByCostCenterCode(ctx, department.CostCenterCode); err == nil && other != nil {
+			return errors.New("department with this cost center code already exists")
+		}
+	}
+
+	department.ID = id
+	department.CreatedAt = existing.CreatedAt
+	department.UpdatedAt = time.Now()
+
+	return s.departmentRepo.Update(ctx, department)
+}
+
+func (s *DepartmentServiceImpl) DeleteDepartment(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.departmentRepo.GetByID(ctx, id); err != nil {
+		return errors.New("department not found")
+	}
+
+	members, err := s.departmentRepo.CountMembers(ctx, id)
+	if err != nil {
+		return err
+	}
+	if members > 0 {
+		return errors.New("department still has users, assets or child departments")
+	}
+
+	return s.departmentRepo.Delete(ctx, id)
+}
+
+func (s *DepartmentServiceImpl) ListDepartments(ctx context.Context) ([]*entity.Department, error) {
+	return s.departmentRepo.List(ctx)
+}
+
+func (s *DepartmentServiceImpl) SetUserDepartment(ctx context.Context, userID uuid.UUID, departmentID *uuid.UUID) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return errors.New("user not found")
+	}
+
+	if departmentID != nil {
+		if _, err := s.departmentRepo.GetByID(ctx, *departmentID); err != nil {
+			return errors.New("department not found")
+		}
+	}
+
+	user.DepartmentID = departmentID
+	return s.userRepo.Update(ctx, user)
+}
+
+func (s *DepartmentServiceImpl) GetReport(ctx context.Context, from, to *time.Time) ([]*entity.DepartmentSummary, error) {
+	if from != nil && to != nil && !from.Before(*to) {
+		return nil, errors.New("from must be before to")
+	}
+	return s.departmentRepo.Summarize(ctx, from, to)
+}
+
+func (s *DepartmentServiceImpl) validateReferences(ctx context.Context, department *entity.Department) error {
+	if department.CostCenterCode == "" {
+		return errors.New("cost center code is required")
+	}
+
+	if department.ParentID != nil {
+		if _, err := s.departmentRepo.GetByID(ctx, *department.ParentID); err != nil {
+			return errors.New("parent department not found")
+		}
+	}
+
+	if department.ManagerID != nil {
+		if _, err := s.userRepo.GetByID(ctx, *department.ManagerID); err != nil {
+			return errors.New("manager not found")
+		}
+	}
+	return nil
+}
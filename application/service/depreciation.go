@@ -0,0 +1,82 @@
+package service
+
+import (
+	"math"
+	"time"
+
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/enum"
+)
+
+// depreciationSchedule writes cost down to the policy's salvage value in
+// yearly periods from acquiredAt. Amounts are rounded to cents and the final
+// year takes up whatever is left, so the schedule ends exactly at salvage.
+func depreciationSchedule(policy *entity.DepreciationPolicy, cost entity.Money, acquiredAt time.Time) []entity.DepreciationPeriod {
+	salvage := salvageValue(policy, cost)
+	life := policy.UsefulLifeYears
+	rate := policy.DecliningRate / float64(life)
+
+	periods := make([]entity.DepreciationPeriod, 0, life)
+	value := cost
+	var accumulated entity.Money
+	for year := 1; year <= life; year++ {
+		var depreciation entity.Money
+		switch {
+		case year == life:
+			depreciation = value - salvage
+		case policy.Method == string(enum.DepreciationDecliningBalance):
+			depreciation = min(scaleMoney(value, rate), value-salvage)
+		default:
+			depreciation = scaleMoney(cost-salvage, 1/float64(life))
+		}
+		accumulated += depreciation
+
+		periods = append(periods, entity.DepreciationPeriod{
+			Year:                    year,
+			Start:                   acquiredAt.AddDate(year-1, 0, 0),
+			End:                     acquiredAt.AddDate(year, 0, 0),
+			OpeningValue:            value,
+			Depreciation:            depreciation,
+			AccumulatedDepreciation: accumulated,
+			ClosingValue:            value - depreciation,
+		})
+		value -= depreciation
+	}
+	return periods
+}
+
+// bookValueAt reads the value at a point in time off a schedule, spreading
+// each year's depreciation evenly over the year.
+func bookValueAt(periods []entity.DepreciationPeriod, cost entity.Money, at time.Time) entity.Money {
+	for _, period := range periods {
+		if at.Before(period.Start) {
+			return period.OpeningValue
+		}
+		if at.Before(period.End) {
+			elapsed := float64(at.Sub(period.Start)) / float64(period.End.Sub(period.Start))
+			return period.OpeningValue - scaleMoney(period.Depreciation, elapsed)
+		}
+	}
+	if len(periods) == 0 {
+		return cost
+	}
+	return periods[len(periods)-1].ClosingValue
+}
+
+func salvageValue(policy *entity.DepreciationPolicy, cost entity.Money) entity.Money {
+	return scaleMoney(cost, policy.SalvagePercent/100)
+}
+
+// acquiredAt is when an asset's depreciation starts: its purchase date, or
+// when it was recorded if that is unknown.
+func acquiredAt(asset *entity.Asset) time.Time {
+	if asset.PurchaseDate != nil {
+		return *asset.PurchaseDate
+	}
+	return asset.CreatedAt
+}
+
+// scaleMoney multiplies an amount by factor, rounding to the cent.
+func scaleMoney(amount entity.Money, factor float64) entity.Money {
+	return entity.Money(math.Round(float64(amount) * factor))
+}
@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/enum"
+	"inventory-ticketing-system/domain/policy"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/domain/service"
+
+	"github.com/google/uuid"
+)
+
+type DepreciationServiceImpl struct {
+	policyRepo repository.DepreciationPolicyRepository
+	assetRepo  repository.AssetRepository
+}
+
+func NewDepreciationService(
+	policyRepo repository.DepreciationPolicyRepository,
+	assetRepo repository.AssetRepository,
+) service.DepreciationService {
+	return &DepreciationServiceImpl{
+		policyRepo: policyRepo,
+		assetRepo:  assetRepo,
+	}
+}
+
+func (s *DepreciationServiceImpl) CreatePolicy(ctx context.Context, policy *entity.DepreciationPolicy) error {
+	if err := s.validatePolicy(ctx, uuid.Nil, policy); err != nil {
+		return err
+	}
+	return s.policyRepo.Create(ctx, policy)
+}
+
+func (s *DepreciationServiceImpl) GetPolicy(ctx context.Context, id uuid.UUID) (*entity.DepreciationPolicy, error) {
+	policy, err := s.policyRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("depreciation policy not found")
+	}
+	return policy, nil
+}
+
+func (s *DepreciationServiceImpl) UpdatePolicy(ctx context.Context, id uuid.UUID, policy *entity.DepreciationPolicy) error {
+	existing, err := s.policyRepo.GetByID(ctx, id)
+	if err != nil {
+		return errors.New("depreciation policy not found")
+	}
+	if err := s.validatePolicy(ctx, id, policy); err != nil {
+		return err
+	}
+
+	policy.ID = id
+	policy.CreatedAt = existing.CreatedAt
+	return s.policyRepo.Update(ctx, policy)
+}
+
+func (s *DepreciationServiceImpl) DeletePolicy(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.policyRepo.GetByID(ctx, id); err != nil {
+		return errors.New("depreciation policy not found")
+	}
+	return s.policyRepo.Delete(ctx, id)
+}
+
+func (s *DepreciationServiceImpl) ListPolicies(ctx context.Context) ([]*entity.DepreciationPolicy, error) {
+	return s.policyRepo.List(ctx)
+}
+
+func (s *DepreciationServiceImpl) GetSchedule(ctx context.Context, assetID uuid.UUID) (*entity.DepreciationSchedule, error) {
+	asset, err := s.assetRepo.GetByID(ctx, assetID)
+	if err != nil {
+		return nil, errors.New("asset not found")
+	}
+	if err := policy.Authorize(ctx, enum.PermissionAssetsRead, assetResource(asset)); err != nil {
+		return nil, err
+	}
+
+	if asset.PurchaseCost <= 0 {
+		return nil, errors.New("asset has no purchase cost")
+	}
+	depreciationPolicy, err := s.policyRepo.GetByCategory(ctx, asset.Category)
+	if err != nil {
+		return nil, fmt.Errorf("no depreciation policy for category %q", asset.Category)
+	}
+
+	acquired := acquiredAt(asset)
+	periods := depreciationSchedule(depreciationPolicy, asset.PurchaseCost, acquired)
+	return &entity.DepreciationSchedule{
+		AssetID:      asset.ID,
+		Cost:         asset.PurchaseCost,
+		Currency:     asset.Currency,
+		AcquiredAt:   acquired,
+		SalvageValue: salvageValue(depreciationPolicy, asset.PurchaseCost),
+		BookValue:    bookValueAt(periods, asset.PurchaseCost, time.Now()),
+		Policy:       depreciationPolicy,
+		Periods:      periods,
+	}, nil
+}
+
+func (s *DepreciationServiceImpl) GetValuation(ctx context.Context, at time.Time) (*entity.AssetValuation, error) {
+	policies, err := s.policyRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byCategory := make(map[string]*entity.DepreciationPolicy, len(policies))
+	for _, depreciationPolicy := range policies {
+		byCategory[strings.ToLower(depreciationPolicy.Category)] = depreciationPolicy
+	}
+
+	assets, err := s.assetRepo.ListHeldAt(ctx, at)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make(map[string]*entity.ValuationGroup)
+	totals := make(map[string]*entity.ValuationTotal)
+	for _, asset := range assets {
+		bookValue := asset.PurchaseCost
+		if depreciationPolicy, ok := byCategory[strings.ToLower(asset.Category)]; ok && asset.PurchaseCost > 0 {
+			periods := depreciationSchedule(depreciationPolicy, asset.PurchaseCost, acquiredAt(asset))
+			bookValue = bookValueAt(periods, asset.PurchaseCost, at)
+		}
+
+		location := asset.LocationLabel
+		if asset.Location != nil {
+			location = asset.Location.Name
+		}
+		key := strings.Join([]string{asset.Category, location, asset.Currency}, "\x00")
+		if asset.LocationID != nil {
+			key += "\x00" + asset.LocationID.String()
+		}
+		group, ok := groups[key]
+		if !ok {
+			group = &entity.ValuationGroup{
+				Category:   asset.Category,
+				LocationID: asset.LocationID,
+				Location:   location,
+				Currency:   asset.Currency,
+			}
+			groups[key] = group
+		}
+		total, ok := totals[asset.Currency]
+		if !ok {
+			total = &entity.ValuationTotal{Currency: asset.Currency}
+			totals[asset.Currency] = total
+		}
+
+		addValuation(&group.ValuationAmounts, asset, bookValue)
+		addValuation(&total.ValuationAmounts, asset, bookValue)
+	}
+
+	valuation := &entity.AssetValuation{
+		At:     at,
+		Groups: make([]*entity.ValuationGroup, 0, len(groups)),
+		Totals: make([]*entity.ValuationTotal, 0, len(totals)),
+	}
+	for _, group := range groups {
+		valuation.Groups = append(valuation.Groups, group)
+	}
+	for _, total := range totals {
+		valuation.Totals = append(valuation.Totals, total)
+	}
+	sort.Slice(valuation.Groups, func(i, j int) bool {
+		a, b := valuation.Groups[i], valuation.Groups[j]
+		if a.Category != b.Category {
+			return a.Category < b.Category
+		}
+		if a.Location != b.Location {
+			return a.Location < b.Location
+		}
+		return a.Currency < b.Currency
+	})
+	sort.Slice(valuation.Totals, func(i, j int) bool {
+		return valuation.Totals[i].Currency < valuation.Totals[j].Currency
+	})
+	return valuation, nil
+}
+
+func (s *DepreciationServiceImpl) validatePolicy(ctx context.Context, id uuid.UUID, depreciationPolicy *entity.DepreciationPolicy) error {
+	depreciationPolicy.Category = strings.TrimSpace(depreciationPolicy.Category)
+	if depreciationPolicy.Category == "" {
+		return errors.New("category is required")
+	}
+	if !enum.DepreciationMethod(depreciationPolicy.Method).IsValid() {
+		return fmt.Errorf("invalid depreciation method %q", depreciationPolicy.Method)
+	}
+	if depreciationPolicy.UsefulLifeYears < 1 {
+		return errors.New("useful life must be at least one year")
+	}
+	if depreciationPolicy.SalvagePercent < 0 || depreciationPolicy.SalvagePercent >= 100 {
+		return errors.New("salvage percent must be at least 0 and below 100")
+	}
+	if depreciationPolicy.DecliningRate == 0 {
+		depreciationPolicy.DecliningRate = 2
+	}
+	if depreciationPolicy.DecliningRate < 0 {
+		return errors.New("declining rate must be positive")
+	}
+
+	existing, err := s.policyRepo.GetByCategory(ctx, depreciationPolicy.Category)
+	if err == nil && existing.ID != id {
+		return errors.New("category already has a depreciation policy")
+	}
+	return nil
+}
+
+func addValuation(amounts *entity.ValuationAmounts, asset *entity.Asset, bookValue entity.Money) {
+	amounts.AssetCount++
+	amounts.Units += asset.Qty
+	amounts.Cost += asset.PurchaseCost
+	amounts.BookValue += bookValue
+	amounts.AccumulatedDepreciation = amounts.Cost - amounts.BookValue
+}
@@ -0,0 +1,185 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/enum"
+)
+
+func TestDepreciationSchedule(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy entity.DepreciationPolicy
+		cost   entity.Money
+		want   []entity.Money // depreciation per year
+	}{
+		{
+			name:   "straight line",
+			policy: straightLine(4, 0),
+			cost:   100000,
+			want:   []entity.Money{25000, 25000, 25000, 25000},
+		},
+		{
+			name:   "straight line to salvage",
+			policy: straightLine(3, 10),
+			cost:   100000,
+			want:   []entity.Money{30000, 30000, 30000},
+		},
+		{
+			name:   "last year takes the rounding remainder",
+			policy: straightLine(3, 0),
+			cost:   10000,
+			want:   []entity.Money{3333, 3333, 3334},
+		},
+		{
+			name:   "declining balance",
+			policy: decliningBalance(5, 0, 2),
+			cost:   100000,
+			want:   []entity.Money{40000, 24000, 14400, 8640, 12960},
+		},
+		{
+			name:   "declining balance stops at salvage",
+			policy: decliningBalance(5, 20, 2),
+			cost:   100000,
+			want:   []entity.Money{40000, 24000, 14400, 1600, 0},
+		},
+		{
+			name:   "declining balance rounds each year",
+			policy: decliningBalance(3, 0, 2),
+			cost:   1000,
+			want:   []entity.Money{667, 222, 111},
+		},
+		{
+			name:   "single year",
+			policy: straightLine(1, 5),
+			cost:   9999,
+			want:   []entity.Money{9499},
+		},
+		{
+			name:   "no cost",
+			policy: straightLine(3, 10),
+			cost:   0,
+			want:   []entity.Money{0, 0, 0},
+		},
+	}
+
+	acquired := date(2024, 3, 15)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			periods := depreciationSchedule(&tt.policy, tt.cost, acquired)
+			if len(periods) != len(tt.want) {
+				t.Fatalf("got %d periods, want %d", len(periods), len(tt.want))
+			}
+
+			value := tt.cost
+			var accumulated entity.Money
+			for i, period := range periods {
+				accumulated += tt.want[i]
+				if period.Year != i+1 {
+					t.Errorf("period %d: year = %d", i, period.Year)
+				}
+				if !period.Start.Equal(acquired.AddDate(i, 0, 0)) || !period.End.Equal(acquired.AddDate(i+1, 0, 0)) {
+					t.Errorf("year %d: runs %s to %s", period.Year, period.Start, period.End)
+				}
+				if period.OpeningValue != value {
+					t.Errorf("year %d: opening value = %s, want %s", period.Year, period.OpeningValue, value)
+				}
+				if period.Depreciation != tt.want[i] {
+					t.Errorf("year %d: depreciation = %s, want %s", period.Year, period.Depreciation, tt.want[i])
+				}
+				if period.AccumulatedDepreciation != accumulated {
+					t.Errorf("year %d: accumulated = %s, want %s", period.Year, period.AccumulatedDepreciation, accumulated)
+				}
+				value -= tt.want[i]
+				if period.ClosingValue != value {
+					t.Errorf("year %d: closing value = %s, want %s", period.Year, period.ClosingValue, value)
+				}
+			}
+
+			if salvage := salvageValue(&tt.policy, tt.cost); value != salvage {
+				t.Errorf("schedule ends at %s, want salvage %s", value, salvage)
+			}
+		})
+	}
+}
+
+func TestSalvageValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		percent float64
+		cost    entity.Money
+		want    entity.Money
+	}{
+		{name: "none", percent: 0, cost: 100000, want: 0},
+		{name: "whole percent", percent: 10, cost: 100000, want: 10000},
+		{name: "rounds to the cent", percent: 12.5, cost: 99999, want: 12500},
+		{name: "rounds down", percent: 33.33, cost: 100, want: 33},
+		{name: "all of it", percent: 100, cost: 4321, want: 4321},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := straightLine(5, tt.percent)
+			if got := salvageValue(&policy, tt.cost); got != tt.want {
+				t.Errorf("salvageValue(%v%% of %s) = %s, want %s", tt.percent, tt.cost, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBookValueAt(t *testing.T) {
+	// 25000 a year from 2024-01-01; 2024 is a leap year of 366 days
+	policy := straightLine(4, 0)
+	periods := depreciationSchedule(&policy, 100000, date(2024, 1, 1))
+
+	tests := []struct {
+		name string
+		at   time.Time
+		want entity.Money
+	}{
+		{name: "before acquisition", at: date(2023, 6, 1), want: 100000},
+		{name: "on acquisition", at: date(2024, 1, 1), want: 100000},
+		// 182 of 366 days: 25000 * 182 / 366 = 12431.69
+		{name: "partial first period", at: date(2024, 7, 1), want: 87568},
+		{name: "start of the second year", at: date(2025, 1, 1), want: 75000},
+		// 181 of 365 days: 25000 * 181 / 365 = 12397.26
+		{name: "partial later period", at: date(2025, 7, 1), want: 62603},
+		{name: "end of life", at: date(2028, 1, 1), want: 0},
+		{name: "after end of life", at: date(2030, 1, 1), want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := bookValueAt(periods, 100000, tt.at); got != tt.want {
+				t.Errorf("bookValueAt(%s) = %s, want %s", tt.at.Format(time.DateOnly), got, tt.want)
+			}
+		})
+	}
+
+	if got := bookValueAt(nil, 4200, date(2024, 1, 1)); got != 4200 {
+		t.Errorf("bookValueAt without a schedule = %s, want the cost 42.00", got)
+	}
+}
+
+func straightLine(years int, salvagePercent float64) entity.DepreciationPolicy {
+	return entity.DepreciationPolicy{
+		Method:          string(enum.DepreciationStraightLine),
+		UsefulLifeYears: years,
+		SalvagePercent:  salvagePercent,
+	}
+}
+
+func decliningBalance(years int, salvagePercent, rate float64) entity.DepreciationPolicy {
+	return entity.DepreciationPolicy{
+		Method:          string(enum.DepreciationDecliningBalance),
+		UsefulLifeYears: years,
+		SalvagePercent:  salvagePercent,
+		DecliningRate:   rate,
+	}
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"time"
+
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/pkg/pdf"
+)
+
+const (
+	certificateMargin     = 50.0
+	certificateValueX     = 210.0
+	certificateFontSize   = 10.0
+	certificateRowHeight  = 16.0
+	certificateDateFormat = "2 January 2006 15:04 MST"
+)
+
+// renderDisposalCertificate lays out the certificate of an approved
+// disposal on a single A4 page. The names identify the requester, approver
+// and whoever confirmed the data wipe.
+func renderDisposalCertificate(disposal *entity.DisposalRequest, requester, approver, wipedBy string) []byte {
+	doc := pdf.New()
+	width := pdf.PageWidth - 2*certificateMargin
+	y := pdf.PageHeight - 70
+
+	doc.Text(certificateMargin, y, 20, true, "Certificate of Asset Disposal")
+	y -= 22
+	doc.Text(certificateMargin, y, 11, false, "Certificate No. "+disposal.CertificateNumber)
+	y -= 14
+	doc.Line(certificateMargin, y, pdf.PageWidth-certificateMargin, y, 1)
+	y -= 28
+
+	section := func(title string) {
+		doc.Text(certificateMargin, y, 12, true, title)
+		y -= certificateRowHeight + 4
+	}
+	row := func(label, value string) {
+		if value == "" {
+			value = "-"
+		}
+		doc.Text(certificateMargin, y, certificateFontSize, true, label)
+		y = doc.Paragraph(certificateValueX, y, pdf.PageWidth-certificateMargin-certificateValueX, certificateFontSize, value)
+		y -= certificateRowHeight - certificateFontSize*1.4
+	}
+
+	asset := disposal.Asset
+	if asset == nil {
+		asset = &entity.Asset{ID: disposal.AssetID}
+	}
+	section("Asset")
+	row("Name", asset.Name)
+	row("Asset ID", asset.UniqueID)
+	row("Type", asset.Type)
+	row("Brand", asset.Brand)
+	row("Category", asset.Category)
+	y -= 12
+
+	section("Disposal")
+	row("Method", disposal.Method)
+	row("Estimated value", disposal.EstimatedValue.String())
+	row("Reason", disposal.Reason)
+	y -= 12
+
+	section("Data sanitisation")
+	switch {
+	case disposal.IsDataWipeConfirmed():
+		row("Wipe method", disposal.DataWipeMethod)
+		row("Confirmed by", wipedBy)
+		row("Confirmed on", formatCertificateTime(disposal.DataWipeConfirmedAt))
+		row("Notes", disposal.DataWipeNotes)
+	case asset.DataBearing:
+		row("Status", "Not confirmed")
+	default:
+		row("Status", "Not applicable, the asset does not hold data")
+	}
+	y -= 12
+
+	section("Approval")
+	row("Requested by", requester)
+	row("Requested on", formatCertificateTime(&disposal.CreatedAt))
+	row("Approved by", approver)
+	row("Approved on", formatCertificateTime(disposal.DecidedAt))
+	row("Comment", disposal.DecisionComment)
+	y -= 24
+
+	doc.Line(certificateMargin, y, pdf.PageWidth-certificateMargin, y, 0.5)
+	y -= 18
+	doc.Paragraph(certificateMargin, y, width, 9,
+		"This certificate records that the asset above was approved for disposal and removed from the active inventory.")
+
+	return doc.Bytes()
+}
+
+func formatCertificateTime(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(certificateDateFormat)
+}
@@ -0,0 +1,274 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/enum"
+	"inventory-ticketing-system/domain/policy"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/domain/service"
+
+	"github.com/google/uuid"
+)
+
+type DisposalServiceImpl struct {
+	disposalRepo repository.DisposalRepository
+	assetRepo    repository.AssetRepository
+	userRepo     repository.UserRepository
+	assetService service.AssetService
+	txManager    repository.TransactionManager
+}
+
+func NewDisposalService(
+	disposalRepo repository.DisposalRepository,
+	assetRepo repository.AssetRepository,
+	userRepo repository.UserRepository,
+	assetService service.AssetService,
+	txManager repository.TransactionManager,
+) service.DisposalService {
+	return &DisposalServiceImpl{
+		disposalRepo: disposalRepo,
+		assetRepo:    assetRepo,
+		userRepo:     userRepo,
+		assetService: assetService,
+		txManager:    txManager,
+	}
+}
+
+func (s *DisposalServiceImpl) Propose(ctx context.Context, disposal *entity.DisposalRequest) error {
+	if strings.TrimSpace(disposal.Reason) == "" {
+		return errors.New("a reason is required")
+	}
+	if !enum.DisposalMethod(disposal.Method).IsValid() {
+		return fmt.Errorf("invalid disposal method %q", disposal.Method)
+	}
+	if disposal.EstimatedValue < 0 {
+		return errors.New("estimated value cannot be negative")
+	}
+
+	asset, err := s.assetRepo.GetByID(ctx, disposal.AssetID)
+	if err != nil {
+		return errors.New("asset not found")
+	}
+	if err := policy.Authorize(ctx, enum.PermissionAssetsRead, assetResource(asset)); err != nil {
+		return err
+	}
+	if !enum.AssetStatus(asset.Status).CanTransitionTo(enum.AssetStatusDisposed) {
+		return fmt.Errorf("a %s asset cannot be disposed of", asset.Status)
+	}
+	if _, err := s.disposalRepo.GetPendingByAsset(ctx, asset.ID); err == nil {
+		return errors.New("asset already has a pending disposal request")
+	}
+
+	if disposal.ID == uuid.Nil {
+		disposal.ID = uuid.New()
+	}
+	disposal.Status = string(enum.DisposalPending)
+	if err := s.disposalRepo.Create(ctx, disposal); err != nil {
+		return err
+	}
+	disposal.Asset = asset
+	return nil
+}
+
+func (s *DisposalServiceImpl) GetDisposal(ctx context.Context, id uuid.UUID) (*entity.DisposalRequest, error) {
+	disposal, err := s.disposalRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("disposal request not found")
+	}
+	if err := authorizeDisposalRead(ctx, disposal); err != nil {
+		return nil, err
+	}
+	return disposal, nil
+}
+
+func (s *DisposalServiceImpl) ListDisposals(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.DisposalRequest, int, error) {
+	principal, err := policy.Caller(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	if principal != nil {
+		conditions, unrestricted := principal.Conditions(enum.PermissionDisposalsApprove)
+		switch {
+		case unrestricted:
+		case len(conditions) > 0:
+			filters["scope"] = conditions
+		default:
+			filters["requested_by"] = principal.UserID
+		}
+	}
+	return s.disposalRepo.List(ctx, limit, offset, filters)
+}
+
+// ConfirmDataWipe may be recorded by whoever works tickets on the asset or
+// may approve its disposal.
+func (s *DisposalServiceImpl) ConfirmDataWipe(ctx context.Context, id uuid.UUID, method, notes string) (*entity.DisposalRequest, error) {
+	if strings.TrimSpace(method) == "" {
+		return nil, errors.New("a wipe method is required")
+	}
+
+	disposal, err := s.disposalRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("disposal request not found")
+	}
+	resource := assetResource(disposal.Asset)
+	if policy.Authorize(ctx, enum.PermissionTicketsWork, resource) != nil {
+		if err := policy.Authorize(ctx, enum.PermissionDisposalsApprove, resource); err != nil {
+			return nil, err
+		}
+	}
+	if disposal.Status != string(enum.DisposalPending) {
+		return nil, fmt.Errorf("disposal request is already %s", disposal.Status)
+	}
+
+	now := time.Now()
+	disposal.DataWipeConfirmedAt = &now
+	disposal.DataWipeConfirmedBy = callerID(ctx)
+	disposal.DataWipeMethod = method
+	disposal.DataWipeNotes = notes
+	if err := s.disposalRepo.Update(ctx, disposal); err != nil {
+		return nil, err
+	}
+	return disposal, nil
+}
+
+// Approve marks the asset disposed as the approver, who therefore also needs
+// write access to the asset.
+func (s *DisposalServiceImpl) Approve(ctx context.Context, id uuid.UUID, comment string) (*entity.DisposalRequest, error) {
+	disposal, err := s.pendingForDecision(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if disposal.Asset.DataBearing && !disposal.IsDataWipeConfirmed() {
+		return nil, errors.New("the data wipe must be confirmed before a data-bearing asset is disposed of")
+	}
+
+	now := time.Now()
+	disposal.Status = string(enum.DisposalApproved)
+	disposal.DecidedBy = callerID(ctx)
+	disposal.DecidedAt = &now
+	disposal.DecisionComment = comment
+	disposal.CertificateNumber = certificateNumber(disposal, now)
+
+	reason := fmt.Sprintf("%s (%s, certificate %s)", disposal.Reason, disposal.Method, disposal.CertificateNumber)
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.disposalRepo.Update(ctx, disposal); err != nil {
+			return err
+		}
+		return s.assetService.UpdateAssetStatus(ctx, disposal.AssetID, string(enum.AssetStatusDisposed), reason)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	disposal.Asset.Status = string(enum.AssetStatusDisposed)
+	return disposal, nil
+}
+
+func (s *DisposalServiceImpl) Reject(ctx context.Context, id uuid.UUID, comment string) (*entity.DisposalRequest, error) {
+	if strings.TrimSpace(comment) == "" {
+		return nil, errors.New("a comment is required to reject a disposal request")
+	}
+
+	disposal, err := s.pendingForDecision(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	disposal.Status = string(enum.DisposalRejected)
+	disposal.DecidedBy = callerID(ctx)
+	disposal.DecidedAt = &now
+	disposal.DecisionComment = comment
+	if err := s.disposalRepo.Update(ctx, disposal); err != nil {
+		return nil, err
+	}
+	return disposal, nil
+}
+
+func (s *DisposalServiceImpl) Cancel(ctx context.Context, id uuid.UUID) (*entity.DisposalRequest, error) {
+	disposal, err := s.disposalRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("disposal request not found")
+	}
+	if err := policy.AuthorizeOwner(ctx, disposal.RequestedBy); err != nil {
+		return nil, err
+	}
+	if disposal.Status != string(enum.DisposalPending) {
+		return nil, fmt.Errorf("disposal request is already %s", disposal.Status)
+	}
+
+	disposal.Status = string(enum.DisposalCancelled)
+	if err := s.disposalRepo.Update(ctx, disposal); err != nil {
+		return nil, err
+	}
+	return disposal, nil
+}
+
+func (s *DisposalServiceImpl) Certificate(ctx context.Context, id uuid.UUID) (*entity.DisposalRequest, []byte, error) {
+	disposal, err := s.GetDisposal(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if disposal.Status != string(enum.DisposalApproved) {
+		return nil, nil, errors.New("only approved disposals have a certificate")
+	}
+
+	return disposal, renderDisposalCertificate(disposal, s.userName(ctx, &disposal.RequestedBy),
+		s.userName(ctx, disposal.DecidedBy), s.userName(ctx, disposal.DataWipeConfirmedBy)), nil
+}
+
+// pendingForDecision loads a pending request the caller may approve or
+// reject.
+func (s *DisposalServiceImpl) pendingForDecision(ctx context.Context, id uuid.UUID) (*entity.DisposalRequest, error) {
+	disposal, err := s.disposalRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("disposal request not found")
+	}
+	if err := policy.Authorize(ctx, enum.PermissionDisposalsApprove, assetResource(disposal.Asset)); err != nil {
+		return nil, err
+	}
+	if disposal.Status != string(enum.DisposalPending) {
+		return nil, fmt.Errorf("disposal request is already %s", disposal.Status)
+	}
+	return disposal, nil
+}
+
+// userName describes a user for the certificate, falling back to the ID if
+// the user has been deleted.
+func (s *DisposalServiceImpl) userName(ctx context.Context, id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	user, err := s.userRepo.GetByID(ctx, *id)
+	if err != nil {
+		return id.String()
+	}
+	return fmt.Sprintf("%s <%s>", user.Name, user.Email)
+}
+
+// authorizeDisposalRead lets requesters see their own requests and approvers
+// the requests for assets they could approve.
+func authorizeDisposalRead(ctx context.Context, disposal *entity.DisposalRequest) error {
+	if principal, ok := policy.FromContext(ctx); ok && principal.UserID == disposal.RequestedBy {
+		return nil
+	}
+	return policy.Authorize(ctx, enum.PermissionDisposalsApprove, assetResource(disposal.Asset))
+}
+
+func callerID(ctx context.Context) *uuid.UUID {
+	if principal, ok := policy.FromContext(ctx); ok {
+		return &principal.UserID
+	}
+	return nil
+}
+
+func certificateNumber(disposal *entity.DisposalRequest, approvedAt time.Time) string {
+	return fmt.Sprintf("DISP-%s-%s", approvedAt.UTC().Format("20060102"),
+		strings.ToUpper(strings.ReplaceAll(disposal.ID.String(), "-", "")[:8]))
+}
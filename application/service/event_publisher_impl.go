@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/enum"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/domain/service"
+)
+
+type OutboxEventPublisher struct {
+	outboxRepo repository.OutboxRepository
+}
+
+func NewEventPublisher(outboxRepo repository.OutboxRepository) service.EventPublisher {
+	return &OutboxEventPublisher{
+		outboxRepo: outboxRepo,
+	}
+}
+
+func (p *OutboxEventPublisher) Publish(ctx context.Context, eventType enum.EventType, aggregateID uuid.UUID, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	aggregateType, _, _ := strings.Cut(string(eventType), ".")
+	now := time.Now()
+
+	return p.outboxRepo.Create(ctx, &entity.OutboxEvent{
+		ID:            uuid.New(),
+		EventType:     string(eventType),
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		Payload:       string(data),
+		OccurredAt:    now,
+		AvailableAt:   now,
+	})
+}
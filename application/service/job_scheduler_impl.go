@@ -0,0 +1,277 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/enum"
+	"inventory-ticketing-system/domain/policy"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/domain/service"
+	"inventory-ticketing-system/pkg/cron"
+)
+
+const (
+	// defaultJobTimeout applies to jobs that do not set a timeout.
+	defaultJobTimeout = 10 * time.Minute
+	// jobRunRetention is how long finished runs are kept in the history.
+	jobRunRetention = 30 * 24 * time.Hour
+)
+
+var jobNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+type scheduledJob struct {
+	service.Job
+	schedule cron.Schedule
+}
+
+type JobSchedulerImpl struct {
+	jobRepo  repository.ScheduledJobRepository
+	runRepo  repository.JobRunRepository
+	instance string
+	jobs     map[string]*scheduledJob
+}
+
+func NewJobScheduler(
+	jobRepo repository.ScheduledJobRepository,
+	runRepo repository.JobRunRepository,
+) service.JobScheduler {
+	hostname, _ := os.Hostname()
+	return &JobSchedulerImpl{
+		jobRepo:  jobRepo,
+		runRepo:  runRepo,
+		instance: fmt.Sprintf("%s-%d-%s", hostname, os.Getpid(), uuid.NewString()[:8]),
+		jobs:     make(map[string]*scheduledJob),
+	}
+}
+
+// Jobs returns the scheduler's own housekeeping.
+func (s *JobSchedulerImpl) Jobs() []service.Job {
+	return []service.Job{
+		{
+			Name:        "job_run_cleanup",
+			Description: "Delete job runs older than 30 days",
+			Schedule:    "@daily",
+			Run: func(ctx context.Context) (string, error) {
+				deleted, err := s.runRepo.DeleteFinishedBefore(ctx, time.Now().Add(-jobRunRetention))
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprintf("deleted %d runs", deleted), nil
+			},
+		},
+	}
+}
+
+func (s *JobSchedulerImpl) Register(ctx context.Context, providers ...service.JobProvider) error {
+	now := time.Now().UTC()
+	for _, provider := range providers {
+		for _, job := range provider.Jobs() {
+			if !jobNamePattern.MatchString(job.Name) {
+				return errors.New("invalid job name: " + job.Name)
+			}
+			if _, exists := s.jobs[job.Name]; exists {
+				return errors.New("job registered twice: " + job.Name)
+			}
+
+			schedule, err := cron.Parse(job.Schedule)
+			if err != nil {
+				return fmt.Errorf("invalid schedule for job %s: %w", job.Name, err)
+			}
+			if schedule.Next(now).IsZero() {
+				return errors.New("schedule never fires for job " + job.Name)
+			}
+			if job.Timeout <= 0 {
+				job.Timeout = defaultJobTimeout
+			}
+
+			if err := s.jobRepo.Upsert(ctx, &entity.ScheduledJob{
+				Name:        job.Name,
+				Description: job.Description,
+				Schedule:    job.Schedule,
+				NextRunAt:   schedule.Next(now),
+			}); err != nil {
+				return err
+			}
+			s.jobs[job.Name] = &scheduledJob{Job: job, schedule: schedule}
+		}
+	}
+	return nil
+}
+
+func (s *JobSchedulerImpl) RunDue(ctx context.Context) (int, error) {
+	started := 0
+	for _, job := range s.jobs {
+		now := time.Now().UTC()
+		claimed, err := s.jobRepo.Claim(ctx, job.Name, s.instance, now, now.Add(job.Timeout), true)
+		if err != nil {
+			return started, err
+		}
+		if !claimed {
+			continue
+		}
+
+		run, err := s.startRun(ctx, job, enum.JobTriggerSchedule, nil)
+		if err != nil {
+			s.release(job, false)
+			return started, err
+		}
+		go s.execute(ctx, job, run, true)
+		started++
+	}
+	return started, nil
+}
+
+func (s *JobSchedulerImpl) ListJobs(ctx context.Context) ([]*entity.ScheduledJob, error) {
+	jobs, err := s.jobRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	latest, err := s.runRepo.LatestByJob(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Jobs no longer registered by this version are left out
+	registered := make([]*entity.ScheduledJob, 0, len(jobs))
+	for _, job := range jobs {
+		if _, ok := s.jobs[job.Name]; ok {
+			job.LastRun = latest[job.Name]
+			registered = append(registered, job)
+		}
+	}
+	return registered, nil
+}
+
+func (s *JobSchedulerImpl) GetJob(ctx context.Context, name string) (*entity.ScheduledJob, error) {
+	if _, ok := s.jobs[name]; !ok {
+		return nil, errors.New("job not found")
+	}
+
+	job, err := s.jobRepo.GetByName(ctx, name)
+	if err != nil {
+		return nil, errors.New("job not found")
+	}
+
+	runs, _, err := s.runRepo.ListByJob(ctx, name, 1, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(runs) > 0 {
+		job.LastRun = runs[0]
+	}
+	return job, nil
+}
+
+func (s *JobSchedulerImpl) ListRuns(ctx context.Context, name string, limit, offset int) ([]*entity.JobRun, int, error) {
+	if _, ok := s.jobs[name]; !ok {
+		return nil, 0, errors.New("job not found")
+	}
+	return s.runRepo.ListByJob(ctx, name, limit, offset)
+}
+
+// Trigger runs the job now without moving its next scheduled run. The run
+// does not inherit the caller's permissions or request lifetime.
+func (s *JobSchedulerImpl) Trigger(ctx context.Context, name string, triggeredBy uuid.UUID) (*entity.JobRun, error) {
+	job, ok := s.jobs[name]
+	if !ok {
+		return nil, errors.New("job not found")
+	}
+
+	now := time.Now().UTC()
+	claimed, err := s.jobRepo.Claim(ctx, job.Name, s.instance, now, now.Add(job.Timeout), false)
+	if err != nil {
+		return nil, err
+	}
+	if !claimed {
+		return nil, errors.New("job is already running")
+	}
+
+	run, err := s.startRun(ctx, job, enum.JobTriggerManual, &triggeredBy)
+	if err != nil {
+		s.release(job, false)
+		return nil, err
+	}
+
+	// The run outlives the request and acts as the system, like scheduled runs
+	go s.execute(policy.AsSystem(context.Background()), job, run, false)
+	return run, nil
+}
+
+// startRun records a new run. Any earlier run still marked running belonged
+// to an instance whose lease expired, since this instance now holds it.
+func (s *JobSchedulerImpl) startRun(ctx context.Context, job *scheduledJob, trigger enum.JobTrigger, triggeredBy *uuid.UUID) (*entity.JobRun, error) {
+	now := time.Now().UTC()
+	if err := s.runRepo.FailRunning(ctx, job.Name, "lease expired before the run finished", now); err != nil {
+		return nil, err
+	}
+
+	run := &entity.JobRun{
+		ID:          uuid.New(),
+		JobName:     job.Name,
+		Trigger:     string(trigger),
+		TriggeredBy: triggeredBy,
+		Instance:    s.instance,
+		Status:      string(enum.JobRunRunning),
+		StartedAt:   now,
+	}
+	if err := s.runRepo.Create(ctx, run); err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+// execute runs the job, records the outcome and releases the lease.
+// Scheduled runs also move the job to its next activation.
+func (s *JobSchedulerImpl) execute(ctx context.Context, job *scheduledJob, run *entity.JobRun, scheduled bool) {
+	runCtx, cancel := context.WithTimeout(ctx, job.Timeout)
+	result, err := s.invoke(runCtx, job)
+	cancel()
+
+	finishedAt := time.Now().UTC()
+	run.FinishedAt = &finishedAt
+	run.DurationMs = finishedAt.Sub(run.StartedAt).Milliseconds()
+	run.Result = result
+	run.Status = string(enum.JobRunSucceeded)
+	if err != nil {
+		run.Status = string(enum.JobRunFailed)
+		run.Error = err.Error()
+		log.Printf("Job %s failed: %v", job.Name, err)
+	}
+
+	// Record the outcome even when the scheduler is shutting down
+	recordCtx := context.WithoutCancel(ctx)
+	if err := s.runRepo.Update(recordCtx, run); err != nil {
+		log.Printf("Failed to record run of job %s: %v", job.Name, err)
+	}
+	s.release(job, scheduled)
+}
+
+// invoke calls the job function, turning a panic into an error.
+func (s *JobSchedulerImpl) invoke(ctx context.Context, job *scheduledJob) (result string, err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("panic: %v", recovered)
+		}
+	}()
+	return job.Run(ctx)
+}
+
+func (s *JobSchedulerImpl) release(job *scheduledJob, moveNextRun bool) {
+	var nextRunAt *time.Time
+	if moveNextRun {
+		next := job.schedule.Next(time.Now().UTC())
+		nextRunAt = &next
+	}
+	if err := s.jobRepo.Release(context.Background(), job.Name, s.instance, nextRunAt); err != nil {
+		log.Printf("Failed to release job %s: %v", job.Name, err)
+	}
+}
@@ -0,0 +1,560 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/enum"
+	"inventory-ticketing-system/domain/policy"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/domain/service"
+
+	"github.com/google/uuid"
+)
+
+type KitServiceImpl struct {
+	kitRepo         repository.KitRepository
+	kitCheckoutRepo repository.KitCheckoutRepository
+	checkoutRepo    repository.AssetCheckoutRepository
+	unitRepo        repository.AssetUnitRepository
+	reservationRepo repository.ReservationRepository
+	assetRepo       repository.AssetRepository
+	userRepo        repository.UserRepository
+	assetService    service.AssetService
+	txManager       repository.TransactionManager
+}
+
+func NewKitService(
+	kitRepo repository.KitRepository,
+	kitCheckoutRepo repository.KitCheckoutRepository,
+	checkoutRepo repository.AssetCheckoutRepository,
+	unitRepo repository.AssetUnitRepository,
+	reservationRepo repository.ReservationRepository,
+	assetRepo repository.AssetRepository,
+	userRepo repository.UserRepository,
+	assetService service.AssetService,
+	txManager repository.TransactionManager,
+) service.KitService {
+	return &KitServiceImpl{
+		kitRepo:         kitRepo,
+		kitCheckoutRepo: kitCheckoutRepo,
+		checkoutRepo:    checkoutRepo,
+		unitRepo:        unitRepo,
+		reservationRepo: reservationRepo,
+		assetRepo:       assetRepo,
+		userRepo:        userRepo,
+		assetService:    assetService,
+		txManager:       txManager,
+	}
+}
+
+// CreateKit defines a kit for everyone, so like tracking modes it needs
+// write access to every asset.
+func (s *KitServiceImpl) CreateKit(ctx context.Context, kit *entity.Kit) error {
+	principal, ok := policy.FromContext(ctx)
+	if !ok {
+		return policy.ErrForbidden
+	}
+	if err := authorizeAllAssets(ctx, enum.PermissionAssetsWrite); err != nil {
+		return err
+	}
+	if err := s.validateKit(ctx, uuid.Nil, kit); err != nil {
+		return err
+	}
+
+	if kit.ID == uuid.Nil {
+		kit.ID = uuid.New()
+	}
+	kit.CreatedBy = principal.UserID
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		return s.kitRepo.Create(ctx, kit)
+	})
+}
+
+func (s *KitServiceImpl) GetKit(ctx context.Context, id uuid.UUID) (*entity.Kit, error) {
+	kit, err := s.kitRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("kit not found")
+	}
+	return kit, nil
+}
+
+func (s *KitServiceImpl) UpdateKit(ctx context.Context, id uuid.UUID, changes *entity.Kit) (*entity.Kit, error) {
+	if err := authorizeAllAssets(ctx, enum.PermissionAssetsWrite); err != nil {
+		return nil, err
+	}
+	kit, err := s.kitRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("kit not found")
+	}
+	if err := s.validateKit(ctx, id, changes); err != nil {
+		return nil, err
+	}
+
+	kit.Name = changes.Name
+	kit.Description = changes.Description
+	kit.Components = changes.Components
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		return s.kitRepo.Update(ctx, kit)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return kit, nil
+}
+
+func (s *KitServiceImpl) DeleteKit(ctx context.Context, id uuid.UUID) error {
+	if err := authorizeAllAssets(ctx, enum.PermissionAssetsWrite); err != nil {
+		return err
+	}
+	if _, err := s.kitRepo.GetByID(ctx, id); err != nil {
+		return errors.New("kit not found")
+	}
+	open, err := s.kitCheckoutRepo.CountOpen(ctx, id)
+	if err != nil {
+		return err
+	}
+	if open > 0 {
+		return fmt.Errorf("the kit still has %s", countNoun(open, "open checkout", "open checkouts"))
+	}
+	return s.kitRepo.Delete(ctx, id)
+}
+
+func (s *KitServiceImpl) ListKits(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.Kit, int, error) {
+	return s.kitRepo.List(ctx, limit, offset, filters)
+}
+
+// GetAvailability counts only the stock of the assets the caller can read.
+func (s *KitServiceImpl) GetAvailability(ctx context.Context, id uuid.UUID) (*entity.KitAvailability, error) {
+	kit, err := s.kitRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("kit not found")
+	}
+	stock, err := s.stock(ctx, kit, enum.PermissionAssetsRead)
+	if err != nil {
+		return nil, err
+	}
+
+	_, short := stock.allocate(1)
+	availability := &entity.KitAvailability{Kit: kit, Available: stock.wholeKits()}
+	for _, component := range kit.Components {
+		availability.Components = append(availability.Components, &entity.KitComponentAvailability{
+			Component: component,
+			Free:      stock.componentFree(component),
+			Short:     short[component.ID],
+		})
+	}
+	return availability, nil
+}
+
+// CheckOutKit takes the stock through the asset service, so the caller
+// needs write access to every asset the components are drawn from. The
+// assets stay locked until all components are checked out; it is the same
+// row lock every other change to their stock takes, so single checkouts,
+// request fulfilment and lot issues cannot take the stock counted here.
+func (s *KitServiceImpl) CheckOutKit(ctx context.Context, kitID uuid.UUID, checkout *entity.KitCheckout) error {
+	kit, err := s.kitRepo.GetByID(ctx, kitID)
+	if err != nil {
+		return errors.New("kit not found")
+	}
+	principal, ok := policy.FromContext(ctx)
+	if !ok {
+		return policy.ErrForbidden
+	}
+	if _, err := s.userRepo.GetByID(ctx, checkout.UserID); err != nil {
+		return errors.New("user not found")
+	}
+
+	now := time.Now()
+	if checkout.DueAt != nil && !checkout.DueAt.After(now) {
+		return errors.New("the due date must be in the future")
+	}
+	if checkout.ID == uuid.Nil {
+		checkout.ID = uuid.New()
+	}
+	checkout.KitID = kit.ID
+	checkout.CheckedOutBy = principal.UserID
+	checkout.CheckedOutAt = now
+
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		stock, err := s.stock(ctx, kit, enum.PermissionAssetsWrite)
+		if err != nil {
+			return err
+		}
+		// Lock in a fixed order so concurrent kit checkouts cannot deadlock,
+		// then look at the stock again now that nobody else can take it
+		for _, assetID := range stock.assetIDs() {
+			if _, err := s.assetRepo.LockByID(ctx, assetID); err != nil {
+				return err
+			}
+		}
+		stock, err = s.stock(ctx, kit, enum.PermissionAssetsWrite)
+		if err != nil {
+			return err
+		}
+
+		takes, short := stock.allocate(1)
+		if len(short) > 0 {
+			return shortageError(kit, short)
+		}
+
+		if err := s.kitCheckoutRepo.Create(ctx, checkout); err != nil {
+			return err
+		}
+		checkout.Items = nil
+		for _, take := range takes {
+			if err := s.assetService.DecreaseAssetQuantity(ctx, take.asset.ID, take.qty, enum.StockMovementIssue); err != nil {
+				return fmt.Errorf("%s: %w", take.asset.Name, err)
+			}
+			item := &entity.AssetCheckout{
+				ID:            uuid.New(),
+				AssetID:       take.asset.ID,
+				Asset:         take.asset,
+				UserID:        checkout.UserID,
+				KitCheckoutID: &checkout.ID,
+				Quantity:      take.qty,
+				CheckedOutAt:  now,
+				DueAt:         checkout.DueAt,
+			}
+			if err := s.checkoutRepo.Create(ctx, item); err != nil {
+				return err
+			}
+			checkout.Items = append(checkout.Items, item)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	checkout.Kit = kit
+	return nil
+}
+
+func (s *KitServiceImpl) GetCheckout(ctx context.Context, id uuid.UUID) (*entity.KitCheckout, error) {
+	checkout, err := s.kitCheckoutRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("kit checkout not found")
+	}
+	if err := authorizeKitCheckout(ctx, checkout); err != nil {
+		return nil, err
+	}
+	return checkout, nil
+}
+
+func (s *KitServiceImpl) ListCheckouts(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.KitCheckout, int, error) {
+	principal, err := policy.Caller(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	if principal != nil {
+		conditions, unrestricted := principal.Conditions(enum.PermissionAssetsWrite)
+		switch {
+		case unrestricted:
+		case len(conditions) > 0:
+			filters["scope"] = conditions
+		default:
+			filters["user_id"] = principal.UserID
+		}
+	}
+	return s.kitCheckoutRepo.List(ctx, limit, offset, filters)
+}
+
+// ReturnKit needs write access to every item's asset, including the ones
+// that are not restocked.
+func (s *KitServiceImpl) ReturnKit(ctx context.Context, id uuid.UUID, items []service.KitReturnItem) (*entity.KitCheckout, error) {
+	checkout, err := s.kitCheckoutRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("kit checkout not found")
+	}
+	for _, item := range checkout.Items {
+		if err := policy.Authorize(ctx, enum.PermissionAssetsWrite, assetResource(item.Asset)); err != nil {
+			return nil, err
+		}
+	}
+	if checkout.ReturnedAt != nil {
+		return nil, errors.New("kit has already been returned")
+	}
+
+	// Every item is checked in explicitly
+	returns := make(map[uuid.UUID]service.KitReturnItem, len(items))
+	for _, item := range items {
+		if !enum.ReturnCondition(item.Condition).IsValid() {
+			return nil, fmt.Errorf("invalid condition %q", item.Condition)
+		}
+		returns[item.CheckoutID] = item
+	}
+	open := 0
+	for _, item := range checkout.Items {
+		if item.ReturnedAt != nil {
+			continue
+		}
+		if _, ok := returns[item.ID]; !ok {
+			return nil, fmt.Errorf("the condition of %s is missing", item.Asset.Name)
+		}
+		open++
+	}
+	if len(returns) != open {
+		return nil, errors.New("some items are not part of this kit checkout")
+	}
+
+	now := time.Now()
+	returnedBy := callerID(ctx)
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		for _, item := range checkout.Items {
+			if item.ReturnedAt != nil {
+				continue
+			}
+			condition := returns[item.ID]
+			if condition.Condition == string(enum.ReturnGood) {
+				if err := s.assetService.IncreaseAssetQuantity(ctx, item.AssetID, item.Quantity, enum.StockMovementReturn); err != nil {
+					return fmt.Errorf("%s: %w", item.Asset.Name, err)
+				}
+			}
+			item.ReturnedAt = &now
+			item.ReturnedBy = returnedBy
+			item.ReturnCondition = condition.Condition
+			item.ReturnNotes = condition.Notes
+			if err := s.checkoutRepo.Update(ctx, item); err != nil {
+				return err
+			}
+		}
+
+		checkout.ReturnedAt = &now
+		checkout.ReturnedBy = returnedBy
+		return s.kitCheckoutRepo.Update(ctx, checkout)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return checkout, nil
+}
+
+func (s *KitServiceImpl) validateKit(ctx context.Context, id uuid.UUID, kit *entity.Kit) error {
+	kit.Name = strings.TrimSpace(kit.Name)
+	if kit.Name == "" {
+		return errors.New("name is required")
+	}
+	if existing, err := s.kitRepo.GetByName(ctx, kit.Name); err == nil && existing.ID != id {
+		return errors.New("a kit with this name already exists")
+	}
+	if len(kit.Components) == 0 {
+		return errors.New("a kit needs at least one component")
+	}
+
+	for i, component := range kit.Components {
+		component.ID = uuid.New()
+		component.Position = i + 1
+		component.Category = strings.TrimSpace(component.Category)
+		if (component.AssetID == nil) == (component.Category == "") {
+			return fmt.Errorf("component %d is for either an asset or a category", i+1)
+		}
+		if component.Quantity < 1 {
+			return fmt.Errorf("the quantity of component %d must be at least 1", i+1)
+		}
+		if component.AssetID != nil {
+			asset, err := s.assetRepo.GetByID(ctx, *component.AssetID)
+			if err != nil {
+				return fmt.Errorf("the asset of component %d was not found", i+1)
+			}
+			if enum.AssetStatus(asset.Status).IsTerminal() {
+				return fmt.Errorf("the asset of component %d is %s", i+1, asset.Status)
+			}
+			units, err := s.unitRepo.CountByAsset(ctx, asset.ID, "")
+			if err != nil {
+				return err
+			}
+			if units > 0 {
+				return fmt.Errorf("the asset of component %d is serialized; its units are checked out individually", i+1)
+			}
+			component.Asset = asset
+		}
+	}
+	return nil
+}
+
+// stock loads the assets the kit's components can be drawn from with their
+// free stock: their quantity less the units reserved right now. Category
+// components only draw from assets the caller holds perm on.
+func (s *KitServiceImpl) stock(ctx context.Context, kit *entity.Kit, perm enum.Permission) (*kitStock, error) {
+	filters := make(map[string]interface{})
+	conditions, restricted, err := policy.ListConditions(ctx, perm)
+	if err != nil {
+		return nil, err
+	}
+	if restricted {
+		filters["scope"] = conditions
+	}
+
+	var categories []string
+	for _, component := range kit.Components {
+		if component.AssetID == nil {
+			categories = append(categories, component.Category)
+		}
+	}
+	categoryAssets, err := s.kitRepo.ListCategoryAssets(ctx, categories, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	stock := &kitStock{
+		kit:        kit,
+		candidates: make(map[uuid.UUID][]*entity.Asset),
+		free:       make(map[uuid.UUID]int),
+	}
+	for _, component := range kit.Components {
+		if component.AssetID != nil {
+			asset, err := s.assetRepo.GetByID(ctx, *component.AssetID)
+			if err != nil {
+				return nil, errors.New("asset not found")
+			}
+			stock.add(component, asset)
+			continue
+		}
+		for _, asset := range categoryAssets {
+			if strings.EqualFold(asset.Category, component.Category) {
+				stock.add(component, asset)
+			}
+		}
+	}
+
+	now := time.Now()
+	reservations, err := s.reservationRepo.ListOverlapping(ctx, stock.assetIDs(), now, now.Add(time.Second), nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, reservation := range reservations {
+		stock.free[reservation.AssetID] = max(stock.free[reservation.AssetID]-reservation.Quantity, 0)
+	}
+	return stock, nil
+}
+
+// authorizeKitCheckout lets the user the kit was checked out to, and whoever
+// may write all of its assets, see a kit checkout.
+func authorizeKitCheckout(ctx context.Context, checkout *entity.KitCheckout) error {
+	if principal, ok := policy.FromContext(ctx); ok && principal.UserID == checkout.UserID {
+		return nil
+	}
+	for _, item := range checkout.Items {
+		if err := policy.Authorize(ctx, enum.PermissionAssetsWrite, assetResource(item.Asset)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func shortageError(kit *entity.Kit, short map[uuid.UUID]int) error {
+	var missing []string
+	for _, component := range kit.Components {
+		if n := short[component.ID]; n > 0 {
+			missing = append(missing, fmt.Sprintf("%s lacks %s", component.Label(), countNoun(n, "unit", "units")))
+		}
+	}
+	return fmt.Errorf("the kit is only partly available: %s", strings.Join(missing, ", "))
+}
+
+// kitStock is the free stock a kit's components can be drawn from.
+type kitStock struct {
+	kit        *entity.Kit
+	candidates map[uuid.UUID][]*entity.Asset
+	free       map[uuid.UUID]int
+}
+
+// kitTake is qty units of an asset taken for a kit.
+type kitTake struct {
+	asset *entity.Asset
+	qty   int
+}
+
+func (k *kitStock) add(component *entity.KitComponent, asset *entity.Asset) {
+	k.candidates[component.ID] = append(k.candidates[component.ID], asset)
+	if asset.Status == string(enum.AssetStatusAvailable) {
+		k.free[asset.ID] = asset.Qty
+	} else {
+		k.free[asset.ID] = 0
+	}
+}
+
+func (k *kitStock) assetIDs() []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(k.free))
+	for id := range k.free {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i].String() < ids[j].String() })
+	return ids
+}
+
+func (k *kitStock) componentFree(component *entity.KitComponent) int {
+	total := 0
+	for _, asset := range k.candidates[component.ID] {
+		total += k.free[asset.ID]
+	}
+	return total
+}
+
+// allocate draws the components of n kits from the free stock. Components
+// for a specific asset go first, so categories fall back on the other
+// assets. It returns the takes and, per component, the units it lacks.
+func (k *kitStock) allocate(n int) ([]kitTake, map[uuid.UUID]int) {
+	components := make([]*entity.KitComponent, len(k.kit.Components))
+	copy(components, k.kit.Components)
+	sort.SliceStable(components, func(i, j int) bool {
+		return components[i].AssetID != nil && components[j].AssetID == nil
+	})
+
+	remaining := make(map[uuid.UUID]int, len(k.free))
+	for id, free := range k.free {
+		remaining[id] = free
+	}
+
+	var takes []kitTake
+	short := make(map[uuid.UUID]int)
+	for _, component := range components {
+		need := n * component.Quantity
+		for _, asset := range k.candidates[component.ID] {
+			if need == 0 {
+				break
+			}
+			taken := min(remaining[asset.ID], need)
+			if taken == 0 {
+				continue
+			}
+			remaining[asset.ID] -= taken
+			need -= taken
+			takes = append(takes, kitTake{asset: asset, qty: taken})
+		}
+		if need > 0 {
+			short[component.ID] = need
+		}
+	}
+	return takes, short
+}
+
+// wholeKits returns how many complete kits the free stock allows.
+func (k *kitStock) wholeKits() int {
+	upper := -1
+	for _, component := range k.kit.Components {
+		kits := k.componentFree(component) / component.Quantity
+		if upper < 0 || kits < upper {
+			upper = kits
+		}
+	}
+
+	// Components may share assets, so search for the most kits that can be
+	// allocated together
+	low, high := 0, max(upper, 0)
+	for low < high {
+		mid := (low + high + 1) / 2
+		if _, short := k.allocate(mid); len(short) == 0 {
+			low = mid
+		} else {
+			high = mid - 1
+		}
+	}
+	return low
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/google/uuid"
 	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/enum"
+	"inventory-ticketing-system/domain/policy"
 	"inventory-ticketing-system/domain/repository"
 	"inventory-ticketing-system/domain/service"
 )
@@ -25,6 +27,17 @@ func (s *LocationServiceImpl) CreateLocation(ctx context.Context, location *enti
 		location.Capacity = 0 // Default capacity
 	}
 
+	// Creating a child location requires write access to its parent
+	if err := policy.Authorize(ctx, enum.PermissionLocationsWrite, &policy.Resource{LocationID: location.ParentID}); err != nil {
+		return err
+	}
+
+	if location.ParentID != nil {
+		if _, err := s.locationRepo.GetByID(ctx, *location.ParentID); err != nil {
+			return errors.New("parent location not found")
+		}
+	}
+
 	existingLocation, err := s.locationRepo.GetByName(ctx, location.Name)
 	if err == nil && existingLocation != nil {
 		return errors.New("location with this name already exists")
@@ -43,6 +56,30 @@ func (s *LocationServiceImpl) UpdateLocation(ctx context.Context, id uuid.UUID,
 		return errors.New("location not found")
 	}
 
+	if err := policy.Authorize(ctx, enum.PermissionLocationsWrite, &policy.Resource{LocationID: &id}); err != nil {
+		return err
+	}
+
+	if location.ParentID != nil && (existingLocation.ParentID == nil || *existingLocation.ParentID != *location.ParentID) {
+		if err := policy.Authorize(ctx, enum.PermissionLocationsWrite, &policy.Resource{LocationID: location.ParentID}); err != nil {
+			return err
+		}
+
+		subtree, err := s.locationRepo.ListDescendantIDs(ctx, id)
+		if err != nil {
+			return err
+		}
+		for _, descendantID := range subtree {
+			if descendantID == *location.ParentID {
+				return errors.New("a location cannot be moved under itself or one of its descendants")
+			}
+		}
+
+		if _, err := s.locationRepo.GetByID(ctx, *location.ParentID); err != nil {
+			return errors.New("parent location not found")
+		}
+	}
+
 	location.ID = id
 	location.CreatedAt = existingLocation.CreatedAt
 
@@ -55,6 +92,18 @@ func (s *LocationServiceImpl) DeleteLocation(ctx context.Context, id uuid.UUID)
 		return errors.New("location not found")
 	}
 
+	if err := policy.Authorize(ctx, enum.PermissionLocationsWrite, &policy.Resource{LocationID: &id}); err != nil {
+		return err
+	}
+
+	subtree, err := s.locationRepo.ListDescendantIDs(ctx, id)
+	if err != nil {
+		return err
+	}
+	if len(subtree) > 1 {
+		return errors.New("location still has child locations")
+	}
+
 	return s.locationRepo.Delete(ctx, id)
 }
 
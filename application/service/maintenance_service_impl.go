@@ -0,0 +1,524 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/enum"
+	"inventory-ticketing-system/domain/policy"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/domain/service"
+
+	"github.com/google/uuid"
+)
+
+// MaintenanceTicketCategory is the category of tickets opened for preventive
+// maintenance, so teams can be routed them.
+const MaintenanceTicketCategory = "maintenance"
+
+type MaintenanceServiceImpl struct {
+	planRepo          repository.MaintenancePlanRepository
+	scheduleRepo      repository.MaintenanceScheduleRepository
+	recordRepo        repository.MaintenanceRecordRepository
+	assetRepo         repository.AssetRepository
+	technicianRepo    repository.TechnicianRepository
+	ticketService     service.TicketService
+	technicianService service.TechnicianService
+	txManager         repository.TransactionManager
+}
+
+func NewMaintenanceService(
+	planRepo repository.MaintenancePlanRepository,
+	scheduleRepo repository.MaintenanceScheduleRepository,
+	recordRepo repository.MaintenanceRecordRepository,
+	assetRepo repository.AssetRepository,
+	technicianRepo repository.TechnicianRepository,
+	ticketService service.TicketService,
+	technicianService service.TechnicianService,
+	txManager repository.TransactionManager,
+) service.MaintenanceService {
+	return &MaintenanceServiceImpl{
+		planRepo:          planRepo,
+		scheduleRepo:      scheduleRepo,
+		recordRepo:        recordRepo,
+		assetRepo:         assetRepo,
+		technicianRepo:    technicianRepo,
+		ticketService:     ticketService,
+		technicianService: technicianService,
+		txManager:         txManager,
+	}
+}
+
+func (s *MaintenanceServiceImpl) Jobs() []service.Job {
+	return []service.Job{
+		{
+			Name:        "maintenance_tickets",
+			Description: "Open tickets for preventive maintenance that is coming due",
+			Schedule:    "@hourly",
+			Run: func(ctx context.Context) (string, error) {
+				opened, err := s.GenerateDueTickets(ctx)
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprintf("opened %d tickets", opened), nil
+			},
+		},
+	}
+}
+
+func (s *MaintenanceServiceImpl) CreatePlan(ctx context.Context, plan *entity.MaintenancePlan) error {
+	if err := s.validatePlan(ctx, plan); err != nil {
+		return err
+	}
+
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.planRepo.Create(ctx, plan); err != nil {
+			return err
+		}
+		_, err := s.syncPlan(ctx, plan)
+		return err
+	})
+}
+
+func (s *MaintenanceServiceImpl) GetPlan(ctx context.Context, id uuid.UUID) (*entity.MaintenancePlan, error) {
+	plan, err := s.planRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("maintenance plan not found")
+	}
+	return plan, nil
+}
+
+// UpdatePlan saves the plan. Existing schedules keep their next due date;
+// the new interval applies from the next completed maintenance.
+func (s *MaintenanceServiceImpl) UpdatePlan(ctx context.Context, id uuid.UUID, plan *entity.MaintenancePlan) error {
+	existing, err := s.planRepo.GetByID(ctx, id)
+	if err != nil {
+		return errors.New("maintenance plan not found")
+	}
+
+	plan.ID = id
+	plan.CreatedBy = existing.CreatedBy
+	plan.CreatedAt = existing.CreatedAt
+	if err := s.validatePlan(ctx, plan); err != nil {
+		return err
+	}
+
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.planRepo.Update(ctx, plan); err != nil {
+			return err
+		}
+		_, err := s.syncPlan(ctx, plan)
+		return err
+	})
+}
+
+func (s *MaintenanceServiceImpl) DeletePlan(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.planRepo.GetByID(ctx, id); err != nil {
+		return errors.New("maintenance plan not found")
+	}
+	return s.planRepo.Delete(ctx, id)
+}
+
+func (s *MaintenanceServiceImpl) ListPlans(ctx context.Context, filters map[string]interface{}) ([]*entity.MaintenancePlan, error) {
+	return s.planRepo.List(ctx, filters)
+}
+
+func (s *MaintenanceServiceImpl) ListRecords(ctx context.Context, planID uuid.UUID, limit, offset int) ([]*entity.MaintenanceRecord, int, error) {
+	if _, err := s.planRepo.GetByID(ctx, planID); err != nil {
+		return nil, 0, errors.New("maintenance plan not found")
+	}
+	return s.recordRepo.ListByPlan(ctx, planID, limit, offset)
+}
+
+func (s *MaintenanceServiceImpl) Calendar(ctx context.Context, from, to time.Time) ([]*entity.MaintenanceOccurrence, error) {
+	schedules, err := s.scheduleRepo.ListCalendar(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	occurrences := []*entity.MaintenanceOccurrence{}
+	for _, schedule := range schedules {
+		if schedule.Plan == nil || schedule.Asset == nil || schedule.NextDueAt == nil {
+			continue
+		}
+		if policy.Authorize(ctx, enum.PermissionAssetsRead, assetResource(schedule.Asset)) != nil {
+			continue
+		}
+
+		interval := time.Duration(schedule.Plan.IntervalDays) * 24 * time.Hour
+		ticketID := schedule.OpenTicketID
+		for due := *schedule.NextDueAt; due.Before(to); due = due.Add(interval) {
+			if !due.Before(from) {
+				occurrences = append(occurrences, &entity.MaintenanceOccurrence{
+					PlanID:    schedule.PlanID,
+					PlanName:  schedule.Plan.Name,
+					AssetID:   schedule.AssetID,
+					AssetName: schedule.Asset.Name,
+					UniqueID:  schedule.Asset.UniqueID,
+					DueAt:     due,
+					TicketID:  ticketID,
+					Projected: due.After(*schedule.NextDueAt),
+				})
+			}
+			// Only the next occurrence can have a ticket
+			ticketID = nil
+		}
+	}
+
+	sort.SliceStable(occurrences, func(i, j int) bool {
+		return occurrences[i].DueAt.Before(occurrences[j].DueAt)
+	})
+	return occurrences, nil
+}
+
+func (s *MaintenanceServiceImpl) RecordUsage(ctx context.Context, assetID uuid.UUID, amount int) (*entity.Asset, error) {
+	if amount <= 0 {
+		return nil, errors.New("usage amount must be positive")
+	}
+
+	asset, err := s.assetRepo.GetByID(ctx, assetID)
+	if err != nil {
+		return nil, errors.New("asset not found")
+	}
+
+	if err := policy.Authorize(ctx, enum.PermissionAssetsWrite, assetResource(asset)); err != nil {
+		return nil, err
+	}
+
+	usage, err := s.assetRepo.IncrementUsage(ctx, assetID, amount)
+	if err != nil {
+		return nil, err
+	}
+	asset.UsageCount = usage
+
+	plans, err := s.planRepo.List(ctx, map[string]interface{}{"is_active": true})
+	if err != nil {
+		return nil, err
+	}
+	for _, plan := range plans {
+		if !plan.IsUsageBased() || !planCovers(plan, asset) {
+			continue
+		}
+		if _, err := s.syncAsset(ctx, plan, asset); err != nil {
+			log.Printf("Failed to schedule maintenance plan %s for asset %s: %v", plan.ID, asset.ID, err)
+		}
+	}
+
+	return asset, nil
+}
+
+func (s *MaintenanceServiceImpl) GenerateDueTickets(ctx context.Context) (int, error) {
+	plans, err := s.planRepo.List(ctx, map[string]interface{}{"is_active": true})
+	if err != nil {
+		return 0, err
+	}
+
+	opened := 0
+	for _, plan := range plans {
+		count, err := s.syncPlan(ctx, plan)
+		opened += count
+		if err != nil {
+			return opened, err
+		}
+	}
+	return opened, nil
+}
+
+// HandleEvent records completed maintenance when a generated ticket is
+// resolved or closed, and schedules the next maintenance from that point.
+func (s *MaintenanceServiceImpl) HandleEvent(ctx context.Context, event *entity.OutboxEvent) error {
+	if enum.EventType(event.EventType) != enum.EventTicketStatusChanged {
+		return nil
+	}
+
+	var payload TicketStatusChangedPayload
+	if err := json.Unmarshal([]byte(event.Payload), &payload); err != nil {
+		return err
+	}
+	ticket := payload.Ticket
+	if ticket == nil || (ticket.Status != string(enum.TicketStatusResolved) && ticket.Status != string(enum.TicketStatusClosed)) {
+		return nil
+	}
+
+	schedule, err := s.scheduleRepo.GetByOpenTicket(ctx, ticket.ID)
+	if err != nil {
+		return err
+	}
+	if schedule == nil {
+		return nil
+	}
+
+	plan := schedule.Plan
+	performedAt := ticket.UpdatedAt
+	if performedAt.IsZero() {
+		performedAt = time.Now()
+	}
+
+	var usage int
+	if schedule.Asset != nil {
+		usage = schedule.Asset.UsageCount
+	}
+
+	record := &entity.MaintenanceRecord{
+		PlanID:      plan.ID,
+		PlanName:    plan.Name,
+		AssetID:     schedule.AssetID,
+		TicketID:    ticket.ID,
+		PerformedAt: performedAt,
+		PerformedBy: ticket.AssignedTo,
+		UsageCount:  usage,
+		Checklist:   plan.Checklist,
+		Notes:       ticket.ResolutionComment,
+	}
+	if record.Checklist == nil {
+		record.Checklist = []string{}
+	}
+	if err := s.recordRepo.Create(ctx, record); err != nil {
+		return err
+	}
+
+	schedule.OpenTicketID = nil
+	schedule.LastPerformedAt = &performedAt
+	if plan.IsUsageBased() {
+		next := usage + plan.UsageInterval
+		schedule.NextDueUsage = &next
+	} else {
+		next := performedAt.AddDate(0, 0, plan.IntervalDays)
+		schedule.NextDueAt = &next
+	}
+	return s.scheduleRepo.Update(ctx, schedule)
+}
+
+// syncPlan brings the plan's schedules in line with the assets it covers and
+// opens tickets for those that are due. It returns how many were opened.
+func (s *MaintenanceServiceImpl) syncPlan(ctx context.Context, plan *entity.MaintenancePlan) (int, error) {
+	if !plan.IsActive {
+		return 0, nil
+	}
+
+	var assets []*entity.Asset
+	if plan.AssetID != nil {
+		asset, err := s.assetRepo.GetByID(ctx, *plan.AssetID)
+		if err != nil {
+			return 0, nil
+		}
+		assets = []*entity.Asset{asset}
+	} else {
+		var err error
+		assets, err = s.assetRepo.ListByCategory(ctx, plan.Category)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	assetIDs := make([]uuid.UUID, len(assets))
+	for i, asset := range assets {
+		assetIDs[i] = asset.ID
+	}
+	if err := s.scheduleRepo.DeleteStale(ctx, plan.ID, assetIDs); err != nil {
+		return 0, err
+	}
+
+	opened := 0
+	for _, asset := range assets {
+		created, err := s.syncAsset(ctx, plan, asset)
+		if err != nil {
+			return opened, err
+		}
+		if created {
+			opened++
+		}
+	}
+	return opened, nil
+}
+
+// syncAsset creates the plan's schedule for the asset if it has none yet and
+// opens a ticket when the maintenance is within the lead time. It reports
+// whether a ticket was opened. Tickets are opened by the system, whoever
+// triggered the check. The schedule stays locked from the check until the
+// ticket is recorded on it, so the job and a usage update cannot both open
+// one.
+func (s *MaintenanceServiceImpl) syncAsset(ctx context.Context, plan *entity.MaintenancePlan, asset *entity.Asset) (bool, error) {
+	ctx = policy.AsSystem(ctx)
+
+	var ticket *entity.Ticket
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		schedule, err := s.lockSchedule(ctx, plan, asset)
+		if err != nil {
+			return err
+		}
+
+		if schedule.OpenTicketID != nil || !isMaintenanceDue(plan, schedule, asset, time.Now()) {
+			return nil
+		}
+
+		ticket = &entity.Ticket{
+			ID:        uuid.New(),
+			AssetID:   asset.ID,
+			Category:  MaintenanceTicketCategory,
+			Severity:  plan.Severity,
+			Comment:   maintenanceTicketComment(plan, schedule),
+			Reporting: plan.CreatedBy,
+		}
+		if schedule.NextDueAt != nil && !plan.IsUsageBased() {
+			ticket.DueDate = *schedule.NextDueAt
+		}
+
+		if err := s.ticketService.CreateTicket(ctx, ticket); err != nil {
+			return err
+		}
+		if technicianID := s.defaultTechnician(ctx, plan); technicianID != nil {
+			if err := s.ticketService.AssignTicket(ctx, ticket.ID, *technicianID); err != nil {
+				return err
+			}
+		} else if s.technicianService != nil {
+			if err := s.technicianService.AutoAssign(ctx, ticket); err != nil {
+				return err
+			}
+		}
+		schedule.OpenTicketID = &ticket.ID
+		return s.scheduleRepo.Update(ctx, schedule)
+	})
+	if err != nil || ticket == nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// lockSchedule locks the plan's schedule for the asset, creating it first
+// if it has none yet.
+func (s *MaintenanceServiceImpl) lockSchedule(ctx context.Context, plan *entity.MaintenancePlan, asset *entity.Asset) (*entity.MaintenanceSchedule, error) {
+	schedule, err := s.scheduleRepo.LockByPlanAndAsset(ctx, plan.ID, asset.ID)
+	if err != nil || schedule != nil {
+		return schedule, err
+	}
+
+	schedule = &entity.MaintenanceSchedule{
+		PlanID:  plan.ID,
+		AssetID: asset.ID,
+	}
+	if plan.IsUsageBased() {
+		next := asset.UsageCount + plan.UsageInterval
+		schedule.NextDueUsage = &next
+	} else {
+		next := plan.CreatedAt.AddDate(0, 0, plan.IntervalDays)
+		if plan.StartsAt != nil {
+			next = *plan.StartsAt
+		}
+		schedule.NextDueAt = &next
+	}
+	if err := s.scheduleRepo.Create(ctx, schedule); err != nil {
+		return nil, err
+	}
+
+	// Another caller may have created it first
+	schedule, err = s.scheduleRepo.LockByPlanAndAsset(ctx, plan.ID, asset.ID)
+	if err != nil {
+		return nil, err
+	}
+	if schedule == nil {
+		return nil, errors.New("maintenance schedule not found")
+	}
+	return schedule, nil
+}
+
+func (s *MaintenanceServiceImpl) validatePlan(ctx context.Context, plan *entity.MaintenancePlan) error {
+	plan.Name = strings.TrimSpace(plan.Name)
+	if plan.Name == "" {
+		return errors.New("name is required")
+	}
+
+	if (plan.AssetID == nil) == (plan.Category == "") {
+		return errors.New("a plan applies to either an asset or a category")
+	}
+	if plan.AssetID != nil {
+		if _, err := s.assetRepo.GetByID(ctx, *plan.AssetID); err != nil {
+			return errors.New("asset not found")
+		}
+	}
+
+	if plan.DefaultTechnicianID != nil {
+		technician, err := s.technicianRepo.GetByUserID(ctx, *plan.DefaultTechnicianID)
+		if err != nil {
+			return errors.New("default technician not found")
+		}
+		if !technician.IsActive {
+			return errors.New("default technician is not active")
+		}
+	}
+
+	if (plan.IntervalDays > 0) == (plan.UsageInterval > 0) {
+		return errors.New("a plan needs either an interval in days or a usage interval")
+	}
+	if plan.IntervalDays < 0 || plan.UsageInterval < 0 || plan.LeadDays < 0 || plan.LeadUsage < 0 {
+		return errors.New("intervals and lead times cannot be negative")
+	}
+
+	if plan.Severity == "" {
+		plan.Severity = string(enum.SeverityLow)
+	}
+	if !enum.TicketSeverity(plan.Severity).IsValid() {
+		return errors.New("invalid severity")
+	}
+
+	if plan.Checklist == nil {
+		plan.Checklist = []string{}
+	}
+	return nil
+}
+
+// defaultTechnician returns the plan's default technician, or nil when there
+// is none or they have been deactivated since the plan was saved, so the
+// ticket goes through auto-assignment instead.
+func (s *MaintenanceServiceImpl) defaultTechnician(ctx context.Context, plan *entity.MaintenancePlan) *uuid.UUID {
+	if plan.DefaultTechnicianID == nil {
+		return nil
+	}
+	technician, err := s.technicianRepo.GetByUserID(ctx, *plan.DefaultTechnicianID)
+	if err != nil || !technician.IsActive {
+		return nil
+	}
+	return plan.DefaultTechnicianID
+}
+
+// planCovers reports whether the plan applies to the asset.
+func planCovers(plan *entity.MaintenancePlan, asset *entity.Asset) bool {
+	if plan.AssetID != nil {
+		return *plan.AssetID == asset.ID
+	}
+	return plan.Category == asset.Category
+}
+
+// isMaintenanceDue reports whether the maintenance is within the plan's lead
+// time at now.
+func isMaintenanceDue(plan *entity.MaintenancePlan, schedule *entity.MaintenanceSchedule, asset *entity.Asset, now time.Time) bool {
+	if plan.IsUsageBased() {
+		return schedule.NextDueUsage != nil && asset.UsageCount >= *schedule.NextDueUsage-plan.LeadUsage
+	}
+	return schedule.NextDueAt != nil && !now.Before(schedule.NextDueAt.AddDate(0, 0, -plan.LeadDays))
+}
+
+func maintenanceTicketComment(plan *entity.MaintenancePlan, schedule *entity.MaintenanceSchedule) string {
+	var b strings.Builder
+	b.WriteString("Preventive maintenance: " + plan.Name)
+	if schedule.NextDueUsage != nil && plan.IsUsageBased() {
+		fmt.Fprintf(&b, " (due at %d uses)", *schedule.NextDueUsage)
+	}
+	if plan.Description != "" {
+		b.WriteString("\n\n" + plan.Description)
+	}
+	if len(plan.Checklist) > 0 {
+		b.WriteString("\n\nChecklist:")
+		for _, item := range plan.Checklist {
+			b.WriteString("\n- [ ] " + item)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,207 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/domain/service"
+)
+
+type ManufacturerServiceImpl struct {
+	manufacturerRepo repository.ManufacturerRepository
+	txManager        repository.TransactionManager
+}
+
+func NewManufacturerService(manufacturerRepo repository.ManufacturerRepository, txManager repository.TransactionManager) service.ManufacturerService {
+	return &ManufacturerServiceImpl{
+		manufacturerRepo: manufacturerRepo,
+		txManager:        txManager,
+	}
+}
+
+func (s *ManufacturerServiceImpl) CreateManufacturer(ctx context.Context, manufacturer *entity.Manufacturer) error {
+	if err := s.validate(ctx, uuid.Nil, manufacturer); err != nil {
+		return err
+	}
+	return s.manufacturerRepo.Create(ctx, manufacturer)
+}
+
+func (s *ManufacturerServiceImpl) GetManufacturer(ctx context.Context, id uuid.UUID) (*entity.Manufacturer, error) {
+	manufacturer, err := s.manufacturerRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("manufacturer not found")
+	}
+	return manufacturer, nil
+}
+
+func (s *ManufacturerServiceImpl) UpdateManufacturer(ctx context.Context, id uuid.UUID, manufacturer *entity.Manufacturer) error {
+	existing, err := s.manufacturerRepo.GetByID(ctx, id)
+	if err != nil {
+		return errors.New("manufacturer not found")
+	}
+	if err := s.validate(ctx, id, manufacturer); err != nil {
+		return err
+	}
+
+	manufacturer.ID = id
+	manufacturer.CreatedAt = existing.CreatedAt
+	manufacturer.UpdatedAt = time.Now()
+
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.manufacturerRepo.Update(ctx, manufacturer); err != nil {
+			return err
+		}
+		if manufacturer.Name == existing.Name {
+			return nil
+		}
+		_, err := s.manufacturerRepo.ReassignAssets(ctx, []uuid.UUID{id}, id, manufacturer.Name)
+		return err
+	})
+}
+
+func (s *ManufacturerServiceImpl) DeleteManufacturer(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.manufacturerRepo.GetByID(ctx, id); err != nil {
+		return errors.New("manufacturer not found")
+	}
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		return s.manufacturerRepo.Delete(ctx, id)
+	})
+}
+
+func (s *ManufacturerServiceImpl) ListManufacturers(ctx context.Context, search string) ([]*entity.Manufacturer, error) {
+	return s.manufacturerRepo.List(ctx, search)
+}
+
+func (s *ManufacturerServiceImpl) MergeManufacturers(ctx context.Context, targetID uuid.UUID, sourceIDs []uuid.UUID) (*entity.Manufacturer, error) {
+	target, err := s.manufacturerRepo.GetByID(ctx, targetID)
+	if err != nil {
+		return nil, errors.New("manufacturer not found")
+	}
+
+	sourceIDs = uniqueIDs(sourceIDs)
+	aliases := target.Aliases
+	for _, sourceID := range sourceIDs {
+		if sourceID == targetID {
+			return nil, errors.New("a manufacturer cannot be merged into itself")
+		}
+		source, err := s.manufacturerRepo.GetByID(ctx, sourceID)
+		if err != nil {
+			return nil, fmt.Errorf("manufacturer %s not found", sourceID)
+		}
+		aliases = append(aliases, source.Name)
+		aliases = append(aliases, source.Aliases...)
+	}
+	if len(sourceIDs) == 0 {
+		return nil, errors.New("at least one manufacturer to merge is required")
+	}
+	target.Aliases = cleanAliases(target.Name, aliases)
+	target.UpdatedAt = time.Now()
+
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := s.manufacturerRepo.ReassignAssets(ctx, sourceIDs, targetID, target.Name); err != nil {
+			return err
+		}
+		for _, sourceID := range sourceIDs {
+			if err := s.manufacturerRepo.Delete(ctx, sourceID); err != nil {
+				return err
+			}
+		}
+		return s.manufacturerRepo.Update(ctx, target)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.manufacturerRepo.GetByID(ctx, targetID)
+}
+
+func (s *ManufacturerServiceImpl) NormalizeBrands(ctx context.Context) (int, error) {
+	brands, err := s.manufacturerRepo.ListUnlinkedBrands(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	linked := 0
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		for _, brand := range brands {
+			manufacturer, err := resolveManufacturer(ctx, s.manufacturerRepo, brand)
+			if err != nil {
+				return err
+			}
+			count, err := s.manufacturerRepo.LinkBrand(ctx, brand, manufacturer.ID, manufacturer.Name)
+			if err != nil {
+				return err
+			}
+			linked += count
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return linked, nil
+}
+
+// validate cleans the name and aliases and makes sure none of them already
+// belongs to another manufacturer.
+func (s *ManufacturerServiceImpl) validate(ctx context.Context, id uuid.UUID, manufacturer *entity.Manufacturer) error {
+	manufacturer.Name = normalizeBrand(manufacturer.Name)
+	if manufacturer.Name == "" {
+		return errors.New("name is required")
+	}
+	manufacturer.Aliases = cleanAliases(manufacturer.Name, manufacturer.Aliases)
+
+	for _, name := range append([]string{manufacturer.Name}, manufacturer.Aliases...) {
+		if other, err := s.manufacturerRepo.FindByName(ctx, name); err == nil && other.ID != id {
+			return fmt.Errorf("%q already belongs to manufacturer %s", name, other.Name)
+		}
+	}
+	return nil
+}
+
+// resolveManufacturer returns the manufacturer a brand belongs to, creating
+// it when no name or alias matches. It returns nil for an empty brand.
+func resolveManufacturer(ctx context.Context, manufacturerRepo repository.ManufacturerRepository, brand string) (*entity.Manufacturer, error) {
+	name := normalizeBrand(brand)
+	if name == "" {
+		return nil, nil
+	}
+	if manufacturer, err := manufacturerRepo.FindByName(ctx, name); err == nil {
+		return manufacturer, nil
+	}
+
+	manufacturer := &entity.Manufacturer{ID: uuid.New(), Name: name, Aliases: []string{}}
+	if err := manufacturerRepo.Create(ctx, manufacturer); err != nil {
+		return nil, err
+	}
+	return manufacturer, nil
+}
+
+// normalizeBrand trims a brand and collapses the spaces inside it. Brands are
+// matched ignoring case.
+func normalizeBrand(brand string) string {
+	return strings.Join(strings.Fields(brand), " ")
+}
+
+// cleanAliases normalizes aliases and drops blanks, duplicates and the
+// manufacturer's own name.
+func cleanAliases(name string, aliases []string) []string {
+	seen := map[string]bool{strings.ToLower(name): true}
+	cleaned := make([]string, 0, len(aliases))
+	for _, alias := range aliases {
+		alias = normalizeBrand(alias)
+		key := strings.ToLower(alias)
+		if alias == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		cleaned = append(cleaned, alias)
+	}
+	return cleaned
+}
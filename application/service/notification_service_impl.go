@@ -0,0 +1,719 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"log"
+	"regexp"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/enum"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/domain/service"
+)
+
+const (
+	// emailBatchSize bounds how many emails one dispatch sends.
+	emailBatchSize = 20
+	// emailMaxAttempts is how often sending is tried before giving up.
+	emailMaxAttempts = 5
+	// emailInitialBackoff doubles after every failed attempt up to
+	// emailMaxBackoff.
+	emailInitialBackoff = time.Minute
+	emailMaxBackoff     = time.Hour
+	// emailSendTimeout bounds a single send.
+	emailSendTimeout = 30 * time.Second
+	// emailRetention is how long sent emails are kept for inspection.
+	emailRetention = 30 * 24 * time.Hour
+)
+
+var localePattern = regexp.MustCompile(`^[a-z]{2}(-[A-Z]{2})?$`)
+
+// notificationData is what templates can refer to. Asset is nil when the
+// ticket's asset no longer exists.
+type notificationData struct {
+	Recipient *entity.User
+	Ticket    *entity.Ticket
+	Asset     *entity.Asset
+}
+
+type NotificationServiceImpl struct {
+	templateRepo     repository.NotificationTemplateRepository
+	preferenceRepo   repository.NotificationPreferenceRepository
+	emailRepo        repository.EmailMessageRepository
+	notificationRepo repository.NotificationRepository
+	userRepo         repository.UserRepository
+	ticketRepo       repository.TicketRepository
+	technicianRepo   repository.TechnicianRepository
+	departmentRepo   repository.DepartmentRepository
+	txManager        repository.TransactionManager
+	mailer           service.Mailer
+	defaultLocale    string
+	slaWarningWindow time.Duration
+	slaCheckInterval time.Duration
+	inboxRetention   time.Duration
+}
+
+// NewNotificationService creates the notification service. Tickets get an
+// SLA warning once they are due within slaWarningWindow, checked every
+// slaCheckInterval, and read inbox notifications are deleted after
+// inboxRetention.
+func NewNotificationService(
+	templateRepo repository.NotificationTemplateRepository,
+	preferenceRepo repository.NotificationPreferenceRepository,
+	emailRepo repository.EmailMessageRepository,
+	notificationRepo repository.NotificationRepository,
+	userRepo repository.UserRepository,
+	ticketRepo repository.TicketRepository,
+	technicianRepo repository.TechnicianRepository,
+	departmentRepo repository.DepartmentRepository,
+	txManager repository.TransactionManager,
+	mailer service.Mailer,
+	defaultLocale string,
+	slaWarningWindow time.Duration,
+	slaCheckInterval time.Duration,
+	inboxRetention time.Duration,
+) service.NotificationService {
+	return &NotificationServiceImpl{
+		templateRepo:     templateRepo,
+		preferenceRepo:   preferenceRepo,
+		emailRepo:        emailRepo,
+		notificationRepo: notificationRepo,
+		userRepo:         userRepo,
+		ticketRepo:       ticketRepo,
+		technicianRepo:   technicianRepo,
+		departmentRepo:   departmentRepo,
+		txManager:        txManager,
+		mailer:           mailer,
+		defaultLocale:    defaultLocale,
+		slaWarningWindow: slaWarningWindow,
+		slaCheckInterval: slaCheckInterval,
+		inboxRetention:   inboxRetention,
+	}
+}
+
+func (s *NotificationServiceImpl) Jobs() []service.Job {
+	return []service.Job{
+		{
+			Name:        "sla_warnings",
+			Description: "Warn about tickets that are about to miss their due date",
+			Schedule:    "@every " + s.slaCheckInterval.String(),
+			Run: func(ctx context.Context) (string, error) {
+				warned, err := s.QueueSLAWarnings(ctx)
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprintf("warned about %d tickets", warned), nil
+			},
+		},
+		{
+			Name:        "email_cleanup",
+			Description: "Delete sent emails older than 30 days",
+			Schedule:    "@hourly",
+			Run: func(ctx context.Context) (string, error) {
+				deleted, err := s.emailRepo.DeleteSentBefore(ctx, time.Now().Add(-emailRetention))
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprintf("deleted %d emails", deleted), nil
+			},
+		},
+		{
+			Name:        "inbox_cleanup",
+			Description: "Delete read inbox notifications past their retention",
+			Schedule:    "@hourly",
+			Run: func(ctx context.Context) (string, error) {
+				deleted, err := s.notificationRepo.DeleteReadBefore(ctx, time.Now().Add(-s.inboxRetention))
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprintf("deleted %d notifications", deleted), nil
+			},
+		},
+	}
+}
+
+// EnsureDefaultTemplates stores the built-in templates in the default locale
+// when they are missing. Existing templates are never overwritten.
+func (s *NotificationServiceImpl) EnsureDefaultTemplates(ctx context.Context) error {
+	for _, kind := range enum.EmailNotificationKinds() {
+		if _, err := s.templateRepo.GetByKindAndLocale(ctx, string(kind), s.defaultLocale); err == nil {
+			continue
+		}
+
+		content := defaultNotificationTemplates[kind]
+		if err := s.templateRepo.Create(ctx, &entity.NotificationTemplate{
+			ID:       uuid.New(),
+			Kind:     string(kind),
+			Locale:   s.defaultLocale,
+			Subject:  content.Subject,
+			TextBody: content.TextBody,
+			HTMLBody: content.HTMLBody,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *NotificationServiceImpl) ListTemplates(ctx context.Context) ([]*entity.NotificationTemplate, error) {
+	return s.templateRepo.List(ctx)
+}
+
+func (s *NotificationServiceImpl) GetTemplate(ctx context.Context, id uuid.UUID) (*entity.NotificationTemplate, error) {
+	template, err := s.templateRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("notification template not found")
+	}
+	return template, nil
+}
+
+func (s *NotificationServiceImpl) CreateTemplate(ctx context.Context, template *entity.NotificationTemplate) error {
+	if !enum.NotificationKind(template.Kind).HasEmail() {
+		return errors.New("invalid notification kind")
+	}
+	if !localePattern.MatchString(template.Locale) {
+		return errors.New("locale must look like en or en-US")
+	}
+	if existing, err := s.templateRepo.GetByKindAndLocale(ctx, template.Kind, template.Locale); err == nil && existing != nil {
+		return errors.New("template for this kind and locale already exists")
+	}
+	if err := validateNotificationTemplate(template); err != nil {
+		return err
+	}
+
+	return s.templateRepo.Create(ctx, template)
+}
+
+// UpdateTemplate replaces a template's subject and bodies. Kind and locale
+// cannot change.
+func (s *NotificationServiceImpl) UpdateTemplate(ctx context.Context, id uuid.UUID, template *entity.NotificationTemplate) error {
+	existing, err := s.templateRepo.GetByID(ctx, id)
+	if err != nil {
+		return errors.New("notification template not found")
+	}
+
+	existing.Subject = template.Subject
+	existing.TextBody = template.TextBody
+	existing.HTMLBody = template.HTMLBody
+	if err := validateNotificationTemplate(existing); err != nil {
+		return err
+	}
+
+	if err := s.templateRepo.Update(ctx, existing); err != nil {
+		return err
+	}
+
+	*template = *existing
+	return nil
+}
+
+// DeleteTemplate removes a translation. Default locale templates are the
+// fallback for every other locale and cannot be deleted.
+func (s *NotificationServiceImpl) DeleteTemplate(ctx context.Context, id uuid.UUID) error {
+	template, err := s.templateRepo.GetByID(ctx, id)
+	if err != nil {
+		return errors.New("notification template not found")
+	}
+
+	if template.Locale == s.defaultLocale {
+		return errors.New("default locale templates cannot be deleted")
+	}
+
+	return s.templateRepo.Delete(ctx, id)
+}
+
+// GetPreferences returns the user's preferences, or the defaults when the
+// user has never saved any.
+func (s *NotificationServiceImpl) GetPreferences(ctx context.Context, userID uuid.UUID) (*entity.NotificationPreference, error) {
+	preference, err := s.preferenceRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return &entity.NotificationPreference{
+			UserID:       userID,
+			Locale:       s.defaultLocale,
+			EmailEnabled: true,
+			MutedKinds:   []string{},
+		}, nil
+	}
+	return preference, nil
+}
+
+func (s *NotificationServiceImpl) UpdatePreferences(ctx context.Context, preference *entity.NotificationPreference) error {
+	if preference.Locale == "" {
+		preference.Locale = s.defaultLocale
+	}
+	if !localePattern.MatchString(preference.Locale) {
+		return errors.New("locale must look like en or en-US")
+	}
+
+	if preference.MutedKinds == nil {
+		preference.MutedKinds = []string{}
+	}
+	for _, kind := range preference.MutedKinds {
+		if !enum.NotificationKind(kind).IsValid() {
+			return errors.New("invalid notification kind: " + kind)
+		}
+	}
+
+	preference.UpdatedAt = time.Now()
+	return s.preferenceRepo.Save(ctx, preference)
+}
+
+// HandleEvent notifies users of ticket and asset events: new tickets go to
+// the team queue (or to admins when no team handles them), assignments to the
+// assignee, resolutions to the reporter, and asset status changes to the
+// department manager and the reporters of the asset's unfinished tickets.
+func (s *NotificationServiceImpl) HandleEvent(ctx context.Context, event *entity.OutboxEvent) error {
+	switch enum.EventType(event.EventType) {
+	case enum.EventTicketCreated:
+		var ticket entity.Ticket
+		if err := json.Unmarshal([]byte(event.Payload), &ticket); err != nil {
+			return err
+		}
+		// Auto-assignment commits after the event, so look up the team now
+		current, err := s.ticketRepo.GetByID(ctx, ticket.ID)
+		if err != nil {
+			return nil
+		}
+		recipients, err := s.queueRecipients(ctx, current)
+		if err != nil {
+			return err
+		}
+		return s.notify(ctx, enum.NotificationTicketCreated, current, recipients)
+
+	case enum.EventTicketAssigned:
+		var ticket entity.Ticket
+		if err := json.Unmarshal([]byte(event.Payload), &ticket); err != nil {
+			return err
+		}
+		if ticket.AssignedTo == nil {
+			return nil
+		}
+		return s.notifyUser(ctx, enum.NotificationTicketAssigned, &ticket, *ticket.AssignedTo)
+
+	case enum.EventTicketStatusChanged:
+		var payload TicketStatusChangedPayload
+		if err := json.Unmarshal([]byte(event.Payload), &payload); err != nil {
+			return err
+		}
+		if payload.Ticket == nil || payload.Ticket.Status != "resolved" {
+			return nil
+		}
+		return s.notifyUser(ctx, enum.NotificationTicketResolved, payload.Ticket, payload.Ticket.Reporting)
+
+	case enum.EventAssetStatusChanged:
+		var payload AssetStatusChangedPayload
+		if err := json.Unmarshal([]byte(event.Payload), &payload); err != nil {
+			return err
+		}
+		if payload.Asset == nil {
+			return nil
+		}
+		return s.notifyAssetStatusChanged(ctx, payload.Asset, payload.PreviousStatus)
+	}
+	return nil
+}
+
+// ListInbox returns a page of the user's notifications, newest first, and the
+// cursor of the next page, which is empty on the last page.
+func (s *NotificationServiceImpl) ListInbox(ctx context.Context, userID uuid.UUID, unreadOnly bool, cursor string, limit int) ([]*entity.Notification, string, error) {
+	var after *entity.NotificationCursor
+	if cursor != "" {
+		var err error
+		if after, err = entity.DecodeNotificationCursor(cursor); err != nil {
+			return nil, "", err
+		}
+	}
+
+	// Fetch one extra row to learn whether another page follows
+	notifications, err := s.notificationRepo.ListByRecipient(ctx, userID, unreadOnly, after, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(notifications) <= limit {
+		return notifications, "", nil
+	}
+
+	notifications = notifications[:limit]
+	last := notifications[limit-1]
+	return notifications, entity.NotificationCursor{CreatedAt: last.CreatedAt, ID: last.ID}.Encode(), nil
+}
+
+func (s *NotificationServiceImpl) CountUnread(ctx context.Context, userID uuid.UUID) (int64, error) {
+	return s.notificationRepo.CountUnread(ctx, userID)
+}
+
+func (s *NotificationServiceImpl) MarkRead(ctx context.Context, userID, id uuid.UUID) error {
+	found, err := s.notificationRepo.MarkRead(ctx, userID, id, time.Now())
+	if err != nil {
+		return err
+	}
+	if !found {
+		return errors.New("notification not found")
+	}
+	return nil
+}
+
+func (s *NotificationServiceImpl) MarkAllRead(ctx context.Context, userID uuid.UUID) (int64, error) {
+	return s.notificationRepo.MarkAllRead(ctx, userID, time.Now())
+}
+
+// QueueSLAWarnings queues a warning for every unfinished ticket due within
+// the warning window that has not had one yet, and returns how many tickets
+// were warned about. Assigned tickets warn the assignee; others warn the
+// team queue or admins.
+func (s *NotificationServiceImpl) QueueSLAWarnings(ctx context.Context) (int, error) {
+	now := time.Now()
+	tickets, err := s.ticketRepo.ListDueWithoutWarning(ctx, now.Add(s.slaWarningWindow))
+	if err != nil {
+		return 0, err
+	}
+
+	for _, ticket := range tickets {
+		err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+			var recipients []*entity.User
+			if ticket.AssignedTo != nil {
+				if user, err := s.userRepo.GetByID(ctx, *ticket.AssignedTo); err == nil {
+					recipients = append(recipients, user)
+				}
+			}
+			if len(recipients) == 0 {
+				var err error
+				if recipients, err = s.queueRecipients(ctx, ticket); err != nil {
+					return err
+				}
+			}
+
+			if err := s.notify(ctx, enum.NotificationSLABreachWarning, ticket, recipients); err != nil {
+				return err
+			}
+			return s.ticketRepo.MarkSLAWarningSent(ctx, ticket.ID, now)
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+	return len(tickets), nil
+}
+
+// DispatchDue sends queued emails whose next attempt is due and returns how
+// many were attempted.
+func (s *NotificationServiceImpl) DispatchDue(ctx context.Context) (int, error) {
+	now := time.Now()
+	lease := now.Add(emailBatchSize*emailSendTimeout + time.Minute)
+	messages, err := s.emailRepo.ClaimDue(ctx, now, lease, emailBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, message := range messages {
+		sendCtx, cancel := context.WithTimeout(ctx, emailSendTimeout)
+		sendErr := s.mailer.Send(sendCtx, message)
+		cancel()
+
+		message.Attempts++
+		switch {
+		case sendErr == nil:
+			sentAt := time.Now()
+			message.Status = string(enum.EmailSent)
+			message.SentAt = &sentAt
+			message.NextAttemptAt = nil
+			message.LastError = ""
+		case message.Attempts >= emailMaxAttempts:
+			message.Status = string(enum.EmailFailed)
+			message.NextAttemptAt = nil
+			message.LastError = sendErr.Error()
+		default:
+			next := time.Now().Add(emailBackoff(message.Attempts))
+			message.NextAttemptAt = &next
+			message.LastError = sendErr.Error()
+		}
+
+		if err := s.emailRepo.Update(ctx, message); err != nil {
+			log.Printf("Failed to record email %s: %v", message.ID, err)
+		}
+	}
+	return len(messages), nil
+}
+
+// queueRecipients returns the active technicians of the ticket's team, or
+// the admins when no team handles the ticket.
+func (s *NotificationServiceImpl) queueRecipients(ctx context.Context, ticket *entity.Ticket) ([]*entity.User, error) {
+	if ticket.TeamID != nil {
+		technicians, err := s.technicianRepo.ListActive(ctx, ticket.TeamID)
+		if err != nil {
+			return nil, err
+		}
+
+		var users []*entity.User
+		for _, technician := range technicians {
+			if user, err := s.userRepo.GetByID(ctx, technician.UserID); err == nil {
+				users = append(users, user)
+			}
+		}
+		if len(users) > 0 {
+			return users, nil
+		}
+	}
+
+	return s.userRepo.ListByRole(ctx, string(enum.RoleAdmin))
+}
+
+func (s *NotificationServiceImpl) notifyUser(ctx context.Context, kind enum.NotificationKind, ticket *entity.Ticket, userID uuid.UUID) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil
+	}
+	return s.notify(ctx, kind, ticket, []*entity.User{user})
+}
+
+// notify adds the notification to each recipient's inbox, titled with the
+// rendered subject, and queues an email for recipients who want one. Muted
+// kinds are skipped entirely.
+func (s *NotificationServiceImpl) notify(ctx context.Context, kind enum.NotificationKind, ticket *entity.Ticket, recipients []*entity.User) error {
+	asset := ticket.Asset
+	if asset == nil {
+		if current, err := s.ticketRepo.GetByID(ctx, ticket.ID); err == nil {
+			asset = current.Asset
+		}
+	}
+
+	payload := map[string]interface{}{
+		"ticketId": ticket.ID,
+		"category": ticket.Category,
+		"severity": ticket.Severity,
+		"status":   ticket.Status,
+		"dueDate":  ticket.DueDate,
+	}
+	if asset != nil {
+		payload["assetId"] = asset.ID
+		payload["assetName"] = asset.Name
+	}
+
+	now := time.Now()
+	seen := make(map[uuid.UUID]bool)
+	for _, recipient := range recipients {
+		if seen[recipient.ID] || recipient.IsServiceAccount {
+			continue
+		}
+		seen[recipient.ID] = true
+
+		preference, err := s.GetPreferences(ctx, recipient.ID)
+		if err != nil {
+			return err
+		}
+		if preference.Mutes(string(kind)) {
+			continue
+		}
+
+		template, err := s.findTemplate(ctx, kind, preference.Locale)
+		if err != nil {
+			return err
+		}
+
+		subject, textBody, htmlBody, err := renderNotificationTemplate(template, notificationData{
+			Recipient: recipient,
+			Ticket:    ticket,
+			Asset:     asset,
+		})
+		if err != nil {
+			log.Printf("Failed to render %s notification (%s): %v", kind, template.Locale, err)
+			continue
+		}
+
+		if err := s.notificationRepo.Create(ctx, &entity.Notification{
+			ID:          uuid.New(),
+			RecipientID: recipient.ID,
+			Type:        string(kind),
+			Title:       subject,
+			Payload:     payload,
+		}); err != nil {
+			return err
+		}
+
+		if !preference.EmailEnabled || recipient.Email == "" {
+			continue
+		}
+
+		recipientID := recipient.ID
+		if err := s.emailRepo.Create(ctx, &entity.EmailMessage{
+			ID:            uuid.New(),
+			UserID:        &recipientID,
+			Recipient:     recipient.Email,
+			Kind:          string(kind),
+			Subject:       subject,
+			TextBody:      textBody,
+			HTMLBody:      htmlBody,
+			Status:        string(enum.EmailPending),
+			NextAttemptAt: &now,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// notifyAssetStatusChanged adds an inbox notification for the manager of the
+// asset's department and the reporters of its unfinished tickets.
+func (s *NotificationServiceImpl) notifyAssetStatusChanged(ctx context.Context, asset *entity.Asset, previousStatus string) error {
+	var recipientIDs []uuid.UUID
+	if asset.DepartmentID != nil {
+		if department, err := s.departmentRepo.GetByID(ctx, *asset.DepartmentID); err == nil && department.ManagerID != nil {
+			recipientIDs = append(recipientIDs, *department.ManagerID)
+		}
+	}
+
+	tickets, err := s.ticketRepo.GetByAssetID(ctx, asset.ID)
+	if err != nil {
+		return err
+	}
+	for _, ticket := range tickets {
+		if ticket.Status != string(enum.TicketStatusResolved) && ticket.Status != string(enum.TicketStatusClosed) {
+			recipientIDs = append(recipientIDs, ticket.Reporting)
+		}
+	}
+
+	return s.NotifyInbox(ctx, enum.NotificationAssetStatusChanged, recipientIDs, asset.Name+" is now "+asset.Status, map[string]interface{}{
+		"assetId":        asset.ID,
+		"assetName":      asset.Name,
+		"uniqueId":       asset.UniqueID,
+		"status":         asset.Status,
+		"previousStatus": previousStatus,
+	})
+}
+
+// NotifyInbox adds an inbox-only notification for each recipient who has not
+// muted the kind.
+func (s *NotificationServiceImpl) NotifyInbox(ctx context.Context, kind enum.NotificationKind, recipientIDs []uuid.UUID, title string, payload map[string]interface{}) error {
+	seen := make(map[uuid.UUID]bool)
+	for _, recipientID := range recipientIDs {
+		if seen[recipientID] {
+			continue
+		}
+		seen[recipientID] = true
+
+		preference, err := s.GetPreferences(ctx, recipientID)
+		if err != nil {
+			return err
+		}
+		if preference.Mutes(string(kind)) {
+			continue
+		}
+
+		if err := s.notificationRepo.Create(ctx, &entity.Notification{
+			ID:          uuid.New(),
+			RecipientID: recipientID,
+			Type:        string(kind),
+			Title:       title,
+			Payload:     payload,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// findTemplate looks up the template for locale, then for its language
+// without the region (de for de-AT), then for the default locale.
+func (s *NotificationServiceImpl) findTemplate(ctx context.Context, kind enum.NotificationKind, locale string) (*entity.NotificationTemplate, error) {
+	candidates := []string{locale}
+	if language, _, found := strings.Cut(locale, "-"); found {
+		candidates = append(candidates, language)
+	}
+	candidates = append(candidates, s.defaultLocale)
+
+	for _, candidate := range candidates {
+		if template, err := s.templateRepo.GetByKindAndLocale(ctx, string(kind), candidate); err == nil {
+			return template, nil
+		}
+	}
+	return nil, errors.New("no template for notification " + string(kind))
+}
+
+func renderNotificationTemplate(tmpl *entity.NotificationTemplate, data notificationData) (subject, textBody, htmlBody string, err error) {
+	var buf bytes.Buffer
+
+	subjectTemplate, err := template.New("subject").Parse(tmpl.Subject)
+	if err != nil {
+		return "", "", "", err
+	}
+	if err := subjectTemplate.Execute(&buf, data); err != nil {
+		return "", "", "", err
+	}
+	// Header values must stay on one line
+	subject = strings.Join(strings.Fields(buf.String()), " ")
+
+	buf.Reset()
+	textTemplate, err := template.New("text").Parse(tmpl.TextBody)
+	if err != nil {
+		return "", "", "", err
+	}
+	if err := textTemplate.Execute(&buf, data); err != nil {
+		return "", "", "", err
+	}
+	textBody = buf.String()
+
+	buf.Reset()
+	htmlTemplate, err := htmltemplate.New("html").Parse(tmpl.HTMLBody)
+	if err != nil {
+		return "", "", "", err
+	}
+	if err := htmlTemplate.Execute(&buf, data); err != nil {
+		return "", "", "", err
+	}
+	htmlBody = buf.String()
+
+	return subject, textBody, htmlBody, nil
+}
+
+// validateNotificationTemplate renders the template against sample data so
+// that syntax errors and unknown fields are rejected when saving rather than
+// when sending.
+func validateNotificationTemplate(tmpl *entity.NotificationTemplate) error {
+	if strings.TrimSpace(tmpl.Subject) == "" {
+		return errors.New("subject is required")
+	}
+	if strings.TrimSpace(tmpl.TextBody) == "" && strings.TrimSpace(tmpl.HTMLBody) == "" {
+		return errors.New("a text or HTML body is required")
+	}
+
+	assignee := uuid.New()
+	sample := notificationData{
+		Recipient: &entity.User{ID: uuid.New(), Name: "Sample User", Email: "user@example.com"},
+		Ticket: &entity.Ticket{
+			ID:         uuid.New(),
+			Category:   "hardware",
+			Severity:   "high",
+			Status:     "open",
+			DueDate:    time.Now(),
+			AssignedTo: &assignee,
+			Comment:    "Sample comment",
+		},
+		Asset: &entity.Asset{ID: uuid.New(), UniqueID: "SAMPLE-001", Name: "Sample asset"},
+	}
+
+	if _, _, _, err := renderNotificationTemplate(tmpl, sample); err != nil {
+		return errors.New("invalid template: " + err.Error())
+	}
+	return nil
+}
+
+// emailBackoff returns the delay after the given number of failed attempts.
+func emailBackoff(attempts int) time.Duration {
+	delay := emailInitialBackoff
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= emailMaxBackoff {
+			return emailMaxBackoff
+		}
+	}
+	return delay
+}
@@ -0,0 +1,99 @@
+package service
+
+import "inventory-ticketing-system/domain/enum"
+
+// notificationTemplateContent is the built-in English text of a notification.
+// It is stored as the default locale's template on startup and can be edited
+// from then on.
+type notificationTemplateContent struct {
+	Subject  string
+	TextBody string
+	HTMLBody string
+}
+
+var defaultNotificationTemplates = map[enum.NotificationKind]notificationTemplateContent{
+	enum.NotificationTicketCreated: {
+		Subject: `New {{.Ticket.Severity}} ticket: {{.Ticket.Category}}{{if .Asset}} on {{.Asset.Name}}{{end}}`,
+		TextBody: `Hello {{.Recipient.Name}},
+
+A new {{.Ticket.Severity}} ticket was reported{{if .Asset}} for {{.Asset.Name}} ({{.Asset.UniqueID}}){{end}}.
+
+Category: {{.Ticket.Category}}
+Due: {{.Ticket.DueDate.Format "2006-01-02 15:04 MST"}}
+{{if .Ticket.Comment}}
+{{.Ticket.Comment}}
+{{end}}
+Ticket ID: {{.Ticket.ID}}
+`,
+		HTMLBody: `<p>Hello {{.Recipient.Name}},</p>
+<p>A new <strong>{{.Ticket.Severity}}</strong> ticket was reported{{if .Asset}} for {{.Asset.Name}} ({{.Asset.UniqueID}}){{end}}.</p>
+<ul>
+  <li>Category: {{.Ticket.Category}}</li>
+  <li>Due: {{.Ticket.DueDate.Format "2006-01-02 15:04 MST"}}</li>
+</ul>
+{{if .Ticket.Comment}}<blockquote>{{.Ticket.Comment}}</blockquote>{{end}}
+<p>Ticket ID: {{.Ticket.ID}}</p>
+`,
+	},
+	enum.NotificationTicketAssigned: {
+		Subject: `Ticket assigned to you: {{.Ticket.Category}}{{if .Asset}} on {{.Asset.Name}}{{end}}`,
+		TextBody: `Hello {{.Recipient.Name}},
+
+A {{.Ticket.Severity}} ticket{{if .Asset}} for {{.Asset.Name}} ({{.Asset.UniqueID}}){{end}} has been assigned to you.
+
+Category: {{.Ticket.Category}}
+Due: {{.Ticket.DueDate.Format "2006-01-02 15:04 MST"}}
+{{if .Ticket.Comment}}
+{{.Ticket.Comment}}
+{{end}}
+Ticket ID: {{.Ticket.ID}}
+`,
+		HTMLBody: `<p>Hello {{.Recipient.Name}},</p>
+<p>A <strong>{{.Ticket.Severity}}</strong> ticket{{if .Asset}} for {{.Asset.Name}} ({{.Asset.UniqueID}}){{end}} has been assigned to you.</p>
+<ul>
+  <li>Category: {{.Ticket.Category}}</li>
+  <li>Due: {{.Ticket.DueDate.Format "2006-01-02 15:04 MST"}}</li>
+</ul>
+{{if .Ticket.Comment}}<blockquote>{{.Ticket.Comment}}</blockquote>{{end}}
+<p>Ticket ID: {{.Ticket.ID}}</p>
+`,
+	},
+	enum.NotificationTicketResolved: {
+		Subject: `Your ticket has been resolved: {{.Ticket.Category}}{{if .Asset}} on {{.Asset.Name}}{{end}}`,
+		TextBody: `Hello {{.Recipient.Name}},
+
+The ticket you reported{{if .Asset}} for {{.Asset.Name}} ({{.Asset.UniqueID}}){{end}} has been resolved.
+{{if .Ticket.ResolutionComment}}
+Resolution: {{.Ticket.ResolutionComment}}
+{{end}}
+Ticket ID: {{.Ticket.ID}}
+`,
+		HTMLBody: `<p>Hello {{.Recipient.Name}},</p>
+<p>The ticket you reported{{if .Asset}} for {{.Asset.Name}} ({{.Asset.UniqueID}}){{end}} has been resolved.</p>
+{{if .Ticket.ResolutionComment}}<p>Resolution: {{.Ticket.ResolutionComment}}</p>{{end}}
+<p>Ticket ID: {{.Ticket.ID}}</p>
+`,
+	},
+	enum.NotificationSLABreachWarning: {
+		Subject: `SLA warning: {{.Ticket.Severity}} ticket due {{.Ticket.DueDate.Format "2006-01-02 15:04 MST"}}`,
+		TextBody: `Hello {{.Recipient.Name}},
+
+A {{.Ticket.Severity}} ticket{{if .Asset}} for {{.Asset.Name}} ({{.Asset.UniqueID}}){{end}} is about to breach its SLA.
+
+Category: {{.Ticket.Category}}
+Status: {{.Ticket.Status}}
+Due: {{.Ticket.DueDate.Format "2006-01-02 15:04 MST"}}
+
+Ticket ID: {{.Ticket.ID}}
+`,
+		HTMLBody: `<p>Hello {{.Recipient.Name}},</p>
+<p>A <strong>{{.Ticket.Severity}}</strong> ticket{{if .Asset}} for {{.Asset.Name}} ({{.Asset.UniqueID}}){{end}} is about to breach its SLA.</p>
+<ul>
+  <li>Category: {{.Ticket.Category}}</li>
+  <li>Status: {{.Ticket.Status}}</li>
+  <li>Due: {{.Ticket.DueDate.Format "2006-01-02 15:04 MST"}}</li>
+</ul>
+<p>Ticket ID: {{.Ticket.ID}}</p>
+`,
+	},
+}
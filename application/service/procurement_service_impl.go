@@ -0,0 +1,559 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/enum"
+	"inventory-ticketing-system/domain/policy"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/domain/service"
+
+	"github.com/google/uuid"
+)
+
+type ProcurementServiceImpl struct {
+	requestRepo  repository.PurchaseRequestRepository
+	orderRepo    repository.PurchaseOrderRepository
+	receiptRepo  repository.GoodsReceiptRepository
+	vendorRepo   repository.VendorRepository
+	assetRepo    repository.AssetRepository
+	userRepo     repository.UserRepository
+	assetService service.AssetService
+	txManager    repository.TransactionManager
+}
+
+func NewProcurementService(
+	requestRepo repository.PurchaseRequestRepository,
+	orderRepo repository.PurchaseOrderRepository,
+	receiptRepo repository.GoodsReceiptRepository,
+	vendorRepo repository.VendorRepository,
+	assetRepo repository.AssetRepository,
+	userRepo repository.UserRepository,
+	assetService service.AssetService,
+	txManager repository.TransactionManager,
+) service.ProcurementService {
+	return &ProcurementServiceImpl{
+		requestRepo:  requestRepo,
+		orderRepo:    orderRepo,
+		receiptRepo:  receiptRepo,
+		vendorRepo:   vendorRepo,
+		assetRepo:    assetRepo,
+		userRepo:     userRepo,
+		assetService: assetService,
+		txManager:    txManager,
+	}
+}
+
+func (s *ProcurementServiceImpl) CreateRequest(ctx context.Context, request *entity.PurchaseRequest) error {
+	if err := validatePurchaseRequest(request); err != nil {
+		return err
+	}
+
+	principal, ok := policy.FromContext(ctx)
+	if !ok {
+		return policy.ErrForbidden
+	}
+	user, err := s.userRepo.GetByID(ctx, principal.UserID)
+	if err != nil {
+		return errors.New("user not found")
+	}
+
+	if request.ID == uuid.Nil {
+		request.ID = uuid.New()
+	}
+	request.RequestedBy = user.ID
+	request.DepartmentID = user.DepartmentID
+	request.Status = string(enum.PurchaseRequestPending)
+	numberItems(request)
+	return s.requestRepo.Create(ctx, request)
+}
+
+func (s *ProcurementServiceImpl) DraftRequest(ctx context.Context, request *entity.PurchaseRequest) error {
+	if err := validatePurchaseRequest(request); err != nil {
+		return err
+	}
+	if _, err := s.userRepo.GetByID(ctx, request.RequestedBy); err != nil {
+		return errors.New("requester not found")
+	}
+
+	if request.ID == uuid.Nil {
+		request.ID = uuid.New()
+	}
+	request.Status = string(enum.PurchaseRequestDraft)
+	numberItems(request)
+	return s.requestRepo.Create(ctx, request)
+}
+
+func (s *ProcurementServiceImpl) SubmitRequest(ctx context.Context, id uuid.UUID) (*entity.PurchaseRequest, error) {
+	request, err := s.requestRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("purchase request not found")
+	}
+	if err := policy.AuthorizeOwner(ctx, request.RequestedBy); err != nil {
+		return nil, err
+	}
+	if request.Status != string(enum.PurchaseRequestDraft) {
+		return nil, fmt.Errorf("purchase request is already %s", request.Status)
+	}
+
+	request.Status = string(enum.PurchaseRequestPending)
+	if err := s.requestRepo.Update(ctx, request); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func (s *ProcurementServiceImpl) GetRequest(ctx context.Context, id uuid.UUID) (*entity.PurchaseRequest, error) {
+	request, err := s.requestRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("purchase request not found")
+	}
+	if err := authorizePurchaseRequestRead(ctx, request); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func (s *ProcurementServiceImpl) ListRequests(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.PurchaseRequest, int, error) {
+	principal, err := policy.Caller(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	if principal != nil {
+		if _, unrestricted := principal.Conditions(enum.PermissionPurchasesManage); !unrestricted {
+			conditions, unrestricted := principal.Conditions(enum.PermissionPurchasesApprove)
+			switch {
+			case unrestricted:
+			case len(conditions) > 0:
+				filters["scope"] = conditions
+				filters["requester"] = principal.UserID
+			default:
+				filters["requested_by"] = principal.UserID
+			}
+		}
+	}
+	return s.requestRepo.List(ctx, limit, offset, filters)
+}
+
+func (s *ProcurementServiceImpl) ApproveRequest(ctx context.Context, id uuid.UUID, comment string) (*entity.PurchaseRequest, error) {
+	request, err := s.pendingForDecision(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return request, s.decide(ctx, request, enum.PurchaseRequestApproved, comment)
+}
+
+func (s *ProcurementServiceImpl) RejectRequest(ctx context.Context, id uuid.UUID, comment string) (*entity.PurchaseRequest, error) {
+	if strings.TrimSpace(comment) == "" {
+		return nil, errors.New("a comment is required to reject a purchase request")
+	}
+
+	request, err := s.pendingForDecision(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return request, s.decide(ctx, request, enum.PurchaseRequestRejected, comment)
+}
+
+func (s *ProcurementServiceImpl) CancelRequest(ctx context.Context, id uuid.UUID) (*entity.PurchaseRequest, error) {
+	request, err := s.requestRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("purchase request not found")
+	}
+	if err := policy.AuthorizeOwner(ctx, request.RequestedBy); err != nil {
+		return nil, err
+	}
+	switch enum.PurchaseRequestStatus(request.Status) {
+	case enum.PurchaseRequestDraft, enum.PurchaseRequestPending, enum.PurchaseRequestApproved:
+	default:
+		return nil, fmt.Errorf("purchase request is already %s", request.Status)
+	}
+
+	request.Status = string(enum.PurchaseRequestCancelled)
+	if err := s.requestRepo.Update(ctx, request); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func (s *ProcurementServiceImpl) CreateOrder(ctx context.Context, order *entity.PurchaseOrder) error {
+	vendor, err := s.vendorRepo.GetByID(ctx, order.VendorID)
+	if err != nil {
+		return errors.New("vendor not found")
+	}
+	if order.Currency == "" {
+		order.Currency = "USD"
+	}
+
+	var request *entity.PurchaseRequest
+	if order.RequestID != nil {
+		request, err = s.requestRepo.GetByID(ctx, *order.RequestID)
+		if err != nil {
+			return errors.New("purchase request not found")
+		}
+		if request.Status != string(enum.PurchaseRequestApproved) {
+			return fmt.Errorf("only approved purchase requests can be ordered, this one is %s", request.Status)
+		}
+		if len(order.Lines) == 0 {
+			for _, item := range request.Items {
+				itemID := item.ID
+				order.Lines = append(order.Lines, &entity.PurchaseOrderLine{
+					RequestItemID: &itemID,
+					Description:   item.Description,
+					Category:      item.Category,
+					Quantity:      item.Quantity,
+					UnitCost:      item.EstimatedUnitCost,
+				})
+			}
+		}
+	}
+
+	if len(order.Lines) == 0 {
+		return errors.New("a purchase order needs at least one line")
+	}
+	if order.ID == uuid.Nil {
+		order.ID = uuid.New()
+	}
+	for i, line := range order.Lines {
+		if err := s.validateLine(ctx, line, request); err != nil {
+			return fmt.Errorf("line %d: %w", i+1, err)
+		}
+		line.ID = uuid.New()
+		line.OrderID = order.ID
+		line.Position = i + 1
+		line.ReceivedQuantity = 0
+	}
+
+	order.Number = purchaseOrderNumber(order, time.Now())
+	order.Status = string(enum.PurchaseOrderOrdered)
+	if id := callerID(ctx); id != nil {
+		order.OrderedBy = *id
+	}
+
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.orderRepo.Create(ctx, order); err != nil {
+			return err
+		}
+		if request == nil {
+			return nil
+		}
+		request.Status = string(enum.PurchaseRequestOrdered)
+		return s.requestRepo.Update(ctx, request)
+	})
+	if err != nil {
+		return err
+	}
+
+	order.Vendor = vendor
+	return nil
+}
+
+func (s *ProcurementServiceImpl) GetOrder(ctx context.Context, id uuid.UUID) (*entity.PurchaseOrder, error) {
+	order, err := s.orderRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("purchase order not found")
+	}
+	return order, nil
+}
+
+func (s *ProcurementServiceImpl) ListOrders(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.PurchaseOrder, int, error) {
+	return s.orderRepo.List(ctx, limit, offset, filters)
+}
+
+func (s *ProcurementServiceImpl) CancelOrder(ctx context.Context, id uuid.UUID) (*entity.PurchaseOrder, error) {
+	order, err := s.orderRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("purchase order not found")
+	}
+	if order.Status != string(enum.PurchaseOrderOrdered) {
+		return nil, fmt.Errorf("a %s purchase order cannot be cancelled", order.Status)
+	}
+
+	order.Status = string(enum.PurchaseOrderCancelled)
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.orderRepo.Update(ctx, order); err != nil {
+			return err
+		}
+		if order.RequestID == nil {
+			return nil
+		}
+		request, err := s.requestRepo.GetByID(ctx, *order.RequestID)
+		if err != nil {
+			return err
+		}
+		if request.Status != string(enum.PurchaseRequestOrdered) {
+			return nil
+		}
+		request.Status = string(enum.PurchaseRequestApproved)
+		return s.requestRepo.Update(ctx, request)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+// ReceiveGoods creates the assets through the asset service, so the receiver
+// also needs write access to the assets' location, department and category.
+func (s *ProcurementServiceImpl) ReceiveGoods(ctx context.Context, orderID uuid.UUID, receipt *entity.GoodsReceipt, items []service.ReceiptItem) (*entity.GoodsReceipt, error) {
+	if len(items) == 0 {
+		return nil, errors.New("a goods receipt needs at least one item")
+	}
+
+	order, err := s.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, errors.New("purchase order not found")
+	}
+	if order.Status != string(enum.PurchaseOrderOrdered) && order.Status != string(enum.PurchaseOrderPartiallyReceived) {
+		return nil, fmt.Errorf("goods cannot be received for a %s purchase order", order.Status)
+	}
+
+	lines := make(map[uuid.UUID]*entity.PurchaseOrderLine, len(order.Lines))
+	for _, line := range order.Lines {
+		lines[line.ID] = line
+	}
+	received := make(map[uuid.UUID]int, len(items))
+	for _, item := range items {
+		line, ok := lines[item.OrderLineID]
+		if !ok {
+			return nil, fmt.Errorf("line %s is not part of purchase order %s", item.OrderLineID, order.Number)
+		}
+		if item.Quantity < 1 {
+			return nil, fmt.Errorf("line %d: the quantity must be at least 1", line.Position)
+		}
+		if len(item.UniqueIDs) > 0 && len(item.UniqueIDs) != item.Quantity {
+			return nil, fmt.Errorf("line %d: give one unique ID per unit received", line.Position)
+		}
+		if len(item.UniqueIDs) > 0 && line.AssetID != nil {
+			return nil, fmt.Errorf("line %d restocks an existing asset and takes no unique IDs", line.Position)
+		}
+		received[line.ID] += item.Quantity
+		if received[line.ID] > line.Outstanding() {
+			return nil, fmt.Errorf("line %d: only %d of %d still outstanding", line.Position, line.Outstanding(), line.Quantity)
+		}
+	}
+
+	// Assets bought for a request belong to the requester's department
+	var departmentID *uuid.UUID
+	if order.RequestID != nil {
+		if request, err := s.requestRepo.GetByID(ctx, *order.RequestID); err == nil {
+			departmentID = request.DepartmentID
+		}
+	}
+
+	if receipt.ID == uuid.Nil {
+		receipt.ID = uuid.New()
+	}
+	if receipt.ReceivedAt.IsZero() {
+		receipt.ReceivedAt = time.Now()
+	}
+	receipt.OrderID = order.ID
+	if id := callerID(ctx); id != nil {
+		receipt.ReceivedBy = *id
+	}
+	receipt.Lines = nil
+	purchaseDate := order.CreatedAt
+
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		for _, item := range items {
+			line := lines[item.OrderLineID]
+
+			if line.AssetID != nil {
+				if err := s.assetService.IncreaseAssetQuantity(ctx, *line.AssetID, item.Quantity, enum.StockMovementReceipt); err != nil {
+					return fmt.Errorf("line %d: %w", line.Position, err)
+				}
+				receipt.Lines = append(receipt.Lines, &entity.GoodsReceiptLine{
+					ID: uuid.New(), ReceiptID: receipt.ID, OrderLineID: line.ID,
+					AssetID: *line.AssetID, Quantity: item.Quantity, Restocked: true,
+				})
+			} else {
+				uniqueIDs := item.UniqueIDs
+				qty := 1
+				if len(uniqueIDs) == 0 {
+					uniqueIDs = []string{fmt.Sprintf("%s-%d-%d", order.Number, line.Position, line.ReceivedQuantity+1)}
+					qty = item.Quantity
+				}
+				for _, uniqueID := range uniqueIDs {
+					asset := &entity.Asset{
+						ID:               uuid.New(),
+						UniqueID:         strings.TrimSpace(uniqueID),
+						Name:             line.Description,
+						Qty:              qty,
+						Brand:            line.Brand,
+						Type:             line.AssetType,
+						Category:         line.Category,
+						LocationID:       receipt.LocationID,
+						DepartmentID:     departmentID,
+						DataBearing:      line.AssetType == string(enum.AssetTypeIT),
+						PurchaseCost:     line.UnitCost * entity.Money(qty),
+						Currency:         order.Currency,
+						PurchaseDate:     &purchaseDate,
+						InvoiceNumber:    receipt.InvoiceNumber,
+						PurchaseVendorID: &order.VendorID,
+						PurchaseOrderID:  &order.ID,
+					}
+					if asset.UniqueID == "" {
+						return fmt.Errorf("line %d: unique IDs cannot be blank", line.Position)
+					}
+					if err := s.assetService.CreateAsset(ctx, asset); err != nil {
+						return fmt.Errorf("line %d: %w", line.Position, err)
+					}
+					receipt.Lines = append(receipt.Lines, &entity.GoodsReceiptLine{
+						ID: uuid.New(), ReceiptID: receipt.ID, OrderLineID: line.ID,
+						AssetID: asset.ID, Quantity: qty,
+					})
+				}
+			}
+
+			line.ReceivedQuantity += item.Quantity
+			if err := s.orderRepo.UpdateLine(ctx, line); err != nil {
+				return err
+			}
+		}
+
+		if err := s.receiptRepo.Create(ctx, receipt); err != nil {
+			return err
+		}
+
+		order.Status = string(enum.PurchaseOrderReceived)
+		for _, line := range order.Lines {
+			if line.Outstanding() > 0 {
+				order.Status = string(enum.PurchaseOrderPartiallyReceived)
+				break
+			}
+		}
+		return s.orderRepo.Update(ctx, order)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
+
+func (s *ProcurementServiceImpl) ListReceipts(ctx context.Context, orderID uuid.UUID) ([]*entity.GoodsReceipt, error) {
+	if _, err := s.orderRepo.GetByID(ctx, orderID); err != nil {
+		return nil, errors.New("purchase order not found")
+	}
+	return s.receiptRepo.ListByOrder(ctx, orderID)
+}
+
+// pendingForDecision loads a pending request the caller may approve or
+// reject.
+func (s *ProcurementServiceImpl) pendingForDecision(ctx context.Context, id uuid.UUID) (*entity.PurchaseRequest, error) {
+	request, err := s.requestRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("purchase request not found")
+	}
+	if err := policy.Authorize(ctx, enum.PermissionPurchasesApprove, purchaseRequestResource(request)); err != nil {
+		return nil, err
+	}
+	if principal, ok := policy.FromContext(ctx); ok && principal.UserID == request.RequestedBy {
+		return nil, errors.New("you cannot decide on your own purchase request")
+	}
+	if request.Status != string(enum.PurchaseRequestPending) {
+		return nil, fmt.Errorf("purchase request is already %s", request.Status)
+	}
+	return request, nil
+}
+
+func (s *ProcurementServiceImpl) decide(ctx context.Context, request *entity.PurchaseRequest, status enum.PurchaseRequestStatus, comment string) error {
+	now := time.Now()
+	request.Status = string(status)
+	request.DecidedBy = callerID(ctx)
+	request.DecidedAt = &now
+	request.DecisionComment = comment
+	return s.requestRepo.Update(ctx, request)
+}
+
+func (s *ProcurementServiceImpl) validateLine(ctx context.Context, line *entity.PurchaseOrderLine, request *entity.PurchaseRequest) error {
+	if strings.TrimSpace(line.Description) == "" {
+		return errors.New("a description is required")
+	}
+	if line.Quantity < 1 {
+		return errors.New("the quantity must be at least 1")
+	}
+	if line.UnitCost < 0 {
+		return errors.New("the unit cost cannot be negative")
+	}
+	if line.AssetType == "" {
+		line.AssetType = string(enum.AssetTypeIT)
+	}
+	if !enum.AssetType(line.AssetType).IsValid() {
+		return fmt.Errorf("invalid asset type %q", line.AssetType)
+	}
+	if line.AssetID != nil {
+		if _, err := s.assetRepo.GetByID(ctx, *line.AssetID); err != nil {
+			return errors.New("asset to restock not found")
+		}
+	}
+	if line.RequestItemID != nil {
+		if request == nil || !requestHasItem(request, *line.RequestItemID) {
+			return errors.New("the request item is not part of the order's purchase request")
+		}
+	}
+	return nil
+}
+
+func validatePurchaseRequest(request *entity.PurchaseRequest) error {
+	if strings.TrimSpace(request.Title) == "" {
+		return errors.New("a title is required")
+	}
+	if len(request.Items) == 0 {
+		return errors.New("a purchase request needs at least one item")
+	}
+	for i, item := range request.Items {
+		if strings.TrimSpace(item.Description) == "" {
+			return fmt.Errorf("item %d needs a description", i+1)
+		}
+		if item.Quantity < 1 {
+			return fmt.Errorf("item %d needs a quantity of at least 1", i+1)
+		}
+		if item.EstimatedUnitCost < 0 {
+			return fmt.Errorf("item %d has a negative estimated cost", i+1)
+		}
+	}
+	return nil
+}
+
+func numberItems(request *entity.PurchaseRequest) {
+	for i, item := range request.Items {
+		item.ID = uuid.New()
+		item.RequestID = request.ID
+		item.Position = i + 1
+	}
+}
+
+// authorizePurchaseRequestRead lets requesters see their own requests,
+// approvers the requests they could decide on and buyers every request.
+func authorizePurchaseRequestRead(ctx context.Context, request *entity.PurchaseRequest) error {
+	if principal, ok := policy.FromContext(ctx); ok && principal.UserID == request.RequestedBy {
+		return nil
+	}
+	if policy.Authorize(ctx, enum.PermissionPurchasesManage, &policy.Resource{}) == nil {
+		return nil
+	}
+	return policy.Authorize(ctx, enum.PermissionPurchasesApprove, purchaseRequestResource(request))
+}
+
+func purchaseRequestResource(request *entity.PurchaseRequest) *policy.Resource {
+	return &policy.Resource{DepartmentID: request.DepartmentID}
+}
+
+func requestHasItem(request *entity.PurchaseRequest, itemID uuid.UUID) bool {
+	for _, item := range request.Items {
+		if item.ID == itemID {
+			return true
+		}
+	}
+	return false
+}
+
+func purchaseOrderNumber(order *entity.PurchaseOrder, orderedAt time.Time) string {
+	return fmt.Sprintf("PO-%s-%s", orderedAt.UTC().Format("20060102"),
+		strings.ToUpper(strings.ReplaceAll(order.ID.String(), "-", "")[:8]))
+}
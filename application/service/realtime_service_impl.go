@@ -0,0 +1,276 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/enum"
+	"inventory-ticketing-system/domain/policy"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/domain/service"
+)
+
+// Realtime topics. Topics with an ID are written "<topic>:<id>".
+const (
+	topicTickets      = "tickets"
+	topicAssets       = "assets"
+	topicTicket       = "ticket"
+	topicAsset        = "asset"
+	topicLocation     = "location"
+	topicAssignedToMe = "assigned:me"
+)
+
+// realtimeTopic is a parsed subscription topic. ids holds the ticket or
+// asset ID, or the location and all locations below it.
+type realtimeTopic struct {
+	kind string
+	ids  []uuid.UUID
+}
+
+type RealtimeServiceImpl struct {
+	assetRepo    repository.AssetRepository
+	locationRepo repository.LocationRepository
+	broker       service.EventBroker
+}
+
+func NewRealtimeService(
+	assetRepo repository.AssetRepository,
+	locationRepo repository.LocationRepository,
+	broker service.EventBroker,
+) service.RealtimeService {
+	return &RealtimeServiceImpl{
+		assetRepo:    assetRepo,
+		locationRepo: locationRepo,
+		broker:       broker,
+	}
+}
+
+// HandleEvent forwards ticket and asset events to the broker together with
+// the asset attributes needed to route them. It runs in the outbox relay
+// transaction, so the notification goes out when the relay commits.
+func (s *RealtimeServiceImpl) HandleEvent(ctx context.Context, event *entity.OutboxEvent) error {
+	realtimeEvent := &entity.RealtimeEvent{
+		ID:          event.ID,
+		Type:        event.EventType,
+		AggregateID: event.AggregateID,
+		OccurredAt:  event.OccurredAt,
+		Data:        json.RawMessage(event.Payload),
+	}
+
+	var asset *entity.Asset
+	switch event.AggregateType {
+	case "ticket":
+		ticket, err := decodeTicketPayload(event)
+		if err != nil {
+			return err
+		}
+		realtimeEvent.Scope.AssetID = &ticket.AssetID
+		realtimeEvent.Scope.AssignedTo = ticket.AssignedTo
+
+		asset = ticket.Asset
+		if asset == nil {
+			asset, _ = s.assetRepo.GetByID(ctx, ticket.AssetID)
+		}
+	case "asset":
+		decoded, err := decodeAssetPayload(event)
+		if err != nil {
+			return err
+		}
+		asset = decoded
+		realtimeEvent.Scope.AssetID = &asset.ID
+	default:
+		return nil
+	}
+
+	if asset != nil {
+		realtimeEvent.Scope.LocationID = asset.LocationID
+		realtimeEvent.Scope.DepartmentID = asset.DepartmentID
+		realtimeEvent.Scope.Category = asset.Category
+	}
+
+	return s.broker.Publish(ctx, realtimeEvent)
+}
+
+func (s *RealtimeServiceImpl) Subscribe(ctx context.Context, topics []string) (<-chan *entity.RealtimeEvent, error) {
+	principal, ok := policy.FromContext(ctx)
+	if !ok {
+		return nil, policy.ErrForbidden
+	}
+	if !principal.Can(enum.PermissionTicketsRead, nil) && !principal.Can(enum.PermissionAssetsRead, nil) {
+		return nil, policy.ErrForbidden
+	}
+
+	parsed := make([]realtimeTopic, 0, len(topics))
+	for _, topic := range topics {
+		t, err := s.parseTopic(ctx, topic)
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, t)
+	}
+
+	events, cancel := s.broker.Subscribe()
+	out := make(chan *entity.RealtimeEvent)
+
+	go func() {
+		defer close(out)
+		defer cancel()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+				if !canReceive(principal, event) || !matchesTopics(principal, parsed, event) {
+					continue
+				}
+
+				select {
+				case out <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+func (s *RealtimeServiceImpl) parseTopic(ctx context.Context, topic string) (realtimeTopic, error) {
+	switch topic {
+	case topicTickets, topicAssets, topicAssignedToMe:
+		return realtimeTopic{kind: topic}, nil
+	}
+
+	kind, value, found := strings.Cut(topic, ":")
+	if !found {
+		return realtimeTopic{}, errors.New("unknown topic: " + topic)
+	}
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return realtimeTopic{}, errors.New("invalid ID in topic: " + topic)
+	}
+
+	switch kind {
+	case topicTicket, topicAsset:
+		return realtimeTopic{kind: kind, ids: []uuid.UUID{id}}, nil
+	case topicLocation:
+		ids, err := s.locationRepo.ListDescendantIDs(ctx, id)
+		if err != nil {
+			return realtimeTopic{}, err
+		}
+		if len(ids) == 0 {
+			return realtimeTopic{}, errors.New("location not found")
+		}
+		return realtimeTopic{kind: kind, ids: ids}, nil
+	}
+	return realtimeTopic{}, errors.New("unknown topic: " + topic)
+}
+
+// canReceive applies the same read checks as the REST API to the event's
+// asset.
+func canReceive(principal *policy.Principal, event *entity.RealtimeEvent) bool {
+	permission := enum.PermissionAssetsRead
+	if strings.HasPrefix(event.Type, "ticket.") {
+		permission = enum.PermissionTicketsRead
+	}
+
+	return principal.Can(permission, &policy.Resource{
+		LocationID:   event.Scope.LocationID,
+		DepartmentID: event.Scope.DepartmentID,
+		Category:     event.Scope.Category,
+	})
+}
+
+func matchesTopics(principal *policy.Principal, topics []realtimeTopic, event *entity.RealtimeEvent) bool {
+	if len(topics) == 0 {
+		return true
+	}
+
+	isTicket := strings.HasPrefix(event.Type, "ticket.")
+	for _, topic := range topics {
+		switch topic.kind {
+		case topicTickets:
+			if isTicket {
+				return true
+			}
+		case topicAssets:
+			if !isTicket {
+				return true
+			}
+		case topicAssignedToMe:
+			if isTicket && event.Scope.AssignedTo != nil && *event.Scope.AssignedTo == principal.UserID {
+				return true
+			}
+		case topicTicket:
+			if isTicket && event.AggregateID == topic.ids[0] {
+				return true
+			}
+		case topicAsset:
+			// An asset topic also covers the asset's tickets
+			if event.Scope.AssetID != nil && *event.Scope.AssetID == topic.ids[0] {
+				return true
+			}
+		case topicLocation:
+			if event.Scope.LocationID != nil && containsUUID(topic.ids, *event.Scope.LocationID) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func decodeTicketPayload(event *entity.OutboxEvent) (*entity.Ticket, error) {
+	if enum.EventType(event.EventType) == enum.EventTicketStatusChanged {
+		var payload TicketStatusChangedPayload
+		if err := json.Unmarshal([]byte(event.Payload), &payload); err != nil {
+			return nil, err
+		}
+		if payload.Ticket == nil {
+			return nil, errors.New("status change event without ticket")
+		}
+		return payload.Ticket, nil
+	}
+
+	var ticket entity.Ticket
+	if err := json.Unmarshal([]byte(event.Payload), &ticket); err != nil {
+		return nil, err
+	}
+	return &ticket, nil
+}
+
+func decodeAssetPayload(event *entity.OutboxEvent) (*entity.Asset, error) {
+	if enum.EventType(event.EventType) == enum.EventAssetStatusChanged {
+		var payload AssetStatusChangedPayload
+		if err := json.Unmarshal([]byte(event.Payload), &payload); err != nil {
+			return nil, err
+		}
+		if payload.Asset == nil {
+			return nil, errors.New("status change event without asset")
+		}
+		return payload.Asset, nil
+	}
+
+	var asset entity.Asset
+	if err := json.Unmarshal([]byte(event.Payload), &asset); err != nil {
+		return nil, err
+	}
+	return &asset, nil
+}
+
+func containsUUID(ids []uuid.UUID, id uuid.UUID) bool {
+	for _, candidate := range ids {
+		if candidate == id {
+			return true
+		}
+	}
+	return false
+}
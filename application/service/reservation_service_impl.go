@@ -0,0 +1,528 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/enum"
+	"inventory-ticketing-system/domain/policy"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/domain/service"
+	"inventory-ticketing-system/pkg/ical"
+
+	"github.com/google/uuid"
+)
+
+// maxAvailabilityResults bounds how many assets an availability search
+// looks at.
+const maxAvailabilityResults = 200
+
+// calendarHistory is how far back calendar feeds include past reservations.
+const calendarHistory = 30 * 24 * time.Hour
+
+type ReservationServiceImpl struct {
+	reservationRepo repository.ReservationRepository
+	assetRepo       repository.AssetRepository
+	userRepo        repository.UserRepository
+	assetService    service.AssetService
+	txManager       repository.TransactionManager
+}
+
+func NewReservationService(
+	reservationRepo repository.ReservationRepository,
+	assetRepo repository.AssetRepository,
+	userRepo repository.UserRepository,
+	assetService service.AssetService,
+	txManager repository.TransactionManager,
+) service.ReservationService {
+	return &ReservationServiceImpl{
+		reservationRepo: reservationRepo,
+		assetRepo:       assetRepo,
+		userRepo:        userRepo,
+		assetService:    assetService,
+		txManager:       txManager,
+	}
+}
+
+func (s *ReservationServiceImpl) Jobs() []service.Job {
+	return []service.Job{
+		{
+			Name:        "reservation_status",
+			Description: "Start and end reservations that are due and book or release their assets",
+			Schedule:    "@every 1m",
+			Run: func(ctx context.Context) (string, error) {
+				changed, err := s.SyncStatuses(ctx)
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprintf("updated %s", countNoun(changed, "reservation", "reservations")), nil
+			},
+		},
+	}
+}
+
+func (s *ReservationServiceImpl) CreateReservation(ctx context.Context, reservation *entity.Reservation) error {
+	if reservation.Quantity == 0 {
+		reservation.Quantity = 1
+	}
+	if err := validateReservationTimes(reservation, time.Now()); err != nil {
+		return err
+	}
+
+	asset, err := s.assetRepo.GetByID(ctx, reservation.AssetID)
+	if err != nil {
+		return errors.New("asset not found")
+	}
+	if err := policy.Authorize(ctx, enum.PermissionAssetsRead, assetResource(asset)); err != nil {
+		return err
+	}
+	if enum.AssetStatus(asset.Status).IsTerminal() {
+		return fmt.Errorf("a %s asset cannot be reserved", asset.Status)
+	}
+
+	principal, ok := policy.FromContext(ctx)
+	if !ok {
+		return policy.ErrForbidden
+	}
+	if reservation.UserID == uuid.Nil {
+		reservation.UserID = principal.UserID
+	}
+	if reservation.UserID != principal.UserID {
+		// Reserving for someone else is up to those who manage the asset
+		if err := policy.Authorize(ctx, enum.PermissionAssetsWrite, assetResource(asset)); err != nil {
+			return err
+		}
+		if _, err := s.userRepo.GetByID(ctx, reservation.UserID); err != nil {
+			return errors.New("user not found")
+		}
+	}
+
+	if reservation.ID == uuid.Nil {
+		reservation.ID = uuid.New()
+	}
+	reservation.CreatedBy = principal.UserID
+	reservation.Status = string(enum.ReservationConfirmed)
+
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		// Check against the asset as it is under the lock, not as loaded
+		// above
+		locked, err := s.assetRepo.LockByID(ctx, asset.ID)
+		if err != nil {
+			return err
+		}
+		if err := s.checkCapacity(ctx, locked, reservation); err != nil {
+			return err
+		}
+		if err := s.reservationRepo.Create(ctx, reservation); err != nil {
+			return err
+		}
+		return s.startIfDue(ctx, reservation, time.Now())
+	})
+	if err != nil {
+		return err
+	}
+
+	reservation.Asset = asset
+	return nil
+}
+
+func (s *ReservationServiceImpl) GetReservation(ctx context.Context, id uuid.UUID) (*entity.Reservation, error) {
+	reservation, err := s.reservationRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("reservation not found")
+	}
+	if err := authorizeReservation(ctx, reservation); err != nil {
+		return nil, err
+	}
+	return reservation, nil
+}
+
+func (s *ReservationServiceImpl) UpdateReservation(ctx context.Context, id uuid.UUID, changes *entity.Reservation) (*entity.Reservation, error) {
+	reservation, err := s.reservationRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("reservation not found")
+	}
+	if err := authorizeReservation(ctx, reservation); err != nil {
+		return nil, err
+	}
+	if !enum.ReservationStatus(reservation.Status).HoldsUnits() {
+		return nil, fmt.Errorf("a %s reservation cannot be changed", reservation.Status)
+	}
+
+	if changes.Quantity == 0 {
+		changes.Quantity = reservation.Quantity
+	}
+	if reservation.Status == string(enum.ReservationActive) &&
+		(!changes.StartsAt.Equal(reservation.StartsAt) || changes.Quantity != reservation.Quantity) {
+		return nil, errors.New("only the end of an active reservation can be changed")
+	}
+	if err := validateReservationTimes(changes, time.Now()); err != nil {
+		return nil, err
+	}
+
+	reservation.StartsAt = changes.StartsAt
+	reservation.EndsAt = changes.EndsAt
+	reservation.Quantity = changes.Quantity
+	reservation.Purpose = changes.Purpose
+
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		asset, err := s.assetRepo.LockByID(ctx, reservation.AssetID)
+		if err != nil {
+			return err
+		}
+		if err := s.checkCapacity(ctx, asset, reservation); err != nil {
+			return err
+		}
+		if err := s.reservationRepo.Update(ctx, reservation); err != nil {
+			return err
+		}
+		return s.startIfDue(ctx, reservation, time.Now())
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reservation, nil
+}
+
+func (s *ReservationServiceImpl) CancelReservation(ctx context.Context, id uuid.UUID) (*entity.Reservation, error) {
+	reservation, err := s.reservationRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("reservation not found")
+	}
+	if err := authorizeReservation(ctx, reservation); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	switch enum.ReservationStatus(reservation.Status) {
+	case enum.ReservationConfirmed:
+		reservation.Status = string(enum.ReservationCancelled)
+	case enum.ReservationActive:
+		reservation.Status = string(enum.ReservationCompleted)
+		reservation.EndsAt = now
+	default:
+		return nil, fmt.Errorf("a %s reservation cannot be cancelled", reservation.Status)
+	}
+
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.reservationRepo.Update(ctx, reservation); err != nil {
+			return err
+		}
+		return s.syncAssetStatus(ctx, reservation.AssetID, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reservation, nil
+}
+
+func (s *ReservationServiceImpl) ListReservations(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.Reservation, int, error) {
+	principal, err := policy.Caller(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	if principal != nil {
+		conditions, unrestricted := principal.Conditions(enum.PermissionAssetsWrite)
+		switch {
+		case unrestricted:
+		case len(conditions) > 0:
+			filters["scope"] = conditions
+		default:
+			filters["user_id"] = principal.UserID
+		}
+	}
+	return s.reservationRepo.List(ctx, limit, offset, filters)
+}
+
+func (s *ReservationServiceImpl) SearchAvailability(ctx context.Context, from, to time.Time, quantity int, filters map[string]interface{}) ([]*entity.AssetAvailability, error) {
+	if !to.After(from) {
+		return nil, errors.New("the end must be after the start")
+	}
+	if quantity < 1 {
+		quantity = 1
+	}
+
+	filters = copyFilters(filters)
+	filters["statuses"] = []string{string(enum.AssetStatusAvailable), string(enum.AssetStatusBooked)}
+	assets, _, err := s.assetService.ListAssets(ctx, maxAvailabilityResults, 0, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, 0, len(assets))
+	for _, asset := range assets {
+		ids = append(ids, asset.ID)
+	}
+	overlapping, err := s.reservationRepo.ListOverlapping(ctx, ids, from, to, nil)
+	if err != nil {
+		return nil, err
+	}
+	byAsset := make(map[uuid.UUID][]*entity.Reservation)
+	for _, reservation := range overlapping {
+		byAsset[reservation.AssetID] = append(byAsset[reservation.AssetID], reservation)
+	}
+
+	results := make([]*entity.AssetAvailability, 0, len(assets))
+	for _, asset := range assets {
+		reserved := peakReserved(byAsset[asset.ID], from, to)
+		if asset.Qty-reserved < quantity {
+			continue
+		}
+		results = append(results, &entity.AssetAvailability{
+			Asset:     asset,
+			Reserved:  reserved,
+			Available: asset.Qty - reserved,
+		})
+	}
+	return results, nil
+}
+
+func (s *ReservationServiceImpl) AssetCalendar(ctx context.Context, assetID uuid.UUID) ([]byte, error) {
+	asset, err := s.assetRepo.GetByID(ctx, assetID)
+	if err != nil {
+		return nil, errors.New("asset not found")
+	}
+	if err := policy.Authorize(ctx, enum.PermissionAssetsRead, assetResource(asset)); err != nil {
+		return nil, err
+	}
+
+	reservations, err := s.calendarReservations(ctx, map[string]interface{}{"asset_id": assetID})
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[uuid.UUID]string)
+	calendar := ical.New("Reservations: " + asset.Name)
+	for _, reservation := range reservations {
+		if _, ok := names[reservation.UserID]; !ok {
+			names[reservation.UserID] = "Unknown user"
+			if user, err := s.userRepo.GetByID(ctx, reservation.UserID); err == nil {
+				names[reservation.UserID] = user.Name
+			}
+		}
+		summary := names[reservation.UserID]
+		if reservation.Quantity > 1 {
+			summary = fmt.Sprintf("%s (%d units)", summary, reservation.Quantity)
+		}
+		calendar.Add(reservationEvent(reservation, summary))
+	}
+	return calendar.Bytes(), nil
+}
+
+func (s *ReservationServiceImpl) UserCalendar(ctx context.Context, userID uuid.UUID) ([]byte, error) {
+	if policy.AuthorizeOwner(ctx, userID) != nil {
+		if err := policy.Authorize(ctx, enum.PermissionUsersManage, nil); err != nil {
+			return nil, err
+		}
+	}
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	reservations, err := s.calendarReservations(ctx, map[string]interface{}{"user_id": userID})
+	if err != nil {
+		return nil, err
+	}
+
+	calendar := ical.New("Reservations: " + user.Name)
+	for _, reservation := range reservations {
+		summary := reservation.Asset.Name
+		if reservation.Quantity > 1 {
+			summary = fmt.Sprintf("%d x %s", reservation.Quantity, summary)
+		}
+		calendar.Add(reservationEvent(reservation, summary))
+	}
+	return calendar.Bytes(), nil
+}
+
+func (s *ReservationServiceImpl) SyncStatuses(ctx context.Context) (int, error) {
+	now := time.Now()
+	due, err := s.reservationRepo.ListDue(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	changed := 0
+	for _, reservation := range due {
+		switch {
+		case reservation.EndsAt.After(now):
+			reservation.Status = string(enum.ReservationActive)
+		default:
+			// Reservations that started and ended between runs go straight
+			// to completed
+			reservation.Status = string(enum.ReservationCompleted)
+		}
+
+		err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+			if err := s.reservationRepo.Update(ctx, reservation); err != nil {
+				return err
+			}
+			return s.syncAssetStatus(ctx, reservation.AssetID, now)
+		})
+		if err != nil {
+			return changed, err
+		}
+		changed++
+	}
+	return changed, nil
+}
+
+// checkCapacity fails when the reservation would take more units than the
+// asset has at any time it overlaps with other reservations.
+func (s *ReservationServiceImpl) checkCapacity(ctx context.Context, asset *entity.Asset, reservation *entity.Reservation) error {
+	if reservation.Quantity > asset.Qty {
+		return fmt.Errorf("the asset has only %d units", asset.Qty)
+	}
+
+	overlapping, err := s.reservationRepo.ListOverlapping(ctx, []uuid.UUID{asset.ID},
+		reservation.StartsAt, reservation.EndsAt, &reservation.ID)
+	if err != nil {
+		return err
+	}
+	reserved := peakReserved(overlapping, reservation.StartsAt, reservation.EndsAt)
+	if reserved+reservation.Quantity > asset.Qty {
+		return fmt.Errorf("only %d of %d units are free for the whole time, %d are already reserved",
+			max(asset.Qty-reserved, 0), asset.Qty, reserved)
+	}
+	return nil
+}
+
+// startIfDue activates a reservation that has already begun, as the status
+// job would on its next run.
+func (s *ReservationServiceImpl) startIfDue(ctx context.Context, reservation *entity.Reservation, now time.Time) error {
+	if reservation.Status != string(enum.ReservationConfirmed) || reservation.StartsAt.After(now) {
+		return nil
+	}
+	reservation.Status = string(enum.ReservationActive)
+	if err := s.reservationRepo.Update(ctx, reservation); err != nil {
+		return err
+	}
+	return s.syncAssetStatus(ctx, reservation.AssetID, now)
+}
+
+// syncAssetStatus books the asset while its active reservations take up all
+// of its units and makes a booked asset available again once they don't.
+// The status changes as a consequence of the reservation, so it is done as
+// the system.
+func (s *ReservationServiceImpl) syncAssetStatus(ctx context.Context, assetID uuid.UUID, now time.Time) error {
+	ctx = policy.AsSystem(ctx)
+	asset, err := s.assetRepo.GetByID(ctx, assetID)
+	if err != nil {
+		return errors.New("asset not found")
+	}
+
+	current, err := s.reservationRepo.ListOverlapping(ctx, []uuid.UUID{assetID}, now, now.Add(time.Second), nil)
+	if err != nil {
+		return err
+	}
+	reserved := 0
+	for _, reservation := range current {
+		if reservation.Status == string(enum.ReservationActive) {
+			reserved += reservation.Quantity
+		}
+	}
+
+	switch {
+	case reserved > 0 && reserved >= asset.Qty && asset.Status == string(enum.AssetStatusAvailable):
+		return s.assetService.UpdateAssetStatus(ctx, assetID, string(enum.AssetStatusBooked), "Reservation started")
+	case reserved < asset.Qty && asset.Status == string(enum.AssetStatusBooked):
+		return s.assetService.UpdateAssetStatus(ctx, assetID, string(enum.AssetStatusAvailable), "Reservation ended")
+	}
+	return nil
+}
+
+func (s *ReservationServiceImpl) calendarReservations(ctx context.Context, filters map[string]interface{}) ([]*entity.Reservation, error) {
+	filters["statuses"] = []string{
+		string(enum.ReservationConfirmed), string(enum.ReservationActive), string(enum.ReservationCompleted),
+	}
+	filters["from"] = time.Now().Add(-calendarHistory)
+	reservations, _, err := s.reservationRepo.List(ctx, 0, 0, filters)
+	return reservations, err
+}
+
+// authorizeReservation lets the reserving user, and whoever may write the
+// asset, see and change a reservation.
+func authorizeReservation(ctx context.Context, reservation *entity.Reservation) error {
+	if principal, ok := policy.FromContext(ctx); ok && principal.UserID == reservation.UserID {
+		return nil
+	}
+	return policy.Authorize(ctx, enum.PermissionAssetsWrite, assetResource(reservation.Asset))
+}
+
+func validateReservationTimes(reservation *entity.Reservation, now time.Time) error {
+	if reservation.Quantity < 1 {
+		return errors.New("the quantity must be at least 1")
+	}
+	if !reservation.EndsAt.After(reservation.StartsAt) {
+		return errors.New("the reservation must end after it starts")
+	}
+	if !reservation.EndsAt.After(now) {
+		return errors.New("the reservation must end in the future")
+	}
+	return nil
+}
+
+// peakReserved returns the most units the reservations take up at any one
+// time within [from, to). A reservation ending when another starts does not
+// overlap with it.
+func peakReserved(reservations []*entity.Reservation, from, to time.Time) int {
+	type change struct {
+		at    time.Time
+		units int
+	}
+	var changes []change
+	for _, reservation := range reservations {
+		if !reservation.Overlaps(from, to) {
+			continue
+		}
+		start, end := reservation.StartsAt, reservation.EndsAt
+		if start.Before(from) {
+			start = from
+		}
+		if end.After(to) {
+			end = to
+		}
+		changes = append(changes, change{start, reservation.Quantity}, change{end, -reservation.Quantity})
+	}
+	// Ends sort before starts at the same time
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].at.Equal(changes[j].at) {
+			return changes[i].units < changes[j].units
+		}
+		return changes[i].at.Before(changes[j].at)
+	})
+
+	peak, reserved := 0, 0
+	for _, c := range changes {
+		reserved += c.units
+		if reserved > peak {
+			peak = reserved
+		}
+	}
+	return peak
+}
+
+func reservationEvent(reservation *entity.Reservation, summary string) ical.Event {
+	event := ical.Event{
+		UID:         reservation.ID.String() + "@inventory-ticketing-system",
+		Start:       reservation.StartsAt,
+		End:         reservation.EndsAt,
+		Summary:     summary,
+		Description: reservation.Purpose,
+		Status:      "CONFIRMED",
+		Modified:    reservation.UpdatedAt,
+	}
+	if asset := reservation.Asset; asset != nil {
+		if asset.Location != nil {
+			event.Location = asset.Location.Name
+		} else {
+			event.Location = asset.LocationLabel
+		}
+	}
+	return event
+}
@@ -0,0 +1,227 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/domain/service"
+	"inventory-ticketing-system/infrastructure/jwt"
+)
+
+// keyReloadInterval is how often each replica re-reads the key set. A
+// rotated key is published this long before it starts signing, so every
+// replica knows it by the time tokens signed with it arrive.
+const keyReloadInterval = time.Minute
+
+// unknownKeyReloadInterval is how often a token with an unknown key ID may
+// trigger an early reload of the key set.
+const unknownKeyReloadInterval = 10 * time.Second
+
+type SigningKeyServiceImpl struct {
+	keyRepo          repository.SigningKeyRepository
+	jwtManager       *jwt.JWTManager
+	rotationInterval time.Duration
+	overlapWindow    time.Duration
+	txManager        repository.TransactionManager
+}
+
+func NewSigningKeyService(
+	keyRepo repository.SigningKeyRepository,
+	jwtManager *jwt.JWTManager,
+	rotationInterval time.Duration,
+	overlapWindow time.Duration,
+	txManager repository.TransactionManager,
+) service.SigningKeyService {
+	return &SigningKeyServiceImpl{
+		keyRepo:          keyRepo,
+		jwtManager:       jwtManager,
+		rotationInterval: rotationInterval,
+		overlapWindow:    overlapWindow,
+		txManager:        txManager,
+	}
+}
+
+// LoadKeys refreshes the JWT manager from the key store, creating the first
+// key when none is active yet.
+func (s *SigningKeyServiceImpl) LoadKeys(ctx context.Context) error {
+	keys, err := s.keyRepo.ListUsable(ctx, s.jwtManager.Algorithm(), time.Now())
+	if err != nil {
+		return err
+	}
+
+	if activeKey(keys) == nil {
+		_, err := s.rotate(ctx, func(keys []*entity.SigningKey) bool {
+			return activeKey(keys) == nil
+		})
+		return err
+	}
+
+	return s.applyKeys(keys)
+}
+
+// RotateKey generates a new signing key and schedules the previous active key
+// to retire after the overlap window, so tokens it signed keep validating.
+// The new key is published right away but signs only after the next reload.
+func (s *SigningKeyServiceImpl) RotateKey(ctx context.Context) (*entity.SigningKey, error) {
+	return s.rotate(ctx, func([]*entity.SigningKey) bool {
+		return true
+	})
+}
+
+// StartRotation reloads the key set periodically and rotates the active key
+// once it is older than the rotation interval. A zero interval disables
+// rotation but keeps reloading. Tokens signed with a key this replica does
+// not know yet also trigger a reload.
+func (s *SigningKeyServiceImpl) StartRotation(ctx context.Context) {
+	s.jwtManager.SetReloader(func() error {
+		err := s.LoadKeys(ctx)
+		if err != nil {
+			log.Printf("Failed to reload JWT signing keys: %v", err)
+		}
+		return err
+	}, unknownKeyReloadInterval)
+
+	go func() {
+		ticker := time.NewTicker(keyReloadInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := s.LoadKeys(ctx); err != nil {
+					log.Printf("Failed to reload JWT signing keys: %v", err)
+					continue
+				}
+
+				active := s.jwtManager.ActiveKey()
+				if s.rotationInterval > 0 && active != nil && time.Since(active.ActivatedAt) >= s.rotationInterval {
+					_, err := s.rotate(ctx, func(keys []*entity.SigningKey) bool {
+						active := activeKey(keys)
+						return active == nil || time.Since(active.ActivatedAt) >= s.rotationInterval
+					})
+					if err != nil {
+						log.Printf("Failed to rotate JWT signing key: %v", err)
+					}
+				}
+			}
+		}
+	}()
+}
+
+// rotate generates a new signing key when due reports that the usable keys
+// need one, and applies the resulting key set. Every replica runs the
+// rotation check, so the decision is taken under the rotation lock: a
+// replica that waited for another one's rotation sees the new key and
+// leaves it active instead of retiring it. While a key is signing, the new
+// one activates a reload interval later so other replicas load it first.
+// It returns nil when no key was generated.
+func (s *SigningKeyServiceImpl) rotate(ctx context.Context, due func(keys []*entity.SigningKey) bool) (*entity.SigningKey, error) {
+	algorithm := s.jwtManager.Algorithm()
+
+	var key *entity.SigningKey
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.keyRepo.LockRotation(ctx); err != nil {
+			return err
+		}
+
+		keys, err := s.keyRepo.ListUsable(ctx, algorithm, time.Now())
+		if err != nil {
+			return err
+		}
+		if !due(keys) {
+			return nil
+		}
+
+		generated, err := jwt.GenerateSigningKey(algorithm)
+		if err != nil {
+			return err
+		}
+
+		privateKeyPEM, err := generated.MarshalPrivateKeyPEM()
+		if err != nil {
+			return err
+		}
+
+		activatedAt := generated.ActivatedAt
+		if hasSigningKey(keys, activatedAt) {
+			activatedAt = activatedAt.Add(keyReloadInterval)
+		}
+
+		key = &entity.SigningKey{
+			ID:            generated.ID,
+			Algorithm:     generated.Algorithm,
+			PrivateKeyPEM: privateKeyPEM,
+			ActivatedAt:   activatedAt,
+		}
+
+		if err := s.keyRepo.Create(ctx, key); err != nil {
+			return err
+		}
+
+		retiresAt := key.ActivatedAt.Add(s.overlapWindow)
+		return s.keyRepo.RetireActive(ctx, key.Algorithm, key.ID, retiresAt)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := s.keyRepo.ListUsable(ctx, algorithm, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.applyKeys(keys); err != nil {
+		return nil, err
+	}
+
+	if key != nil {
+		log.Printf("Rotated JWT signing key, new kid %s signs from %s", key.ID, key.ActivatedAt.Format(time.RFC3339))
+	}
+	return key, nil
+}
+
+func (s *SigningKeyServiceImpl) applyKeys(keys []*entity.SigningKey) error {
+	signingKeys := make([]*jwt.SigningKey, 0, len(keys))
+	for _, key := range keys {
+		privateKey, err := jwt.ParsePrivateKeyPEM(key.Algorithm, key.PrivateKeyPEM)
+		if err != nil {
+			log.Printf("Skipping unreadable JWT signing key %s: %v", key.ID, err)
+			continue
+		}
+
+		signingKeys = append(signingKeys, &jwt.SigningKey{
+			ID:          key.ID,
+			Algorithm:   key.Algorithm,
+			PrivateKey:  privateKey,
+			ActivatedAt: key.ActivatedAt,
+			RetiresAt:   key.RetiresAt,
+		})
+	}
+
+	return s.jwtManager.SetKeys(signingKeys)
+}
+
+// activeKey returns the newest key that is not scheduled to retire, or nil.
+// Keys are listed newest first. The key may not be signing yet.
+func activeKey(keys []*entity.SigningKey) *entity.SigningKey {
+	for _, key := range keys {
+		if key.RetiresAt == nil {
+			return key
+		}
+	}
+	return nil
+}
+
+// hasSigningKey reports whether any of the usable keys has activated by now.
+func hasSigningKey(keys []*entity.SigningKey, now time.Time) bool {
+	for _, key := range keys {
+		if !key.ActivatedAt.After(now) {
+			return true
+		}
+	}
+	return false
+}
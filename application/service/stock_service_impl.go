@@ -0,0 +1,760 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/enum"
+	"inventory-ticketing-system/domain/policy"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/domain/service"
+
+	"github.com/google/uuid"
+)
+
+type StockServiceImpl struct {
+	reorderRepo         repository.ReorderPointRepository
+	movementRepo        repository.StockMovementRepository
+	alertRepo           repository.StockAlertRepository
+	lotRepo             repository.StockLotRepository
+	unitRepo            repository.AssetUnitRepository
+	assetRepo           repository.AssetRepository
+	departmentRepo      repository.DepartmentRepository
+	assetService        service.AssetService
+	notificationService service.NotificationService
+	procurementService  service.ProcurementService
+	txManager           repository.TransactionManager
+	consumptionDays     int
+}
+
+// NewStockService creates the stock service. Days of cover are computed from
+// the consumption over the last consumptionDays.
+func NewStockService(
+	reorderRepo repository.ReorderPointRepository,
+	movementRepo repository.StockMovementRepository,
+	alertRepo repository.StockAlertRepository,
+	lotRepo repository.StockLotRepository,
+	unitRepo repository.AssetUnitRepository,
+	assetRepo repository.AssetRepository,
+	departmentRepo repository.DepartmentRepository,
+	assetService service.AssetService,
+	notificationService service.NotificationService,
+	procurementService service.ProcurementService,
+	txManager repository.TransactionManager,
+	consumptionDays int,
+) service.StockService {
+	return &StockServiceImpl{
+		reorderRepo:         reorderRepo,
+		movementRepo:        movementRepo,
+		alertRepo:           alertRepo,
+		lotRepo:             lotRepo,
+		unitRepo:            unitRepo,
+		assetRepo:           assetRepo,
+		departmentRepo:      departmentRepo,
+		assetService:        assetService,
+		notificationService: notificationService,
+		procurementService:  procurementService,
+		txManager:           txManager,
+		consumptionDays:     consumptionDays,
+	}
+}
+
+func (s *StockServiceImpl) Jobs() []service.Job {
+	return []service.Job{
+		{
+			Name:        "low_stock",
+			Description: "Alert about assets at or below their reorder point",
+			Schedule:    "@hourly",
+			Run: func(ctx context.Context) (string, error) {
+				raised, err := s.DetectLowStock(ctx)
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprintf("raised %s", countNoun(raised, "low-stock alert", "low-stock alerts")), nil
+			},
+		},
+		{
+			Name:        "lot_expiry",
+			Description: "Quarantine lots past their expiry date",
+			Schedule:    "@daily",
+			Run: func(ctx context.Context) (string, error) {
+				quarantined, err := s.QuarantineExpiredLots(ctx)
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprintf("quarantined %s", countNoun(quarantined, "expired lot", "expired lots")), nil
+			},
+		},
+	}
+}
+
+// HandleEvent checks an asset against its reorder point whenever its
+// quantity changes.
+func (s *StockServiceImpl) HandleEvent(ctx context.Context, event *entity.OutboxEvent) error {
+	if enum.EventType(event.EventType) != enum.EventAssetQtyChanged {
+		return nil
+	}
+
+	var payload AssetQuantityChangedPayload
+	if err := json.Unmarshal([]byte(event.Payload), &payload); err != nil {
+		return err
+	}
+	if payload.Asset == nil {
+		return nil
+	}
+
+	// Look the asset up again, as later changes may have committed since
+	var assets []*entity.Asset
+	if asset, err := s.assetRepo.GetByID(ctx, payload.Asset.ID); err == nil {
+		assets = append(assets, asset)
+	}
+	alerts, err := s.alertRepo.ListOpen(ctx, []uuid.UUID{payload.Asset.ID})
+	if err != nil {
+		return err
+	}
+	_, err = s.check(ctx, assets, alerts)
+	return err
+}
+
+func (s *StockServiceImpl) CreateReorderPoint(ctx context.Context, point *entity.ReorderPoint) error {
+	principal, ok := policy.FromContext(ctx)
+	if !ok {
+		return policy.ErrForbidden
+	}
+	if err := s.validateReorderPoint(ctx, uuid.Nil, point); err != nil {
+		return err
+	}
+
+	if point.ID == uuid.Nil {
+		point.ID = uuid.New()
+	}
+	point.CreatedBy = principal.UserID
+	if err := s.reorderRepo.Create(ctx, point); err != nil {
+		return err
+	}
+	return s.recheck(ctx)
+}
+
+func (s *StockServiceImpl) GetReorderPoint(ctx context.Context, id uuid.UUID) (*entity.ReorderPoint, error) {
+	point, err := s.reorderRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("reorder point not found")
+	}
+	if point.Asset != nil {
+		if err := policy.Authorize(ctx, enum.PermissionAssetsRead, assetResource(point.Asset)); err != nil {
+			return nil, err
+		}
+	}
+	return point, nil
+}
+
+func (s *StockServiceImpl) UpdateReorderPoint(ctx context.Context, id uuid.UUID, point *entity.ReorderPoint) error {
+	existing, err := s.reorderRepo.GetByID(ctx, id)
+	if err != nil {
+		return errors.New("reorder point not found")
+	}
+	if err := s.authorizeReorderPoint(ctx, existing); err != nil {
+		return err
+	}
+	if err := s.validateReorderPoint(ctx, id, point); err != nil {
+		return err
+	}
+
+	point.ID = id
+	point.CreatedBy = existing.CreatedBy
+	point.CreatedAt = existing.CreatedAt
+	if err := s.reorderRepo.Update(ctx, point); err != nil {
+		return err
+	}
+	return s.recheck(ctx)
+}
+
+func (s *StockServiceImpl) DeleteReorderPoint(ctx context.Context, id uuid.UUID) error {
+	point, err := s.reorderRepo.GetByID(ctx, id)
+	if err != nil {
+		return errors.New("reorder point not found")
+	}
+	if err := s.authorizeReorderPoint(ctx, point); err != nil {
+		return err
+	}
+	if err := s.reorderRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+	return s.recheck(ctx)
+}
+
+// ListReorderPoints lists category reorder points and those of the assets
+// the caller can read.
+func (s *StockServiceImpl) ListReorderPoints(ctx context.Context, filters map[string]interface{}) ([]*entity.ReorderPoint, error) {
+	conditions, restricted, err := policy.ListConditions(ctx, enum.PermissionAssetsRead)
+	if err != nil {
+		return nil, err
+	}
+	if restricted {
+		filters["scope"] = conditions
+	}
+	return s.reorderRepo.List(ctx, filters)
+}
+
+func (s *StockServiceImpl) ListLowStock(ctx context.Context, filters map[string]interface{}) ([]*entity.LowStockItem, error) {
+	conditions, restricted, err := policy.ListConditions(ctx, enum.PermissionAssetsRead)
+	if err != nil {
+		return nil, err
+	}
+	if restricted {
+		filters["scope"] = conditions
+	}
+
+	assets, err := s.reorderRepo.ListAssets(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+	points, err := s.reorderPoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var low []*entity.Asset
+	for _, asset := range assets {
+		if point := points.lookup(asset); point != nil && asset.Qty <= point.MinQty {
+			low = append(low, asset)
+		}
+	}
+	ids := make([]uuid.UUID, len(low))
+	for i, asset := range low {
+		ids[i] = asset.ID
+	}
+
+	consumed, err := s.movementRepo.SumConsumption(ctx, ids, time.Now().AddDate(0, 0, -s.consumptionDays))
+	if err != nil {
+		return nil, err
+	}
+	alerts, err := s.alertRepo.ListOpen(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	alertByAsset := make(map[uuid.UUID]*entity.StockAlert, len(alerts))
+	for _, alert := range alerts {
+		alertByAsset[alert.AssetID] = alert
+	}
+
+	items := make([]*entity.LowStockItem, len(low))
+	for i, asset := range low {
+		item := &entity.LowStockItem{
+			Asset:           asset,
+			ReorderPoint:    points.lookup(asset),
+			Consumed:        consumed[asset.ID],
+			ConsumptionDays: s.consumptionDays,
+			Alert:           alertByAsset[asset.ID],
+		}
+		if item.Consumed > 0 {
+			item.DailyConsumption = float64(item.Consumed) / float64(s.consumptionDays)
+			cover := math.Round(float64(asset.Qty)/item.DailyConsumption*10) / 10
+			item.DaysOfCover = &cover
+		}
+		items[i] = item
+	}
+
+	// Assets that are not consumed last the longest
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := items[i].DaysOfCover, items[j].DaysOfCover
+		switch {
+		case a != nil && b != nil && *a != *b:
+			return *a < *b
+		case (a == nil) != (b == nil):
+			return a != nil
+		default:
+			return items[i].Asset.Qty < items[j].Asset.Qty
+		}
+	})
+	return items, nil
+}
+
+func (s *StockServiceImpl) DetectLowStock(ctx context.Context) (int, error) {
+	assets, err := s.reorderRepo.ListAssets(ctx, map[string]interface{}{})
+	if err != nil {
+		return 0, err
+	}
+	alerts, err := s.alertRepo.ListOpen(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	return s.check(ctx, assets, alerts)
+}
+
+// recheck runs the detector after a reorder point changed.
+func (s *StockServiceImpl) recheck(ctx context.Context) error {
+	_, err := s.DetectLowStock(policy.AsSystem(ctx))
+	return err
+}
+
+// check raises alerts for the assets that are at or below their reorder
+// point and have none open, and resolves the given open alerts of every
+// other asset.
+func (s *StockServiceImpl) check(ctx context.Context, assets []*entity.Asset, alerts []*entity.StockAlert) (int, error) {
+	points, err := s.reorderPoints(ctx)
+	if err != nil {
+		return 0, err
+	}
+	open := make(map[uuid.UUID]*entity.StockAlert, len(alerts))
+	for _, alert := range alerts {
+		open[alert.AssetID] = alert
+	}
+
+	var raised int
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		for _, asset := range assets {
+			point := points.lookup(asset)
+			low := point != nil && !enum.AssetStatus(asset.Status).IsTerminal() && asset.Qty <= point.MinQty
+			alert := open[asset.ID]
+			delete(open, asset.ID)
+
+			switch {
+			case low && alert == nil:
+				if err := s.raise(ctx, asset, point); err != nil {
+					return err
+				}
+				raised++
+			case !low && alert != nil:
+				if err := s.resolve(ctx, alert); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Alerts of assets without a reorder point any more
+		for _, alert := range open {
+			if err := s.resolve(ctx, alert); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return raised, nil
+}
+
+// raise opens an alert for the asset, prepares a draft purchase request when
+// the reorder point asks for one, and notifies whoever set the reorder point
+// and the manager of the asset's department.
+func (s *StockServiceImpl) raise(ctx context.Context, asset *entity.Asset, point *entity.ReorderPoint) error {
+	alert := &entity.StockAlert{
+		AssetID:  asset.ID,
+		Qty:      asset.Qty,
+		MinQty:   point.MinQty,
+		RaisedAt: time.Now(),
+	}
+
+	if point.DraftPurchaseRequest {
+		request := &entity.PurchaseRequest{
+			RequestedBy:   point.CreatedBy,
+			DepartmentID:  asset.DepartmentID,
+			Title:         "Restock " + asset.Name,
+			Justification: fmt.Sprintf("%s is down to %d, at or below its reorder point of %d.", asset.Name, asset.Qty, point.MinQty),
+			Items: []*entity.PurchaseRequestItem{{
+				Description: asset.Name,
+				Category:    asset.Category,
+				Quantity:    point.ReorderQty,
+			}},
+		}
+		if err := s.procurementService.DraftRequest(ctx, request); err != nil {
+			return err
+		}
+		alert.PurchaseRequestID = &request.ID
+	}
+
+	if err := s.alertRepo.Create(ctx, alert); err != nil {
+		return err
+	}
+
+	recipientIDs := []uuid.UUID{point.CreatedBy}
+	if asset.DepartmentID != nil {
+		if department, err := s.departmentRepo.GetByID(ctx, *asset.DepartmentID); err == nil && department.ManagerID != nil {
+			recipientIDs = append(recipientIDs, *department.ManagerID)
+		}
+	}
+	title := fmt.Sprintf("%s is low on stock: %d left", asset.Name, asset.Qty)
+	return s.notificationService.NotifyInbox(ctx, enum.NotificationLowStock, recipientIDs, title, map[string]interface{}{
+		"assetId":           asset.ID,
+		"assetName":         asset.Name,
+		"uniqueId":          asset.UniqueID,
+		"qty":               asset.Qty,
+		"minQty":            point.MinQty,
+		"reorderQty":        point.ReorderQty,
+		"purchaseRequestId": alert.PurchaseRequestID,
+	})
+}
+
+func (s *StockServiceImpl) CreateLot(ctx context.Context, lot *entity.StockLot) error {
+	asset, err := s.assetRepo.GetByID(ctx, lot.AssetID)
+	if err != nil {
+		return errors.New("asset not found")
+	}
+	if err := policy.Authorize(ctx, enum.PermissionAssetsWrite, assetResource(asset)); err != nil {
+		return err
+	}
+	if enum.AssetStatus(asset.Status).IsTerminal() {
+		return fmt.Errorf("a %s asset cannot get new lots", asset.Status)
+	}
+	units, err := s.unitRepo.CountByAsset(ctx, asset.ID, "")
+	if err != nil {
+		return err
+	}
+	if units > 0 {
+		return errors.New("a serialized asset is tracked by its units and cannot have lots")
+	}
+
+	if lot.Status == "" {
+		lot.Status = string(enum.LotActive)
+	}
+	if err := validateLot(lot); err != nil {
+		return err
+	}
+	if lot.Qty < 1 {
+		return errors.New("the quantity must be at least 1")
+	}
+	if lot.ReceivedAt.IsZero() {
+		lot.ReceivedAt = time.Now()
+	}
+	// Stock received already expired goes straight into quarantine
+	if lot.IsExpired(currentDay()) {
+		lot.Status = string(enum.LotQuarantined)
+	}
+
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		locked, err := s.assetRepo.LockByID(ctx, asset.ID)
+		if err != nil {
+			return err
+		}
+
+		// Once an asset has lots its quantity is theirs, so the stock already
+		// on hand must become the first lot rather than be replaced by it
+		kind := enum.StockMovementReceipt
+		lots, err := s.lotRepo.CountByAsset(ctx, asset.ID)
+		if err != nil {
+			return err
+		}
+		if lots == 0 && locked.Qty > 0 {
+			if lot.Qty != locked.Qty {
+				return fmt.Errorf("the asset holds %d units in no lot; register them as its first lot before receiving more", locked.Qty)
+			}
+			kind = enum.StockMovementRecount
+		}
+
+		existing, err := s.lotRepo.GetByNumber(ctx, asset.ID, lot.LotNumber)
+		if err == nil {
+			if !sameDay(existing.ExpiresAt, lot.ExpiresAt) {
+				return fmt.Errorf("lot %s already exists with a different expiry date", lot.LotNumber)
+			}
+			if err := s.adjustLot(ctx, existing, lot.Qty); err != nil {
+				return err
+			}
+			*lot = *existing
+		} else {
+			if lot.ID == uuid.Nil {
+				lot.ID = uuid.New()
+			}
+			if err := s.lotRepo.Create(ctx, lot); err != nil {
+				return err
+			}
+		}
+		return s.assetService.RecountLots(ctx, asset.ID, kind)
+	})
+	if err != nil {
+		return err
+	}
+
+	lot.Asset = asset
+	return nil
+}
+
+func (s *StockServiceImpl) GetLot(ctx context.Context, id uuid.UUID) (*entity.StockLot, error) {
+	lot, err := s.lotRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("lot not found")
+	}
+	if err := policy.Authorize(ctx, enum.PermissionAssetsRead, assetResource(lot.Asset)); err != nil {
+		return nil, err
+	}
+	return lot, nil
+}
+
+func (s *StockServiceImpl) UpdateLot(ctx context.Context, id uuid.UUID, changes *entity.StockLot) (*entity.StockLot, error) {
+	lot, err := s.lotRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("lot not found")
+	}
+	if err := policy.Authorize(ctx, enum.PermissionAssetsWrite, assetResource(lot.Asset)); err != nil {
+		return nil, err
+	}
+
+	changes.Status = lot.Status
+	if err := validateLot(changes); err != nil {
+		return nil, err
+	}
+	if existing, err := s.lotRepo.GetByNumber(ctx, lot.AssetID, changes.LotNumber); err == nil && existing.ID != id {
+		return nil, errors.New("the asset already has a lot with this number")
+	}
+
+	lot.LotNumber = changes.LotNumber
+	lot.ExpiresAt = changes.ExpiresAt
+	lot.Notes = changes.Notes
+	// Moving the expiry into the past quarantines the lot right away
+	if lot.IsExpired(currentDay()) {
+		lot.Status = string(enum.LotQuarantined)
+	}
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.saveLot(ctx, lot); err != nil {
+			return err
+		}
+		// The new quantity replaces whatever the lot holds now, which may
+		// have been issued from since it was read
+		if err := s.adjustLot(ctx, lot, changes.Qty-lot.Qty); err != nil {
+			return err
+		}
+		return s.assetService.RecountLots(ctx, lot.AssetID, enum.StockMovementAdjustment)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return lot, nil
+}
+
+func (s *StockServiceImpl) UpdateLotStatus(ctx context.Context, id uuid.UUID, status string) (*entity.StockLot, error) {
+	lot, err := s.lotRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("lot not found")
+	}
+	if err := policy.Authorize(ctx, enum.PermissionAssetsWrite, assetResource(lot.Asset)); err != nil {
+		return nil, err
+	}
+
+	if !enum.LotStatus(status).IsValid() {
+		return nil, fmt.Errorf("invalid lot status %q", status)
+	}
+	if status == lot.Status {
+		return lot, nil
+	}
+	if status == string(enum.LotActive) && lot.IsExpired(currentDay()) {
+		return nil, errors.New("an expired lot cannot be released from quarantine")
+	}
+
+	lot.Status = status
+	if err := s.saveLot(ctx, lot); err != nil {
+		return nil, err
+	}
+	return lot, nil
+}
+
+func (s *StockServiceImpl) DeleteLot(ctx context.Context, id uuid.UUID) error {
+	lot, err := s.lotRepo.GetByID(ctx, id)
+	if err != nil {
+		return errors.New("lot not found")
+	}
+	if err := policy.Authorize(ctx, enum.PermissionAssetsDelete, assetResource(lot.Asset)); err != nil {
+		return err
+	}
+
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := s.assetRepo.LockByID(ctx, lot.AssetID); err != nil {
+			return err
+		}
+		if err := s.lotRepo.Delete(ctx, id); err != nil {
+			return err
+		}
+		return s.assetService.RecountLots(ctx, lot.AssetID, enum.StockMovementAdjustment)
+	})
+}
+
+func (s *StockServiceImpl) ListAssetLots(ctx context.Context, assetID uuid.UUID) ([]*entity.StockLot, error) {
+	asset, err := s.assetRepo.GetByID(ctx, assetID)
+	if err != nil {
+		return nil, errors.New("asset not found")
+	}
+	if err := policy.Authorize(ctx, enum.PermissionAssetsRead, assetResource(asset)); err != nil {
+		return nil, err
+	}
+	return s.lotRepo.ListByAsset(ctx, assetID)
+}
+
+func (s *StockServiceImpl) ListExpiringLots(ctx context.Context, days int, filters map[string]interface{}) ([]*entity.StockLot, error) {
+	if days < 0 {
+		return nil, errors.New("days cannot be negative")
+	}
+	conditions, restricted, err := policy.ListConditions(ctx, enum.PermissionAssetsRead)
+	if err != nil {
+		return nil, err
+	}
+	if restricted {
+		filters["scope"] = conditions
+	}
+	filters["expires_before"] = currentDay().AddDate(0, 0, days+1)
+	return s.lotRepo.List(ctx, filters)
+}
+
+func (s *StockServiceImpl) QuarantineExpiredLots(ctx context.Context) (int, error) {
+	lots, err := s.lotRepo.ListExpired(ctx, currentDay())
+	if err != nil {
+		return 0, err
+	}
+
+	for _, lot := range lots {
+		lot.Status = string(enum.LotQuarantined)
+		if err := s.saveLot(policy.AsSystem(ctx), lot); err != nil {
+			return 0, err
+		}
+	}
+	return len(lots), nil
+}
+
+// saveLot updates the lot's attributes and recounts its asset. Like every
+// change to an asset's stock it locks the asset first, and it leaves lot.Qty
+// as the lot holds it now.
+func (s *StockServiceImpl) saveLot(ctx context.Context, lot *entity.StockLot) error {
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := s.assetRepo.LockByID(ctx, lot.AssetID); err != nil {
+			return err
+		}
+		if err := s.lotRepo.Update(ctx, lot); err != nil {
+			return err
+		}
+		current, err := s.lotRepo.GetByID(ctx, lot.ID)
+		if err != nil {
+			return err
+		}
+		lot.Qty = current.Qty
+		return s.assetService.RecountLots(ctx, lot.AssetID, enum.StockMovementRecount)
+	})
+}
+
+// adjustLot adds delta to the lot's stock; the asset must be locked.
+func (s *StockServiceImpl) adjustLot(ctx context.Context, lot *entity.StockLot, delta int) error {
+	if delta == 0 {
+		return nil
+	}
+	adjusted, err := s.lotRepo.AdjustQty(ctx, lot.ID, delta)
+	if err != nil {
+		return err
+	}
+	if !adjusted {
+		return errors.New("the lot does not hold that much stock")
+	}
+	lot.Qty += delta
+	return nil
+}
+
+func validateLot(lot *entity.StockLot) error {
+	lot.LotNumber = strings.TrimSpace(lot.LotNumber)
+	if lot.LotNumber == "" {
+		return errors.New("lot number is required")
+	}
+	if lot.Qty < 0 {
+		return errors.New("the quantity cannot be negative")
+	}
+	if !enum.LotStatus(lot.Status).IsValid() {
+		return fmt.Errorf("invalid lot status %q", lot.Status)
+	}
+	if lot.ExpiresAt != nil {
+		expiresAt := lot.ExpiresAt.UTC().Truncate(24 * time.Hour)
+		lot.ExpiresAt = &expiresAt
+	}
+	return nil
+}
+
+// currentDay is the current day in UTC, which lot expiry dates are compared to.
+func currentDay() time.Time {
+	return time.Now().UTC().Truncate(24 * time.Hour)
+}
+
+func sameDay(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.UTC().Truncate(24 * time.Hour).Equal(b.UTC().Truncate(24 * time.Hour))
+}
+
+func (s *StockServiceImpl) resolve(ctx context.Context, alert *entity.StockAlert) error {
+	now := time.Now()
+	alert.ResolvedAt = &now
+	return s.alertRepo.Update(ctx, alert)
+}
+
+func (s *StockServiceImpl) validateReorderPoint(ctx context.Context, id uuid.UUID, point *entity.ReorderPoint) error {
+	point.Category = strings.TrimSpace(point.Category)
+	if (point.AssetID == nil) == (point.Category == "") {
+		return errors.New("a reorder point is for either an asset or a category")
+	}
+	if point.MinQty < 0 {
+		return errors.New("the minimum quantity cannot be negative")
+	}
+	if point.ReorderQty < 1 {
+		return errors.New("the reorder quantity must be at least 1")
+	}
+
+	var existing *entity.ReorderPoint
+	if point.AssetID != nil {
+		asset, err := s.assetRepo.GetByID(ctx, *point.AssetID)
+		if err != nil {
+			return errors.New("asset not found")
+		}
+		point.Asset = asset
+		existing, _ = s.reorderRepo.GetByAsset(ctx, asset.ID)
+	} else {
+		existing, _ = s.reorderRepo.GetByCategory(ctx, point.Category)
+	}
+	if existing != nil && existing.ID != id {
+		return errors.New("a reorder point for this asset or category already exists")
+	}
+	return s.authorizeReorderPoint(ctx, point)
+}
+
+// authorizeReorderPoint needs write access to the point's asset, or to every
+// asset for a category's reorder point.
+func (s *StockServiceImpl) authorizeReorderPoint(ctx context.Context, point *entity.ReorderPoint) error {
+	if point.Asset != nil {
+		return policy.Authorize(ctx, enum.PermissionAssetsWrite, assetResource(point.Asset))
+	}
+	return authorizeAllAssets(ctx, enum.PermissionAssetsWrite)
+}
+
+// reorderPointIndex finds the reorder point that applies to an asset.
+type reorderPointIndex struct {
+	byAsset    map[uuid.UUID]*entity.ReorderPoint
+	byCategory map[string]*entity.ReorderPoint
+}
+
+func (s *StockServiceImpl) reorderPoints(ctx context.Context) (*reorderPointIndex, error) {
+	points, err := s.reorderRepo.List(ctx, map[string]interface{}{})
+	if err != nil {
+		return nil, err
+	}
+
+	index := &reorderPointIndex{
+		byAsset:    make(map[uuid.UUID]*entity.ReorderPoint),
+		byCategory: make(map[string]*entity.ReorderPoint),
+	}
+	for _, point := range points {
+		if point.AssetID != nil {
+			index.byAsset[*point.AssetID] = point
+		} else {
+			index.byCategory[strings.ToLower(point.Category)] = point
+		}
+	}
+	return index, nil
+}
+
+func (i *reorderPointIndex) lookup(asset *entity.Asset) *entity.ReorderPoint {
+	if point, ok := i.byAsset[asset.ID]; ok {
+		return point
+	}
+	return i.byCategory[strings.ToLower(asset.Category)]
+}
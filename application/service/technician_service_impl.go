@@ -0,0 +1,310 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/enum"
+	"inventory-ticketing-system/domain/policy"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/domain/service"
+)
+
+type TechnicianServiceImpl struct {
+	technicianRepo  repository.TechnicianRepository
+	teamRepo        repository.TeamRepository
+	ticketRepo      repository.TicketRepository
+	userRepo        repository.UserRepository
+	assetRepo       repository.AssetRepository
+	ticketService   service.TicketService
+	txManager       repository.TransactionManager
+	defaultStrategy enum.AssignmentStrategy
+}
+
+// NewTechnicianService creates the technician service. defaultStrategy
+// assigns tickets that no team handles across all active technicians; an
+// empty strategy leaves those tickets unassigned.
+func NewTechnicianService(
+	technicianRepo repository.TechnicianRepository,
+	teamRepo repository.TeamRepository,
+	ticketRepo repository.TicketRepository,
+	userRepo repository.UserRepository,
+	assetRepo repository.AssetRepository,
+	ticketService service.TicketService,
+	txManager repository.TransactionManager,
+	defaultStrategy enum.AssignmentStrategy,
+) service.TechnicianService {
+	return &TechnicianServiceImpl{
+		technicianRepo:  technicianRepo,
+		teamRepo:        teamRepo,
+		ticketRepo:      ticketRepo,
+		userRepo:        userRepo,
+		assetRepo:       assetRepo,
+		ticketService:   ticketService,
+		txManager:       txManager,
+		defaultStrategy: defaultStrategy,
+	}
+}
+
+func (s *TechnicianServiceImpl) CreateTechnician(ctx context.Context, technician *entity.Technician) error {
+	if _, err := s.userRepo.GetByID(ctx, technician.UserID); err != nil {
+		return errors.New("user not found")
+	}
+
+	if existing, err := s.technicianRepo.GetByUserID(ctx, technician.UserID); err == nil && existing != nil {
+		return errors.New("user is already a technician")
+	}
+
+	if err := s.validateTechnician(ctx, technician); err != nil {
+		return err
+	}
+
+	return s.technicianRepo.Create(ctx, technician)
+}
+
+func (s *TechnicianServiceImpl) GetTechnician(ctx context.Context, userID uuid.UUID) (*entity.Technician, error) {
+	technician, err := s.technicianRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, errors.New("technician not found")
+	}
+	return technician, nil
+}
+
+func (s *TechnicianServiceImpl) UpdateTechnician(ctx context.Context, userID uuid.UUID, technician *entity.Technician) error {
+	existing, err := s.technicianRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return errors.New("technician not found")
+	}
+
+	if err := s.validateTechnician(ctx, technician); err != nil {
+		return err
+	}
+
+	technician.UserID = userID
+	technician.LastAssignedAt = existing.LastAssignedAt
+	technician.CreatedAt = existing.CreatedAt
+	technician.UpdatedAt = time.Now()
+
+	return s.technicianRepo.Update(ctx, technician)
+}
+
+func (s *TechnicianServiceImpl) DeleteTechnician(ctx context.Context, userID uuid.UUID) error {
+	if _, err := s.technicianRepo.GetByUserID(ctx, userID); err != nil {
+		return errors.New("technician not found")
+	}
+
+	return s.technicianRepo.Delete(ctx, userID)
+}
+
+func (s *TechnicianServiceImpl) ListTechnicians(ctx context.Context, teamID *uuid.UUID) ([]*entity.Technician, error) {
+	return s.technicianRepo.List(ctx, teamID)
+}
+
+func (s *TechnicianServiceImpl) CreateTeam(ctx context.Context, team *entity.Team) error {
+	if err := validateTeam(team); err != nil {
+		return err
+	}
+
+	if existing, err := s.teamRepo.GetByName(ctx, team.Name); err == nil && existing != nil {
+		return errors.New("team with this name already exists")
+	}
+
+	return s.teamRepo.Create(ctx, team)
+}
+
+func (s *TechnicianServiceImpl) GetTeam(ctx context.Context, id uuid.UUID) (*entity.Team, error) {
+	team, err := s.teamRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("team not found")
+	}
+	return team, nil
+}
+
+func (s *TechnicianServiceImpl) UpdateTeam(ctx context.Context, id uuid.UUID, team *entity.Team) error {
+	existing, err := s.teamRepo.GetByID(ctx, id)
+	if err != nil {
+		return errors.New("team not found")
+	}
+
+	if err := validateTeam(team); err != nil {
+		return err
+	}
+
+	if team.Name != existing.Name {
+		if other, err := s.teamRepo.GetByName(ctx, team.Name); err == nil && other != nil {
+			return errors.New("team with this name already exists")
+		}
+	}
+
+	team.ID = id
+	team.CreatedAt = existing.CreatedAt
+	team.UpdatedAt = time.Now()
+
+	return s.teamRepo.Update(ctx, team)
+}
+
+func (s *TechnicianServiceImpl) DeleteTeam(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.teamRepo.GetByID(ctx, id); err != nil {
+		return errors.New("team not found")
+	}
+
+	members, err := s.teamRepo.CountTechnicians(ctx, id)
+	if err != nil {
+		return err
+	}
+	if members > 0 {
+		return errors.New("team still has technicians")
+	}
+
+	return s.teamRepo.Delete(ctx, id)
+}
+
+func (s *TechnicianServiceImpl) ListTeams(ctx context.Context) ([]*entity.Team, error) {
+	return s.teamRepo.List(ctx)
+}
+
+// AutoAssign routes a new ticket to the first team that handles its category
+// and assigns it to one of the team's technicians using the team strategy.
+// Tickets no team handles go to all active technicians using the default
+// strategy. The ticket stays unassigned when nobody is available. Called
+// within the transaction that creates the ticket, the ticket is only saved
+// together with its assignment.
+func (s *TechnicianServiceImpl) AutoAssign(ctx context.Context, ticket *entity.Ticket) error {
+	teams, err := s.teamRepo.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	var team *entity.Team
+	for _, candidate := range teams {
+		if candidate.HandlesCategory(ticket.Category) {
+			team = candidate
+			break
+		}
+	}
+
+	strategy := s.defaultStrategy
+	var teamID *uuid.UUID
+	if team != nil {
+		strategy = enum.AssignmentStrategy(team.AssignmentStrategy)
+		teamID = &team.ID
+	}
+	if strategy == "" && teamID == nil {
+		return nil
+	}
+
+	var assetType string
+	if asset, err := s.assetRepo.GetByID(ctx, ticket.AssetID); err == nil {
+		assetType = asset.Type
+	}
+
+	// Candidates and their open ticket counts are read under the assignment
+	// lock, so concurrent tickets cannot both take a technician's last slot
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.technicianRepo.LockAssignment(ctx); err != nil {
+			return err
+		}
+
+		technicians, err := s.technicianRepo.ListActive(ctx, teamID)
+		if err != nil {
+			return err
+		}
+
+		ids := make([]uuid.UUID, len(technicians))
+		for i, technician := range technicians {
+			ids[i] = technician.UserID
+		}
+		loads, err := s.ticketRepo.CountOpenByAssignee(ctx, ids)
+		if err != nil {
+			return err
+		}
+
+		candidates := make([]assignmentCandidate, len(technicians))
+		for i, technician := range technicians {
+			candidates[i] = assignmentCandidate{technician: technician, load: loads[technician.UserID]}
+		}
+
+		technician := pickTechnician(strategy, candidates, ticket.Category, assetType)
+		if teamID == nil && technician == nil {
+			return nil
+		}
+
+		ticket.TeamID = teamID
+		if err := s.ticketRepo.Update(ctx, ticket); err != nil {
+			return err
+		}
+
+		if technician == nil {
+			return nil
+		}
+		if err := s.technicianRepo.TouchLastAssigned(ctx, technician.UserID, time.Now()); err != nil {
+			return err
+		}
+		// Assigned like a manual assignment, so the ticket moves to
+		// in_progress with the same events and asset status rules. The
+		// routing is the system's decision, not the reporter's.
+		if err := s.ticketService.AssignTicket(policy.AsSystem(ctx), ticket.ID, technician.UserID); err != nil {
+			return err
+		}
+		assigned, err := s.ticketRepo.GetByID(ctx, ticket.ID)
+		if err != nil {
+			return err
+		}
+		*ticket = *assigned
+		return nil
+	})
+}
+
+// GetQueue returns the user's unfinished tickets and, for technicians in a
+// team, the team's unassigned tickets, ordered by due date.
+func (s *TechnicianServiceImpl) GetQueue(ctx context.Context, userID uuid.UUID) ([]*entity.Ticket, error) {
+	var teamIDs []uuid.UUID
+	if technician, err := s.technicianRepo.GetByUserID(ctx, userID); err == nil && technician.TeamID != nil {
+		teamIDs = append(teamIDs, *technician.TeamID)
+	}
+
+	return s.ticketRepo.ListQueue(ctx, userID, teamIDs)
+}
+
+func (s *TechnicianServiceImpl) validateTechnician(ctx context.Context, technician *entity.Technician) error {
+	if technician.TeamID != nil {
+		if _, err := s.teamRepo.GetByID(ctx, *technician.TeamID); err != nil {
+			return errors.New("team not found")
+		}
+	}
+
+	for _, assetType := range technician.AssetTypes {
+		if !enum.AssetType(assetType).IsValid() {
+			return errors.New("invalid asset type: " + assetType)
+		}
+	}
+
+	if technician.MaxOpenTickets < 0 {
+		return errors.New("max open tickets cannot be negative")
+	}
+
+	if technician.Skills == nil {
+		technician.Skills = []string{}
+	}
+	if technician.AssetTypes == nil {
+		technician.AssetTypes = []string{}
+	}
+	return nil
+}
+
+func validateTeam(team *entity.Team) error {
+	if team.AssignmentStrategy == "" {
+		team.AssignmentStrategy = string(enum.AssignmentLeastLoaded)
+	}
+	if !enum.AssignmentStrategy(team.AssignmentStrategy).IsValid() {
+		return errors.New("invalid assignment strategy")
+	}
+
+	if team.Categories == nil {
+		team.Categories = []string{}
+	}
+	return nil
+}
@@ -3,42 +3,143 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/enum"
+	"inventory-ticketing-system/domain/policy"
 	"inventory-ticketing-system/domain/repository"
 	"inventory-ticketing-system/domain/service"
 
 	"github.com/google/uuid"
 )
 
+// TicketStatusChangedPayload is the payload of ticket.status_changed events.
+type TicketStatusChangedPayload struct {
+	Ticket         *entity.Ticket `json:"ticket"`
+	PreviousStatus string         `json:"previousStatus"`
+}
+
 type TicketServiceImpl struct {
-	ticketRepo repository.TicketRepository
-	assetRepo  repository.AssetRepository
+	ticketRepo     repository.TicketRepository
+	technicianRepo repository.TechnicianRepository
+	assetRepo      repository.AssetRepository
+	unitRepo       repository.AssetUnitRepository
+	assetService   service.AssetService
+	txManager      repository.TransactionManager
+	events         service.EventPublisher
+	autoCloseAfter time.Duration
 }
 
-func NewTicketService(ticketRepo repository.TicketRepository, assetRepo repository.AssetRepository) service.TicketService {
+// NewTicketService creates the ticket service. Resolved tickets are closed
+// once they have not been updated for autoCloseAfter; zero disables this.
+// assetService applies the asset status rules in the ticket's transaction.
+func NewTicketService(
+	ticketRepo repository.TicketRepository,
+	technicianRepo repository.TechnicianRepository,
+	assetRepo repository.AssetRepository,
+	unitRepo repository.AssetUnitRepository,
+	assetService service.AssetService,
+	txManager repository.TransactionManager,
+	events service.EventPublisher,
+	autoCloseAfter time.Duration,
+) service.TicketService {
 	return &TicketServiceImpl{
-		ticketRepo: ticketRepo,
-		assetRepo:  assetRepo,
+		ticketRepo:     ticketRepo,
+		technicianRepo: technicianRepo,
+		assetRepo:      assetRepo,
+		unitRepo:       unitRepo,
+		assetService:   assetService,
+		txManager:      txManager,
+		events:         events,
+		autoCloseAfter: autoCloseAfter,
+	}
+}
+
+func (s *TicketServiceImpl) Jobs() []service.Job {
+	if s.autoCloseAfter <= 0 {
+		return nil
+	}
+	return []service.Job{
+		{
+			Name:        "ticket_auto_close",
+			Description: "Close resolved tickets that have not been updated for " + s.autoCloseAfter.String(),
+			Schedule:    "@hourly",
+			Run: func(ctx context.Context) (string, error) {
+				closed, err := s.closeStaleResolved(ctx)
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprintf("closed %d tickets", closed), nil
+			},
+		},
+	}
+}
+
+// closeStaleResolved closes resolved tickets that have not been updated for
+// the auto-close period and returns how many were closed.
+func (s *TicketServiceImpl) closeStaleResolved(ctx context.Context) (int, error) {
+	tickets, err := s.ticketRepo.ListResolvedBefore(ctx, time.Now().Add(-s.autoCloseAfter))
+	if err != nil {
+		return 0, err
 	}
+
+	for _, ticket := range tickets {
+		previousStatus := ticket.Status
+		ticket.Status = "closed"
+		if err := s.save(ctx, ticket, previousStatus); err != nil {
+			return 0, err
+		}
+	}
+	return len(tickets), nil
 }
 
 func (s *TicketServiceImpl) CreateTicket(ctx context.Context, ticket *entity.Ticket) error {
-	_, err := s.assetRepo.GetByID(ctx, ticket.AssetID)
+	asset, err := s.assetRepo.GetByID(ctx, ticket.AssetID)
 	if err != nil {
 		return errors.New("asset not found")
 	}
 
+	if err := policy.Authorize(ctx, enum.PermissionTicketsWrite, assetResource(asset)); err != nil {
+		return err
+	}
+	if ticket.UnitID != nil {
+		unit, err := s.unitRepo.GetByID(ctx, *ticket.UnitID)
+		if err != nil || unit.AssetID != asset.ID {
+			return errors.New("unit not found on this asset")
+		}
+	}
+
 	ticket.Status = "open"
 	ticket.Duration = s.calculateDuration(ticket.Severity)
-	ticket.DueDate = time.Now().Add(time.Duration(ticket.Duration) * time.Hour)
+	// Generated tickets such as preventive maintenance bring their own due date
+	if ticket.DueDate.IsZero() {
+		ticket.DueDate = time.Now().Add(time.Duration(ticket.Duration) * time.Hour)
+	}
 
-	return s.ticketRepo.Create(ctx, ticket)
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.ticketRepo.Create(ctx, ticket); err != nil {
+			return err
+		}
+		if err := s.events.Publish(ctx, enum.EventTicketCreated, ticket.ID, ticket); err != nil {
+			return err
+		}
+		return s.assetService.SyncStatusWithTicket(ctx, ticket, enum.EventTicketCreated)
+	})
 }
 
 func (s *TicketServiceImpl) GetTicket(ctx context.Context, id uuid.UUID) (*entity.Ticket, error) {
-	return s.ticketRepo.GetByID(ctx, id)
+	ticket, err := s.ticketRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("ticket not found")
+	}
+
+	if err := policy.Authorize(ctx, enum.PermissionTicketsRead, assetResource(ticket.Asset)); err != nil {
+		return nil, err
+	}
+
+	return ticket, nil
 }
 
 func (s *TicketServiceImpl) UpdateTicket(ctx context.Context, id uuid.UUID, ticket *entity.Ticket) error {
@@ -47,23 +148,47 @@ func (s *TicketServiceImpl) UpdateTicket(ctx context.Context, id uuid.UUID, tick
 		return errors.New("ticket not found")
 	}
 
+	if err := policy.Authorize(ctx, enum.PermissionTicketsWork, assetResource(existingTicket.Asset)); err != nil {
+		return err
+	}
+
 	ticket.ID = id
 	ticket.CreatedAt = existingTicket.CreatedAt
 	ticket.UpdatedAt = time.Now()
 
-	return s.ticketRepo.Update(ctx, ticket)
+	return s.save(ctx, ticket, existingTicket.Status)
 }
 
 func (s *TicketServiceImpl) DeleteTicket(ctx context.Context, id uuid.UUID) error {
-	_, err := s.ticketRepo.GetByID(ctx, id)
+	ticket, err := s.ticketRepo.GetByID(ctx, id)
 	if err != nil {
 		return errors.New("ticket not found")
 	}
 
-	return s.ticketRepo.Delete(ctx, id)
+	if err := policy.Authorize(ctx, enum.PermissionTicketsDelete, assetResource(ticket.Asset)); err != nil {
+		return err
+	}
+
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.ticketRepo.Delete(ctx, id); err != nil {
+			return err
+		}
+		return s.events.Publish(ctx, enum.EventTicketDeleted, ticket.ID, ticket)
+	})
 }
 
 func (s *TicketServiceImpl) ListTickets(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.Ticket, int, error) {
+	conditions, restricted, err := policy.ListConditions(ctx, enum.PermissionTicketsRead)
+	if err != nil {
+		return nil, 0, err
+	}
+	if restricted {
+		if filters == nil {
+			filters = make(map[string]interface{})
+		}
+		filters["scope"] = conditions
+	}
+
 	return s.ticketRepo.List(ctx, limit, offset, filters)
 }
 
@@ -73,10 +198,28 @@ func (s *TicketServiceImpl) AssignTicket(ctx context.Context, ticketID, assigned
 		return errors.New("ticket not found")
 	}
 
+	if err := policy.Authorize(ctx, enum.PermissionTicketsWork, assetResource(ticket.Asset)); err != nil {
+		return err
+	}
+
+	technician, err := s.technicianRepo.GetByUserID(ctx, assignedTo)
+	if err != nil || !technician.IsActive {
+		return errors.New("tickets can only be assigned to active technicians")
+	}
+
+	previousStatus := ticket.Status
 	ticket.AssignedTo = &assignedTo
 	ticket.Status = "in_progress"
 
-	return s.ticketRepo.Update(ctx, ticket)
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.save(ctx, ticket, previousStatus); err != nil {
+			return err
+		}
+		if err := s.events.Publish(ctx, enum.EventTicketAssigned, ticket.ID, ticket); err != nil {
+			return err
+		}
+		return s.assetService.SyncStatusWithTicket(ctx, ticket, enum.EventTicketAssigned)
+	})
 }
 
 func (s *TicketServiceImpl) ResolveTicket(ctx context.Context, ticketID uuid.UUID, resolutionComment string) error {
@@ -85,10 +228,15 @@ func (s *TicketServiceImpl) ResolveTicket(ctx context.Context, ticketID uuid.UUI
 		return errors.New("ticket not found")
 	}
 
+	if err := policy.Authorize(ctx, enum.PermissionTicketsWork, assetResource(ticket.Asset)); err != nil {
+		return err
+	}
+
+	previousStatus := ticket.Status
 	ticket.Status = "resolved"
 	ticket.ResolutionComment = resolutionComment
 
-	return s.ticketRepo.Update(ctx, ticket)
+	return s.save(ctx, ticket, previousStatus)
 }
 
 func (s *TicketServiceImpl) CloseTicket(ctx context.Context, ticketID uuid.UUID) error {
@@ -97,9 +245,14 @@ func (s *TicketServiceImpl) CloseTicket(ctx context.Context, ticketID uuid.UUID)
 		return errors.New("ticket not found")
 	}
 
+	if err := policy.Authorize(ctx, enum.PermissionTicketsWork, assetResource(ticket.Asset)); err != nil {
+		return err
+	}
+
+	previousStatus := ticket.Status
 	ticket.Status = "closed"
 
-	return s.ticketRepo.Update(ctx, ticket)
+	return s.save(ctx, ticket, previousStatus)
 }
 
 func (s *TicketServiceImpl) GetTicketsByAsset(ctx context.Context, assetID uuid.UUID) ([]*entity.Ticket, error) {
@@ -110,6 +263,27 @@ func (s *TicketServiceImpl) GetTicketsByReporter(ctx context.Context, reporterID
 	return s.ticketRepo.GetByReporter(ctx, reporterID)
 }
 
+// save updates the ticket and, when its status differs from previousStatus,
+// publishes ticket.status_changed and applies the asset status rules.
+func (s *TicketServiceImpl) save(ctx context.Context, ticket *entity.Ticket, previousStatus string) error {
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.ticketRepo.Update(ctx, ticket); err != nil {
+			return err
+		}
+		if ticket.Status == previousStatus {
+			return nil
+		}
+		err := s.events.Publish(ctx, enum.EventTicketStatusChanged, ticket.ID, TicketStatusChangedPayload{
+			Ticket:         ticket,
+			PreviousStatus: previousStatus,
+		})
+		if err != nil {
+			return err
+		}
+		return s.assetService.SyncStatusWithTicket(ctx, ticket, enum.EventTicketStatusChanged)
+	})
+}
+
 func (s *TicketServiceImpl) calculateDuration(severity string) int {
 	switch severity {
 	case "low":
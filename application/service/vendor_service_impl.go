@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/enum"
+	"inventory-ticketing-system/domain/policy"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/domain/service"
+)
+
+// vendorDetailLimit caps the assets and tickets listed in a vendor's detail.
+const vendorDetailLimit = 50
+
+type VendorServiceImpl struct {
+	vendorRepo repository.VendorRepository
+	assetRepo  repository.AssetRepository
+	ticketRepo repository.TicketRepository
+	txManager  repository.TransactionManager
+}
+
+func NewVendorService(
+	vendorRepo repository.VendorRepository,
+	assetRepo repository.AssetRepository,
+	ticketRepo repository.TicketRepository,
+	txManager repository.TransactionManager,
+) service.VendorService {
+	return &VendorServiceImpl{
+		vendorRepo: vendorRepo,
+		assetRepo:  assetRepo,
+		ticketRepo: ticketRepo,
+		txManager:  txManager,
+	}
+}
+
+func (s *VendorServiceImpl) CreateVendor(ctx context.Context, vendor *entity.Vendor) error {
+	if err := validateVendor(vendor); err != nil {
+		return err
+	}
+	if existing, err := s.vendorRepo.GetByName(ctx, vendor.Name); err == nil && existing != nil {
+		return errors.New("vendor with this name already exists")
+	}
+	return s.vendorRepo.Create(ctx, vendor)
+}
+
+func (s *VendorServiceImpl) GetVendor(ctx context.Context, id uuid.UUID) (*entity.Vendor, error) {
+	vendor, err := s.vendorRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("vendor not found")
+	}
+	return vendor, nil
+}
+
+func (s *VendorServiceImpl) GetVendorDetail(ctx context.Context, id uuid.UUID) (*entity.VendorDetail, error) {
+	vendor, err := s.GetVendor(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	assetFilters := map[string]interface{}{}
+	conditions, restricted, err := policy.ListConditions(ctx, enum.PermissionAssetsRead)
+	if err != nil {
+		return nil, err
+	}
+	if restricted {
+		assetFilters["scope"] = conditions
+	}
+
+	detail := &entity.VendorDetail{Vendor: vendor, OpenRepairTickets: []*entity.Ticket{}}
+
+	purchased := copyFilters(assetFilters)
+	purchased["purchase_vendor_id"] = id
+	purchased["include_disposed"] = true
+	detail.PurchasedAssets, detail.PurchasedAssetCount, err = s.assetRepo.List(ctx, vendorDetailLimit, 0, purchased)
+	if err != nil {
+		return nil, err
+	}
+
+	serviced := copyFilters(assetFilters)
+	serviced["service_vendor_id"] = id
+	if _, detail.ServicedAssetCount, err = s.assetRepo.List(ctx, 1, 0, serviced); err != nil {
+		return nil, err
+	}
+
+	if detail.Spend, err = s.vendorRepo.Spend(ctx, id, assetFilters); err != nil {
+		return nil, err
+	}
+
+	// Tickets are only listed for callers who may read them
+	ticketConditions, ticketsRestricted, err := policy.ListConditions(ctx, enum.PermissionTicketsRead)
+	if err == nil {
+		ticketFilters := map[string]interface{}{"service_vendor_id": id, "unfinished": true}
+		if ticketsRestricted {
+			ticketFilters["scope"] = ticketConditions
+		}
+		detail.OpenRepairTickets, detail.OpenRepairTicketCount, err = s.ticketRepo.List(ctx, vendorDetailLimit, 0, ticketFilters)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return detail, nil
+}
+
+func (s *VendorServiceImpl) UpdateVendor(ctx context.Context, id uuid.UUID, vendor *entity.Vendor) error {
+	existing, err := s.vendorRepo.GetByID(ctx, id)
+	if err != nil {
+		return errors.New("vendor not found")
+	}
+	if err := validateVendor(vendor); err != nil {
+		return err
+	}
+	if !strings.EqualFold(vendor.Name, existing.Name) {
+		if other, err := s.vendorRepo.GetByName(ctx, vendor.Name); err == nil && other != nil {
+			return errors.New("vendor with this name already exists")
+		}
+	}
+
+	vendor.ID = id
+	vendor.CreatedAt = existing.CreatedAt
+	vendor.UpdatedAt = time.Now()
+
+	return s.vendorRepo.Update(ctx, vendor)
+}
+
+func (s *VendorServiceImpl) DeleteVendor(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.vendorRepo.GetByID(ctx, id); err != nil {
+		return errors.New("vendor not found")
+	}
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		return s.vendorRepo.Delete(ctx, id)
+	})
+}
+
+func (s *VendorServiceImpl) ListVendors(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.Vendor, int, error) {
+	return s.vendorRepo.List(ctx, limit, offset, filters)
+}
+
+func validateVendor(vendor *entity.Vendor) error {
+	vendor.Name = strings.TrimSpace(vendor.Name)
+	if vendor.Name == "" {
+		return errors.New("name is required")
+	}
+	if vendor.SupportResponseHours < 0 || vendor.SupportResolutionHours < 0 {
+		return errors.New("support hours cannot be negative")
+	}
+	if vendor.SupportResolutionHours > 0 && vendor.SupportResolutionHours < vendor.SupportResponseHours {
+		return errors.New("resolution time cannot be shorter than the response time")
+	}
+	if vendor.Contacts == nil {
+		vendor.Contacts = []entity.VendorContact{}
+	}
+	if vendor.Addresses == nil {
+		vendor.Addresses = []entity.VendorAddress{}
+	}
+	return nil
+}
+
+func copyFilters(filters map[string]interface{}) map[string]interface{} {
+	copied := make(map[string]interface{}, len(filters))
+	for key, value := range filters {
+		copied[key] = value
+	}
+	return copied
+}
@@ -0,0 +1,578 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/enum"
+	"inventory-ticketing-system/domain/policy"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/domain/service"
+
+	"github.com/google/uuid"
+)
+
+// maxExpiryWindowDays bounds the expiring coverage report.
+const maxExpiryWindowDays = 366
+
+type WarrantyServiceImpl struct {
+	warrantyRepo        repository.WarrantyRepository
+	contractRepo        repository.SupportContractRepository
+	claimRepo           repository.WarrantyClaimRepository
+	assetRepo           repository.AssetRepository
+	ticketRepo          repository.TicketRepository
+	userRepo            repository.UserRepository
+	departmentRepo      repository.DepartmentRepository
+	notificationService service.NotificationService
+	txManager           repository.TransactionManager
+	alertDays           int
+}
+
+// NewWarrantyService creates the warranty service. Coverage ending within
+// alertDays is reported once by the daily expiry alert.
+func NewWarrantyService(
+	warrantyRepo repository.WarrantyRepository,
+	contractRepo repository.SupportContractRepository,
+	claimRepo repository.WarrantyClaimRepository,
+	assetRepo repository.AssetRepository,
+	ticketRepo repository.TicketRepository,
+	userRepo repository.UserRepository,
+	departmentRepo repository.DepartmentRepository,
+	notificationService service.NotificationService,
+	txManager repository.TransactionManager,
+	alertDays int,
+) service.WarrantyService {
+	return &WarrantyServiceImpl{
+		warrantyRepo:        warrantyRepo,
+		contractRepo:        contractRepo,
+		claimRepo:           claimRepo,
+		assetRepo:           assetRepo,
+		ticketRepo:          ticketRepo,
+		userRepo:            userRepo,
+		departmentRepo:      departmentRepo,
+		notificationService: notificationService,
+		txManager:           txManager,
+		alertDays:           alertDays,
+	}
+}
+
+func (s *WarrantyServiceImpl) Jobs() []service.Job {
+	return []service.Job{
+		{
+			Name:        "coverage_expiry_alerts",
+			Description: fmt.Sprintf("Alert about warranties and support contracts expiring within %d days", s.alertDays),
+			Schedule:    "@daily",
+			Run: func(ctx context.Context) (string, error) {
+				reported, err := s.AlertExpiring(ctx)
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprintf("reported %d warranties and contracts", reported), nil
+			},
+		},
+	}
+}
+
+func (s *WarrantyServiceImpl) CreateWarranty(ctx context.Context, warranty *entity.Warranty) error {
+	if err := s.validateWarranty(ctx, warranty); err != nil {
+		return err
+	}
+	return s.warrantyRepo.Create(ctx, warranty)
+}
+
+func (s *WarrantyServiceImpl) GetWarranty(ctx context.Context, id uuid.UUID) (*entity.Warranty, error) {
+	warranty, err := s.warrantyRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("warranty not found")
+	}
+	if err := policy.Authorize(ctx, enum.PermissionAssetsRead, assetResource(warranty.Asset)); err != nil {
+		return nil, err
+	}
+	return warranty, nil
+}
+
+func (s *WarrantyServiceImpl) UpdateWarranty(ctx context.Context, id uuid.UUID, warranty *entity.Warranty) error {
+	existing, err := s.warrantyRepo.GetByID(ctx, id)
+	if err != nil {
+		return errors.New("warranty not found")
+	}
+	if err := policy.Authorize(ctx, enum.PermissionWarrantiesManage, assetResource(existing.Asset)); err != nil {
+		return err
+	}
+	if err := s.validateWarranty(ctx, warranty); err != nil {
+		return err
+	}
+
+	warranty.ID = id
+	warranty.CreatedBy = existing.CreatedBy
+	warranty.CreatedAt = existing.CreatedAt
+	// A new end date is alerted about again
+	if warranty.EndDate.Equal(existing.EndDate) {
+		warranty.ExpiryNotifiedAt = existing.ExpiryNotifiedAt
+	}
+	return s.warrantyRepo.Update(ctx, warranty)
+}
+
+func (s *WarrantyServiceImpl) DeleteWarranty(ctx context.Context, id uuid.UUID) error {
+	warranty, err := s.warrantyRepo.GetByID(ctx, id)
+	if err != nil {
+		return errors.New("warranty not found")
+	}
+	if err := policy.Authorize(ctx, enum.PermissionWarrantiesManage, assetResource(warranty.Asset)); err != nil {
+		return err
+	}
+	return s.warrantyRepo.Delete(ctx, id)
+}
+
+func (s *WarrantyServiceImpl) ListAssetWarranties(ctx context.Context, assetID uuid.UUID) ([]*entity.Warranty, error) {
+	asset, err := s.assetRepo.GetByID(ctx, assetID)
+	if err != nil {
+		return nil, errors.New("asset not found")
+	}
+	if err := policy.Authorize(ctx, enum.PermissionAssetsRead, assetResource(asset)); err != nil {
+		return nil, err
+	}
+	return s.warrantyRepo.ListByAsset(ctx, assetID)
+}
+
+func (s *WarrantyServiceImpl) CreateContract(ctx context.Context, contract *entity.SupportContract) error {
+	if err := s.validateContract(ctx, contract); err != nil {
+		return err
+	}
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.contractRepo.Create(ctx, contract); err != nil {
+			return err
+		}
+		return s.contractRepo.SetAssets(ctx, contract.ID, contract.AssetIDs)
+	})
+}
+
+func (s *WarrantyServiceImpl) GetContract(ctx context.Context, id uuid.UUID) (*entity.SupportContract, error) {
+	contract, err := s.contractRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("support contract not found")
+	}
+	return contract, nil
+}
+
+func (s *WarrantyServiceImpl) UpdateContract(ctx context.Context, id uuid.UUID, contract *entity.SupportContract) error {
+	existing, err := s.contractRepo.GetByID(ctx, id)
+	if err != nil {
+		return errors.New("support contract not found")
+	}
+	// Assets dropped from the contract need the same access as added ones
+	if err := s.authorizeAssets(ctx, existing.AssetIDs); err != nil {
+		return err
+	}
+	if err := s.validateContract(ctx, contract); err != nil {
+		return err
+	}
+
+	contract.ID = id
+	contract.CreatedBy = existing.CreatedBy
+	contract.CreatedAt = existing.CreatedAt
+	if contract.EndDate.Equal(existing.EndDate) {
+		contract.ExpiryNotifiedAt = existing.ExpiryNotifiedAt
+	}
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.contractRepo.Update(ctx, contract); err != nil {
+			return err
+		}
+		return s.contractRepo.SetAssets(ctx, contract.ID, contract.AssetIDs)
+	})
+}
+
+func (s *WarrantyServiceImpl) DeleteContract(ctx context.Context, id uuid.UUID) error {
+	contract, err := s.contractRepo.GetByID(ctx, id)
+	if err != nil {
+		return errors.New("support contract not found")
+	}
+	if err := s.authorizeAssets(ctx, contract.AssetIDs); err != nil {
+		return err
+	}
+	return s.contractRepo.Delete(ctx, id)
+}
+
+func (s *WarrantyServiceImpl) ListContracts(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.SupportContract, int, error) {
+	return s.contractRepo.List(ctx, limit, offset, filters)
+}
+
+func (s *WarrantyServiceImpl) GetCoverage(ctx context.Context, assetID uuid.UUID) (*entity.AssetCoverage, error) {
+	asset, err := s.assetRepo.GetByID(ctx, assetID)
+	if err != nil {
+		return nil, errors.New("asset not found")
+	}
+	if err := policy.Authorize(ctx, enum.PermissionAssetsRead, assetResource(asset)); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	warranties, err := s.warrantyRepo.ListByAsset(ctx, assetID)
+	if err != nil {
+		return nil, err
+	}
+	coverage := &entity.AssetCoverage{AssetID: assetID, WarrantyStatus: string(enum.WarrantyNone)}
+	if warranty := currentWarranty(warranties, now); warranty != nil {
+		coverage.WarrantyStatus = string(enum.WarrantyActive)
+		coverage.Warranty = warranty
+	} else {
+		// Warranties are sorted by end date, so the first that has ended is
+		// the latest
+		for _, warranty := range warranties {
+			if !warranty.CoversDate(now) && warranty.EndDate.Before(now) {
+				coverage.WarrantyStatus = string(enum.WarrantyExpired)
+				coverage.Warranty = warranty
+				break
+			}
+		}
+	}
+
+	coverage.Contracts, _, err = s.contractRepo.List(ctx, maxExpiryWindowDays, 0, map[string]interface{}{
+		"asset_id":  assetID,
+		"active_on": now.Format("2006-01-02"),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return coverage, nil
+}
+
+func (s *WarrantyServiceImpl) ClaimWarranty(ctx context.Context, ticketID uuid.UUID, claim *entity.WarrantyClaim) error {
+	ticket, err := s.ticketRepo.GetByID(ctx, ticketID)
+	if err != nil {
+		return errors.New("ticket not found")
+	}
+	if err := policy.Authorize(ctx, enum.PermissionTicketsWork, assetResource(ticket.Asset)); err != nil {
+		return err
+	}
+	if ticket.Status == string(enum.TicketStatusClosed) {
+		return errors.New("closed tickets cannot be claimed under warranty")
+	}
+
+	claims, err := s.claimRepo.ListByTicket(ctx, ticketID)
+	if err != nil {
+		return err
+	}
+	for _, existing := range claims {
+		if existing.Status != string(enum.WarrantyClaimRejected) {
+			return errors.New("ticket already has a warranty claim")
+		}
+	}
+
+	// The defect has to be reported while the warranty was in force
+	var warranty *entity.Warranty
+	if claim.WarrantyID != uuid.Nil {
+		warranty, err = s.warrantyRepo.GetByID(ctx, claim.WarrantyID)
+		if err != nil || warranty.AssetID != ticket.AssetID {
+			return errors.New("warranty not found for the ticket's asset")
+		}
+		if !warranty.CoversDate(ticket.CreatedAt) {
+			return errors.New("warranty did not cover the asset when the ticket was reported")
+		}
+	} else {
+		warranties, err := s.warrantyRepo.ListByAsset(ctx, ticket.AssetID)
+		if err != nil {
+			return err
+		}
+		if warranty = currentWarranty(warranties, ticket.CreatedAt); warranty == nil {
+			return errors.New("asset was not under warranty when the ticket was reported")
+		}
+	}
+
+	if claim.ID == uuid.Nil {
+		claim.ID = uuid.New()
+	}
+	claim.WarrantyID = warranty.ID
+	claim.TicketID = ticketID
+	claim.Status = string(enum.WarrantyClaimSubmitted)
+	return s.claimRepo.Create(ctx, claim)
+}
+
+func (s *WarrantyServiceImpl) UpdateClaim(ctx context.Context, id uuid.UUID, status, providerReference, notes string) (*entity.WarrantyClaim, error) {
+	claim, err := s.claimRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("warranty claim not found")
+	}
+	var asset *entity.Asset
+	if claim.Warranty != nil {
+		asset = claim.Warranty.Asset
+	}
+	if err := policy.Authorize(ctx, enum.PermissionTicketsWork, assetResource(asset)); err != nil {
+		return nil, err
+	}
+
+	if status != "" && status != claim.Status {
+		if !enum.WarrantyClaimStatus(status).IsValid() {
+			return nil, fmt.Errorf("invalid claim status %q", status)
+		}
+		if claim.Status == string(enum.WarrantyClaimRejected) || claim.Status == string(enum.WarrantyClaimCompleted) {
+			return nil, fmt.Errorf("warranty claim is already %s", claim.Status)
+		}
+		claim.Status = status
+	}
+	if providerReference != "" {
+		claim.ProviderReference = providerReference
+	}
+	if notes != "" {
+		claim.Notes = notes
+	}
+
+	if err := s.claimRepo.Update(ctx, claim); err != nil {
+		return nil, err
+	}
+	return claim, nil
+}
+
+func (s *WarrantyServiceImpl) ListClaims(ctx context.Context, warrantyID uuid.UUID) ([]*entity.WarrantyClaim, error) {
+	if _, err := s.GetWarranty(ctx, warrantyID); err != nil {
+		return nil, err
+	}
+	return s.claimRepo.ListByWarranty(ctx, warrantyID)
+}
+
+func (s *WarrantyServiceImpl) GetExpiring(ctx context.Context, days int) (*entity.ExpiringCoverage, error) {
+	if days < 1 || days > maxExpiryWindowDays {
+		return nil, fmt.Errorf("days must be between 1 and %d", maxExpiryWindowDays)
+	}
+	return s.expiring(ctx, days, false)
+}
+
+func (s *WarrantyServiceImpl) AlertExpiring(ctx context.Context) (int, error) {
+	expiring, err := s.expiring(ctx, s.alertDays, true)
+	if err != nil {
+		return 0, err
+	}
+	if len(expiring.Warranties) == 0 && len(expiring.Contracts) == 0 {
+		return 0, nil
+	}
+
+	admins, err := s.userRepo.ListByRole(ctx, string(enum.RoleAdmin))
+	if err != nil {
+		return 0, err
+	}
+
+	// Admins hear about everything, department managers about their assets
+	reports := make(map[uuid.UUID]*entity.ExpiringCoverage)
+	report := func(userID uuid.UUID) *entity.ExpiringCoverage {
+		if reports[userID] == nil {
+			reports[userID] = &entity.ExpiringCoverage{Until: expiring.Until}
+		}
+		return reports[userID]
+	}
+	for _, admin := range admins {
+		reports[admin.ID] = expiring
+	}
+	managers := make(map[uuid.UUID]*uuid.UUID)
+	for _, warranty := range expiring.Warranties {
+		if managerID := s.departmentManager(ctx, warranty.Asset, managers); managerID != nil && reports[*managerID] != expiring {
+			r := report(*managerID)
+			r.Warranties = append(r.Warranties, warranty)
+		}
+	}
+	for _, contract := range expiring.Contracts {
+		notified := make(map[uuid.UUID]bool)
+		for _, assetID := range contract.AssetIDs {
+			asset, err := s.assetRepo.GetByID(ctx, assetID)
+			if err != nil {
+				continue
+			}
+			managerID := s.departmentManager(ctx, asset, managers)
+			if managerID == nil || notified[*managerID] || reports[*managerID] == expiring {
+				continue
+			}
+			notified[*managerID] = true
+			r := report(*managerID)
+			r.Contracts = append(r.Contracts, contract)
+		}
+	}
+
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		for userID, coverage := range reports {
+			title, payload := expiryNotification(coverage, s.alertDays)
+			if err := s.notificationService.NotifyInbox(ctx, enum.NotificationCoverageExpiring, []uuid.UUID{userID}, title, payload); err != nil {
+				return err
+			}
+		}
+
+		now := time.Now()
+		warrantyIDs := make([]uuid.UUID, len(expiring.Warranties))
+		for i, warranty := range expiring.Warranties {
+			warrantyIDs[i] = warranty.ID
+		}
+		if err := s.warrantyRepo.MarkExpiryNotified(ctx, warrantyIDs, now); err != nil {
+			return err
+		}
+		contractIDs := make([]uuid.UUID, len(expiring.Contracts))
+		for i, contract := range expiring.Contracts {
+			contractIDs[i] = contract.ID
+		}
+		return s.contractRepo.MarkExpiryNotified(ctx, contractIDs, now)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(expiring.Warranties) + len(expiring.Contracts), nil
+}
+
+func (s *WarrantyServiceImpl) expiring(ctx context.Context, days int, unnotifiedOnly bool) (*entity.ExpiringCoverage, error) {
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	until := today.AddDate(0, 0, days)
+
+	warranties, err := s.warrantyRepo.ListExpiring(ctx, today, until, unnotifiedOnly)
+	if err != nil {
+		return nil, err
+	}
+	contracts, err := s.contractRepo.ListExpiring(ctx, today, until, unnotifiedOnly)
+	if err != nil {
+		return nil, err
+	}
+	return &entity.ExpiringCoverage{Until: until, Warranties: warranties, Contracts: contracts}, nil
+}
+
+// departmentManager returns the manager of the asset's department, caching
+// lookups by department.
+func (s *WarrantyServiceImpl) departmentManager(ctx context.Context, asset *entity.Asset, cache map[uuid.UUID]*uuid.UUID) *uuid.UUID {
+	if asset == nil || asset.DepartmentID == nil {
+		return nil
+	}
+	if managerID, ok := cache[*asset.DepartmentID]; ok {
+		return managerID
+	}
+
+	var managerID *uuid.UUID
+	if department, err := s.departmentRepo.GetByID(ctx, *asset.DepartmentID); err == nil {
+		managerID = department.ManagerID
+	}
+	cache[*asset.DepartmentID] = managerID
+	return managerID
+}
+
+func (s *WarrantyServiceImpl) validateWarranty(ctx context.Context, warranty *entity.Warranty) error {
+	if strings.TrimSpace(warranty.Provider) == "" {
+		return errors.New("provider is required")
+	}
+	if warranty.StartDate.IsZero() || warranty.EndDate.IsZero() {
+		return errors.New("start and end dates are required")
+	}
+	if warranty.EndDate.Before(warranty.StartDate) {
+		return errors.New("end date must not be before the start date")
+	}
+	if warranty.Documents == nil {
+		warranty.Documents = []string{}
+	}
+
+	asset, err := s.assetRepo.GetByID(ctx, warranty.AssetID)
+	if err != nil {
+		return errors.New("asset not found")
+	}
+	return policy.Authorize(ctx, enum.PermissionWarrantiesManage, assetResource(asset))
+}
+
+func (s *WarrantyServiceImpl) validateContract(ctx context.Context, contract *entity.SupportContract) error {
+	if strings.TrimSpace(contract.Provider) == "" {
+		return errors.New("provider is required")
+	}
+	if contract.StartDate.IsZero() || contract.EndDate.IsZero() {
+		return errors.New("start and end dates are required")
+	}
+	if contract.EndDate.Before(contract.StartDate) {
+		return errors.New("end date must not be before the start date")
+	}
+	if contract.Cost < 0 {
+		return errors.New("cost cannot be negative")
+	}
+	if contract.Currency == "" {
+		contract.Currency = "USD"
+	}
+	if contract.Documents == nil {
+		contract.Documents = []string{}
+	}
+
+	contract.AssetIDs = uniqueIDs(contract.AssetIDs)
+	return s.authorizeAssets(ctx, contract.AssetIDs)
+}
+
+// authorizeAssets checks that the assets exist and the caller manages
+// warranties for each of them.
+func (s *WarrantyServiceImpl) authorizeAssets(ctx context.Context, assetIDs []uuid.UUID) error {
+	for _, assetID := range assetIDs {
+		asset, err := s.assetRepo.GetByID(ctx, assetID)
+		if err != nil {
+			return fmt.Errorf("asset %s not found", assetID)
+		}
+		if err := policy.Authorize(ctx, enum.PermissionWarrantiesManage, assetResource(asset)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// currentWarranty returns the warranty that covers the day of t, preferring
+// the one that runs longest.
+func currentWarranty(warranties []*entity.Warranty, t time.Time) *entity.Warranty {
+	for _, warranty := range warranties {
+		if warranty.CoversDate(t) {
+			return warranty
+		}
+	}
+	return nil
+}
+
+func expiryNotification(coverage *entity.ExpiringCoverage, days int) (string, map[string]interface{}) {
+	warranties := make([]map[string]interface{}, len(coverage.Warranties))
+	for i, warranty := range coverage.Warranties {
+		item := map[string]interface{}{
+			"id":       warranty.ID,
+			"assetId":  warranty.AssetID,
+			"provider": warranty.Provider,
+			"endDate":  warranty.EndDate.Format("2006-01-02"),
+		}
+		if warranty.Asset != nil {
+			item["assetName"] = warranty.Asset.Name
+		}
+		warranties[i] = item
+	}
+	contracts := make([]map[string]interface{}, len(coverage.Contracts))
+	for i, contract := range coverage.Contracts {
+		contracts[i] = map[string]interface{}{
+			"id":             contract.ID,
+			"provider":       contract.Provider,
+			"contractNumber": contract.ContractNumber,
+			"endDate":        contract.EndDate.Format("2006-01-02"),
+			"assetIds":       contract.AssetIDs,
+		}
+	}
+
+	title := fmt.Sprintf("%s and %s expire within %d days",
+		countNoun(len(warranties), "warranty", "warranties"),
+		countNoun(len(contracts), "support contract", "support contracts"), days)
+	return title, map[string]interface{}{
+		"days":       days,
+		"warranties": warranties,
+		"contracts":  contracts,
+	}
+}
+
+func countNoun(n int, singular, plural string) string {
+	if n == 1 {
+		return "1 " + singular
+	}
+	return fmt.Sprintf("%d %s", n, plural)
+}
+
+func uniqueIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	unique := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
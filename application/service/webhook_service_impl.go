@@ -0,0 +1,332 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/enum"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/domain/service"
+	"inventory-ticketing-system/infrastructure/webhook"
+)
+
+const (
+	webhookSecretPrefix = "whsec_"
+	// webhookBatchSize bounds how many deliveries one dispatch sends.
+	webhookBatchSize = 20
+	// webhookInitialBackoff doubles after every failed attempt up to
+	// webhookMaxBackoff.
+	webhookInitialBackoff = 30 * time.Second
+	webhookMaxBackoff     = 6 * time.Hour
+)
+
+// webhookEnvelope is the JSON body sent to endpoints.
+type webhookEnvelope struct {
+	ID         uuid.UUID       `json:"id"`
+	Type       string          `json:"type"`
+	OccurredAt time.Time       `json:"occurredAt"`
+	Data       json.RawMessage `json:"data"`
+}
+
+type WebhookServiceImpl struct {
+	endpointRepo repository.WebhookEndpointRepository
+	deliveryRepo repository.WebhookDeliveryRepository
+	client       *webhook.Client
+	timeout      time.Duration
+	maxAttempts  int
+}
+
+func NewWebhookService(
+	endpointRepo repository.WebhookEndpointRepository,
+	deliveryRepo repository.WebhookDeliveryRepository,
+	timeout time.Duration,
+	maxAttempts int,
+) service.WebhookService {
+	return &WebhookServiceImpl{
+		endpointRepo: endpointRepo,
+		deliveryRepo: deliveryRepo,
+		client:       webhook.NewClient(timeout),
+		timeout:      timeout,
+		maxAttempts:  maxAttempts,
+	}
+}
+
+// CreateEndpoint registers an endpoint and returns its signing secret. The
+// secret is only returned here and by RotateSecret.
+func (s *WebhookServiceImpl) CreateEndpoint(ctx context.Context, endpoint *entity.WebhookEndpoint) (string, error) {
+	if err := validateWebhookEndpoint(endpoint); err != nil {
+		return "", err
+	}
+
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		return "", err
+	}
+	endpoint.Secret = secret
+
+	if err := s.endpointRepo.Create(ctx, endpoint); err != nil {
+		return "", err
+	}
+	return secret, nil
+}
+
+func (s *WebhookServiceImpl) GetEndpoint(ctx context.Context, id uuid.UUID) (*entity.WebhookEndpoint, error) {
+	endpoint, err := s.endpointRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("webhook endpoint not found")
+	}
+	return endpoint, nil
+}
+
+func (s *WebhookServiceImpl) UpdateEndpoint(ctx context.Context, id uuid.UUID, endpoint *entity.WebhookEndpoint) error {
+	existing, err := s.endpointRepo.GetByID(ctx, id)
+	if err != nil {
+		return errors.New("webhook endpoint not found")
+	}
+
+	if err := validateWebhookEndpoint(endpoint); err != nil {
+		return err
+	}
+
+	endpoint.ID = id
+	endpoint.Secret = existing.Secret
+	endpoint.CreatedBy = existing.CreatedBy
+	endpoint.CreatedAt = existing.CreatedAt
+	endpoint.UpdatedAt = time.Now()
+
+	return s.endpointRepo.Update(ctx, endpoint)
+}
+
+func (s *WebhookServiceImpl) DeleteEndpoint(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.endpointRepo.GetByID(ctx, id); err != nil {
+		return errors.New("webhook endpoint not found")
+	}
+	return s.endpointRepo.Delete(ctx, id)
+}
+
+func (s *WebhookServiceImpl) ListEndpoints(ctx context.Context) ([]*entity.WebhookEndpoint, error) {
+	return s.endpointRepo.List(ctx)
+}
+
+func (s *WebhookServiceImpl) RotateSecret(ctx context.Context, id uuid.UUID) (string, error) {
+	endpoint, err := s.endpointRepo.GetByID(ctx, id)
+	if err != nil {
+		return "", errors.New("webhook endpoint not found")
+	}
+
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		return "", err
+	}
+
+	endpoint.Secret = secret
+	if err := s.endpointRepo.Update(ctx, endpoint); err != nil {
+		return "", err
+	}
+	return secret, nil
+}
+
+func (s *WebhookServiceImpl) ListDeliveries(ctx context.Context, endpointID uuid.UUID, limit, offset int, status string) ([]*entity.WebhookDelivery, int, error) {
+	if status != "" && !enum.WebhookDeliveryStatus(status).IsValid() {
+		return nil, 0, errors.New("invalid delivery status")
+	}
+	return s.deliveryRepo.ListByEndpoint(ctx, endpointID, limit, offset, status)
+}
+
+func (s *WebhookServiceImpl) GetDelivery(ctx context.Context, id uuid.UUID) (*entity.WebhookDelivery, error) {
+	delivery, err := s.deliveryRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("webhook delivery not found")
+	}
+	return delivery, nil
+}
+
+// ReplayDelivery queues a new delivery with the same payload. The original
+// delivery and its attempt log are left untouched.
+func (s *WebhookServiceImpl) ReplayDelivery(ctx context.Context, id uuid.UUID) (*entity.WebhookDelivery, error) {
+	original, err := s.deliveryRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.New("webhook delivery not found")
+	}
+
+	now := time.Now()
+	replay := &entity.WebhookDelivery{
+		ID:            uuid.New(),
+		EndpointID:    original.EndpointID,
+		EventID:       original.EventID,
+		EventType:     original.EventType,
+		Payload:       original.Payload,
+		Status:        string(enum.WebhookDeliveryPending),
+		NextAttemptAt: &now,
+		ReplayOfID:    &original.ID,
+	}
+
+	if err := s.deliveryRepo.Create(ctx, replay); err != nil {
+		return nil, err
+	}
+	return replay, nil
+}
+
+// HandleEvent queues a delivery for every active endpoint subscribed to the
+// event. It runs inside the outbox relay transaction.
+func (s *WebhookServiceImpl) HandleEvent(ctx context.Context, event *entity.OutboxEvent) error {
+	endpoints, err := s.endpointRepo.ListActive(ctx)
+	if err != nil {
+		return err
+	}
+
+	var body []byte
+	now := time.Now()
+	for _, endpoint := range endpoints {
+		if !endpoint.Subscribes(event.EventType) {
+			continue
+		}
+
+		if body == nil {
+			body, err = json.Marshal(webhookEnvelope{
+				ID:         event.ID,
+				Type:       event.EventType,
+				OccurredAt: event.OccurredAt,
+				Data:       json.RawMessage(event.Payload),
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		delivery := &entity.WebhookDelivery{
+			ID:            uuid.New(),
+			EndpointID:    endpoint.ID,
+			EventID:       event.ID,
+			EventType:     event.EventType,
+			Payload:       string(body),
+			Status:        string(enum.WebhookDeliveryPending),
+			NextAttemptAt: &now,
+		}
+		if err := s.deliveryRepo.Create(ctx, delivery); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DispatchDue sends deliveries whose next attempt is due and returns how
+// many were attempted.
+func (s *WebhookServiceImpl) DispatchDue(ctx context.Context) (int, error) {
+	// The lease outlasts sending the whole batch; deliveries left behind by a
+	// crashed dispatcher become due again once it expires
+	now := time.Now()
+	lease := now.Add(webhookBatchSize*s.timeout + time.Minute)
+	deliveries, err := s.deliveryRepo.ClaimDue(ctx, now, lease, webhookBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, delivery := range deliveries {
+		if err := s.attempt(ctx, delivery); err != nil {
+			log.Printf("Failed to record webhook delivery %s: %v", delivery.ID, err)
+		}
+	}
+	return len(deliveries), nil
+}
+
+func (s *WebhookServiceImpl) attempt(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	now := time.Now()
+	delivery.AttemptCount++
+	delivery.LastAttemptAt = &now
+
+	attempt := &entity.WebhookDeliveryAttempt{
+		ID:            uuid.New(),
+		DeliveryID:    delivery.ID,
+		AttemptNumber: delivery.AttemptCount,
+		AttemptedAt:   now,
+	}
+
+	endpoint, err := s.endpointRepo.GetByID(ctx, delivery.EndpointID)
+	switch {
+	case err != nil:
+		attempt.Error = "webhook endpoint not found"
+	case !endpoint.IsActive:
+		attempt.Error = "webhook endpoint is disabled"
+	default:
+		response, err := s.client.Send(ctx, webhook.Request{
+			URL:        endpoint.URL,
+			Secret:     endpoint.Secret,
+			DeliveryID: delivery.ID.String(),
+			EventType:  delivery.EventType,
+			Body:       []byte(delivery.Payload),
+		})
+		if err != nil {
+			attempt.Error = err.Error()
+		} else {
+			attempt.ResponseStatus = response.StatusCode
+			attempt.ResponseBody = response.Body
+			attempt.DurationMs = response.Duration.Milliseconds()
+			if !response.Succeeded() {
+				attempt.Error = "unexpected response status"
+			}
+		}
+	}
+
+	switch {
+	case attempt.Error == "":
+		delivery.Status = string(enum.WebhookDeliverySucceeded)
+		delivery.NextAttemptAt = nil
+	case delivery.AttemptCount >= s.maxAttempts || endpoint == nil || !endpoint.IsActive:
+		delivery.Status = string(enum.WebhookDeliveryFailed)
+		delivery.NextAttemptAt = nil
+	default:
+		next := now.Add(webhookBackoff(delivery.AttemptCount))
+		delivery.NextAttemptAt = &next
+	}
+
+	if err := s.deliveryRepo.CreateAttempt(ctx, attempt); err != nil {
+		return err
+	}
+	return s.deliveryRepo.Update(ctx, delivery)
+}
+
+// webhookBackoff returns the delay after the given number of failed attempts.
+func webhookBackoff(attempts int) time.Duration {
+	delay := webhookInitialBackoff
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= webhookMaxBackoff {
+			return webhookMaxBackoff
+		}
+	}
+	return delay
+}
+
+func validateWebhookEndpoint(endpoint *entity.WebhookEndpoint) error {
+	parsed, err := url.Parse(endpoint.URL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return errors.New("url must be an absolute http or https URL")
+	}
+
+	if len(endpoint.Events) == 0 {
+		return errors.New("at least one event is required")
+	}
+	for _, eventType := range endpoint.Events {
+		if eventType != enum.EventWildcard && !enum.EventType(eventType).IsValid() {
+			return errors.New("invalid event: " + eventType)
+		}
+	}
+	return nil
+}
+
+func generateWebhookSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return webhookSecretPrefix + base64.RawURLEncoding.EncodeToString(buf), nil
+}
@@ -0,0 +1,31 @@
+package asset
+
+import (
+	"context"
+
+	assetdto "inventory-ticketing-system/application/dto/asset"
+	"inventory-ticketing-system/domain/service"
+)
+
+type AssetStatusReportUseCase struct {
+	assetService service.AssetService
+}
+
+func NewAssetStatusReportUseCase(assetService service.AssetService) *AssetStatusReportUseCase {
+	return &AssetStatusReportUseCase{
+		assetService: assetService,
+	}
+}
+
+func (uc *AssetStatusReportUseCase) Execute(ctx context.Context, req *assetdto.StatusReportRequest) (*assetdto.StatusReportResponse, error) {
+	assets, err := uc.assetService.GetStatusReport(ctx, req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	return &assetdto.StatusReportResponse{
+		From:   req.From,
+		To:     req.To,
+		Assets: assets,
+	}, nil
+}
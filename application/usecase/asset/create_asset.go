@@ -2,10 +2,12 @@ package asset
 
 import (
 	"context"
+	"strings"
 
 	"github.com/google/uuid"
 	assetdto "inventory-ticketing-system/application/dto/asset"
 	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/enum"
 	"inventory-ticketing-system/domain/service"
 )
 
@@ -21,18 +23,30 @@ func NewCreateAssetUseCase(assetService service.AssetService) *CreateAssetUseCas
 
 func (uc *CreateAssetUseCase) Execute(ctx context.Context, req *assetdto.CreateAssetRequest) (*entity.Asset, error) {
 	asset := &entity.Asset{
-		ID:            uuid.New(),
-		UniqueID:      req.UniqueID,
-		Name:          req.Name,
-		Comment:       req.Comment,
-		Detail:        req.Detail,
-		Qty:           req.Qty,
-		Brand:         req.Brand,
-		Type:          req.Type,
-		Status:        req.Status,
-		Category:      req.Category,
-		LocationID:    req.GetLocationID(),
-		LocationLabel: req.LocationLabel,
+		ID:               uuid.New(),
+		UniqueID:         req.UniqueID,
+		Name:             req.Name,
+		Comment:          req.Comment,
+		Detail:           req.Detail,
+		Qty:              req.Qty,
+		Brand:            req.Brand,
+		Type:             req.Type,
+		Status:           req.Status,
+		Category:         req.Category,
+		LocationID:       req.GetLocationID(),
+		LocationLabel:    req.LocationLabel,
+		DepartmentID:     req.GetDepartmentID(),
+		DataBearing:      req.Type == string(enum.AssetTypeIT),
+		PurchaseCost:     req.PurchaseCost,
+		Currency:         strings.ToUpper(req.Currency),
+		PurchaseDate:     req.PurchaseDate,
+		InvoiceNumber:    req.InvoiceNumber,
+		Vendor:           req.Vendor,
+		PurchaseVendorID: req.GetPurchaseVendorID(),
+		ServiceVendorID:  req.GetServiceVendorID(),
+	}
+	if req.DataBearing != nil {
+		asset.DataBearing = *req.DataBearing
 	}
 
 	err := uc.assetService.CreateAsset(ctx, asset)
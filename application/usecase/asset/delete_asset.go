@@ -0,0 +1,22 @@
+package asset
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/service"
+)
+
+type DeleteAssetUseCase struct {
+	assetService service.AssetService
+}
+
+func NewDeleteAssetUseCase(assetService service.AssetService) *DeleteAssetUseCase {
+	return &DeleteAssetUseCase{
+		assetService: assetService,
+	}
+}
+
+func (uc *DeleteAssetUseCase) Execute(ctx context.Context, id uuid.UUID) error {
+	return uc.assetService.DeleteAsset(ctx, id)
+}
@@ -0,0 +1,23 @@
+package asset
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/service"
+)
+
+type GetAssetUseCase struct {
+	assetService service.AssetService
+}
+
+func NewGetAssetUseCase(assetService service.AssetService) *GetAssetUseCase {
+	return &GetAssetUseCase{
+		assetService: assetService,
+	}
+}
+
+func (uc *GetAssetUseCase) Execute(ctx context.Context, id uuid.UUID) (*entity.Asset, error) {
+	return uc.assetService.GetAsset(ctx, id)
+}
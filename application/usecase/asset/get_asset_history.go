@@ -0,0 +1,23 @@
+package asset
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/service"
+)
+
+type GetAssetHistoryUseCase struct {
+	assetService service.AssetService
+}
+
+func NewGetAssetHistoryUseCase(assetService service.AssetService) *GetAssetHistoryUseCase {
+	return &GetAssetHistoryUseCase{
+		assetService: assetService,
+	}
+}
+
+func (uc *GetAssetHistoryUseCase) Execute(ctx context.Context, id uuid.UUID) ([]*entity.AssetStatusChange, error) {
+	return uc.assetService.GetStatusHistory(ctx, id)
+}
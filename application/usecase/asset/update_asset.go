@@ -0,0 +1,104 @@
+package asset
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	assetdto "inventory-ticketing-system/application/dto/asset"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/service"
+)
+
+type UpdateAssetUseCase struct {
+	assetService        service.AssetService
+	relationshipService service.AssetRelationshipService
+}
+
+func NewUpdateAssetUseCase(assetService service.AssetService, relationshipService service.AssetRelationshipService) *UpdateAssetUseCase {
+	return &UpdateAssetUseCase{
+		assetService:        assetService,
+		relationshipService: relationshipService,
+	}
+}
+
+// Execute applies the fields present in the request on top of the stored
+// asset; omitted fields keep their current values.
+func (uc *UpdateAssetUseCase) Execute(ctx context.Context, id uuid.UUID, req *assetdto.UpdateAssetRequest) (*entity.Asset, error) {
+	existing, err := uc.assetService.GetAsset(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	asset := *existing
+	asset.Location = nil
+
+	if req.Name != "" {
+		asset.Name = req.Name
+	}
+	if req.Comment != "" {
+		asset.Comment = req.Comment
+	}
+	if req.Detail != "" {
+		asset.Detail = req.Detail
+	}
+	if req.Qty != nil {
+		asset.Qty = *req.Qty
+	}
+	if req.Brand != "" {
+		asset.Brand = req.Brand
+	}
+	if req.Type != "" {
+		asset.Type = req.Type
+	}
+	if req.Status != "" {
+		asset.Status = req.Status
+	}
+	if req.Category != "" {
+		asset.Category = req.Category
+	}
+	if locationID := req.GetLocationID(); locationID != nil {
+		asset.LocationID = locationID
+	}
+	if req.LocationLabel != "" {
+		asset.LocationLabel = req.LocationLabel
+	}
+	if departmentID := req.GetDepartmentID(); departmentID != nil {
+		asset.DepartmentID = departmentID
+	}
+	if req.DataBearing != nil {
+		asset.DataBearing = *req.DataBearing
+	}
+	if req.PurchaseCost != nil {
+		asset.PurchaseCost = *req.PurchaseCost
+	}
+	if req.Currency != "" {
+		asset.Currency = strings.ToUpper(req.Currency)
+	}
+	if req.PurchaseDate != nil {
+		asset.PurchaseDate = req.PurchaseDate
+	}
+	if req.InvoiceNumber != "" {
+		asset.InvoiceNumber = req.InvoiceNumber
+	}
+	if req.Vendor != "" {
+		asset.Vendor = req.Vendor
+	}
+	if vendorID := req.GetPurchaseVendorID(); vendorID != nil {
+		asset.PurchaseVendorID = vendorID
+	}
+	if vendorID := req.GetServiceVendorID(); vendorID != nil {
+		asset.ServiceVendorID = vendorID
+	}
+
+	if req.MoveComponents {
+		err = uc.relationshipService.UpdateWithComponents(ctx, id, &asset)
+	} else {
+		err = uc.assetService.UpdateAsset(ctx, id, &asset)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &asset, nil
+}
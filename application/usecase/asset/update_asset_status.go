@@ -0,0 +1,27 @@
+package asset
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	assetdto "inventory-ticketing-system/application/dto/asset"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/service"
+)
+
+type UpdateAssetStatusUseCase struct {
+	assetService service.AssetService
+}
+
+func NewUpdateAssetStatusUseCase(assetService service.AssetService) *UpdateAssetStatusUseCase {
+	return &UpdateAssetStatusUseCase{
+		assetService: assetService,
+	}
+}
+
+func (uc *UpdateAssetStatusUseCase) Execute(ctx context.Context, id uuid.UUID, req *assetdto.UpdateAssetStatusRequest) (*entity.Asset, error) {
+	if err := uc.assetService.UpdateAssetStatus(ctx, id, req.Status, req.Reason); err != nil {
+		return nil, err
+	}
+	return uc.assetService.GetAsset(ctx, id)
+}
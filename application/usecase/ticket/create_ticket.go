@@ -2,37 +2,67 @@ package ticket
 
 import (
 	"context"
+	"log"
 
 	"github.com/google/uuid"
 	ticketdto "inventory-ticketing-system/application/dto/ticket"
 	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/repository"
 	"inventory-ticketing-system/domain/service"
 )
 
 type CreateTicketUseCase struct {
-	ticketService service.TicketService
+	ticketService     service.TicketService
+	technicianService service.TechnicianService
+	warrantyService   service.WarrantyService
+	txManager         repository.TransactionManager
 }
 
-func NewCreateTicketUseCase(ticketService service.TicketService) *CreateTicketUseCase {
+func NewCreateTicketUseCase(ticketService service.TicketService, technicianService service.TechnicianService, warrantyService service.WarrantyService, txManager repository.TransactionManager) *CreateTicketUseCase {
 	return &CreateTicketUseCase{
-		ticketService: ticketService,
+		ticketService:     ticketService,
+		technicianService: technicianService,
+		warrantyService:   warrantyService,
+		txManager:         txManager,
 	}
 }
 
-func (uc *CreateTicketUseCase) Execute(ctx context.Context, req *ticketdto.CreateTicketRequest, reporterID uuid.UUID) (*entity.Ticket, error) {
+// Execute creates the ticket and returns it with the asset's warranty and
+// support contract coverage, so the reporter can tell whether the repair
+// should be claimed under warranty. Coverage is nil when it can't be read.
+func (uc *CreateTicketUseCase) Execute(ctx context.Context, req *ticketdto.CreateTicketRequest, reporterID uuid.UUID) (*entity.Ticket, *entity.AssetCoverage, error) {
 	ticket := &entity.Ticket{
 		ID:        uuid.New(),
 		AssetID:   req.AssetID,
+		UnitID:    req.UnitID,
 		Category:  req.Category,
 		Severity:  req.Severity,
 		Comment:   req.Comment,
 		Reporting: reporterID,
 	}
 
-	err := uc.ticketService.CreateTicket(ctx, ticket)
+	// The ticket is saved together with its assignment, so a failed
+	// assignment fails the request instead of leaving the ticket unassigned
+	err := uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.ticketService.CreateTicket(ctx, ticket); err != nil {
+			return err
+		}
+		if uc.technicianService == nil {
+			return nil
+		}
+		return uc.technicianService.AutoAssign(ctx, ticket)
+	})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return ticket, nil
+	var coverage *entity.AssetCoverage
+	if uc.warrantyService != nil {
+		coverage, err = uc.warrantyService.GetCoverage(ctx, ticket.AssetID)
+		if err != nil {
+			log.Printf("Failed to get coverage for ticket %s: %v", ticket.ID, err)
+		}
+	}
+
+	return ticket, coverage, nil
 }
\ No newline at end of file
@@ -0,0 +1,22 @@
+package ticket
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/service"
+)
+
+type DeleteTicketUseCase struct {
+	ticketService service.TicketService
+}
+
+func NewDeleteTicketUseCase(ticketService service.TicketService) *DeleteTicketUseCase {
+	return &DeleteTicketUseCase{
+		ticketService: ticketService,
+	}
+}
+
+func (uc *DeleteTicketUseCase) Execute(ctx context.Context, id uuid.UUID) error {
+	return uc.ticketService.DeleteTicket(ctx, id)
+}
@@ -0,0 +1,23 @@
+package ticket
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/service"
+)
+
+type GetTicketQueueUseCase struct {
+	technicianService service.TechnicianService
+}
+
+func NewGetTicketQueueUseCase(technicianService service.TechnicianService) *GetTicketQueueUseCase {
+	return &GetTicketQueueUseCase{
+		technicianService: technicianService,
+	}
+}
+
+func (uc *GetTicketQueueUseCase) Execute(ctx context.Context, userID uuid.UUID) ([]*entity.Ticket, error) {
+	return uc.technicianService.GetQueue(ctx, userID)
+}
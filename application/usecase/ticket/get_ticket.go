@@ -0,0 +1,23 @@
+package ticket
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/service"
+)
+
+type GetTicketUseCase struct {
+	ticketService service.TicketService
+}
+
+func NewGetTicketUseCase(ticketService service.TicketService) *GetTicketUseCase {
+	return &GetTicketUseCase{
+		ticketService: ticketService,
+	}
+}
+
+func (uc *GetTicketUseCase) Execute(ctx context.Context, id uuid.UUID) (*entity.Ticket, error) {
+	return uc.ticketService.GetTicket(ctx, id)
+}
@@ -0,0 +1,59 @@
+package ticket
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	ticketdto "inventory-ticketing-system/application/dto/ticket"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/service"
+)
+
+type UpdateTicketUseCase struct {
+	ticketService service.TicketService
+}
+
+func NewUpdateTicketUseCase(ticketService service.TicketService) *UpdateTicketUseCase {
+	return &UpdateTicketUseCase{
+		ticketService: ticketService,
+	}
+}
+
+// Execute routes assignment, resolution and closing through their dedicated
+// service operations, and any other status change through UpdateTicket.
+func (uc *UpdateTicketUseCase) Execute(ctx context.Context, id uuid.UUID, req *ticketdto.UpdateTicketRequest) (*entity.Ticket, error) {
+	if req.AssignedTo != nil {
+		if err := uc.ticketService.AssignTicket(ctx, id, *req.AssignedTo); err != nil {
+			return nil, err
+		}
+	}
+
+	switch req.Status {
+	case "":
+	case "resolved":
+		if err := uc.ticketService.ResolveTicket(ctx, id, req.ResolutionComment); err != nil {
+			return nil, err
+		}
+	case "closed":
+		if err := uc.ticketService.CloseTicket(ctx, id); err != nil {
+			return nil, err
+		}
+	default:
+		ticket, err := uc.ticketService.GetTicket(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		ticket.Status = req.Status
+		if req.ResolutionComment != "" {
+			ticket.ResolutionComment = req.ResolutionComment
+		}
+		ticket.Asset = nil
+
+		if err := uc.ticketService.UpdateTicket(ctx, id, ticket); err != nil {
+			return nil, err
+		}
+	}
+
+	return uc.ticketService.GetTicket(ctx, id)
+}
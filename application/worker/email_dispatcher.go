@@ -0,0 +1,41 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"inventory-ticketing-system/domain/service"
+)
+
+// EmailDispatcher sends queued notification emails through the configured
+// mailer.
+type EmailDispatcher struct {
+	notificationService service.NotificationService
+	pollInterval        time.Duration
+}
+
+func NewEmailDispatcher(notificationService service.NotificationService, pollInterval time.Duration) *EmailDispatcher {
+	return &EmailDispatcher{
+		notificationService: notificationService,
+		pollInterval:        pollInterval,
+	}
+}
+
+func (d *EmailDispatcher) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(d.pollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if _, err := d.notificationService.DispatchDue(ctx); err != nil {
+					log.Printf("Failed to dispatch emails: %v", err)
+				}
+			}
+		}
+	}()
+}
@@ -0,0 +1,41 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"inventory-ticketing-system/domain/service"
+)
+
+// JobRunner asks the scheduler to start due jobs. Every instance runs one;
+// the scheduler's leases decide which instance runs each job.
+type JobRunner struct {
+	scheduler    service.JobScheduler
+	pollInterval time.Duration
+}
+
+func NewJobRunner(scheduler service.JobScheduler, pollInterval time.Duration) *JobRunner {
+	return &JobRunner{
+		scheduler:    scheduler,
+		pollInterval: pollInterval,
+	}
+}
+
+func (r *JobRunner) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(r.pollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if _, err := r.scheduler.RunDue(ctx); err != nil {
+					log.Printf("Failed to start scheduled jobs: %v", err)
+				}
+			}
+		}
+	}()
+}
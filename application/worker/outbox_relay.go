@@ -0,0 +1,123 @@
+// Package worker contains the background loops that run next to the HTTP
+// server.
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/repository"
+	"inventory-ticketing-system/domain/service"
+)
+
+const (
+	// outboxBatchSize bounds how many events one poll processes.
+	outboxBatchSize = 100
+	// outboxRetryDelay is how long a failed event waits before the next try.
+	outboxRetryDelay = time.Minute
+	// outboxRetention is how long processed events are kept for inspection.
+	outboxRetention = 7 * 24 * time.Hour
+	// outboxCleanupInterval is how often processed events are purged.
+	outboxCleanupInterval = time.Hour
+)
+
+// OutboxRelay hands committed outbox events to subscribers. Each event is
+// claimed, handled and marked processed in one transaction, so subscribers
+// see every event at least once even across crashes and multiple instances.
+type OutboxRelay struct {
+	outboxRepo   repository.OutboxRepository
+	txManager    repository.TransactionManager
+	subscribers  []service.EventSubscriber
+	pollInterval time.Duration
+}
+
+func NewOutboxRelay(
+	outboxRepo repository.OutboxRepository,
+	txManager repository.TransactionManager,
+	pollInterval time.Duration,
+	subscribers ...service.EventSubscriber,
+) *OutboxRelay {
+	return &OutboxRelay{
+		outboxRepo:   outboxRepo,
+		txManager:    txManager,
+		subscribers:  subscribers,
+		pollInterval: pollInterval,
+	}
+}
+
+func (r *OutboxRelay) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(r.pollInterval)
+		defer ticker.Stop()
+
+		lastCleanup := time.Now()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if _, err := r.ProcessBatch(ctx); err != nil {
+					log.Printf("Failed to relay outbox events: %v", err)
+				}
+
+				if time.Since(lastCleanup) >= outboxCleanupInterval {
+					lastCleanup = time.Now()
+					if _, err := r.outboxRepo.DeleteProcessedBefore(ctx, time.Now().Add(-outboxRetention)); err != nil {
+						log.Printf("Failed to purge processed outbox events: %v", err)
+					}
+				}
+			}
+		}
+	}()
+}
+
+// ProcessBatch relays due events until none are left or the batch is full
+// and returns how many were handled successfully.
+func (r *OutboxRelay) ProcessBatch(ctx context.Context) (int, error) {
+	processed := 0
+	for i := 0; i < outboxBatchSize; i++ {
+		done, err := r.processNext(ctx)
+		if err != nil {
+			return processed, err
+		}
+		if !done {
+			return processed, nil
+		}
+		processed++
+	}
+	return processed, nil
+}
+
+// processNext handles one event. It reports false when no event was due.
+func (r *OutboxRelay) processNext(ctx context.Context) (bool, error) {
+	var event *entity.OutboxEvent
+	var handlerErr error
+
+	err := r.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		event, err = r.outboxRepo.ClaimNext(ctx, time.Now())
+		if err != nil || event == nil {
+			return err
+		}
+
+		for _, subscriber := range r.subscribers {
+			if handlerErr = subscriber.HandleEvent(ctx, event); handlerErr != nil {
+				return handlerErr
+			}
+		}
+
+		return r.outboxRepo.MarkProcessed(ctx, event.ID, time.Now())
+	})
+
+	if handlerErr != nil {
+		// Subscriber writes were rolled back with the transaction
+		log.Printf("Outbox event %s (%s) failed, retrying later: %v", event.ID, event.EventType, handlerErr)
+		return true, r.outboxRepo.MarkFailed(ctx, event.ID, handlerErr.Error(), time.Now().Add(outboxRetryDelay))
+	}
+	if err != nil {
+		return false, err
+	}
+	return event != nil, nil
+}
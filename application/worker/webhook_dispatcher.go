@@ -0,0 +1,42 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"inventory-ticketing-system/domain/service"
+)
+
+// WebhookDispatcher sends queued webhook deliveries whose next attempt is
+// due. Deliveries are claimed with a lease, so several instances can run the
+// dispatcher without sending the same delivery twice.
+type WebhookDispatcher struct {
+	webhookService service.WebhookService
+	pollInterval   time.Duration
+}
+
+func NewWebhookDispatcher(webhookService service.WebhookService, pollInterval time.Duration) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		webhookService: webhookService,
+		pollInterval:   pollInterval,
+	}
+}
+
+func (d *WebhookDispatcher) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(d.pollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if _, err := d.webhookService.DispatchDue(ctx); err != nil {
+					log.Printf("Failed to dispatch webhook deliveries: %v", err)
+				}
+			}
+		}
+	}()
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -9,10 +10,14 @@ import (
 	"inventory-ticketing-system/application/usecase/asset"
 	"inventory-ticketing-system/application/usecase/auth"
 	"inventory-ticketing-system/application/usecase/ticket"
+	"inventory-ticketing-system/application/worker"
 	httpdelivery "inventory-ticketing-system/delivery/http"
 	"inventory-ticketing-system/delivery/http/handler"
+	"inventory-ticketing-system/domain/policy"
 	"inventory-ticketing-system/infrastructure/config"
 	"inventory-ticketing-system/infrastructure/jwt"
+	"inventory-ticketing-system/infrastructure/mail"
+	"inventory-ticketing-system/infrastructure/realtime"
 	"inventory-ticketing-system/pkg/database"
 
 	"github.com/joho/godotenv"
@@ -39,36 +44,382 @@ func main() {
 
 	log.Println("Database connected successfully")
 
-	// Initialize JWT manager
-	jwtManager := jwt.NewJWTManager(cfg.JWTSecret)
+	// Startup, workers and jobs run as the system; requests carry the
+	// caller's principal instead
+	ctx, cancel := context.WithCancel(policy.AsSystem(context.Background()))
+	defer cancel()
 
 	// Initialize repositories
 	userRepo := repository.NewUserRepository(db)
 	assetRepo := repository.NewAssetRepository(db)
 	ticketRepo := repository.NewTicketRepository(db)
 	locationRepo := repository.NewLocationRepository(db)
+	signingKeyRepo := repository.NewSigningKeyRepository(db)
+	accessTokenRepo := repository.NewPersonalAccessTokenRepository(db)
+	roleRepo := repository.NewRoleRepository(db)
+	roleAssignmentRepo := repository.NewRoleAssignmentRepository(db)
+	technicianRepo := repository.NewTechnicianRepository(db)
+	teamRepo := repository.NewTeamRepository(db)
+	departmentRepo := repository.NewDepartmentRepository(db)
+	outboxRepo := repository.NewOutboxRepository(db)
+	webhookEndpointRepo := repository.NewWebhookEndpointRepository(db)
+	webhookDeliveryRepo := repository.NewWebhookDeliveryRepository(db)
+	notificationTemplateRepo := repository.NewNotificationTemplateRepository(db)
+	notificationPreferenceRepo := repository.NewNotificationPreferenceRepository(db)
+	emailMessageRepo := repository.NewEmailMessageRepository(db)
+	notificationRepo := repository.NewNotificationRepository(db)
+	scheduledJobRepo := repository.NewScheduledJobRepository(db)
+	jobRunRepo := repository.NewJobRunRepository(db)
+	assetStatusChangeRepo := repository.NewAssetStatusChangeRepository(db)
+	maintenancePlanRepo := repository.NewMaintenancePlanRepository(db)
+	maintenanceScheduleRepo := repository.NewMaintenanceScheduleRepository(db)
+	maintenanceRecordRepo := repository.NewMaintenanceRecordRepository(db)
+	disposalRepo := repository.NewDisposalRepository(db)
+	depreciationPolicyRepo := repository.NewDepreciationPolicyRepository(db)
+	warrantyRepo := repository.NewWarrantyRepository(db)
+	supportContractRepo := repository.NewSupportContractRepository(db)
+	warrantyClaimRepo := repository.NewWarrantyClaimRepository(db)
+	vendorRepo := repository.NewVendorRepository(db)
+	manufacturerRepo := repository.NewManufacturerRepository(db)
+	purchaseRequestRepo := repository.NewPurchaseRequestRepository(db)
+	purchaseOrderRepo := repository.NewPurchaseOrderRepository(db)
+	goodsReceiptRepo := repository.NewGoodsReceiptRepository(db)
+	assetRequestRepo := repository.NewAssetRequestRepository(db)
+	approvalDelegationRepo := repository.NewApprovalDelegationRepository(db)
+	assetCheckoutRepo := repository.NewAssetCheckoutRepository(db)
+	reservationRepo := repository.NewReservationRepository(db)
+	calendarFeedTokenRepo := repository.NewCalendarFeedTokenRepository(db)
+	assetUnitRepo := repository.NewAssetUnitRepository(db)
+	assetUnitStatusChangeRepo := repository.NewAssetUnitStatusChangeRepository(db)
+	categoryTrackingModeRepo := repository.NewCategoryTrackingModeRepository(db)
+	reorderPointRepo := repository.NewReorderPointRepository(db)
+	stockMovementRepo := repository.NewStockMovementRepository(db)
+	stockAlertRepo := repository.NewStockAlertRepository(db)
+	stockLotRepo := repository.NewStockLotRepository(db)
+	kitRepo := repository.NewKitRepository(db)
+	kitCheckoutRepo := repository.NewKitCheckoutRepository(db)
+	assetRelationshipRepo := repository.NewAssetRelationshipRepository(db)
+	txManager := repository.NewTransactionManager(db)
+
+	// Initialize JWT manager
+	var jwtManager *jwt.JWTManager
+	if jwt.IsAsymmetricAlgorithm(cfg.JWTConfig.Algorithm) {
+		jwtManager, err = jwt.NewAsymmetricJWTManager(cfg.JWTConfig.Algorithm)
+		if err != nil {
+			log.Fatalf("Failed to initialize JWT manager: %v", err)
+		}
+
+		signingKeyService := service.NewSigningKeyService(
+			signingKeyRepo,
+			jwtManager,
+			cfg.JWTConfig.KeyRotationInterval,
+			cfg.JWTConfig.KeyOverlapWindow,
+			txManager,
+		)
+		if err := signingKeyService.LoadKeys(ctx); err != nil {
+			log.Fatalf("Failed to load JWT signing keys: %v", err)
+		}
+		signingKeyService.StartRotation(ctx)
+
+		log.Printf("JWT signing with %s, active kid %s", cfg.JWTConfig.Algorithm, jwtManager.ActiveKey().ID)
+	} else {
+		jwtManager = jwt.NewJWTManager(cfg.JWTSecret)
+	}
+
+	// Initialize mailer
+	mailer := mail.NewFileMailer(cfg.MailConfig.FileDir, cfg.MailConfig.From)
+	if cfg.MailConfig.Driver == "smtp" {
+		mailer = mail.NewSMTPMailer(
+			cfg.MailConfig.SMTPHost,
+			cfg.MailConfig.SMTPPort,
+			cfg.MailConfig.SMTPUsername,
+			cfg.MailConfig.SMTPPassword,
+			cfg.MailConfig.From,
+		)
+	}
 
 	// Initialize services
-	authService := service.NewAuthService(userRepo, jwtManager)
-	assetService := service.NewAssetService(assetRepo)
-	ticketService := service.NewTicketService(ticketRepo, assetRepo)
+	eventPublisher := service.NewEventPublisher(outboxRepo)
+	authService := service.NewAuthService(userRepo, jwtManager, cfg.JWTConfig.TokenLifetime)
+	assetService := service.NewAssetService(
+		assetRepo,
+		departmentRepo,
+		assetStatusChangeRepo,
+		assetUnitRepo,
+		assetUnitStatusChangeRepo,
+		stockMovementRepo,
+		stockLotRepo,
+		ticketRepo,
+		vendorRepo,
+		manufacturerRepo,
+		txManager,
+		eventPublisher,
+		cfg.AssetStatusRules(),
+	)
+	ticketService := service.NewTicketService(
+		ticketRepo,
+		technicianRepo,
+		assetRepo,
+		assetUnitRepo,
+		assetService,
+		txManager,
+		eventPublisher,
+		cfg.TicketConfig.AutoCloseAfter,
+	)
 	locationService := service.NewLocationService(locationRepo)
+	accessTokenService := service.NewAccessTokenService(accessTokenRepo, userRepo, roleRepo)
+	authorizationService := service.NewAuthorizationService(
+		roleRepo,
+		roleAssignmentRepo,
+		userRepo,
+		locationRepo,
+		departmentRepo,
+	)
+	departmentService := service.NewDepartmentService(departmentRepo, userRepo)
+	technicianService := service.NewTechnicianService(
+		technicianRepo,
+		teamRepo,
+		ticketRepo,
+		userRepo,
+		assetRepo,
+		ticketService,
+		txManager,
+		cfg.DefaultAssignmentStrategy(),
+	)
+	webhookService := service.NewWebhookService(
+		webhookEndpointRepo,
+		webhookDeliveryRepo,
+		cfg.WebhookConfig.Timeout,
+		cfg.WebhookConfig.MaxAttempts,
+	)
+	notificationService := service.NewNotificationService(
+		notificationTemplateRepo,
+		notificationPreferenceRepo,
+		emailMessageRepo,
+		notificationRepo,
+		userRepo,
+		ticketRepo,
+		technicianRepo,
+		departmentRepo,
+		txManager,
+		mailer,
+		cfg.NotificationConfig.DefaultLocale,
+		cfg.NotificationConfig.SLAWarningWindow,
+		cfg.NotificationConfig.SLACheckInterval,
+		cfg.NotificationConfig.InboxRetention,
+	)
+	maintenanceService := service.NewMaintenanceService(
+		maintenancePlanRepo,
+		maintenanceScheduleRepo,
+		maintenanceRecordRepo,
+		assetRepo,
+		technicianRepo,
+		ticketService,
+		technicianService,
+		txManager,
+	)
+	disposalService := service.NewDisposalService(disposalRepo, assetRepo, userRepo, assetService, txManager)
+	depreciationService := service.NewDepreciationService(depreciationPolicyRepo, assetRepo)
+	vendorService := service.NewVendorService(vendorRepo, assetRepo, ticketRepo, txManager)
+	manufacturerService := service.NewManufacturerService(manufacturerRepo, txManager)
+	procurementService := service.NewProcurementService(
+		purchaseRequestRepo,
+		purchaseOrderRepo,
+		goodsReceiptRepo,
+		vendorRepo,
+		assetRepo,
+		userRepo,
+		assetService,
+		txManager,
+	)
+	warrantyService := service.NewWarrantyService(
+		warrantyRepo,
+		supportContractRepo,
+		warrantyClaimRepo,
+		assetRepo,
+		ticketRepo,
+		userRepo,
+		departmentRepo,
+		notificationService,
+		txManager,
+		cfg.WarrantyConfig.ExpiryAlertDays,
+	)
+	assetRequestService := service.NewAssetRequestService(
+		assetRequestRepo,
+		approvalDelegationRepo,
+		assetCheckoutRepo,
+		assetRepo,
+		reservationRepo,
+		userRepo,
+		departmentRepo,
+		assetService,
+		notificationService,
+		txManager,
+		cfg.AssetRequestApprovalChain(),
+		cfg.AssetRequestConfig.ApprovalSLA,
+	)
+	reservationService := service.NewReservationService(reservationRepo, assetRepo, userRepo, assetService, txManager)
+	calendarFeedService := service.NewCalendarFeedService(calendarFeedTokenRepo, assetRepo, userRepo)
+	assetUnitService := service.NewAssetUnitService(
+		assetUnitRepo,
+		assetUnitStatusChangeRepo,
+		categoryTrackingModeRepo,
+		stockLotRepo,
+		assetRepo,
+		userRepo,
+		locationRepo,
+		assetService,
+		txManager,
+	)
+	stockService := service.NewStockService(
+		reorderPointRepo,
+		stockMovementRepo,
+		stockAlertRepo,
+		stockLotRepo,
+		assetUnitRepo,
+		assetRepo,
+		departmentRepo,
+		assetService,
+		notificationService,
+		procurementService,
+		txManager,
+		cfg.InventoryConfig.ConsumptionDays,
+	)
+	kitService := service.NewKitService(
+		kitRepo,
+		kitCheckoutRepo,
+		assetCheckoutRepo,
+		assetUnitRepo,
+		reservationRepo,
+		assetRepo,
+		userRepo,
+		assetService,
+		txManager,
+	)
+	assetRelationshipService := service.NewAssetRelationshipService(
+		assetRelationshipRepo,
+		assetRepo,
+		assetService,
+		txManager,
+	)
+
+	broker := realtime.NewPostgresBroker(db, cfg.GetDatabaseDSN())
+	broker.Start(ctx)
+	realtimeService := service.NewRealtimeService(assetRepo, locationRepo, broker)
+
+	if err := authorizationService.EnsureSystemRoles(ctx); err != nil {
+		log.Fatalf("Failed to create system roles: %v", err)
+	}
+	if err := notificationService.EnsureDefaultTemplates(ctx); err != nil {
+		log.Fatalf("Failed to create notification templates: %v", err)
+	}
+
+	// Register recurring jobs
+	jobScheduler := service.NewJobScheduler(scheduledJobRepo, jobRunRepo)
+	if err := jobScheduler.Register(ctx, jobScheduler, notificationService, ticketService, accessTokenService, maintenanceService, warrantyService, assetRequestService, reservationService, stockService); err != nil {
+		log.Fatalf("Failed to register jobs: %v", err)
+	}
+
+	// Start background workers
+	worker.NewOutboxRelay(outboxRepo, txManager, cfg.WorkerConfig.PollInterval, webhookService, notificationService, realtimeService, maintenanceService, stockService).Start(ctx)
+	worker.NewWebhookDispatcher(webhookService, cfg.WorkerConfig.PollInterval).Start(ctx)
+	worker.NewEmailDispatcher(notificationService, cfg.WorkerConfig.PollInterval).Start(ctx)
+	worker.NewJobRunner(jobScheduler, cfg.WorkerConfig.PollInterval).Start(ctx)
 
 	// Initialize use cases
 	loginUseCase := auth.NewLoginUseCase(authService)
 	createAssetUseCase := asset.NewCreateAssetUseCase(assetService)
 	listAssetsUseCase := asset.NewListAssetsUseCase(assetService)
-	createTicketUseCase := ticket.NewCreateTicketUseCase(ticketService)
+	getAssetUseCase := asset.NewGetAssetUseCase(assetService)
+	updateAssetUseCase := asset.NewUpdateAssetUseCase(assetService, assetRelationshipService)
+	deleteAssetUseCase := asset.NewDeleteAssetUseCase(assetService)
+	updateAssetStatusUseCase := asset.NewUpdateAssetStatusUseCase(assetService)
+	getAssetHistoryUseCase := asset.NewGetAssetHistoryUseCase(assetService)
+	assetStatusReportUseCase := asset.NewAssetStatusReportUseCase(assetService)
+	createTicketUseCase := ticket.NewCreateTicketUseCase(ticketService, technicianService, warrantyService, txManager)
 	listTicketsUseCase := ticket.NewListTicketsUseCase(ticketService)
+	getTicketUseCase := ticket.NewGetTicketUseCase(ticketService)
+	updateTicketUseCase := ticket.NewUpdateTicketUseCase(ticketService)
+	deleteTicketUseCase := ticket.NewDeleteTicketUseCase(ticketService)
+	ticketQueueUseCase := ticket.NewGetTicketQueueUseCase(technicianService)
 
 	// Initialize handlers
 	authHandler := handler.NewAuthHandler(loginUseCase)
-	assetHandler := handler.NewAssetHandler(createAssetUseCase, listAssetsUseCase)
-	ticketHandler := handler.NewTicketHandler(createTicketUseCase, listTicketsUseCase)
+	assetHandler := handler.NewAssetHandler(
+		createAssetUseCase,
+		listAssetsUseCase,
+		getAssetUseCase,
+		updateAssetUseCase,
+		deleteAssetUseCase,
+		updateAssetStatusUseCase,
+		getAssetHistoryUseCase,
+		assetStatusReportUseCase,
+	)
+	ticketHandler := handler.NewTicketHandler(
+		createTicketUseCase,
+		listTicketsUseCase,
+		getTicketUseCase,
+		updateTicketUseCase,
+		deleteTicketUseCase,
+		ticketQueueUseCase,
+	)
 	locationHandler := handler.NewLocationHandler(locationService)
+	jwksHandler := handler.NewJWKSHandler(jwtManager)
+	tokenHandler := handler.NewTokenHandler(accessTokenService)
+	roleHandler := handler.NewRoleHandler(authorizationService)
+	technicianHandler := handler.NewTechnicianHandler(technicianService)
+	departmentHandler := handler.NewDepartmentHandler(departmentService)
+	webhookHandler := handler.NewWebhookHandler(webhookService)
+	notificationHandler := handler.NewNotificationHandler(notificationService)
+	eventStreamHandler := handler.NewEventStreamHandler(realtimeService)
+	jobHandler := handler.NewJobHandler(jobScheduler)
+	maintenanceHandler := handler.NewMaintenanceHandler(maintenanceService)
+	disposalHandler := handler.NewDisposalHandler(disposalService)
+	depreciationHandler := handler.NewDepreciationHandler(depreciationService)
+	warrantyHandler := handler.NewWarrantyHandler(warrantyService)
+	vendorHandler := handler.NewVendorHandler(vendorService)
+	manufacturerHandler := handler.NewManufacturerHandler(manufacturerService)
+	procurementHandler := handler.NewProcurementHandler(procurementService)
+	assetRequestHandler := handler.NewAssetRequestHandler(assetRequestService)
+	reservationHandler := handler.NewReservationHandler(reservationService, calendarFeedService)
+	assetUnitHandler := handler.NewAssetUnitHandler(assetUnitService)
+	inventoryHandler := handler.NewInventoryHandler(stockService)
+	kitHandler := handler.NewKitHandler(kitService)
+	assetRelationshipHandler := handler.NewAssetRelationshipHandler(assetRelationshipService)
 
 	// Initialize router
-	router := httpdelivery.NewRouter(authHandler, assetHandler, ticketHandler, locationHandler, jwtManager)
+	router := httpdelivery.NewRouter(
+		authHandler,
+		assetHandler,
+		ticketHandler,
+		locationHandler,
+		jwksHandler,
+		tokenHandler,
+		roleHandler,
+		technicianHandler,
+		departmentHandler,
+		webhookHandler,
+		notificationHandler,
+		eventStreamHandler,
+		jobHandler,
+		maintenanceHandler,
+		disposalHandler,
+		depreciationHandler,
+		warrantyHandler,
+		vendorHandler,
+		manufacturerHandler,
+		procurementHandler,
+		assetRequestHandler,
+		reservationHandler,
+		assetUnitHandler,
+		inventoryHandler,
+		kitHandler,
+		assetRelationshipHandler,
+		jwtManager,
+		accessTokenService,
+		authorizationService,
+		calendarFeedService,
+	)
 
 	// Start server
 	addr := fmt.Sprintf(":%s", cfg.ServerPort)
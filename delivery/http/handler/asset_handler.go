@@ -12,17 +12,35 @@ import (
 )
 
 type AssetHandler struct {
-	createAssetUseCase *assetusecase.CreateAssetUseCase
-	listAssetsUseCase  *assetusecase.ListAssetsUseCase
+	createAssetUseCase  *assetusecase.CreateAssetUseCase
+	listAssetsUseCase   *assetusecase.ListAssetsUseCase
+	getAssetUseCase     *assetusecase.GetAssetUseCase
+	updateAssetUseCase  *assetusecase.UpdateAssetUseCase
+	deleteAssetUseCase  *assetusecase.DeleteAssetUseCase
+	updateStatusUseCase *assetusecase.UpdateAssetStatusUseCase
+	historyUseCase      *assetusecase.GetAssetHistoryUseCase
+	statusReportUseCase *assetusecase.AssetStatusReportUseCase
 }
 
 func NewAssetHandler(
 	createAssetUseCase *assetusecase.CreateAssetUseCase,
 	listAssetsUseCase *assetusecase.ListAssetsUseCase,
+	getAssetUseCase *assetusecase.GetAssetUseCase,
+	updateAssetUseCase *assetusecase.UpdateAssetUseCase,
+	deleteAssetUseCase *assetusecase.DeleteAssetUseCase,
+	updateStatusUseCase *assetusecase.UpdateAssetStatusUseCase,
+	historyUseCase *assetusecase.GetAssetHistoryUseCase,
+	statusReportUseCase *assetusecase.AssetStatusReportUseCase,
 ) *AssetHandler {
 	return &AssetHandler{
-		createAssetUseCase: createAssetUseCase,
-		listAssetsUseCase:  listAssetsUseCase,
+		createAssetUseCase:  createAssetUseCase,
+		listAssetsUseCase:   listAssetsUseCase,
+		getAssetUseCase:     getAssetUseCase,
+		updateAssetUseCase:  updateAssetUseCase,
+		deleteAssetUseCase:  deleteAssetUseCase,
+		updateStatusUseCase: updateStatusUseCase,
+		historyUseCase:      historyUseCase,
+		statusReportUseCase: statusReportUseCase,
 	}
 }
 
@@ -35,7 +53,7 @@ func (h *AssetHandler) Create(c *gin.Context) {
 
 	asset, err := h.createAssetUseCase.Execute(c.Request.Context(), &req)
 	if err != nil {
-		common.SendError(c, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
 		return
 	}
 
@@ -51,9 +69,13 @@ func (h *AssetHandler) Get(c *gin.Context) {
 		return
 	}
 
-	// Note: Implement GetAssetUseCase for this functionality
-	// For now, return a simple response
-	common.SendSuccess(c, http.StatusOK, "Asset retrieved successfully", gin.H{"id": id})
+	asset, err := h.getAssetUseCase.Execute(c.Request.Context(), id)
+	if err != nil {
+		sendServiceError(c, err, http.StatusNotFound, "NOT_FOUND")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Asset retrieved successfully", assetdto.AssetResponse{Asset: asset})
 }
 
 func (h *AssetHandler) List(c *gin.Context) {
@@ -84,10 +106,28 @@ func (h *AssetHandler) List(c *gin.Context) {
 	if brand := c.Query("brand"); brand != "" {
 		filters["brand"] = brand
 	}
+	if departmentID := c.Query("departmentId"); departmentID != "" {
+		if id, err := uuid.Parse(departmentID); err == nil {
+			filters["department_id"] = id
+		}
+	}
+	for param, filter := range map[string]string{
+		"manufacturerId":   "manufacturer_id",
+		"purchaseVendorId": "purchase_vendor_id",
+		"serviceVendorId":  "service_vendor_id",
+		"purchaseOrderId":  "purchase_order_id",
+	} {
+		if id, err := uuid.Parse(c.Query(param)); err == nil {
+			filters[filter] = id
+		}
+	}
+	if c.Query("includeDisposed") == "true" {
+		filters["include_disposed"] = true
+	}
 
 	response, err := h.listAssetsUseCase.Execute(c.Request.Context(), limit, offset, filters)
 	if err != nil {
-		common.SendError(c, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error(), nil)
+		sendServiceError(c, err, http.StatusInternalServerError, "INTERNAL_ERROR")
 		return
 	}
 
@@ -110,24 +150,95 @@ func (h *AssetHandler) List(c *gin.Context) {
 
 func (h *AssetHandler) Update(c *gin.Context) {
 	idStr := c.Param("id")
-	_, err := uuid.Parse(idStr)
+	id, err := uuid.Parse(idStr)
 	if err != nil {
 		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid asset ID", nil)
 		return
 	}
 
-	// Note: Implement UpdateAssetUseCase for this functionality
-	common.SendSuccess(c, http.StatusOK, "Asset updated successfully", gin.H{"id": idStr})
+	var req assetdto.UpdateAssetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	asset, err := h.updateAssetUseCase.Execute(c.Request.Context(), id, &req)
+	if err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Asset updated successfully", assetdto.AssetResponse{Asset: asset})
 }
 
 func (h *AssetHandler) Delete(c *gin.Context) {
 	idStr := c.Param("id")
-	_, err := uuid.Parse(idStr)
+	id, err := uuid.Parse(idStr)
 	if err != nil {
 		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid asset ID", nil)
 		return
 	}
 
-	// Note: Implement DeleteAssetUseCase for this functionality
+	if err := h.deleteAssetUseCase.Execute(c.Request.Context(), id); err != nil {
+		sendServiceError(c, err, http.StatusNotFound, "NOT_FOUND")
+		return
+	}
+
 	common.SendSuccess(c, http.StatusOK, "Asset deleted successfully", gin.H{"id": idStr})
 }
+
+// UpdateStatus moves the asset along the status lifecycle.
+func (h *AssetHandler) UpdateStatus(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid asset ID", nil)
+		return
+	}
+
+	var req assetdto.UpdateAssetStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	asset, err := h.updateStatusUseCase.Execute(c.Request.Context(), id, &req)
+	if err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Asset status updated successfully", assetdto.AssetResponse{Asset: asset})
+}
+
+func (h *AssetHandler) History(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid asset ID", nil)
+		return
+	}
+
+	history, err := h.historyUseCase.Execute(c.Request.Context(), id)
+	if err != nil {
+		sendServiceError(c, err, http.StatusNotFound, "NOT_FOUND")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Asset history retrieved successfully", history)
+}
+
+// StatusReport returns the time each asset spent in each status.
+func (h *AssetHandler) StatusReport(c *gin.Context) {
+	var req assetdto.StatusReportRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	report, err := h.statusReportUseCase.Execute(c.Request.Context(), &req)
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Asset status report generated successfully", report)
+}
@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	relationshipdto "inventory-ticketing-system/application/dto/assetrelationship"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/service"
+	"inventory-ticketing-system/pkg/common"
+)
+
+type AssetRelationshipHandler struct {
+	relationshipService service.AssetRelationshipService
+}
+
+func NewAssetRelationshipHandler(relationshipService service.AssetRelationshipService) *AssetRelationshipHandler {
+	return &AssetRelationshipHandler{
+		relationshipService: relationshipService,
+	}
+}
+
+func (h *AssetRelationshipHandler) Attach(c *gin.Context) {
+	assetID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid asset ID", nil)
+		return
+	}
+
+	var req relationshipdto.AttachRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	relationship := &entity.AssetRelationship{
+		ID:          uuid.New(),
+		FromAssetID: assetID,
+		ToAssetID:   req.RelatedAssetID,
+		Type:        req.Type,
+		Notes:       req.Notes,
+	}
+	if err := h.relationshipService.Attach(c.Request.Context(), relationship); err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusCreated, "Assets related successfully", relationship)
+}
+
+func (h *AssetRelationshipHandler) Detach(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid relationship ID", nil)
+		return
+	}
+
+	if err := h.relationshipService.Detach(c.Request.Context(), id); err != nil {
+		sendServiceError(c, err, http.StatusNotFound, "NOT_FOUND")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Relationship removed successfully", gin.H{"id": idStr})
+}
+
+func (h *AssetRelationshipHandler) ListByAsset(c *gin.Context) {
+	assetID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid asset ID", nil)
+		return
+	}
+
+	relationships, err := h.relationshipService.ListRelationships(c.Request.Context(), assetID)
+	if err != nil {
+		sendServiceError(c, err, http.StatusNotFound, "NOT_FOUND")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Relationships retrieved successfully", gin.H{
+		"relationships": relationships,
+	})
+}
+
+// Graph returns the assets and relationships reachable from the asset
+// within the requested depth.
+func (h *AssetRelationshipHandler) Graph(c *gin.Context) {
+	assetID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid asset ID", nil)
+		return
+	}
+
+	var req relationshipdto.GraphRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	graph, err := h.relationshipService.GetGraph(c.Request.Context(), assetID, req.Depth)
+	if err != nil {
+		sendServiceError(c, err, http.StatusNotFound, "NOT_FOUND")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Relationship graph retrieved successfully", graph)
+}
@@ -0,0 +1,270 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	assetrequestdto "inventory-ticketing-system/application/dto/assetrequest"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/service"
+	"inventory-ticketing-system/pkg/common"
+)
+
+type AssetRequestHandler struct {
+	assetRequestService service.AssetRequestService
+}
+
+func NewAssetRequestHandler(assetRequestService service.AssetRequestService) *AssetRequestHandler {
+	return &AssetRequestHandler{
+		assetRequestService: assetRequestService,
+	}
+}
+
+func (h *AssetRequestHandler) Create(c *gin.Context) {
+	assetID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid asset ID", nil)
+		return
+	}
+
+	var req assetrequestdto.CreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	request := &entity.AssetRequest{
+		ID:       uuid.New(),
+		AssetID:  assetID,
+		Quantity: req.Quantity,
+		Reason:   req.Reason,
+		ReturnBy: req.ReturnBy,
+	}
+	if err := h.assetRequestService.CreateRequest(c.Request.Context(), request); err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusCreated, "Asset request created successfully", request)
+}
+
+func (h *AssetRequestHandler) Get(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid asset request ID", nil)
+		return
+	}
+
+	request, err := h.assetRequestService.GetRequest(c.Request.Context(), id)
+	if err != nil {
+		sendServiceError(c, err, http.StatusNotFound, "NOT_FOUND")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Asset request retrieved successfully", request)
+}
+
+func (h *AssetRequestHandler) List(c *gin.Context) {
+	var req assetrequestdto.ListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	filters := make(map[string]interface{})
+	if req.Status != "" {
+		filters["status"] = req.Status
+	}
+	if req.AssetID != "" {
+		filters["asset_id"] = req.AssetID
+	}
+	if req.Awaiting {
+		filters["awaiting"] = true
+	}
+
+	requests, total, err := h.assetRequestService.ListRequests(c.Request.Context(), req.Limit, req.Offset, filters)
+	if err != nil {
+		sendServiceError(c, err, http.StatusInternalServerError, "INTERNAL_ERROR")
+		return
+	}
+
+	pagination := common.PaginationInfo{
+		Total:   total,
+		Limit:   req.Limit,
+		Offset:  req.Offset,
+		HasMore: total > (req.Offset + req.Limit),
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Asset requests retrieved successfully", gin.H{
+		"requests":   requests,
+		"pagination": pagination,
+	})
+}
+
+func (h *AssetRequestHandler) Approve(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid asset request ID", nil)
+		return
+	}
+
+	var req assetrequestdto.DecisionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	request, err := h.assetRequestService.ApproveRequest(c.Request.Context(), id, req.Comment)
+	if err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Asset request approved successfully", request)
+}
+
+func (h *AssetRequestHandler) Reject(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid asset request ID", nil)
+		return
+	}
+
+	var req assetrequestdto.DecisionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	request, err := h.assetRequestService.RejectRequest(c.Request.Context(), id, req.Comment)
+	if err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Asset request rejected successfully", request)
+}
+
+func (h *AssetRequestHandler) Cancel(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid asset request ID", nil)
+		return
+	}
+
+	request, err := h.assetRequestService.CancelRequest(c.Request.Context(), id)
+	if err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Asset request cancelled successfully", request)
+}
+
+func (h *AssetRequestHandler) CreateDelegation(c *gin.Context) {
+	var req assetrequestdto.DelegationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	delegation := &entity.ApprovalDelegation{
+		ID:         uuid.New(),
+		DelegateID: req.DelegateID,
+		EndsAt:     req.EndsAt,
+		Reason:     req.Reason,
+	}
+	if req.StartsAt != nil {
+		delegation.StartsAt = *req.StartsAt
+	}
+
+	if err := h.assetRequestService.CreateDelegation(c.Request.Context(), delegation); err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusCreated, "Delegation created successfully", delegation)
+}
+
+func (h *AssetRequestHandler) ListDelegations(c *gin.Context) {
+	delegations, err := h.assetRequestService.ListDelegations(c.Request.Context())
+	if err != nil {
+		sendServiceError(c, err, http.StatusInternalServerError, "INTERNAL_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Delegations retrieved successfully", delegations)
+}
+
+func (h *AssetRequestHandler) DeleteDelegation(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid delegation ID", nil)
+		return
+	}
+
+	if err := h.assetRequestService.DeleteDelegation(c.Request.Context(), id); err != nil {
+		sendServiceError(c, err, http.StatusNotFound, "NOT_FOUND")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Delegation deleted successfully", gin.H{"id": id})
+}
+
+func (h *AssetRequestHandler) ListCheckouts(c *gin.Context) {
+	var req assetrequestdto.CheckoutListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	filters := make(map[string]interface{})
+	if req.AssetID != "" {
+		filters["asset_id"] = req.AssetID
+	}
+	if req.UserID != "" {
+		filters["user_id"] = req.UserID
+	}
+	if req.Open != nil {
+		filters["open"] = *req.Open
+	}
+	if req.Overdue {
+		filters["overdue_at"] = time.Now()
+	}
+
+	checkouts, total, err := h.assetRequestService.ListCheckouts(c.Request.Context(), req.Limit, req.Offset, filters)
+	if err != nil {
+		sendServiceError(c, err, http.StatusInternalServerError, "INTERNAL_ERROR")
+		return
+	}
+
+	pagination := common.PaginationInfo{
+		Total:   total,
+		Limit:   req.Limit,
+		Offset:  req.Offset,
+		HasMore: total > (req.Offset + req.Limit),
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Checkouts retrieved successfully", gin.H{
+		"checkouts":  checkouts,
+		"pagination": pagination,
+	})
+}
+
+func (h *AssetRequestHandler) ReturnCheckout(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid checkout ID", nil)
+		return
+	}
+
+	checkout, err := h.assetRequestService.ReturnCheckout(c.Request.Context(), id)
+	if err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Checkout returned successfully", checkout)
+}
@@ -0,0 +1,272 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	assetunitdto "inventory-ticketing-system/application/dto/assetunit"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/service"
+	"inventory-ticketing-system/pkg/common"
+)
+
+type AssetUnitHandler struct {
+	unitService service.AssetUnitService
+}
+
+func NewAssetUnitHandler(unitService service.AssetUnitService) *AssetUnitHandler {
+	return &AssetUnitHandler{
+		unitService: unitService,
+	}
+}
+
+func (h *AssetUnitHandler) Create(c *gin.Context) {
+	assetID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid asset ID", nil)
+		return
+	}
+
+	var req assetunitdto.CreateUnitRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	unit := &entity.AssetUnit{
+		ID:           uuid.New(),
+		AssetID:      assetID,
+		SerialNumber: req.SerialNumber,
+		Status:       req.Status,
+		LocationID:   req.LocationID,
+		CustodianID:  req.CustodianID,
+		Notes:        req.Notes,
+	}
+	if err := h.unitService.CreateUnit(c.Request.Context(), unit); err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusCreated, "Unit created successfully", unit)
+}
+
+// ListByAsset lists the units of the asset in the path.
+func (h *AssetUnitHandler) ListByAsset(c *gin.Context) {
+	assetID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid asset ID", nil)
+		return
+	}
+	h.list(c, map[string]interface{}{"asset_id": assetID})
+}
+
+func (h *AssetUnitHandler) List(c *gin.Context) {
+	h.list(c, make(map[string]interface{}))
+}
+
+func (h *AssetUnitHandler) list(c *gin.Context, filters map[string]interface{}) {
+	var req assetunitdto.UnitListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	if req.AssetID != "" {
+		if _, ok := filters["asset_id"]; !ok {
+			filters["asset_id"] = req.AssetID
+		}
+	}
+	if req.SerialNumber != "" {
+		filters["serial_number"] = req.SerialNumber
+	}
+	if req.Status != "" {
+		filters["status"] = req.Status
+	}
+	if req.CustodianID != "" {
+		filters["custodian_id"] = req.CustodianID
+	}
+	if req.LocationID != "" {
+		filters["location_id"] = req.LocationID
+	}
+
+	units, total, err := h.unitService.ListUnits(c.Request.Context(), req.Limit, req.Offset, filters)
+	if err != nil {
+		sendServiceError(c, err, http.StatusInternalServerError, "INTERNAL_ERROR")
+		return
+	}
+
+	pagination := common.PaginationInfo{
+		Total:   total,
+		Limit:   req.Limit,
+		Offset:  req.Offset,
+		HasMore: total > (req.Offset + req.Limit),
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Units retrieved successfully", gin.H{
+		"units":      units,
+		"pagination": pagination,
+	})
+}
+
+func (h *AssetUnitHandler) Get(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid unit ID", nil)
+		return
+	}
+
+	unit, err := h.unitService.GetUnit(c.Request.Context(), id)
+	if err != nil {
+		sendServiceError(c, err, http.StatusNotFound, "NOT_FOUND")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Unit retrieved successfully", unit)
+}
+
+func (h *AssetUnitHandler) Update(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid unit ID", nil)
+		return
+	}
+
+	var req assetunitdto.UpdateUnitRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	unit, err := h.unitService.UpdateUnit(c.Request.Context(), id, &entity.AssetUnit{
+		SerialNumber: req.SerialNumber,
+		LocationID:   req.LocationID,
+		CustodianID:  req.CustodianID,
+		Notes:        req.Notes,
+	})
+	if err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Unit updated successfully", unit)
+}
+
+// UpdateStatus moves the unit along the status lifecycle.
+func (h *AssetUnitHandler) UpdateStatus(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid unit ID", nil)
+		return
+	}
+
+	var req assetunitdto.UpdateUnitStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	unit, err := h.unitService.UpdateUnitStatus(c.Request.Context(), id, req.Status, req.Reason)
+	if err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Unit status updated successfully", unit)
+}
+
+func (h *AssetUnitHandler) Delete(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid unit ID", nil)
+		return
+	}
+
+	if err := h.unitService.DeleteUnit(c.Request.Context(), id); err != nil {
+		sendServiceError(c, err, http.StatusNotFound, "NOT_FOUND")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Unit deleted successfully", gin.H{"id": idStr})
+}
+
+func (h *AssetUnitHandler) History(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid unit ID", nil)
+		return
+	}
+
+	history, err := h.unitService.GetUnitHistory(c.Request.Context(), id)
+	if err != nil {
+		sendServiceError(c, err, http.StatusNotFound, "NOT_FOUND")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Unit history retrieved successfully", history)
+}
+
+func (h *AssetUnitHandler) CreateTrackingMode(c *gin.Context) {
+	var req assetunitdto.TrackingModeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	mode := &entity.CategoryTrackingMode{ID: uuid.New(), Category: req.Category, Mode: req.Mode}
+	if err := h.unitService.CreateTrackingMode(c.Request.Context(), mode); err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusCreated, "Tracking mode created successfully", mode)
+}
+
+func (h *AssetUnitHandler) UpdateTrackingMode(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid tracking mode ID", nil)
+		return
+	}
+
+	var req assetunitdto.TrackingModeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	mode := &entity.CategoryTrackingMode{Category: req.Category, Mode: req.Mode}
+	if err := h.unitService.UpdateTrackingMode(c.Request.Context(), id, mode); err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Tracking mode updated successfully", mode)
+}
+
+func (h *AssetUnitHandler) DeleteTrackingMode(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid tracking mode ID", nil)
+		return
+	}
+
+	if err := h.unitService.DeleteTrackingMode(c.Request.Context(), id); err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Tracking mode deleted successfully", gin.H{"id": idStr})
+}
+
+func (h *AssetUnitHandler) ListTrackingModes(c *gin.Context) {
+	modes, err := h.unitService.ListTrackingModes(c.Request.Context())
+	if err != nil {
+		sendServiceError(c, err, http.StatusInternalServerError, "INTERNAL_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Tracking modes retrieved successfully", modes)
+}
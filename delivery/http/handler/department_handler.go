@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	departmentdto "inventory-ticketing-system/application/dto/department"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/service"
+	"inventory-ticketing-system/pkg/common"
+)
+
+type DepartmentHandler struct {
+	departmentService service.DepartmentService
+}
+
+func NewDepartmentHandler(departmentService service.DepartmentService) *DepartmentHandler {
+	return &DepartmentHandler{
+		departmentService: departmentService,
+	}
+}
+
+func (h *DepartmentHandler) Create(c *gin.Context) {
+	var req departmentdto.DepartmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	department := &entity.Department{
+		ID:             uuid.New(),
+		ParentID:       req.GetParentID(),
+		Name:           req.Name,
+		CostCenterCode: req.CostCenterCode,
+		ManagerID:      req.GetManagerID(),
+		Description:    req.Description,
+	}
+
+	if err := h.departmentService.CreateDepartment(c.Request.Context(), department); err != nil {
+		common.SendError(c, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusCreated, "Department created successfully", department)
+}
+
+func (h *DepartmentHandler) Get(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid department ID", nil)
+		return
+	}
+
+	department, err := h.departmentService.GetDepartment(c.Request.Context(), id)
+	if err != nil {
+		common.SendError(c, http.StatusNotFound, "NOT_FOUND", "Department not found", nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Department retrieved successfully", department)
+}
+
+func (h *DepartmentHandler) List(c *gin.Context) {
+	departments, err := h.departmentService.ListDepartments(c.Request.Context())
+	if err != nil {
+		common.SendError(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve departments", nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Departments retrieved successfully", departmentdto.DepartmentListResponse{
+		Departments: departments,
+	})
+}
+
+func (h *DepartmentHandler) Update(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid department ID", nil)
+		return
+	}
+
+	var req departmentdto.DepartmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	department := &entity.Department{
+		ParentID:       req.GetParentID(),
+		Name:           req.Name,
+		CostCenterCode: req.CostCenterCode,
+		ManagerID:      req.GetManagerID(),
+		Description:    req.Description,
+	}
+
+	if err := h.departmentService.UpdateDepartment(c.Request.Context(), id, department); err != nil {
+		common.SendError(c, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Department updated successfully", department)
+}
+
+func (h *DepartmentHandler) Delete(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid department ID", nil)
+		return
+	}
+
+	if err := h.departmentService.DeleteDepartment(c.Request.Context(), id); err != nil {
+		common.SendError(c, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Department deleted successfully", gin.H{"id": id})
+}
+
+func (h *DepartmentHandler) SetUserDepartment(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid user ID", nil)
+		return
+	}
+
+	var req departmentdto.SetUserDepartmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	departmentID := req.GetDepartmentID()
+	if err := h.departmentService.SetUserDepartment(c.Request.Context(), userID, departmentID); err != nil {
+		common.SendError(c, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "User department updated successfully", gin.H{"id": userID, "departmentId": departmentID})
+}
+
+// Report returns asset and ticket volume per department for chargeback.
+func (h *DepartmentHandler) Report(c *gin.Context) {
+	var req departmentdto.DepartmentReportRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	summaries, err := h.departmentService.GetReport(c.Request.Context(), req.From, req.To)
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Department report generated successfully", departmentdto.DepartmentReportResponse{
+		From:        req.From,
+		To:          req.To,
+		Departments: summaries,
+	})
+}
@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	depreciationdto "inventory-ticketing-system/application/dto/depreciation"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/service"
+	"inventory-ticketing-system/pkg/common"
+)
+
+type DepreciationHandler struct {
+	depreciationService service.DepreciationService
+}
+
+func NewDepreciationHandler(depreciationService service.DepreciationService) *DepreciationHandler {
+	return &DepreciationHandler{
+		depreciationService: depreciationService,
+	}
+}
+
+func (h *DepreciationHandler) CreatePolicy(c *gin.Context) {
+	var req depreciationdto.PolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	policy := policyFromRequest(&req)
+	policy.ID = uuid.New()
+	if err := h.depreciationService.CreatePolicy(c.Request.Context(), policy); err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusCreated, "Depreciation policy created successfully", policy)
+}
+
+func (h *DepreciationHandler) GetPolicy(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid depreciation policy ID", nil)
+		return
+	}
+
+	policy, err := h.depreciationService.GetPolicy(c.Request.Context(), id)
+	if err != nil {
+		common.SendError(c, http.StatusNotFound, "NOT_FOUND", "Depreciation policy not found", nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Depreciation policy retrieved successfully", policy)
+}
+
+func (h *DepreciationHandler) ListPolicies(c *gin.Context) {
+	policies, err := h.depreciationService.ListPolicies(c.Request.Context())
+	if err != nil {
+		common.SendError(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve depreciation policies", nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Depreciation policies retrieved successfully", policies)
+}
+
+func (h *DepreciationHandler) UpdatePolicy(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid depreciation policy ID", nil)
+		return
+	}
+
+	var req depreciationdto.PolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	policy := policyFromRequest(&req)
+	if err := h.depreciationService.UpdatePolicy(c.Request.Context(), id, policy); err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Depreciation policy updated successfully", policy)
+}
+
+func (h *DepreciationHandler) DeletePolicy(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid depreciation policy ID", nil)
+		return
+	}
+
+	if err := h.depreciationService.DeletePolicy(c.Request.Context(), id); err != nil {
+		common.SendError(c, http.StatusNotFound, "NOT_FOUND", "Depreciation policy not found", nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Depreciation policy deleted successfully", gin.H{"id": id})
+}
+
+func (h *DepreciationHandler) Schedule(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid asset ID", nil)
+		return
+	}
+
+	schedule, err := h.depreciationService.GetSchedule(c.Request.Context(), id)
+	if err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Depreciation schedule retrieved successfully", schedule)
+}
+
+func (h *DepreciationHandler) Valuation(c *gin.Context) {
+	var req depreciationdto.ValuationRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	at := time.Now()
+	if req.At != nil {
+		at = *req.At
+	}
+
+	valuation, err := h.depreciationService.GetValuation(c.Request.Context(), at)
+	if err != nil {
+		common.SendError(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to value assets", nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Asset valuation retrieved successfully", valuation)
+}
+
+func policyFromRequest(req *depreciationdto.PolicyRequest) *entity.DepreciationPolicy {
+	return &entity.DepreciationPolicy{
+		Category:        req.Category,
+		Method:          req.Method,
+		UsefulLifeYears: req.UsefulLifeYears,
+		SalvagePercent:  req.SalvagePercent,
+		DecliningRate:   req.DecliningRate,
+	}
+}
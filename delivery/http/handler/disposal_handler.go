@@ -0,0 +1,209 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	disposaldto "inventory-ticketing-system/application/dto/disposal"
+	"inventory-ticketing-system/delivery/http/middleware"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/service"
+	"inventory-ticketing-system/pkg/common"
+)
+
+type DisposalHandler struct {
+	disposalService service.DisposalService
+}
+
+func NewDisposalHandler(disposalService service.DisposalService) *DisposalHandler {
+	return &DisposalHandler{
+		disposalService: disposalService,
+	}
+}
+
+func (h *DisposalHandler) Propose(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		common.SendError(c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated", nil)
+		return
+	}
+
+	assetID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid asset ID", nil)
+		return
+	}
+
+	var req disposaldto.ProposeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	disposal := &entity.DisposalRequest{
+		ID:             uuid.New(),
+		AssetID:        assetID,
+		RequestedBy:    userID,
+		Reason:         req.Reason,
+		Method:         req.Method,
+		EstimatedValue: req.EstimatedValue,
+	}
+	if err := h.disposalService.Propose(c.Request.Context(), disposal); err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusCreated, "Disposal request created successfully", disposal)
+}
+
+func (h *DisposalHandler) Get(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid disposal request ID", nil)
+		return
+	}
+
+	disposal, err := h.disposalService.GetDisposal(c.Request.Context(), id)
+	if err != nil {
+		sendServiceError(c, err, http.StatusNotFound, "NOT_FOUND")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Disposal request retrieved successfully", disposal)
+}
+
+func (h *DisposalHandler) List(c *gin.Context) {
+	var req disposaldto.ListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	filters := make(map[string]interface{})
+	if req.Status != "" {
+		filters["status"] = req.Status
+	}
+	if req.AssetID != "" {
+		filters["asset_id"] = req.AssetID
+	}
+
+	disposals, total, err := h.disposalService.ListDisposals(c.Request.Context(), req.Limit, req.Offset, filters)
+	if err != nil {
+		sendServiceError(c, err, http.StatusInternalServerError, "INTERNAL_ERROR")
+		return
+	}
+
+	pagination := common.PaginationInfo{
+		Total:   total,
+		Limit:   req.Limit,
+		Offset:  req.Offset,
+		HasMore: total > (req.Offset + req.Limit),
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Disposal requests retrieved successfully", gin.H{
+		"disposals":  disposals,
+		"pagination": pagination,
+	})
+}
+
+func (h *DisposalHandler) ConfirmDataWipe(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid disposal request ID", nil)
+		return
+	}
+
+	var req disposaldto.DataWipeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	disposal, err := h.disposalService.ConfirmDataWipe(c.Request.Context(), id, req.Method, req.Notes)
+	if err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Data wipe confirmed successfully", disposal)
+}
+
+func (h *DisposalHandler) Approve(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid disposal request ID", nil)
+		return
+	}
+
+	var req disposaldto.DecisionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	disposal, err := h.disposalService.Approve(c.Request.Context(), id, req.Comment)
+	if err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Disposal request approved successfully", disposal)
+}
+
+func (h *DisposalHandler) Reject(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid disposal request ID", nil)
+		return
+	}
+
+	var req disposaldto.DecisionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	disposal, err := h.disposalService.Reject(c.Request.Context(), id, req.Comment)
+	if err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Disposal request rejected successfully", disposal)
+}
+
+func (h *DisposalHandler) Cancel(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid disposal request ID", nil)
+		return
+	}
+
+	disposal, err := h.disposalService.Cancel(c.Request.Context(), id)
+	if err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Disposal request cancelled successfully", disposal)
+}
+
+// Certificate downloads the disposal certificate of an approved request.
+func (h *DisposalHandler) Certificate(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid disposal request ID", nil)
+		return
+	}
+
+	disposal, document, err := h.disposalService.Certificate(c.Request.Context(), id)
+	if err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", disposal.CertificateNumber+".pdf"))
+	c.Data(http.StatusOK, "application/pdf", document)
+}
@@ -0,0 +1,20 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"inventory-ticketing-system/domain/policy"
+	"inventory-ticketing-system/pkg/common"
+)
+
+// sendServiceError reports a service error. Authorization failures from the
+// policy layer become 403; anything else uses the given status and code.
+func sendServiceError(c *gin.Context, err error, statusCode int, code string) {
+	if errors.Is(err, policy.ErrForbidden) {
+		common.SendError(c, http.StatusForbidden, "FORBIDDEN", "Insufficient permissions", nil)
+		return
+	}
+	common.SendError(c, statusCode, code, err.Error(), nil)
+}
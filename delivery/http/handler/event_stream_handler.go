@@ -0,0 +1,140 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/service"
+	"inventory-ticketing-system/pkg/common"
+	"inventory-ticketing-system/pkg/websocket"
+)
+
+// streamHeartbeat keeps idle connections open through proxies that drop
+// silent ones.
+const streamHeartbeat = 25 * time.Second
+
+type EventStreamHandler struct {
+	realtimeService service.RealtimeService
+}
+
+func NewEventStreamHandler(realtimeService service.RealtimeService) *EventStreamHandler {
+	return &EventStreamHandler{
+		realtimeService: realtimeService,
+	}
+}
+
+// Stream pushes ticket and asset events to the caller, over WebSocket when the
+// request asks for an upgrade and as Server-Sent Events otherwise.
+func (h *EventStreamHandler) Stream(c *gin.Context) {
+	var topics []string
+	for _, topic := range strings.Split(c.Query("topics"), ",") {
+		if topic = strings.TrimSpace(topic); topic != "" {
+			topics = append(topics, topic)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer cancel()
+
+	events, err := h.realtimeService.Subscribe(ctx, topics)
+	if err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	if websocket.IsUpgradeRequest(c.Request) {
+		h.streamWebSocket(c, ctx, cancel, events)
+		return
+	}
+	h.streamSSE(c, ctx, events)
+}
+
+func (h *EventStreamHandler) streamSSE(c *gin.Context, ctx context.Context, events <-chan *entity.RealtimeEvent) {
+	flusher, ok := c.Writer.(http.Flusher)
+	if !ok {
+		common.SendError(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Streaming not supported", nil)
+		return
+	}
+
+	header := c.Writer.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Connection", "keep-alive")
+	header.Set("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	fmt.Fprint(c.Writer, ": connected\n\n")
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(c.Writer, ": ping\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			data, err := json.Marshal(event)
+			if err != nil {
+				continue
+			}
+			if _, err := fmt.Fprintf(c.Writer, "id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+func (h *EventStreamHandler) streamWebSocket(c *gin.Context, ctx context.Context, cancel context.CancelFunc, events <-chan *entity.RealtimeEvent) {
+	conn, err := websocket.Upgrade(c.Writer, c.Request)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	// Clients only send control frames; the read loop ends the stream when
+	// they go away
+	go func() {
+		conn.ReadLoop(nil)
+		cancel()
+	}()
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-heartbeat.C:
+			if err := conn.WritePing(); err != nil {
+				return
+			}
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			data, err := json.Marshal(event)
+			if err != nil {
+				continue
+			}
+			if err := conn.WriteText(data); err != nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,316 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	inventorydto "inventory-ticketing-system/application/dto/inventory"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/service"
+	"inventory-ticketing-system/pkg/common"
+)
+
+type InventoryHandler struct {
+	stockService service.StockService
+}
+
+func NewInventoryHandler(stockService service.StockService) *InventoryHandler {
+	return &InventoryHandler{
+		stockService: stockService,
+	}
+}
+
+// LowStock reports the assets at or below their reorder point, the ones
+// with the fewest days of cover first.
+func (h *InventoryHandler) LowStock(c *gin.Context) {
+	var req inventorydto.LowStockRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	filters := make(map[string]interface{})
+	if req.Category != "" {
+		filters["category"] = req.Category
+	}
+	if req.DepartmentID != "" {
+		filters["department_id"] = req.DepartmentID
+	}
+
+	items, err := h.stockService.ListLowStock(c.Request.Context(), filters)
+	if err != nil {
+		sendServiceError(c, err, http.StatusInternalServerError, "INTERNAL_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Low stock retrieved successfully", gin.H{
+		"items": items,
+	})
+}
+
+func (h *InventoryHandler) CreateReorderPoint(c *gin.Context) {
+	var req inventorydto.ReorderPointRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	point := reorderPointFromRequest(&req)
+	point.ID = uuid.New()
+	if err := h.stockService.CreateReorderPoint(c.Request.Context(), point); err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusCreated, "Reorder point created successfully", point)
+}
+
+func (h *InventoryHandler) GetReorderPoint(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid reorder point ID", nil)
+		return
+	}
+
+	point, err := h.stockService.GetReorderPoint(c.Request.Context(), id)
+	if err != nil {
+		sendServiceError(c, err, http.StatusNotFound, "NOT_FOUND")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Reorder point retrieved successfully", point)
+}
+
+func (h *InventoryHandler) UpdateReorderPoint(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid reorder point ID", nil)
+		return
+	}
+
+	var req inventorydto.ReorderPointRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	point := reorderPointFromRequest(&req)
+	if err := h.stockService.UpdateReorderPoint(c.Request.Context(), id, point); err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Reorder point updated successfully", point)
+}
+
+func (h *InventoryHandler) DeleteReorderPoint(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid reorder point ID", nil)
+		return
+	}
+
+	if err := h.stockService.DeleteReorderPoint(c.Request.Context(), id); err != nil {
+		sendServiceError(c, err, http.StatusNotFound, "NOT_FOUND")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Reorder point deleted successfully", gin.H{"id": idStr})
+}
+
+func (h *InventoryHandler) ListReorderPoints(c *gin.Context) {
+	var req inventorydto.ReorderPointListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	filters := make(map[string]interface{})
+	if req.AssetID != "" {
+		filters["asset_id"] = req.AssetID
+	}
+	if req.Category != "" {
+		filters["category"] = req.Category
+	}
+
+	points, err := h.stockService.ListReorderPoints(c.Request.Context(), filters)
+	if err != nil {
+		sendServiceError(c, err, http.StatusInternalServerError, "INTERNAL_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Reorder points retrieved successfully", points)
+}
+
+// ExpiringLots reports the lots with stock that expire within the given
+// number of days, including those already expired.
+func (h *InventoryHandler) ExpiringLots(c *gin.Context) {
+	var req inventorydto.ExpiringLotsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	filters := make(map[string]interface{})
+	if req.AssetID != "" {
+		filters["asset_id"] = req.AssetID
+	}
+	if req.Status != "" {
+		filters["status"] = req.Status
+	}
+
+	lots, err := h.stockService.ListExpiringLots(c.Request.Context(), req.Days, filters)
+	if err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Expiring lots retrieved successfully", gin.H{
+		"days": req.Days,
+		"lots": lots,
+	})
+}
+
+func (h *InventoryHandler) CreateLot(c *gin.Context) {
+	assetID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid asset ID", nil)
+		return
+	}
+
+	var req inventorydto.CreateLotRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	lot := &entity.StockLot{
+		ID:        uuid.New(),
+		AssetID:   assetID,
+		LotNumber: req.LotNumber,
+		ExpiresAt: req.ExpiresAt,
+		Qty:       req.Qty,
+		Notes:     req.Notes,
+	}
+	if req.ReceivedAt != nil {
+		lot.ReceivedAt = *req.ReceivedAt
+	}
+
+	if err := h.stockService.CreateLot(c.Request.Context(), lot); err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusCreated, "Lot received successfully", lot)
+}
+
+func (h *InventoryHandler) ListAssetLots(c *gin.Context) {
+	assetID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid asset ID", nil)
+		return
+	}
+
+	lots, err := h.stockService.ListAssetLots(c.Request.Context(), assetID)
+	if err != nil {
+		sendServiceError(c, err, http.StatusNotFound, "NOT_FOUND")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Lots retrieved successfully", lots)
+}
+
+func (h *InventoryHandler) GetLot(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid lot ID", nil)
+		return
+	}
+
+	lot, err := h.stockService.GetLot(c.Request.Context(), id)
+	if err != nil {
+		sendServiceError(c, err, http.StatusNotFound, "NOT_FOUND")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Lot retrieved successfully", lot)
+}
+
+func (h *InventoryHandler) UpdateLot(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid lot ID", nil)
+		return
+	}
+
+	var req inventorydto.UpdateLotRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	lot, err := h.stockService.UpdateLot(c.Request.Context(), id, &entity.StockLot{
+		LotNumber: req.LotNumber,
+		ExpiresAt: req.ExpiresAt,
+		Qty:       req.Qty,
+		Notes:     req.Notes,
+	})
+	if err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Lot updated successfully", lot)
+}
+
+// UpdateLotStatus quarantines a lot, which stops it from being issued, or
+// releases it again.
+func (h *InventoryHandler) UpdateLotStatus(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid lot ID", nil)
+		return
+	}
+
+	var req inventorydto.UpdateLotStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	lot, err := h.stockService.UpdateLotStatus(c.Request.Context(), id, req.Status)
+	if err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Lot status updated successfully", lot)
+}
+
+func (h *InventoryHandler) DeleteLot(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid lot ID", nil)
+		return
+	}
+
+	if err := h.stockService.DeleteLot(c.Request.Context(), id); err != nil {
+		sendServiceError(c, err, http.StatusNotFound, "NOT_FOUND")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Lot deleted successfully", gin.H{"id": idStr})
+}
+
+func reorderPointFromRequest(req *inventorydto.ReorderPointRequest) *entity.ReorderPoint {
+	return &entity.ReorderPoint{
+		AssetID:              req.AssetID,
+		Category:             req.Category,
+		MinQty:               req.MinQty,
+		ReorderQty:           req.ReorderQty,
+		DraftPurchaseRequest: req.DraftPurchaseRequest,
+	}
+}
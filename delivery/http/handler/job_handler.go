@@ -0,0 +1,91 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	jobdto "inventory-ticketing-system/application/dto/job"
+	"inventory-ticketing-system/delivery/http/middleware"
+	"inventory-ticketing-system/domain/service"
+	"inventory-ticketing-system/pkg/common"
+)
+
+type JobHandler struct {
+	jobScheduler service.JobScheduler
+}
+
+func NewJobHandler(jobScheduler service.JobScheduler) *JobHandler {
+	return &JobHandler{
+		jobScheduler: jobScheduler,
+	}
+}
+
+func (h *JobHandler) List(c *gin.Context) {
+	jobs, err := h.jobScheduler.ListJobs(c.Request.Context())
+	if err != nil {
+		common.SendError(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve jobs", nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Jobs retrieved successfully", jobs)
+}
+
+func (h *JobHandler) Get(c *gin.Context) {
+	job, err := h.jobScheduler.GetJob(c.Request.Context(), c.Param("name"))
+	if err != nil {
+		common.SendError(c, http.StatusNotFound, "NOT_FOUND", "Job not found", nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Job retrieved successfully", job)
+}
+
+func (h *JobHandler) ListRuns(c *gin.Context) {
+	var req jobdto.RunListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	runs, total, err := h.jobScheduler.ListRuns(c.Request.Context(), c.Param("name"), req.Limit, req.Offset)
+	if err != nil {
+		common.SendError(c, http.StatusNotFound, "NOT_FOUND", "Job not found", nil)
+		return
+	}
+
+	pagination := common.PaginationInfo{
+		Total:   total,
+		Limit:   req.Limit,
+		Offset:  req.Offset,
+		HasMore: total > (req.Offset + req.Limit),
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Job runs retrieved successfully", gin.H{
+		"runs":       runs,
+		"pagination": pagination,
+	})
+}
+
+// Run starts the job in the background; poll the returned run for the
+// outcome.
+func (h *JobHandler) Run(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		common.SendError(c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated", nil)
+		return
+	}
+
+	name := c.Param("name")
+	if _, err := h.jobScheduler.GetJob(c.Request.Context(), name); err != nil {
+		common.SendError(c, http.StatusNotFound, "NOT_FOUND", "Job not found", nil)
+		return
+	}
+
+	run, err := h.jobScheduler.Trigger(c.Request.Context(), name, userID)
+	if err != nil {
+		common.SendError(c, http.StatusConflict, "CONFLICT", err.Error(), nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusAccepted, "Job started", run)
+}
@@ -0,0 +1,25 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"inventory-ticketing-system/infrastructure/jwt"
+)
+
+type JWKSHandler struct {
+	jwtManager *jwt.JWTManager
+}
+
+func NewJWKSHandler(jwtManager *jwt.JWTManager) *JWKSHandler {
+	return &JWKSHandler{
+		jwtManager: jwtManager,
+	}
+}
+
+// Get serves the public signing keys as a bare JWK Set (RFC 7517) so that
+// standard JWT libraries can consume it without unwrapping our envelope.
+func (h *JWKSHandler) Get(c *gin.Context) {
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, h.jwtManager.JWKS())
+}
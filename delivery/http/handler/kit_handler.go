@@ -0,0 +1,275 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	kitdto "inventory-ticketing-system/application/dto/kit"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/service"
+	"inventory-ticketing-system/pkg/common"
+)
+
+type KitHandler struct {
+	kitService service.KitService
+}
+
+func NewKitHandler(kitService service.KitService) *KitHandler {
+	return &KitHandler{
+		kitService: kitService,
+	}
+}
+
+func (h *KitHandler) Create(c *gin.Context) {
+	var req kitdto.KitRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	kit := kitFromRequest(&req)
+	kit.ID = uuid.New()
+	if err := h.kitService.CreateKit(c.Request.Context(), kit); err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusCreated, "Kit created successfully", kit)
+}
+
+func (h *KitHandler) Get(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid kit ID", nil)
+		return
+	}
+
+	kit, err := h.kitService.GetKit(c.Request.Context(), id)
+	if err != nil {
+		sendServiceError(c, err, http.StatusNotFound, "NOT_FOUND")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Kit retrieved successfully", kit)
+}
+
+func (h *KitHandler) Update(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid kit ID", nil)
+		return
+	}
+
+	var req kitdto.KitRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	kit, err := h.kitService.UpdateKit(c.Request.Context(), id, kitFromRequest(&req))
+	if err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Kit updated successfully", kit)
+}
+
+func (h *KitHandler) Delete(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid kit ID", nil)
+		return
+	}
+
+	if err := h.kitService.DeleteKit(c.Request.Context(), id); err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Kit deleted successfully", gin.H{"id": idStr})
+}
+
+func (h *KitHandler) List(c *gin.Context) {
+	var req kitdto.KitListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	filters := make(map[string]interface{})
+	if req.Name != "" {
+		filters["name"] = req.Name
+	}
+	if req.AssetID != "" {
+		filters["asset_id"] = req.AssetID
+	}
+	if req.Category != "" {
+		filters["category"] = req.Category
+	}
+
+	kits, total, err := h.kitService.ListKits(c.Request.Context(), req.Limit, req.Offset, filters)
+	if err != nil {
+		sendServiceError(c, err, http.StatusInternalServerError, "INTERNAL_ERROR")
+		return
+	}
+
+	pagination := common.PaginationInfo{
+		Total:   total,
+		Limit:   req.Limit,
+		Offset:  req.Offset,
+		HasMore: total > (req.Offset + req.Limit),
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Kits retrieved successfully", gin.H{
+		"kits":       kits,
+		"pagination": pagination,
+	})
+}
+
+// Availability reports how many whole kits can be checked out now and what
+// each component lacks for one.
+func (h *KitHandler) Availability(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid kit ID", nil)
+		return
+	}
+
+	availability, err := h.kitService.GetAvailability(c.Request.Context(), id)
+	if err != nil {
+		sendServiceError(c, err, http.StatusNotFound, "NOT_FOUND")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Kit availability retrieved successfully", availability)
+}
+
+func (h *KitHandler) Checkout(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid kit ID", nil)
+		return
+	}
+
+	var req kitdto.CheckoutRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	checkout := &entity.KitCheckout{
+		ID:     uuid.New(),
+		UserID: req.UserID,
+		Notes:  req.Notes,
+		DueAt:  req.DueAt,
+	}
+	if err := h.kitService.CheckOutKit(c.Request.Context(), id, checkout); err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusCreated, "Kit checked out successfully", checkout)
+}
+
+func (h *KitHandler) GetCheckout(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid kit checkout ID", nil)
+		return
+	}
+
+	checkout, err := h.kitService.GetCheckout(c.Request.Context(), id)
+	if err != nil {
+		sendServiceError(c, err, http.StatusNotFound, "NOT_FOUND")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Kit checkout retrieved successfully", checkout)
+}
+
+func (h *KitHandler) ListCheckouts(c *gin.Context) {
+	var req kitdto.CheckoutListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	filters := make(map[string]interface{})
+	if req.KitID != "" {
+		filters["kit_id"] = req.KitID
+	}
+	if req.UserID != "" {
+		filters["user_id"] = req.UserID
+	}
+	if req.Open != nil {
+		filters["open"] = *req.Open
+	}
+
+	checkouts, total, err := h.kitService.ListCheckouts(c.Request.Context(), req.Limit, req.Offset, filters)
+	if err != nil {
+		sendServiceError(c, err, http.StatusInternalServerError, "INTERNAL_ERROR")
+		return
+	}
+
+	pagination := common.PaginationInfo{
+		Total:   total,
+		Limit:   req.Limit,
+		Offset:  req.Offset,
+		HasMore: total > (req.Offset + req.Limit),
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Kit checkouts retrieved successfully", gin.H{
+		"checkouts":  checkouts,
+		"pagination": pagination,
+	})
+}
+
+// Return checks a kit back in with the condition of each item.
+func (h *KitHandler) Return(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid kit checkout ID", nil)
+		return
+	}
+
+	var req kitdto.ReturnRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	items := make([]service.KitReturnItem, len(req.Items))
+	for i, item := range req.Items {
+		items[i] = service.KitReturnItem{
+			CheckoutID: item.CheckoutID,
+			Condition:  item.Condition,
+			Notes:      item.Notes,
+		}
+	}
+
+	checkout, err := h.kitService.ReturnKit(c.Request.Context(), id, items)
+	if err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Kit returned successfully", checkout)
+}
+
+func kitFromRequest(req *kitdto.KitRequest) *entity.Kit {
+	kit := &entity.Kit{
+		Name:        req.Name,
+		Description: req.Description,
+	}
+	for _, component := range req.Components {
+		kit.Components = append(kit.Components, &entity.KitComponent{
+			AssetID:  component.AssetID,
+			Category: component.Category,
+			Quantity: component.Quantity,
+		})
+	}
+	return kit
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	locationdto "inventory-ticketing-system/application/dto/location"
+	"inventory-ticketing-system/domain/entity"
 	"inventory-ticketing-system/domain/service"
 	"inventory-ticketing-system/pkg/common"
 )
@@ -21,8 +23,27 @@ func NewLocationHandler(locationService service.LocationService) *LocationHandle
 }
 
 func (h *LocationHandler) Create(c *gin.Context) {
-	// Note: Implement CreateLocationUseCase
-	common.SendSuccess(c, http.StatusCreated, "Location created successfully", gin.H{"id": uuid.New()})
+	var req locationdto.CreateLocationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	location := &entity.Location{
+		ID:          uuid.New(),
+		ParentID:    req.GetParentID(),
+		Name:        req.Name,
+		Area:        req.Area,
+		Description: req.Description,
+		Capacity:    req.Capacity,
+	}
+
+	if err := h.locationService.CreateLocation(c.Request.Context(), location); err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusCreated, "Location created successfully", location)
 }
 
 func (h *LocationHandler) Get(c *gin.Context) {
@@ -77,24 +98,60 @@ func (h *LocationHandler) List(c *gin.Context) {
 
 func (h *LocationHandler) Update(c *gin.Context) {
 	idStr := c.Param("id")
-	_, err := uuid.Parse(idStr)
+	id, err := uuid.Parse(idStr)
 	if err != nil {
 		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid location ID", nil)
 		return
 	}
 
-	// Note: Implement UpdateLocationUseCase
-	common.SendSuccess(c, http.StatusOK, "Location updated successfully", gin.H{"id": idStr})
+	var req locationdto.UpdateLocationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	location, err := h.locationService.GetLocation(c.Request.Context(), id)
+	if err != nil {
+		common.SendError(c, http.StatusNotFound, "NOT_FOUND", "Location not found", nil)
+		return
+	}
+
+	if req.Name != "" {
+		location.Name = req.Name
+	}
+	if req.Area != "" {
+		location.Area = req.Area
+	}
+	if req.Description != "" {
+		location.Description = req.Description
+	}
+	if req.Capacity != nil {
+		location.Capacity = *req.Capacity
+	}
+	if parentID := req.GetParentID(); parentID != nil {
+		location.ParentID = parentID
+	}
+
+	if err := h.locationService.UpdateLocation(c.Request.Context(), id, location); err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Location updated successfully", location)
 }
 
 func (h *LocationHandler) Delete(c *gin.Context) {
 	idStr := c.Param("id")
-	_, err := uuid.Parse(idStr)
+	id, err := uuid.Parse(idStr)
 	if err != nil {
 		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid location ID", nil)
 		return
 	}
 
-	// Note: Implement DeleteLocationUseCase
+	if err := h.locationService.DeleteLocation(c.Request.Context(), id); err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
 	common.SendSuccess(c, http.StatusOK, "Location deleted successfully", gin.H{"id": idStr})
 }
\ No newline at end of file
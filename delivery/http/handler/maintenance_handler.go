@@ -0,0 +1,244 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	maintenancedto "inventory-ticketing-system/application/dto/maintenance"
+	"inventory-ticketing-system/delivery/http/middleware"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/service"
+	"inventory-ticketing-system/pkg/common"
+)
+
+// maxCalendarRange bounds the maintenance calendar so projections stay cheap.
+const maxCalendarRange = 366 * 24 * time.Hour
+
+type MaintenanceHandler struct {
+	maintenanceService service.MaintenanceService
+}
+
+func NewMaintenanceHandler(maintenanceService service.MaintenanceService) *MaintenanceHandler {
+	return &MaintenanceHandler{
+		maintenanceService: maintenanceService,
+	}
+}
+
+func (h *MaintenanceHandler) CreatePlan(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		common.SendError(c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated", nil)
+		return
+	}
+
+	var req maintenancedto.PlanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	plan := planFromRequest(&req)
+	plan.ID = uuid.New()
+	plan.CreatedBy = userID
+
+	if err := h.maintenanceService.CreatePlan(c.Request.Context(), plan); err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusCreated, "Maintenance plan created successfully", plan)
+}
+
+func (h *MaintenanceHandler) GetPlan(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid maintenance plan ID", nil)
+		return
+	}
+
+	plan, err := h.maintenanceService.GetPlan(c.Request.Context(), id)
+	if err != nil {
+		common.SendError(c, http.StatusNotFound, "NOT_FOUND", "Maintenance plan not found", nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Maintenance plan retrieved successfully", plan)
+}
+
+func (h *MaintenanceHandler) ListPlans(c *gin.Context) {
+	var req maintenancedto.PlanListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	filters := make(map[string]interface{})
+	if req.AssetID != "" {
+		filters["asset_id"] = req.AssetID
+	}
+	if req.Category != "" {
+		filters["category"] = req.Category
+	}
+	if req.Active != nil {
+		filters["is_active"] = *req.Active
+	}
+
+	plans, err := h.maintenanceService.ListPlans(c.Request.Context(), filters)
+	if err != nil {
+		common.SendError(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve maintenance plans", nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Maintenance plans retrieved successfully", plans)
+}
+
+func (h *MaintenanceHandler) UpdatePlan(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid maintenance plan ID", nil)
+		return
+	}
+
+	var req maintenancedto.PlanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	plan := planFromRequest(&req)
+	if err := h.maintenanceService.UpdatePlan(c.Request.Context(), id, plan); err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Maintenance plan updated successfully", plan)
+}
+
+func (h *MaintenanceHandler) DeletePlan(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid maintenance plan ID", nil)
+		return
+	}
+
+	if err := h.maintenanceService.DeletePlan(c.Request.Context(), id); err != nil {
+		common.SendError(c, http.StatusNotFound, "NOT_FOUND", "Maintenance plan not found", nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Maintenance plan deleted successfully", gin.H{"id": id})
+}
+
+func (h *MaintenanceHandler) ListRecords(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid maintenance plan ID", nil)
+		return
+	}
+
+	var req maintenancedto.RecordListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	records, total, err := h.maintenanceService.ListRecords(c.Request.Context(), id, req.Limit, req.Offset)
+	if err != nil {
+		common.SendError(c, http.StatusNotFound, "NOT_FOUND", "Maintenance plan not found", nil)
+		return
+	}
+
+	pagination := common.PaginationInfo{
+		Total:   total,
+		Limit:   req.Limit,
+		Offset:  req.Offset,
+		HasMore: total > (req.Offset + req.Limit),
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Maintenance records retrieved successfully", gin.H{
+		"records":    records,
+		"pagination": pagination,
+	})
+}
+
+// Calendar lists maintenance due in a date range, 30 days from today by
+// default. Usage-based plans have no dates and are not included.
+func (h *MaintenanceHandler) Calendar(c *gin.Context) {
+	var req maintenancedto.CalendarRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	from := req.From
+	if from.IsZero() {
+		from = time.Now().UTC().Truncate(24 * time.Hour)
+	}
+	to := req.To
+	if to.IsZero() {
+		to = from.AddDate(0, 0, 30)
+	}
+	if !to.After(from) || to.Sub(from) > maxCalendarRange {
+		common.SendError(c, http.StatusBadRequest, "VALIDATION_ERROR", "to must be after from and at most a year later", nil)
+		return
+	}
+
+	occurrences, err := h.maintenanceService.Calendar(c.Request.Context(), from, to)
+	if err != nil {
+		common.SendError(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve maintenance calendar", nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Maintenance calendar retrieved successfully", gin.H{
+		"from":        from,
+		"to":          to,
+		"occurrences": occurrences,
+	})
+}
+
+func (h *MaintenanceHandler) RecordUsage(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid asset ID", nil)
+		return
+	}
+
+	var req maintenancedto.UsageRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	asset, err := h.maintenanceService.RecordUsage(c.Request.Context(), id, req.Amount)
+	if err != nil {
+		sendServiceError(c, err, http.StatusNotFound, "NOT_FOUND")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Asset usage recorded successfully", asset)
+}
+
+func planFromRequest(req *maintenancedto.PlanRequest) *entity.MaintenancePlan {
+	isActive := true
+	if req.IsActive != nil {
+		isActive = *req.IsActive
+	}
+
+	return &entity.MaintenancePlan{
+		Name:                req.Name,
+		Description:         req.Description,
+		AssetID:             req.AssetID,
+		Category:            req.Category,
+		IntervalDays:        req.IntervalDays,
+		UsageInterval:       req.UsageInterval,
+		LeadDays:            req.LeadDays,
+		LeadUsage:           req.LeadUsage,
+		StartsAt:            req.StartsAt,
+		Checklist:           req.Checklist,
+		DefaultTechnicianID: req.DefaultTechnicianID,
+		Severity:            req.Severity,
+		IsActive:            isActive,
+	}
+}
@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	vendordto "inventory-ticketing-system/application/dto/vendor"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/service"
+	"inventory-ticketing-system/pkg/common"
+)
+
+type ManufacturerHandler struct {
+	manufacturerService service.ManufacturerService
+}
+
+func NewManufacturerHandler(manufacturerService service.ManufacturerService) *ManufacturerHandler {
+	return &ManufacturerHandler{
+		manufacturerService: manufacturerService,
+	}
+}
+
+func (h *ManufacturerHandler) Create(c *gin.Context) {
+	var req vendordto.ManufacturerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	manufacturer := &entity.Manufacturer{ID: uuid.New(), Name: req.Name, Aliases: req.Aliases}
+	if err := h.manufacturerService.CreateManufacturer(c.Request.Context(), manufacturer); err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusCreated, "Manufacturer created successfully", manufacturer)
+}
+
+func (h *ManufacturerHandler) Get(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid manufacturer ID", nil)
+		return
+	}
+
+	manufacturer, err := h.manufacturerService.GetManufacturer(c.Request.Context(), id)
+	if err != nil {
+		common.SendError(c, http.StatusNotFound, "NOT_FOUND", "Manufacturer not found", nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Manufacturer retrieved successfully", manufacturer)
+}
+
+func (h *ManufacturerHandler) List(c *gin.Context) {
+	manufacturers, err := h.manufacturerService.ListManufacturers(c.Request.Context(), c.Query("search"))
+	if err != nil {
+		common.SendError(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve manufacturers", nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Manufacturers retrieved successfully", manufacturers)
+}
+
+func (h *ManufacturerHandler) Update(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid manufacturer ID", nil)
+		return
+	}
+
+	var req vendordto.ManufacturerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	manufacturer := &entity.Manufacturer{Name: req.Name, Aliases: req.Aliases}
+	if err := h.manufacturerService.UpdateManufacturer(c.Request.Context(), id, manufacturer); err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Manufacturer updated successfully", manufacturer)
+}
+
+func (h *ManufacturerHandler) Delete(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid manufacturer ID", nil)
+		return
+	}
+
+	if err := h.manufacturerService.DeleteManufacturer(c.Request.Context(), id); err != nil {
+		common.SendError(c, http.StatusNotFound, "NOT_FOUND", "Manufacturer not found", nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Manufacturer deleted successfully", gin.H{"id": id})
+}
+
+// Merge folds duplicate manufacturers, such as spelling variants of one
+// brand, into the manufacturer in the path.
+func (h *ManufacturerHandler) Merge(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid manufacturer ID", nil)
+		return
+	}
+
+	var req vendordto.MergeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	sourceIDs := make([]uuid.UUID, len(req.SourceIDs))
+	for i, sourceID := range req.SourceIDs {
+		sourceIDs[i] = uuid.MustParse(sourceID)
+	}
+
+	manufacturer, err := h.manufacturerService.MergeManufacturers(c.Request.Context(), id, sourceIDs)
+	if err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Manufacturers merged successfully", manufacturer)
+}
+
+// Normalize links assets whose brand has no manufacturer yet.
+func (h *ManufacturerHandler) Normalize(c *gin.Context) {
+	linked, err := h.manufacturerService.NormalizeBrands(c.Request.Context())
+	if err != nil {
+		common.SendError(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to normalize brands", nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Brands normalized successfully", gin.H{"linkedAssets": linked})
+}
@@ -0,0 +1,241 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	notificationdto "inventory-ticketing-system/application/dto/notification"
+	"inventory-ticketing-system/delivery/http/middleware"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/enum"
+	"inventory-ticketing-system/domain/service"
+	"inventory-ticketing-system/pkg/common"
+)
+
+type NotificationHandler struct {
+	notificationService service.NotificationService
+}
+
+func NewNotificationHandler(notificationService service.NotificationService) *NotificationHandler {
+	return &NotificationHandler{
+		notificationService: notificationService,
+	}
+}
+
+func (h *NotificationHandler) ListTemplates(c *gin.Context) {
+	templates, err := h.notificationService.ListTemplates(c.Request.Context())
+	if err != nil {
+		common.SendError(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve notification templates", nil)
+		return
+	}
+
+	kinds := make([]string, 0, len(enum.EmailNotificationKinds()))
+	for _, kind := range enum.EmailNotificationKinds() {
+		kinds = append(kinds, string(kind))
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Notification templates retrieved successfully", notificationdto.TemplateListResponse{
+		Templates: templates,
+		Kinds:     kinds,
+	})
+}
+
+func (h *NotificationHandler) GetTemplate(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid template ID", nil)
+		return
+	}
+
+	template, err := h.notificationService.GetTemplate(c.Request.Context(), id)
+	if err != nil {
+		common.SendError(c, http.StatusNotFound, "NOT_FOUND", "Notification template not found", nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Notification template retrieved successfully", template)
+}
+
+func (h *NotificationHandler) CreateTemplate(c *gin.Context) {
+	var req notificationdto.CreateTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	template := &entity.NotificationTemplate{
+		ID:       uuid.New(),
+		Kind:     req.Kind,
+		Locale:   req.Locale,
+		Subject:  req.Subject,
+		TextBody: req.TextBody,
+		HTMLBody: req.HTMLBody,
+	}
+
+	if err := h.notificationService.CreateTemplate(c.Request.Context(), template); err != nil {
+		common.SendError(c, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusCreated, "Notification template created successfully", template)
+}
+
+func (h *NotificationHandler) UpdateTemplate(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid template ID", nil)
+		return
+	}
+
+	var req notificationdto.UpdateTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	template := &entity.NotificationTemplate{
+		Subject:  req.Subject,
+		TextBody: req.TextBody,
+		HTMLBody: req.HTMLBody,
+	}
+
+	if err := h.notificationService.UpdateTemplate(c.Request.Context(), id, template); err != nil {
+		common.SendError(c, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Notification template updated successfully", template)
+}
+
+func (h *NotificationHandler) DeleteTemplate(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid template ID", nil)
+		return
+	}
+
+	if err := h.notificationService.DeleteTemplate(c.Request.Context(), id); err != nil {
+		common.SendError(c, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Notification template deleted successfully", gin.H{"id": id})
+}
+
+func (h *NotificationHandler) GetPreferences(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		common.SendError(c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated", nil)
+		return
+	}
+
+	preference, err := h.notificationService.GetPreferences(c.Request.Context(), userID)
+	if err != nil {
+		common.SendError(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve notification preferences", nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Notification preferences retrieved successfully", preference)
+}
+
+func (h *NotificationHandler) UpdatePreferences(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		common.SendError(c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated", nil)
+		return
+	}
+
+	var req notificationdto.UpdatePreferencesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	preference := &entity.NotificationPreference{
+		UserID:       userID,
+		Locale:       req.Locale,
+		EmailEnabled: *req.EmailEnabled,
+		MutedKinds:   req.MutedKinds,
+	}
+
+	if err := h.notificationService.UpdatePreferences(c.Request.Context(), preference); err != nil {
+		common.SendError(c, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Notification preferences updated successfully", preference)
+}
+
+func (h *NotificationHandler) ListInbox(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		common.SendError(c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated", nil)
+		return
+	}
+
+	var req notificationdto.InboxListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	notifications, nextCursor, err := h.notificationService.ListInbox(c.Request.Context(), userID, req.Unread, req.Cursor, req.Limit)
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
+		return
+	}
+
+	unread, err := h.notificationService.CountUnread(c.Request.Context(), userID)
+	if err != nil {
+		common.SendError(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve notifications", nil)
+		return
+	}
+
+	if notifications == nil {
+		notifications = []*entity.Notification{}
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Notifications retrieved successfully", notificationdto.InboxResponse{
+		Items:       notifications,
+		UnreadCount: unread,
+		NextCursor:  nextCursor,
+	})
+}
+
+func (h *NotificationHandler) MarkRead(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		common.SendError(c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated", nil)
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid notification ID", nil)
+		return
+	}
+
+	if err := h.notificationService.MarkRead(c.Request.Context(), userID, id); err != nil {
+		common.SendError(c, http.StatusNotFound, "NOT_FOUND", "Notification not found", nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Notification marked as read", gin.H{"id": id})
+}
+
+func (h *NotificationHandler) MarkAllRead(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		common.SendError(c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated", nil)
+		return
+	}
+
+	updated, err := h.notificationService.MarkAllRead(c.Request.Context(), userID)
+	if err != nil {
+		common.SendError(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to mark notifications as read", nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Notifications marked as read", gin.H{"updated": updated})
+}
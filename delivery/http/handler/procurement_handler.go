@@ -0,0 +1,349 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	procurementdto "inventory-ticketing-system/application/dto/procurement"
+	"inventory-ticketing-system/delivery/http/middleware"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/service"
+	"inventory-ticketing-system/pkg/common"
+)
+
+type ProcurementHandler struct {
+	procurementService service.ProcurementService
+}
+
+func NewProcurementHandler(procurementService service.ProcurementService) *ProcurementHandler {
+	return &ProcurementHandler{
+		procurementService: procurementService,
+	}
+}
+
+func (h *ProcurementHandler) CreateRequest(c *gin.Context) {
+	var req procurementdto.CreatePurchaseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	request := &entity.PurchaseRequest{
+		ID:            uuid.New(),
+		Title:         req.Title,
+		Justification: req.Justification,
+	}
+	for _, item := range req.Items {
+		request.Items = append(request.Items, &entity.PurchaseRequestItem{
+			Description:       item.Description,
+			Category:          item.Category,
+			Quantity:          item.Quantity,
+			EstimatedUnitCost: item.EstimatedUnitCost,
+		})
+	}
+
+	if err := h.procurementService.CreateRequest(c.Request.Context(), request); err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusCreated, "Purchase request created successfully", request)
+}
+
+func (h *ProcurementHandler) GetRequest(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid purchase request ID", nil)
+		return
+	}
+
+	request, err := h.procurementService.GetRequest(c.Request.Context(), id)
+	if err != nil {
+		sendServiceError(c, err, http.StatusNotFound, "NOT_FOUND")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Purchase request retrieved successfully", request)
+}
+
+func (h *ProcurementHandler) ListRequests(c *gin.Context) {
+	var req procurementdto.PurchaseRequestListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	filters := make(map[string]interface{})
+	if req.Status != "" {
+		filters["status"] = req.Status
+	}
+	if req.DepartmentID != "" {
+		filters["department_id"] = req.DepartmentID
+	}
+	if req.Mine {
+		userID, err := middleware.GetUserID(c)
+		if err != nil {
+			common.SendError(c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated", nil)
+			return
+		}
+		filters["requested_by"] = userID
+	}
+
+	requests, total, err := h.procurementService.ListRequests(c.Request.Context(), req.Limit, req.Offset, filters)
+	if err != nil {
+		sendServiceError(c, err, http.StatusInternalServerError, "INTERNAL_ERROR")
+		return
+	}
+
+	pagination := common.PaginationInfo{
+		Total:   total,
+		Limit:   req.Limit,
+		Offset:  req.Offset,
+		HasMore: total > (req.Offset + req.Limit),
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Purchase requests retrieved successfully", gin.H{
+		"requests":   requests,
+		"pagination": pagination,
+	})
+}
+
+func (h *ProcurementHandler) ApproveRequest(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid purchase request ID", nil)
+		return
+	}
+
+	var req procurementdto.DecisionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	request, err := h.procurementService.ApproveRequest(c.Request.Context(), id, req.Comment)
+	if err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Purchase request approved successfully", request)
+}
+
+func (h *ProcurementHandler) RejectRequest(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid purchase request ID", nil)
+		return
+	}
+
+	var req procurementdto.DecisionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	request, err := h.procurementService.RejectRequest(c.Request.Context(), id, req.Comment)
+	if err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Purchase request rejected successfully", request)
+}
+
+// SubmitRequest sends a draft request for approval.
+func (h *ProcurementHandler) SubmitRequest(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid purchase request ID", nil)
+		return
+	}
+
+	request, err := h.procurementService.SubmitRequest(c.Request.Context(), id)
+	if err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Purchase request submitted successfully", request)
+}
+
+func (h *ProcurementHandler) CancelRequest(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid purchase request ID", nil)
+		return
+	}
+
+	request, err := h.procurementService.CancelRequest(c.Request.Context(), id)
+	if err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Purchase request cancelled successfully", request)
+}
+
+func (h *ProcurementHandler) CreateOrder(c *gin.Context) {
+	var req procurementdto.CreatePurchaseOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	order := &entity.PurchaseOrder{
+		ID:         uuid.New(),
+		VendorID:   req.VendorID,
+		RequestID:  req.RequestID,
+		Currency:   strings.ToUpper(req.Currency),
+		ExpectedAt: req.ExpectedAt,
+		Notes:      req.Notes,
+	}
+	for _, line := range req.Lines {
+		order.Lines = append(order.Lines, &entity.PurchaseOrderLine{
+			RequestItemID: line.RequestItemID,
+			Description:   line.Description,
+			Category:      line.Category,
+			AssetType:     line.AssetType,
+			Brand:         line.Brand,
+			AssetID:       line.AssetID,
+			Quantity:      line.Quantity,
+			UnitCost:      line.UnitCost,
+		})
+	}
+
+	if err := h.procurementService.CreateOrder(c.Request.Context(), order); err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusCreated, "Purchase order created successfully", order)
+}
+
+func (h *ProcurementHandler) GetOrder(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid purchase order ID", nil)
+		return
+	}
+
+	order, err := h.procurementService.GetOrder(c.Request.Context(), id)
+	if err != nil {
+		sendServiceError(c, err, http.StatusNotFound, "NOT_FOUND")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Purchase order retrieved successfully", order)
+}
+
+func (h *ProcurementHandler) ListOrders(c *gin.Context) {
+	var req procurementdto.PurchaseOrderListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	filters := make(map[string]interface{})
+	if req.Status != "" {
+		filters["status"] = req.Status
+	}
+	if req.VendorID != "" {
+		filters["vendor_id"] = req.VendorID
+	}
+	if req.RequestID != "" {
+		filters["request_id"] = req.RequestID
+	}
+
+	orders, total, err := h.procurementService.ListOrders(c.Request.Context(), req.Limit, req.Offset, filters)
+	if err != nil {
+		sendServiceError(c, err, http.StatusInternalServerError, "INTERNAL_ERROR")
+		return
+	}
+
+	pagination := common.PaginationInfo{
+		Total:   total,
+		Limit:   req.Limit,
+		Offset:  req.Offset,
+		HasMore: total > (req.Offset + req.Limit),
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Purchase orders retrieved successfully", gin.H{
+		"orders":     orders,
+		"pagination": pagination,
+	})
+}
+
+func (h *ProcurementHandler) CancelOrder(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid purchase order ID", nil)
+		return
+	}
+
+	order, err := h.procurementService.CancelOrder(c.Request.Context(), id)
+	if err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Purchase order cancelled successfully", order)
+}
+
+func (h *ProcurementHandler) ReceiveGoods(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid purchase order ID", nil)
+		return
+	}
+
+	var req procurementdto.GoodsReceiptRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	receipt := &entity.GoodsReceipt{
+		ID:            uuid.New(),
+		LocationID:    req.LocationID,
+		InvoiceNumber: req.InvoiceNumber,
+		Notes:         req.Notes,
+	}
+	if req.ReceivedAt != nil {
+		receipt.ReceivedAt = *req.ReceivedAt
+	}
+	items := make([]service.ReceiptItem, 0, len(req.Items))
+	for _, item := range req.Items {
+		items = append(items, service.ReceiptItem{
+			OrderLineID: item.OrderLineID,
+			Quantity:    item.Quantity,
+			UniqueIDs:   item.UniqueIDs,
+		})
+	}
+
+	receipt, err = h.procurementService.ReceiveGoods(c.Request.Context(), id, receipt, items)
+	if err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusCreated, "Goods received successfully", receipt)
+}
+
+func (h *ProcurementHandler) ListReceipts(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid purchase order ID", nil)
+		return
+	}
+
+	receipts, err := h.procurementService.ListReceipts(c.Request.Context(), id)
+	if err != nil {
+		sendServiceError(c, err, http.StatusNotFound, "NOT_FOUND")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Goods receipts retrieved successfully", receipts)
+}
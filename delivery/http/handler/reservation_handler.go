@@ -0,0 +1,312 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	reservationdto "inventory-ticketing-system/application/dto/reservation"
+	"inventory-ticketing-system/delivery/http/middleware"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/enum"
+	"inventory-ticketing-system/domain/service"
+	"inventory-ticketing-system/pkg/common"
+	"inventory-ticketing-system/pkg/ical"
+)
+
+type ReservationHandler struct {
+	reservationService  service.ReservationService
+	calendarFeedService service.CalendarFeedService
+}
+
+func NewReservationHandler(reservationService service.ReservationService, calendarFeedService service.CalendarFeedService) *ReservationHandler {
+	return &ReservationHandler{
+		reservationService:  reservationService,
+		calendarFeedService: calendarFeedService,
+	}
+}
+
+func (h *ReservationHandler) Create(c *gin.Context) {
+	var req reservationdto.CreateReservationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	reservation := &entity.Reservation{
+		ID:       uuid.New(),
+		AssetID:  req.AssetID,
+		Quantity: req.Quantity,
+		StartsAt: req.StartsAt,
+		EndsAt:   req.EndsAt,
+		Purpose:  req.Purpose,
+	}
+	if req.UserID != nil {
+		reservation.UserID = *req.UserID
+	}
+
+	if err := h.reservationService.CreateReservation(c.Request.Context(), reservation); err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusCreated, "Reservation created successfully", reservation)
+}
+
+func (h *ReservationHandler) Get(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid reservation ID", nil)
+		return
+	}
+
+	reservation, err := h.reservationService.GetReservation(c.Request.Context(), id)
+	if err != nil {
+		sendServiceError(c, err, http.StatusNotFound, "NOT_FOUND")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Reservation retrieved successfully", reservation)
+}
+
+func (h *ReservationHandler) Update(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid reservation ID", nil)
+		return
+	}
+
+	var req reservationdto.UpdateReservationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	reservation, err := h.reservationService.UpdateReservation(c.Request.Context(), id, &entity.Reservation{
+		Quantity: req.Quantity,
+		StartsAt: req.StartsAt,
+		EndsAt:   req.EndsAt,
+		Purpose:  req.Purpose,
+	})
+	if err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Reservation updated successfully", reservation)
+}
+
+func (h *ReservationHandler) Cancel(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid reservation ID", nil)
+		return
+	}
+
+	reservation, err := h.reservationService.CancelReservation(c.Request.Context(), id)
+	if err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Reservation cancelled successfully", reservation)
+}
+
+func (h *ReservationHandler) List(c *gin.Context) {
+	var req reservationdto.ListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	filters := make(map[string]interface{})
+	if req.AssetID != "" {
+		filters["asset_id"] = req.AssetID
+	}
+	if req.UserID != "" {
+		filters["user_id"] = req.UserID
+	}
+	if req.Status != "" {
+		filters["status"] = req.Status
+	}
+	if !req.From.IsZero() {
+		filters["from"] = req.From
+	}
+	if !req.To.IsZero() {
+		filters["to"] = req.To
+	}
+
+	reservations, total, err := h.reservationService.ListReservations(c.Request.Context(), req.Limit, req.Offset, filters)
+	if err != nil {
+		sendServiceError(c, err, http.StatusInternalServerError, "INTERNAL_ERROR")
+		return
+	}
+
+	pagination := common.PaginationInfo{
+		Total:   total,
+		Limit:   req.Limit,
+		Offset:  req.Offset,
+		HasMore: total > (req.Offset + req.Limit),
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Reservations retrieved successfully", gin.H{
+		"reservations": reservations,
+		"pagination":   pagination,
+	})
+}
+
+// Availability lists the assets with enough units free for a whole time
+// range, such as the cameras free on Friday from 9 to 12.
+func (h *ReservationHandler) Availability(c *gin.Context) {
+	var req reservationdto.AvailabilityRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	filters := make(map[string]interface{})
+	if req.Type != "" {
+		filters["type"] = req.Type
+	}
+	if req.Category != "" {
+		filters["category"] = req.Category
+	}
+	if req.Brand != "" {
+		filters["brand"] = req.Brand
+	}
+	if id, err := uuid.Parse(req.DepartmentID); err == nil {
+		filters["department_id"] = id
+	}
+
+	available, err := h.reservationService.SearchAvailability(c.Request.Context(), req.From, req.To, req.Quantity, filters)
+	if err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Availability retrieved successfully", gin.H{
+		"from":   req.From,
+		"to":     req.To,
+		"assets": available,
+	})
+}
+
+// AssetCalendar serves the asset's reservations as an iCalendar feed.
+func (h *ReservationHandler) AssetCalendar(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid asset ID", nil)
+		return
+	}
+
+	feed, err := h.reservationService.AssetCalendar(c.Request.Context(), id)
+	if err != nil {
+		sendServiceError(c, err, http.StatusNotFound, "NOT_FOUND")
+		return
+	}
+
+	sendCalendar(c, "asset-"+id.String(), feed)
+}
+
+// UserCalendar serves a user's reservations as an iCalendar feed; "me" is
+// the caller.
+func (h *ReservationHandler) UserCalendar(c *gin.Context) {
+	var id uuid.UUID
+	if c.Param("id") == "me" {
+		userID, err := middleware.GetUserID(c)
+		if err != nil {
+			common.SendError(c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated", nil)
+			return
+		}
+		id = userID
+	} else {
+		userID, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid user ID", nil)
+			return
+		}
+		id = userID
+	}
+
+	feed, err := h.reservationService.UserCalendar(c.Request.Context(), id)
+	if err != nil {
+		sendServiceError(c, err, http.StatusNotFound, "NOT_FOUND")
+		return
+	}
+
+	sendCalendar(c, "user-"+id.String(), feed)
+}
+
+// CreateFeedToken issues a token that calendar applications put in the feed
+// URL instead of a session or personal access token.
+func (h *ReservationHandler) CreateFeedToken(c *gin.Context) {
+	var req reservationdto.CreateFeedTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		common.SendError(c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated", nil)
+		return
+	}
+
+	token := &entity.CalendarFeedToken{ID: uuid.New(), Feed: req.Feed, ResourceID: userID}
+	if req.ResourceID != nil {
+		token.ResourceID = *req.ResourceID
+	} else if req.Feed != string(enum.CalendarFeedUser) {
+		common.SendError(c, http.StatusBadRequest, "VALIDATION_ERROR", "resourceId is required for asset feeds", nil)
+		return
+	}
+
+	plaintext, err := h.calendarFeedService.CreateFeedToken(c.Request.Context(), token)
+	if err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	response := reservationdto.CreateFeedTokenResponse{
+		CalendarFeedToken: token,
+		Token:             plaintext,
+		URL:               fmt.Sprintf("/api/v1/calendars/%ss/%s?token=%s", token.Feed, token.ResourceID, plaintext),
+	}
+	common.SendSuccess(c, http.StatusCreated, "Feed token created successfully, store it now as it will not be shown again", response)
+}
+
+func (h *ReservationHandler) ListFeedTokens(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		common.SendError(c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated", nil)
+		return
+	}
+
+	tokens, err := h.calendarFeedService.ListFeedTokens(c.Request.Context(), userID)
+	if err != nil {
+		common.SendError(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve feed tokens", nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Feed tokens retrieved successfully", reservationdto.FeedTokenListResponse{FeedTokens: tokens})
+}
+
+func (h *ReservationHandler) RevokeFeedToken(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid feed token ID", nil)
+		return
+	}
+
+	if err := h.calendarFeedService.RevokeFeedToken(c.Request.Context(), id); err != nil {
+		sendServiceError(c, err, http.StatusNotFound, "NOT_FOUND")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Feed token revoked successfully", gin.H{"id": id})
+}
+
+func sendCalendar(c *gin.Context, name string, feed []byte) {
+	c.Header("Content-Disposition", fmt.Sprintf("inline; filename=%q", name+".ics"))
+	c.Data(http.StatusOK, ical.ContentType, feed)
+}
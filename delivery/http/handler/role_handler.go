@@ -0,0 +1,209 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	roledto "inventory-ticketing-system/application/dto/role"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/enum"
+	"inventory-ticketing-system/domain/service"
+	"inventory-ticketing-system/pkg/common"
+)
+
+type RoleHandler struct {
+	authorizationService service.AuthorizationService
+}
+
+func NewRoleHandler(authorizationService service.AuthorizationService) *RoleHandler {
+	return &RoleHandler{
+		authorizationService: authorizationService,
+	}
+}
+
+func (h *RoleHandler) List(c *gin.Context) {
+	roles, err := h.authorizationService.ListRoles(c.Request.Context())
+	if err != nil {
+		common.SendError(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve roles", nil)
+		return
+	}
+
+	permissions := make([]string, 0, len(enum.AllPermissions()))
+	for _, permission := range enum.AllPermissions() {
+		permissions = append(permissions, string(permission))
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Roles retrieved successfully", roledto.RoleListResponse{
+		Roles:       roles,
+		Permissions: permissions,
+	})
+}
+
+func (h *RoleHandler) Get(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid role ID", nil)
+		return
+	}
+
+	role, err := h.authorizationService.GetRole(c.Request.Context(), id)
+	if err != nil {
+		common.SendError(c, http.StatusNotFound, "NOT_FOUND", "Role not found", nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Role retrieved successfully", role)
+}
+
+func (h *RoleHandler) Create(c *gin.Context) {
+	var req roledto.CreateRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	role := &entity.Role{
+		ID:          uuid.New(),
+		Name:        req.Name,
+		Description: req.Description,
+		Permissions: req.Permissions,
+	}
+
+	if err := h.authorizationService.CreateRole(c.Request.Context(), role); err != nil {
+		common.SendError(c, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusCreated, "Role created successfully", role)
+}
+
+func (h *RoleHandler) Update(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid role ID", nil)
+		return
+	}
+
+	var req roledto.UpdateRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	role := &entity.Role{
+		Description: req.Description,
+		Permissions: req.Permissions,
+	}
+
+	if err := h.authorizationService.UpdateRole(c.Request.Context(), id, role); err != nil {
+		common.SendError(c, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Role updated successfully", role)
+}
+
+func (h *RoleHandler) Delete(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid role ID", nil)
+		return
+	}
+
+	if err := h.authorizationService.DeleteRole(c.Request.Context(), id); err != nil {
+		common.SendError(c, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Role deleted successfully", gin.H{"id": id})
+}
+
+func (h *RoleHandler) SetUserRole(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid user ID", nil)
+		return
+	}
+
+	var req roledto.SetUserRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	if err := h.authorizationService.SetUserRole(c.Request.Context(), userID, req.Role); err != nil {
+		common.SendError(c, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "User role updated successfully", gin.H{"id": userID, "role": req.Role})
+}
+
+func (h *RoleHandler) ListUserRoles(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid user ID", nil)
+		return
+	}
+
+	assignments, err := h.authorizationService.ListRoleAssignments(c.Request.Context(), userID)
+	if err != nil {
+		common.SendError(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve role assignments", nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Role assignments retrieved successfully", roledto.RoleAssignmentListResponse{
+		Assignments: assignments,
+	})
+}
+
+func (h *RoleHandler) AssignUserRole(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid user ID", nil)
+		return
+	}
+
+	var req roledto.AssignRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	assignment := &entity.RoleAssignment{
+		ID:         uuid.New(),
+		UserID:     userID,
+		RoleID:     req.RoleID,
+		LocationID: req.GetLocationID(),
+		Category:   req.Category,
+	}
+
+	if err := h.authorizationService.AssignRole(c.Request.Context(), assignment); err != nil {
+		common.SendError(c, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusCreated, "Role assigned successfully", assignment)
+}
+
+func (h *RoleHandler) RemoveUserRole(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid user ID", nil)
+		return
+	}
+
+	assignmentID, err := uuid.Parse(c.Param("assignmentId"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid role assignment ID", nil)
+		return
+	}
+
+	if err := h.authorizationService.RemoveRoleAssignment(c.Request.Context(), userID, assignmentID); err != nil {
+		common.SendError(c, http.StatusNotFound, "NOT_FOUND", err.Error(), nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Role assignment removed successfully", gin.H{"id": assignmentID})
+}
@@ -0,0 +1,220 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	techniciandto "inventory-ticketing-system/application/dto/technician"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/service"
+	"inventory-ticketing-system/pkg/common"
+)
+
+type TechnicianHandler struct {
+	technicianService service.TechnicianService
+}
+
+func NewTechnicianHandler(technicianService service.TechnicianService) *TechnicianHandler {
+	return &TechnicianHandler{
+		technicianService: technicianService,
+	}
+}
+
+func (h *TechnicianHandler) Create(c *gin.Context) {
+	var req techniciandto.CreateTechnicianRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	technician := &entity.Technician{
+		UserID:         req.UserID,
+		TeamID:         req.GetTeamID(),
+		Skills:         req.Skills,
+		AssetTypes:     req.AssetTypes,
+		IsActive:       true,
+		MaxOpenTickets: req.MaxOpenTickets,
+	}
+
+	if err := h.technicianService.CreateTechnician(c.Request.Context(), technician); err != nil {
+		common.SendError(c, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusCreated, "Technician created successfully", technician)
+}
+
+func (h *TechnicianHandler) Get(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("userId"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid user ID", nil)
+		return
+	}
+
+	technician, err := h.technicianService.GetTechnician(c.Request.Context(), userID)
+	if err != nil {
+		common.SendError(c, http.StatusNotFound, "NOT_FOUND", "Technician not found", nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Technician retrieved successfully", technician)
+}
+
+func (h *TechnicianHandler) List(c *gin.Context) {
+	var teamID *uuid.UUID
+	if value := c.Query("teamId"); value != "" {
+		parsed, err := uuid.Parse(value)
+		if err != nil {
+			common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid team ID", nil)
+			return
+		}
+		teamID = &parsed
+	}
+
+	technicians, err := h.technicianService.ListTechnicians(c.Request.Context(), teamID)
+	if err != nil {
+		common.SendError(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve technicians", nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Technicians retrieved successfully", techniciandto.TechnicianListResponse{
+		Technicians: technicians,
+	})
+}
+
+func (h *TechnicianHandler) Update(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("userId"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid user ID", nil)
+		return
+	}
+
+	var req techniciandto.UpdateTechnicianRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	technician := &entity.Technician{
+		TeamID:         req.GetTeamID(),
+		Skills:         req.Skills,
+		AssetTypes:     req.AssetTypes,
+		IsActive:       req.IsActive == nil || *req.IsActive,
+		MaxOpenTickets: req.MaxOpenTickets,
+	}
+
+	if err := h.technicianService.UpdateTechnician(c.Request.Context(), userID, technician); err != nil {
+		common.SendError(c, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Technician updated successfully", technician)
+}
+
+func (h *TechnicianHandler) Delete(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("userId"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid user ID", nil)
+		return
+	}
+
+	if err := h.technicianService.DeleteTechnician(c.Request.Context(), userID); err != nil {
+		common.SendError(c, http.StatusNotFound, "NOT_FOUND", err.Error(), nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Technician deleted successfully", gin.H{"userId": userID})
+}
+
+func (h *TechnicianHandler) CreateTeam(c *gin.Context) {
+	var req techniciandto.TeamRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	team := &entity.Team{
+		ID:                 uuid.New(),
+		Name:               req.Name,
+		Description:        req.Description,
+		Categories:         req.Categories,
+		AssignmentStrategy: req.AssignmentStrategy,
+	}
+
+	if err := h.technicianService.CreateTeam(c.Request.Context(), team); err != nil {
+		common.SendError(c, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusCreated, "Team created successfully", team)
+}
+
+func (h *TechnicianHandler) GetTeam(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid team ID", nil)
+		return
+	}
+
+	team, err := h.technicianService.GetTeam(c.Request.Context(), id)
+	if err != nil {
+		common.SendError(c, http.StatusNotFound, "NOT_FOUND", "Team not found", nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Team retrieved successfully", team)
+}
+
+func (h *TechnicianHandler) ListTeams(c *gin.Context) {
+	teams, err := h.technicianService.ListTeams(c.Request.Context())
+	if err != nil {
+		common.SendError(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve teams", nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Teams retrieved successfully", techniciandto.TeamListResponse{Teams: teams})
+}
+
+func (h *TechnicianHandler) UpdateTeam(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid team ID", nil)
+		return
+	}
+
+	var req techniciandto.TeamRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	team := &entity.Team{
+		Name:               req.Name,
+		Description:        req.Description,
+		Categories:         req.Categories,
+		AssignmentStrategy: req.AssignmentStrategy,
+	}
+
+	if err := h.technicianService.UpdateTeam(c.Request.Context(), id, team); err != nil {
+		common.SendError(c, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Team updated successfully", team)
+}
+
+func (h *TechnicianHandler) DeleteTeam(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid team ID", nil)
+		return
+	}
+
+	if err := h.technicianService.DeleteTeam(c.Request.Context(), id); err != nil {
+		common.SendError(c, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Team deleted successfully", gin.H{"id": id})
+}
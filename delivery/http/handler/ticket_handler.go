@@ -2,26 +2,40 @@ package handler
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	ticketdto "inventory-ticketing-system/application/dto/ticket"
 	ticketusecase "inventory-ticketing-system/application/usecase/ticket"
+	"inventory-ticketing-system/delivery/http/middleware"
 	"inventory-ticketing-system/pkg/common"
 )
 
 type TicketHandler struct {
 	createTicketUseCase *ticketusecase.CreateTicketUseCase
 	listTicketsUseCase  *ticketusecase.ListTicketsUseCase
+	getTicketUseCase    *ticketusecase.GetTicketUseCase
+	updateTicketUseCase *ticketusecase.UpdateTicketUseCase
+	deleteTicketUseCase *ticketusecase.DeleteTicketUseCase
+	ticketQueueUseCase  *ticketusecase.GetTicketQueueUseCase
 }
 
 func NewTicketHandler(
 	createTicketUseCase *ticketusecase.CreateTicketUseCase,
 	listTicketsUseCase *ticketusecase.ListTicketsUseCase,
+	getTicketUseCase *ticketusecase.GetTicketUseCase,
+	updateTicketUseCase *ticketusecase.UpdateTicketUseCase,
+	deleteTicketUseCase *ticketusecase.DeleteTicketUseCase,
+	ticketQueueUseCase *ticketusecase.GetTicketQueueUseCase,
 ) *TicketHandler {
 	return &TicketHandler{
 		createTicketUseCase: createTicketUseCase,
 		listTicketsUseCase:  listTicketsUseCase,
+		getTicketUseCase:    getTicketUseCase,
+		updateTicketUseCase: updateTicketUseCase,
+		deleteTicketUseCase: deleteTicketUseCase,
+		ticketQueueUseCase:  ticketQueueUseCase,
 	}
 }
 
@@ -45,26 +59,34 @@ func (h *TicketHandler) Create(c *gin.Context) {
 		return
 	}
 
-	ticket, err := h.createTicketUseCase.Execute(c.Request.Context(), &req, reporterID)
+	ticket, coverage, err := h.createTicketUseCase.Execute(c.Request.Context(), &req, reporterID)
 	if err != nil {
-		common.SendError(c, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
 		return
 	}
 
-	response := ticketdto.NewTicketResponse(ticket)
+	response := ticketdto.CreateTicketResponse{
+		TicketResponse: ticketdto.NewTicketResponse(ticket),
+		Coverage:       coverage,
+	}
 	common.SendSuccess(c, http.StatusCreated, "Ticket created successfully", response)
 }
 
 func (h *TicketHandler) Get(c *gin.Context) {
 	idStr := c.Param("id")
-	_, err := uuid.Parse(idStr)
+	id, err := uuid.Parse(idStr)
 	if err != nil {
 		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid ticket ID", nil)
 		return
 	}
 
-	// Note: Implement GetTicketUseCase for this functionality
-	common.SendSuccess(c, http.StatusOK, "Ticket retrieved successfully", gin.H{"id": idStr})
+	ticket, err := h.getTicketUseCase.Execute(c.Request.Context(), id)
+	if err != nil {
+		sendServiceError(c, err, http.StatusNotFound, "NOT_FOUND")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Ticket retrieved successfully", ticketdto.NewTicketResponse(ticket))
 }
 
 func (h *TicketHandler) List(c *gin.Context) {
@@ -81,13 +103,28 @@ func (h *TicketHandler) List(c *gin.Context) {
 	}
 	if req.AssetID != "" {
 		if assetID, err := uuid.Parse(req.AssetID); err == nil {
-			filters["asset_id"] = assetID
+			// Tickets on an asset's components show up on the asset too
+			if req.DirectOnly {
+				filters["asset_id"] = assetID
+			} else {
+				filters["asset_tree"] = assetID
+			}
+		}
+	}
+	if req.UnitID != "" {
+		if unitID, err := uuid.Parse(req.UnitID); err == nil {
+			filters["unit_id"] = unitID
+		}
+	}
+	if req.DepartmentID != "" {
+		if departmentID, err := uuid.Parse(req.DepartmentID); err == nil {
+			filters["department_id"] = departmentID
 		}
 	}
 
 	response, err := h.listTicketsUseCase.Execute(c.Request.Context(), req.Limit, req.Offset, filters)
 	if err != nil {
-		common.SendError(c, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error(), nil)
+		sendServiceError(c, err, http.StatusInternalServerError, "INTERNAL_ERROR")
 		return
 	}
 
@@ -110,24 +147,56 @@ func (h *TicketHandler) List(c *gin.Context) {
 
 func (h *TicketHandler) Update(c *gin.Context) {
 	idStr := c.Param("id")
-	_, err := uuid.Parse(idStr)
+	id, err := uuid.Parse(idStr)
 	if err != nil {
 		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid ticket ID", nil)
 		return
 	}
 
-	// Note: Implement UpdateTicketUseCase for this functionality
-	common.SendSuccess(c, http.StatusOK, "Ticket updated successfully", gin.H{"id": idStr})
+	var req ticketdto.UpdateTicketRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	ticket, err := h.updateTicketUseCase.Execute(c.Request.Context(), id, &req)
+	if err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Ticket updated successfully", ticketdto.NewTicketResponse(ticket))
 }
 
 func (h *TicketHandler) Delete(c *gin.Context) {
 	idStr := c.Param("id")
-	_, err := uuid.Parse(idStr)
+	id, err := uuid.Parse(idStr)
 	if err != nil {
 		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid ticket ID", nil)
 		return
 	}
 
-	// Note: Implement DeleteTicketUseCase for this functionality
+	if err := h.deleteTicketUseCase.Execute(c.Request.Context(), id); err != nil {
+		sendServiceError(c, err, http.StatusNotFound, "NOT_FOUND")
+		return
+	}
+
 	common.SendSuccess(c, http.StatusOK, "Ticket deleted successfully", gin.H{"id": idStr})
-}
\ No newline at end of file
+}
+
+// Queue returns the caller's open work, most urgent first.
+func (h *TicketHandler) Queue(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		common.SendError(c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated", nil)
+		return
+	}
+
+	tickets, err := h.ticketQueueUseCase.Execute(c.Request.Context(), userID)
+	if err != nil {
+		sendServiceError(c, err, http.StatusInternalServerError, "INTERNAL_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Ticket queue retrieved successfully", ticketdto.NewTicketQueueResponse(tickets, time.Now()))
+}
@@ -0,0 +1,167 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	tokendto "inventory-ticketing-system/application/dto/token"
+	"inventory-ticketing-system/delivery/http/middleware"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/enum"
+	"inventory-ticketing-system/domain/service"
+	"inventory-ticketing-system/pkg/common"
+)
+
+type TokenHandler struct {
+	accessTokenService service.AccessTokenService
+}
+
+func NewTokenHandler(accessTokenService service.AccessTokenService) *TokenHandler {
+	return &TokenHandler{
+		accessTokenService: accessTokenService,
+	}
+}
+
+func (h *TokenHandler) Create(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		common.SendError(c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated", nil)
+		return
+	}
+
+	h.createToken(c, userID, userID)
+}
+
+func (h *TokenHandler) List(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		common.SendError(c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated", nil)
+		return
+	}
+
+	h.listTokens(c, userID)
+}
+
+func (h *TokenHandler) Revoke(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid token ID", nil)
+		return
+	}
+
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		common.SendError(c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated", nil)
+		return
+	}
+	principal, _ := middleware.GetPrincipal(c)
+
+	// Users manage their own tokens; user managers may revoke anyone's
+	token, err := h.accessTokenService.GetToken(c.Request.Context(), id)
+	if err != nil || (token.UserID != userID && !principal.Can(enum.PermissionUsersManage, nil)) {
+		common.SendError(c, http.StatusNotFound, "NOT_FOUND", "Token not found", nil)
+		return
+	}
+
+	if err := h.accessTokenService.RevokeToken(c.Request.Context(), id); err != nil {
+		common.SendError(c, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error(), nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Token revoked successfully", gin.H{"id": id})
+}
+
+func (h *TokenHandler) CreateServiceAccount(c *gin.Context) {
+	var req tokendto.CreateServiceAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	user := &entity.User{
+		ID:    uuid.New(),
+		Name:  req.Name,
+		Email: req.Email,
+		Role:  req.Role,
+	}
+
+	if err := h.accessTokenService.CreateServiceAccount(c.Request.Context(), user); err != nil {
+		common.SendError(c, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusCreated, "Service account created successfully", user)
+}
+
+func (h *TokenHandler) ListServiceAccounts(c *gin.Context) {
+	users, err := h.accessTokenService.ListServiceAccounts(c.Request.Context())
+	if err != nil {
+		common.SendError(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve service accounts", nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Service accounts retrieved successfully", gin.H{"serviceAccounts": users})
+}
+
+func (h *TokenHandler) CreateServiceAccountToken(c *gin.Context) {
+	accountID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid service account ID", nil)
+		return
+	}
+
+	adminID, err := middleware.GetUserID(c)
+	if err != nil {
+		common.SendError(c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated", nil)
+		return
+	}
+
+	h.createToken(c, accountID, adminID)
+}
+
+func (h *TokenHandler) ListServiceAccountTokens(c *gin.Context) {
+	accountID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid service account ID", nil)
+		return
+	}
+
+	h.listTokens(c, accountID)
+}
+
+func (h *TokenHandler) createToken(c *gin.Context, ownerID, createdBy uuid.UUID) {
+	var req tokendto.CreateTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	token := &entity.PersonalAccessToken{
+		ID:        uuid.New(),
+		UserID:    ownerID,
+		Name:      req.Name,
+		Scopes:    req.Scopes,
+		ExpiresAt: req.ExpiresAt,
+		CreatedBy: createdBy,
+	}
+
+	plaintext, err := h.accessTokenService.CreateToken(c.Request.Context(), token)
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
+		return
+	}
+
+	response := tokendto.CreateTokenResponse{PersonalAccessToken: token, Token: plaintext}
+	common.SendSuccess(c, http.StatusCreated, "Token created successfully, store it now as it will not be shown again", response)
+}
+
+func (h *TokenHandler) listTokens(c *gin.Context, userID uuid.UUID) {
+	tokens, err := h.accessTokenService.ListTokens(c.Request.Context(), userID)
+	if err != nil {
+		common.SendError(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve tokens", nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Tokens retrieved successfully", tokendto.TokenListResponse{Tokens: tokens})
+}
@@ -0,0 +1,149 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	vendordto "inventory-ticketing-system/application/dto/vendor"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/service"
+	"inventory-ticketing-system/pkg/common"
+)
+
+type VendorHandler struct {
+	vendorService service.VendorService
+}
+
+func NewVendorHandler(vendorService service.VendorService) *VendorHandler {
+	return &VendorHandler{
+		vendorService: vendorService,
+	}
+}
+
+func (h *VendorHandler) Create(c *gin.Context) {
+	var req vendordto.VendorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	vendor := vendorFromRequest(&req)
+	vendor.ID = uuid.New()
+	if err := h.vendorService.CreateVendor(c.Request.Context(), vendor); err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusCreated, "Vendor created successfully", vendor)
+}
+
+// Get returns the vendor with its purchased assets, open repair tickets and
+// spend.
+func (h *VendorHandler) Get(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid vendor ID", nil)
+		return
+	}
+
+	detail, err := h.vendorService.GetVendorDetail(c.Request.Context(), id)
+	if err != nil {
+		sendServiceError(c, err, http.StatusNotFound, "NOT_FOUND")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Vendor retrieved successfully", detail)
+}
+
+func (h *VendorHandler) List(c *gin.Context) {
+	var req vendordto.VendorListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	filters := make(map[string]interface{})
+	if req.Search != "" {
+		filters["search"] = req.Search
+	}
+
+	vendors, total, err := h.vendorService.ListVendors(c.Request.Context(), req.Limit, req.Offset, filters)
+	if err != nil {
+		common.SendError(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve vendors", nil)
+		return
+	}
+
+	pagination := common.PaginationInfo{
+		Total:   total,
+		Limit:   req.Limit,
+		Offset:  req.Offset,
+		HasMore: total > (req.Offset + req.Limit),
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Vendors retrieved successfully", gin.H{
+		"vendors":    vendors,
+		"pagination": pagination,
+	})
+}
+
+func (h *VendorHandler) Update(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid vendor ID", nil)
+		return
+	}
+
+	var req vendordto.VendorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	vendor := vendorFromRequest(&req)
+	if err := h.vendorService.UpdateVendor(c.Request.Context(), id, vendor); err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Vendor updated successfully", vendor)
+}
+
+func (h *VendorHandler) Delete(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid vendor ID", nil)
+		return
+	}
+
+	if err := h.vendorService.DeleteVendor(c.Request.Context(), id); err != nil {
+		common.SendError(c, http.StatusNotFound, "NOT_FOUND", "Vendor not found", nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Vendor deleted successfully", gin.H{"id": id})
+}
+
+func vendorFromRequest(req *vendordto.VendorRequest) *entity.Vendor {
+	contacts := make([]entity.VendorContact, len(req.Contacts))
+	for i, contact := range req.Contacts {
+		contacts[i] = entity.VendorContact(contact)
+	}
+	addresses := make([]entity.VendorAddress, len(req.Addresses))
+	for i, address := range req.Addresses {
+		addresses[i] = entity.VendorAddress(address)
+	}
+
+	return &entity.Vendor{
+		Name:                   req.Name,
+		Website:                req.Website,
+		Email:                  req.Email,
+		Phone:                  req.Phone,
+		Contacts:               contacts,
+		Addresses:              addresses,
+		SupportHours:           req.SupportHours,
+		SupportResponseHours:   req.SupportResponseHours,
+		SupportResolutionHours: req.SupportResolutionHours,
+		Notes:                  req.Notes,
+	}
+}
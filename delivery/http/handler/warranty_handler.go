@@ -0,0 +1,370 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	warrantydto "inventory-ticketing-system/application/dto/warranty"
+	"inventory-ticketing-system/delivery/http/middleware"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/service"
+	"inventory-ticketing-system/pkg/common"
+)
+
+type WarrantyHandler struct {
+	warrantyService service.WarrantyService
+}
+
+func NewWarrantyHandler(warrantyService service.WarrantyService) *WarrantyHandler {
+	return &WarrantyHandler{
+		warrantyService: warrantyService,
+	}
+}
+
+func (h *WarrantyHandler) Create(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		common.SendError(c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated", nil)
+		return
+	}
+
+	var req warrantydto.WarrantyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	warranty := warrantyFromRequest(&req)
+	warranty.ID = uuid.New()
+	warranty.CreatedBy = userID
+
+	if err := h.warrantyService.CreateWarranty(c.Request.Context(), warranty); err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusCreated, "Warranty created successfully", warranty)
+}
+
+func (h *WarrantyHandler) Get(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid warranty ID", nil)
+		return
+	}
+
+	warranty, err := h.warrantyService.GetWarranty(c.Request.Context(), id)
+	if err != nil {
+		sendServiceError(c, err, http.StatusNotFound, "NOT_FOUND")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Warranty retrieved successfully", warranty)
+}
+
+func (h *WarrantyHandler) Update(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid warranty ID", nil)
+		return
+	}
+
+	var req warrantydto.WarrantyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	warranty := warrantyFromRequest(&req)
+	if err := h.warrantyService.UpdateWarranty(c.Request.Context(), id, warranty); err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Warranty updated successfully", warranty)
+}
+
+func (h *WarrantyHandler) Delete(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid warranty ID", nil)
+		return
+	}
+
+	if err := h.warrantyService.DeleteWarranty(c.Request.Context(), id); err != nil {
+		sendServiceError(c, err, http.StatusNotFound, "NOT_FOUND")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Warranty deleted successfully", gin.H{"id": id})
+}
+
+func (h *WarrantyHandler) ListByAsset(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid asset ID", nil)
+		return
+	}
+
+	warranties, err := h.warrantyService.ListAssetWarranties(c.Request.Context(), id)
+	if err != nil {
+		sendServiceError(c, err, http.StatusNotFound, "NOT_FOUND")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Warranties retrieved successfully", warranties)
+}
+
+func (h *WarrantyHandler) Coverage(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid asset ID", nil)
+		return
+	}
+
+	coverage, err := h.warrantyService.GetCoverage(c.Request.Context(), id)
+	if err != nil {
+		sendServiceError(c, err, http.StatusNotFound, "NOT_FOUND")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Asset coverage retrieved successfully", coverage)
+}
+
+func (h *WarrantyHandler) CreateContract(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		common.SendError(c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated", nil)
+		return
+	}
+
+	var req warrantydto.ContractRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	contract := contractFromRequest(&req)
+	contract.ID = uuid.New()
+	contract.CreatedBy = userID
+
+	if err := h.warrantyService.CreateContract(c.Request.Context(), contract); err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusCreated, "Support contract created successfully", contract)
+}
+
+func (h *WarrantyHandler) GetContract(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid support contract ID", nil)
+		return
+	}
+
+	contract, err := h.warrantyService.GetContract(c.Request.Context(), id)
+	if err != nil {
+		common.SendError(c, http.StatusNotFound, "NOT_FOUND", "Support contract not found", nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Support contract retrieved successfully", contract)
+}
+
+func (h *WarrantyHandler) ListContracts(c *gin.Context) {
+	var req warrantydto.ContractListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	filters := make(map[string]interface{})
+	if req.AssetID != "" {
+		filters["asset_id"] = req.AssetID
+	}
+	if req.Provider != "" {
+		filters["provider"] = req.Provider
+	}
+	if req.ActiveOn != "" {
+		filters["active_on"] = req.ActiveOn
+	}
+
+	contracts, total, err := h.warrantyService.ListContracts(c.Request.Context(), req.Limit, req.Offset, filters)
+	if err != nil {
+		common.SendError(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve support contracts", nil)
+		return
+	}
+
+	pagination := common.PaginationInfo{
+		Total:   total,
+		Limit:   req.Limit,
+		Offset:  req.Offset,
+		HasMore: total > (req.Offset + req.Limit),
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Support contracts retrieved successfully", gin.H{
+		"contracts":  contracts,
+		"pagination": pagination,
+	})
+}
+
+func (h *WarrantyHandler) UpdateContract(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid support contract ID", nil)
+		return
+	}
+
+	var req warrantydto.ContractRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	contract := contractFromRequest(&req)
+	if err := h.warrantyService.UpdateContract(c.Request.Context(), id, contract); err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Support contract updated successfully", contract)
+}
+
+func (h *WarrantyHandler) DeleteContract(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid support contract ID", nil)
+		return
+	}
+
+	if err := h.warrantyService.DeleteContract(c.Request.Context(), id); err != nil {
+		sendServiceError(c, err, http.StatusNotFound, "NOT_FOUND")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Support contract deleted successfully", gin.H{"id": id})
+}
+
+// Claim claims the repair of a ticket under the asset's warranty.
+func (h *WarrantyHandler) Claim(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		common.SendError(c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated", nil)
+		return
+	}
+
+	ticketID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid ticket ID", nil)
+		return
+	}
+
+	var req warrantydto.ClaimRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	claim := &entity.WarrantyClaim{
+		ID:                uuid.New(),
+		ProviderReference: req.ProviderReference,
+		Notes:             req.Notes,
+		ClaimedBy:         userID,
+	}
+	if req.WarrantyID != nil {
+		claim.WarrantyID = *req.WarrantyID
+	}
+
+	if err := h.warrantyService.ClaimWarranty(c.Request.Context(), ticketID, claim); err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusCreated, "Warranty claim submitted successfully", claim)
+}
+
+func (h *WarrantyHandler) UpdateClaim(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid warranty claim ID", nil)
+		return
+	}
+
+	var req warrantydto.ClaimUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	claim, err := h.warrantyService.UpdateClaim(c.Request.Context(), id, req.Status, req.ProviderReference, req.Notes)
+	if err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Warranty claim updated successfully", claim)
+}
+
+func (h *WarrantyHandler) ListClaims(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid warranty ID", nil)
+		return
+	}
+
+	claims, err := h.warrantyService.ListClaims(c.Request.Context(), id)
+	if err != nil {
+		sendServiceError(c, err, http.StatusNotFound, "NOT_FOUND")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Warranty claims retrieved successfully", claims)
+}
+
+// Expiring reports warranties and support contracts ending within the next
+// days, 30 by default.
+func (h *WarrantyHandler) Expiring(c *gin.Context) {
+	var req warrantydto.ExpiringRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	expiring, err := h.warrantyService.GetExpiring(c.Request.Context(), req.Days)
+	if err != nil {
+		sendServiceError(c, err, http.StatusBadRequest, "VALIDATION_ERROR")
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Expiring coverage retrieved successfully", expiring)
+}
+
+func warrantyFromRequest(req *warrantydto.WarrantyRequest) *entity.Warranty {
+	return &entity.Warranty{
+		AssetID:   req.AssetID,
+		Provider:  req.Provider,
+		Coverage:  req.Coverage,
+		Reference: req.Reference,
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+		Documents: req.Documents,
+		Notes:     req.Notes,
+	}
+}
+
+func contractFromRequest(req *warrantydto.ContractRequest) *entity.SupportContract {
+	return &entity.SupportContract{
+		Provider:       req.Provider,
+		ContractNumber: req.ContractNumber,
+		Coverage:       req.Coverage,
+		StartDate:      req.StartDate,
+		EndDate:        req.EndDate,
+		Cost:           req.Cost,
+		Currency:       req.Currency,
+		Documents:      req.Documents,
+		Notes:          req.Notes,
+		AssetIDs:       req.AssetIDs,
+	}
+}
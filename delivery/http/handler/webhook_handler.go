@@ -0,0 +1,229 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	webhookdto "inventory-ticketing-system/application/dto/webhook"
+	"inventory-ticketing-system/delivery/http/middleware"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/enum"
+	"inventory-ticketing-system/domain/service"
+	"inventory-ticketing-system/pkg/common"
+)
+
+type WebhookHandler struct {
+	webhookService service.WebhookService
+}
+
+func NewWebhookHandler(webhookService service.WebhookService) *WebhookHandler {
+	return &WebhookHandler{
+		webhookService: webhookService,
+	}
+}
+
+func (h *WebhookHandler) Create(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		common.SendError(c, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated", nil)
+		return
+	}
+
+	var req webhookdto.CreateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	endpoint := &entity.WebhookEndpoint{
+		ID:          uuid.New(),
+		URL:         req.URL,
+		Description: req.Description,
+		Events:      req.Events,
+		IsActive:    true,
+		CreatedBy:   userID,
+	}
+
+	secret, err := h.webhookService.CreateEndpoint(c.Request.Context(), endpoint)
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusCreated, "Webhook created successfully", webhookdto.WebhookSecretResponse{
+		WebhookEndpoint: endpoint,
+		Secret:          secret,
+	})
+}
+
+func (h *WebhookHandler) Get(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid webhook ID", nil)
+		return
+	}
+
+	endpoint, err := h.webhookService.GetEndpoint(c.Request.Context(), id)
+	if err != nil {
+		common.SendError(c, http.StatusNotFound, "NOT_FOUND", "Webhook not found", nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Webhook retrieved successfully", endpoint)
+}
+
+func (h *WebhookHandler) List(c *gin.Context) {
+	endpoints, err := h.webhookService.ListEndpoints(c.Request.Context())
+	if err != nil {
+		common.SendError(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve webhooks", nil)
+		return
+	}
+
+	events := []string{enum.EventWildcard}
+	for _, eventType := range enum.AllEventTypes() {
+		events = append(events, string(eventType))
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Webhooks retrieved successfully", webhookdto.WebhookListResponse{
+		Webhooks: endpoints,
+		Events:   events,
+	})
+}
+
+func (h *WebhookHandler) Update(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid webhook ID", nil)
+		return
+	}
+
+	var req webhookdto.UpdateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	endpoint := &entity.WebhookEndpoint{
+		URL:         req.URL,
+		Description: req.Description,
+		Events:      req.Events,
+		IsActive:    *req.IsActive,
+	}
+
+	if err := h.webhookService.UpdateEndpoint(c.Request.Context(), id, endpoint); err != nil {
+		common.SendError(c, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Webhook updated successfully", endpoint)
+}
+
+func (h *WebhookHandler) Delete(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid webhook ID", nil)
+		return
+	}
+
+	if err := h.webhookService.DeleteEndpoint(c.Request.Context(), id); err != nil {
+		common.SendError(c, http.StatusNotFound, "NOT_FOUND", "Webhook not found", nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Webhook deleted successfully", gin.H{"id": id})
+}
+
+func (h *WebhookHandler) RotateSecret(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid webhook ID", nil)
+		return
+	}
+
+	secret, err := h.webhookService.RotateSecret(c.Request.Context(), id)
+	if err != nil {
+		common.SendError(c, http.StatusNotFound, "NOT_FOUND", err.Error(), nil)
+		return
+	}
+
+	endpoint, err := h.webhookService.GetEndpoint(c.Request.Context(), id)
+	if err != nil {
+		common.SendError(c, http.StatusNotFound, "NOT_FOUND", "Webhook not found", nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Webhook secret rotated successfully", webhookdto.WebhookSecretResponse{
+		WebhookEndpoint: endpoint,
+		Secret:          secret,
+	})
+}
+
+func (h *WebhookHandler) ListDeliveries(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid webhook ID", nil)
+		return
+	}
+
+	var req webhookdto.DeliveryListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		common.SendValidationError(c, err)
+		return
+	}
+
+	if _, err := h.webhookService.GetEndpoint(c.Request.Context(), id); err != nil {
+		common.SendError(c, http.StatusNotFound, "NOT_FOUND", "Webhook not found", nil)
+		return
+	}
+
+	deliveries, total, err := h.webhookService.ListDeliveries(c.Request.Context(), id, req.Limit, req.Offset, req.Status)
+	if err != nil {
+		common.SendError(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve deliveries", nil)
+		return
+	}
+
+	pagination := common.PaginationInfo{
+		Total:   total,
+		Limit:   req.Limit,
+		Offset:  req.Offset,
+		HasMore: total > (req.Offset + req.Limit),
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Deliveries retrieved successfully", gin.H{
+		"deliveries": deliveries,
+		"pagination": pagination,
+	})
+}
+
+func (h *WebhookHandler) GetDelivery(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("deliveryId"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid delivery ID", nil)
+		return
+	}
+
+	delivery, err := h.webhookService.GetDelivery(c.Request.Context(), id)
+	if err != nil {
+		common.SendError(c, http.StatusNotFound, "NOT_FOUND", "Delivery not found", nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusOK, "Delivery retrieved successfully", delivery)
+}
+
+func (h *WebhookHandler) ReplayDelivery(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("deliveryId"))
+	if err != nil {
+		common.SendError(c, http.StatusBadRequest, "INVALID_ID", "Invalid delivery ID", nil)
+		return
+	}
+
+	delivery, err := h.webhookService.ReplayDelivery(c.Request.Context(), id)
+	if err != nil {
+		common.SendError(c, http.StatusNotFound, "NOT_FOUND", "Delivery not found", nil)
+		return
+	}
+
+	common.SendSuccess(c, http.StatusAccepted, "Delivery queued for replay", delivery)
+}
@@ -5,11 +5,22 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/enum"
+	"inventory-ticketing-system/domain/policy"
+	"inventory-ticketing-system/domain/service"
 	"inventory-ticketing-system/infrastructure/jwt"
 	"inventory-ticketing-system/pkg/common"
 )
 
-func AuthMiddleware(jwtManager *jwt.JWTManager) gin.HandlerFunc {
+// AuthMiddleware accepts either a JWT from the login endpoint or a personal
+// access token, then resolves the caller's roles into a policy principal that
+// is stored on the gin context and on the request context for services.
+func AuthMiddleware(
+	jwtManager *jwt.JWTManager,
+	accessTokenService service.AccessTokenService,
+	authorizationService service.AuthorizationService,
+) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
@@ -25,15 +36,146 @@ func AuthMiddleware(jwtManager *jwt.JWTManager) gin.HandlerFunc {
 			return
 		}
 
-		userID, role, err := jwtManager.ValidateToken(tokenString)
+		var userID uuid.UUID
+		var scopes []string
+
+		if accessTokenService.IsAccessToken(tokenString) {
+			token, user, err := accessTokenService.Authenticate(c.Request.Context(), tokenString)
+			if err != nil {
+				common.SendError(c, 401, "UNAUTHORIZED", "Invalid token", nil)
+				c.Abort()
+				return
+			}
+			userID = user.ID
+			// A non-nil slice marks the principal as token-limited, even with no scopes
+			scopes = append([]string{}, token.Scopes...)
+		} else {
+			id, _, err := jwtManager.ValidateToken(tokenString)
+			if err != nil {
+				common.SendError(c, 401, "UNAUTHORIZED", "Invalid token", nil)
+				c.Abort()
+				return
+			}
+			userID = id
+		}
+
+		principal, err := authorizationService.BuildPrincipal(c.Request.Context(), userID, scopes)
+		if err != nil {
+			common.SendError(c, 401, "UNAUTHORIZED", "Invalid token", nil)
+			c.Abort()
+			return
+		}
+
+		setPrincipal(c, principal)
+		c.Next()
+	}
+}
+
+// QueryTokenMiddleware lets clients that cannot set headers, such as browser
+// EventSource and WebSocket, pass their token as ?access_token=. It only
+// fills in a missing Authorization header and must run before AuthMiddleware.
+// The token is removed from the URL so later handlers and logs never see it.
+func QueryTokenMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if token := takeQueryToken(c, "access_token"); token != "" && c.GetHeader("Authorization") == "" {
+			c.Request.Header.Set("Authorization", "Bearer "+token)
+		}
+		c.Next()
+	}
+}
+
+// FeedTokenMiddleware authenticates calendar subscriptions by the ?token= of
+// a calendar feed token. The token only opens the one feed it was issued for,
+// with the owner's current grants limited to what that feed needs to read.
+// Requests without a token fall through to auth, so header clients still work.
+func FeedTokenMiddleware(
+	feed enum.CalendarFeed,
+	calendarFeedService service.CalendarFeedService,
+	authorizationService service.AuthorizationService,
+	auth gin.HandlerFunc,
+) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		plaintext := takeQueryToken(c, "token")
+		if plaintext == "" {
+			auth(c)
+			return
+		}
+
+		token, err := calendarFeedService.Authenticate(c.Request.Context(), plaintext)
+		if err != nil || !feedTokenMatches(c, token, feed) {
+			common.SendError(c, 401, "UNAUTHORIZED", "Invalid token", nil)
+			c.Abort()
+			return
+		}
+
+		scopes := []string{string(enum.PermissionAssetsRead)}
+		if feed == enum.CalendarFeedUser {
+			scopes = []string{string(enum.PermissionUsersManage)}
+		}
+
+		principal, err := authorizationService.BuildPrincipal(c.Request.Context(), token.UserID, scopes)
 		if err != nil {
 			common.SendError(c, 401, "UNAUTHORIZED", "Invalid token", nil)
 			c.Abort()
 			return
 		}
 
-		c.Set("user_id", userID)
-		c.Set("user_role", role)
+		setPrincipal(c, principal)
+		c.Next()
+	}
+}
+
+// feedTokenMatches reports whether the token was issued for the requested
+// feed; "me" only names the owner's own user feed.
+func feedTokenMatches(c *gin.Context, token *entity.CalendarFeedToken, feed enum.CalendarFeed) bool {
+	if enum.CalendarFeed(token.Feed) != feed {
+		return false
+	}
+	if c.Param("id") == "me" {
+		return feed == enum.CalendarFeedUser && token.ResourceID == token.UserID
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	return err == nil && id == token.ResourceID
+}
+
+// takeQueryToken returns the named query parameter and removes it from the
+// request URL.
+func takeQueryToken(c *gin.Context, name string) string {
+	query := c.Request.URL.Query()
+	if !query.Has(name) {
+		return ""
+	}
+	token := query.Get(name)
+	query.Del(name)
+	c.Request.URL.RawQuery = query.Encode()
+	return token
+}
+
+func setPrincipal(c *gin.Context, principal *policy.Principal) {
+	c.Set("user_id", principal.UserID)
+	c.Set("user_role", principal.Role)
+	c.Set("principal", principal)
+	c.Request = c.Request.WithContext(policy.WithPrincipal(c.Request.Context(), principal))
+}
+
+// RequirePermission rejects callers that do not hold the permission anywhere.
+// Services still check the specific row, since a grant may be limited to a
+// location subtree or category.
+func RequirePermission(permission enum.Permission) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, err := GetPrincipal(c)
+		if err != nil {
+			common.SendError(c, 401, "UNAUTHORIZED", "User not authenticated", nil)
+			c.Abort()
+			return
+		}
+
+		if !policy.Evaluate(principal, permission, nil) {
+			common.SendError(c, 403, "FORBIDDEN", "Missing permission "+string(permission), nil)
+			c.Abort()
+			return
+		}
+
 		c.Next()
 	}
 }
@@ -88,4 +230,13 @@ func GetUserRole(c *gin.Context) (string, error) {
 	}
 
 	return userRole.(string), nil
+}
+
+func GetPrincipal(c *gin.Context) (*policy.Principal, error) {
+	principal, exists := c.Get("principal")
+	if !exists {
+		return nil, gin.Error{}
+	}
+
+	return principal.(*policy.Principal), nil
 }
\ No newline at end of file
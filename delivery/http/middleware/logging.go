@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"log"
+	"net/url"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -10,6 +11,7 @@ import (
 func LoggingMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		startTime := time.Now()
+		path := loggedPath(c.Request.URL)
 
 		// Process request
 		c.Next()
@@ -19,7 +21,7 @@ func LoggingMiddleware() gin.HandlerFunc {
 		log.Printf(
 			"[%s] %s %s - %d - %v",
 			c.Request.Method,
-			c.Request.URL.Path,
+			path,
 			c.ClientIP(),
 			c.Writer.Status(),
 			duration,
@@ -27,6 +29,24 @@ func LoggingMiddleware() gin.HandlerFunc {
 	}
 }
 
+// redactedQueryParams may carry credentials and are never written to logs.
+var redactedQueryParams = []string{"access_token", "token"}
+
+// loggedPath is the request path with credentials in the query redacted.
+// It is taken before the handlers run, as the token middlewares rewrite the URL.
+func loggedPath(u *url.URL) string {
+	if u.RawQuery == "" {
+		return u.Path
+	}
+	query := u.Query()
+	for _, name := range redactedQueryParams {
+		if query.Has(name) {
+			query.Set(name, "REDACTED")
+		}
+	}
+	return u.Path + "?" + query.Encode()
+}
+
 func CORSMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		c.Header("Access-Control-Allow-Origin", "*")
@@ -8,6 +8,8 @@ import (
 	"inventory-ticketing-system/application/usecase/ticket"
 	"inventory-ticketing-system/delivery/http/handler"
 	"inventory-ticketing-system/delivery/http/middleware"
+	"inventory-ticketing-system/domain/enum"
+	domainservice "inventory-ticketing-system/domain/service"
 	"inventory-ticketing-system/infrastructure/jwt"
 )
 
@@ -20,7 +22,32 @@ func NewRouter(
 	assetHandler *handler.AssetHandler,
 	ticketHandler *handler.TicketHandler,
 	locationHandler *handler.LocationHandler,
+	jwksHandler *handler.JWKSHandler,
+	tokenHandler *handler.TokenHandler,
+	roleHandler *handler.RoleHandler,
+	technicianHandler *handler.TechnicianHandler,
+	departmentHandler *handler.DepartmentHandler,
+	webhookHandler *handler.WebhookHandler,
+	notificationHandler *handler.NotificationHandler,
+	eventStreamHandler *handler.EventStreamHandler,
+	jobHandler *handler.JobHandler,
+	maintenanceHandler *handler.MaintenanceHandler,
+	disposalHandler *handler.DisposalHandler,
+	depreciationHandler *handler.DepreciationHandler,
+	warrantyHandler *handler.WarrantyHandler,
+	vendorHandler *handler.VendorHandler,
+	manufacturerHandler *handler.ManufacturerHandler,
+	procurementHandler *handler.ProcurementHandler,
+	assetRequestHandler *handler.AssetRequestHandler,
+	reservationHandler *handler.ReservationHandler,
+	assetUnitHandler *handler.AssetUnitHandler,
+	inventoryHandler *handler.InventoryHandler,
+	kitHandler *handler.KitHandler,
+	assetRelationshipHandler *handler.AssetRelationshipHandler,
 	jwtManager *jwt.JWTManager,
+	accessTokenService domainservice.AccessTokenService,
+	authorizationService domainservice.AuthorizationService,
+	calendarFeedService domainservice.CalendarFeedService,
 ) *Router {
 	gin.SetMode(gin.ReleaseMode)
 	engine := gin.New()
@@ -35,7 +62,38 @@ func NewRouter(
 		engine: engine,
 	}
 
-	router.setupRoutes(authHandler, assetHandler, ticketHandler, locationHandler, jwtManager)
+	router.setupRoutes(
+		authHandler,
+		assetHandler,
+		ticketHandler,
+		locationHandler,
+		jwksHandler,
+		tokenHandler,
+		roleHandler,
+		technicianHandler,
+		departmentHandler,
+		webhookHandler,
+		notificationHandler,
+		eventStreamHandler,
+		jobHandler,
+		maintenanceHandler,
+		disposalHandler,
+		depreciationHandler,
+		warrantyHandler,
+		vendorHandler,
+		manufacturerHandler,
+		procurementHandler,
+		assetRequestHandler,
+		reservationHandler,
+		assetUnitHandler,
+		inventoryHandler,
+		kitHandler,
+		assetRelationshipHandler,
+		jwtManager,
+		accessTokenService,
+		authorizationService,
+		calendarFeedService,
+	)
 
 	return router
 }
@@ -45,8 +103,36 @@ func (r *Router) setupRoutes(
 	assetHandler *handler.AssetHandler,
 	ticketHandler *handler.TicketHandler,
 	locationHandler *handler.LocationHandler,
+	jwksHandler *handler.JWKSHandler,
+	tokenHandler *handler.TokenHandler,
+	roleHandler *handler.RoleHandler,
+	technicianHandler *handler.TechnicianHandler,
+	departmentHandler *handler.DepartmentHandler,
+	webhookHandler *handler.WebhookHandler,
+	notificationHandler *handler.NotificationHandler,
+	eventStreamHandler *handler.EventStreamHandler,
+	jobHandler *handler.JobHandler,
+	maintenanceHandler *handler.MaintenanceHandler,
+	disposalHandler *handler.DisposalHandler,
+	depreciationHandler *handler.DepreciationHandler,
+	warrantyHandler *handler.WarrantyHandler,
+	vendorHandler *handler.VendorHandler,
+	manufacturerHandler *handler.ManufacturerHandler,
+	procurementHandler *handler.ProcurementHandler,
+	assetRequestHandler *handler.AssetRequestHandler,
+	reservationHandler *handler.ReservationHandler,
+	assetUnitHandler *handler.AssetUnitHandler,
+	inventoryHandler *handler.InventoryHandler,
+	kitHandler *handler.KitHandler,
+	assetRelationshipHandler *handler.AssetRelationshipHandler,
 	jwtManager *jwt.JWTManager,
+	accessTokenService domainservice.AccessTokenService,
+	authorizationService domainservice.AuthorizationService,
+	calendarFeedService domainservice.CalendarFeedService,
 ) {
+	// Public signing keys for services that verify our tokens
+	r.engine.GET("/.well-known/jwks.json", jwksHandler.Get)
+
 	v1 := r.engine.Group("/api/v1")
 
 	// Health check route (public)
@@ -63,55 +149,440 @@ func (r *Router) setupRoutes(
 		authRoutes.POST("/login", authHandler.Login)
 	}
 
+	// Event stream routes; browsers cannot set headers on EventSource or
+	// WebSocket, so the token may also be passed as ?access_token=
+	eventRoutes := v1.Group("/events")
+	eventRoutes.Use(middleware.QueryTokenMiddleware())
+	eventRoutes.Use(middleware.AuthMiddleware(jwtManager, accessTokenService, authorizationService))
+	{
+		eventRoutes.GET("/stream", eventStreamHandler.Stream) // Filtered by read permissions
+	}
+
+	// Calendar feeds; calendar applications subscribe by URL, so these take a
+	// feed token from /calendar-feeds as ?token= instead of a bearer token
+	calendarRoutes := v1.Group("/calendars")
+	{
+		calendarAuth := middleware.AuthMiddleware(jwtManager, accessTokenService, authorizationService)
+		assetFeed := middleware.FeedTokenMiddleware(enum.CalendarFeedAsset, calendarFeedService, authorizationService, calendarAuth)
+		userFeed := middleware.FeedTokenMiddleware(enum.CalendarFeedUser, calendarFeedService, authorizationService, calendarAuth)
+		calendarRoutes.GET("/assets/:id", assetFeed, reservationHandler.AssetCalendar) // Assets you may read
+		calendarRoutes.GET("/users/:id", userFeed, reservationHandler.UserCalendar)    // Your own ("me"), or users:manage
+	}
+
 	// Protected routes
 	protected := v1.Group("/")
-	protected.Use(middleware.AuthMiddleware(jwtManager))
+	protected.Use(middleware.AuthMiddleware(jwtManager, accessTokenService, authorizationService))
 	{
+		// Permission checks; services additionally enforce location and category scopes
+		assetsRead := middleware.RequirePermission(enum.PermissionAssetsRead)
+		assetsWrite := middleware.RequirePermission(enum.PermissionAssetsWrite)
+		assetsDelete := middleware.RequirePermission(enum.PermissionAssetsDelete)
+		ticketsRead := middleware.RequirePermission(enum.PermissionTicketsRead)
+		ticketsWrite := middleware.RequirePermission(enum.PermissionTicketsWrite)
+		ticketsWork := middleware.RequirePermission(enum.PermissionTicketsWork)
+		ticketsDelete := middleware.RequirePermission(enum.PermissionTicketsDelete)
+		locationsRead := middleware.RequirePermission(enum.PermissionLocationsRead)
+		locationsWrite := middleware.RequirePermission(enum.PermissionLocationsWrite)
+		tokensWrite := middleware.RequirePermission(enum.PermissionTokensWrite)
+		usersManage := middleware.RequirePermission(enum.PermissionUsersManage)
+		rolesManage := middleware.RequirePermission(enum.PermissionRolesManage)
+		departmentsManage := middleware.RequirePermission(enum.PermissionDepartmentsManage)
+		reportsRead := middleware.RequirePermission(enum.PermissionReportsRead)
+		webhooksManage := middleware.RequirePermission(enum.PermissionWebhooksManage)
+		notificationsManage := middleware.RequirePermission(enum.PermissionNotificationsManage)
+		jobsManage := middleware.RequirePermission(enum.PermissionJobsManage)
+		maintenanceManage := middleware.RequirePermission(enum.PermissionMaintenanceManage)
+		disposalsApprove := middleware.RequirePermission(enum.PermissionDisposalsApprove)
+		financeManage := middleware.RequirePermission(enum.PermissionFinanceManage)
+		warrantiesManage := middleware.RequirePermission(enum.PermissionWarrantiesManage)
+		vendorsManage := middleware.RequirePermission(enum.PermissionVendorsManage)
+		purchasesApprove := middleware.RequirePermission(enum.PermissionPurchasesApprove)
+		purchasesManage := middleware.RequirePermission(enum.PermissionPurchasesManage)
+
 		// Asset routes
 		assetRoutes := protected.Group("/assets")
 		{
-			assetRoutes.GET("", assetHandler.List)                   // All authenticated users
-			assetRoutes.GET("/:id", assetHandler.Get)               // All authenticated users
-			assetRoutes.POST("", middleware.RoleMiddleware("admin"), assetHandler.Create) // Admin only
-			assetRoutes.PUT("/:id", middleware.RoleMiddleware("admin"), assetHandler.Update) // Admin only
-			assetRoutes.DELETE("/:id", middleware.RoleMiddleware("admin"), assetHandler.Delete) // Admin only
+			assetRoutes.GET("", assetsRead, assetHandler.List)
+			assetRoutes.GET("/:id", assetsRead, assetHandler.Get)
+			assetRoutes.POST("", assetsWrite, assetHandler.Create)
+			assetRoutes.PUT("/:id", assetsWrite, assetHandler.Update)
+			assetRoutes.DELETE("/:id", assetsDelete, assetHandler.Delete)
+			assetRoutes.PUT("/:id/status", assetsWrite, assetHandler.UpdateStatus)
+			assetRoutes.GET("/:id/history", assetsRead, assetHandler.History)
+			assetRoutes.POST("/:id/usage", assetsWrite, maintenanceHandler.RecordUsage)
+			assetRoutes.POST("/:id/disposals", assetsRead, disposalHandler.Propose)
+			assetRoutes.POST("/:id/requests", assetsRead, assetRequestHandler.Create)
+			assetRoutes.GET("/:id/depreciation", assetsRead, depreciationHandler.Schedule)
+			assetRoutes.GET("/:id/warranties", assetsRead, warrantyHandler.ListByAsset)
+			assetRoutes.GET("/:id/coverage", assetsRead, warrantyHandler.Coverage)
+			assetRoutes.GET("/:id/units", assetsRead, assetUnitHandler.ListByAsset)
+			assetRoutes.POST("/:id/units", assetsWrite, assetUnitHandler.Create)
+			assetRoutes.GET("/:id/lots", assetsRead, inventoryHandler.ListAssetLots)
+			assetRoutes.POST("/:id/lots", assetsWrite, inventoryHandler.CreateLot)
+			assetRoutes.GET("/:id/relationships", assetsRead, assetRelationshipHandler.ListByAsset)
+			assetRoutes.POST("/:id/relationships", assetsWrite, assetRelationshipHandler.Attach)
+			assetRoutes.GET("/:id/relationship-graph", assetsRead, assetRelationshipHandler.Graph)
 		}
 
 		// Ticket routes
 		ticketRoutes := protected.Group("/tickets")
 		{
-			ticketRoutes.GET("", ticketHandler.List)                 // All authenticated users
-			ticketRoutes.GET("/:id", ticketHandler.Get)             // All authenticated users
-			ticketRoutes.POST("", ticketHandler.Create)             // All authenticated users
-			ticketRoutes.PUT("/:id", middleware.RoleMiddleware("admin"), ticketHandler.Update) // Admin only
-			ticketRoutes.DELETE("/:id", middleware.RoleMiddleware("admin"), ticketHandler.Delete) // Admin only
+			ticketRoutes.GET("", ticketsRead, ticketHandler.List)
+			ticketRoutes.GET("/queue", ticketsWork, ticketHandler.Queue)
+			ticketRoutes.GET("/:id", ticketsRead, ticketHandler.Get)
+			ticketRoutes.POST("", ticketsWrite, ticketHandler.Create)
+			ticketRoutes.PUT("/:id", ticketsWork, ticketHandler.Update)
+			ticketRoutes.DELETE("/:id", ticketsDelete, ticketHandler.Delete)
+			ticketRoutes.POST("/:id/warranty-claim", ticketsWork, warrantyHandler.Claim)
 		}
 
 		// Location routes
 		locationRoutes := protected.Group("/locations")
 		{
-			locationRoutes.GET("", locationHandler.List)                 // All authenticated users
-			locationRoutes.GET("/:id", locationHandler.Get)             // All authenticated users
-			locationRoutes.POST("", middleware.RoleMiddleware("admin"), locationHandler.Create) // Admin only
-			locationRoutes.PUT("/:id", middleware.RoleMiddleware("admin"), locationHandler.Update) // Admin only
-			locationRoutes.DELETE("/:id", middleware.RoleMiddleware("admin"), locationHandler.Delete) // Admin only
+			locationRoutes.GET("", locationsRead, locationHandler.List)
+			locationRoutes.GET("/:id", locationsRead, locationHandler.Get)
+			locationRoutes.POST("", locationsWrite, locationHandler.Create)
+			locationRoutes.PUT("/:id", locationsWrite, locationHandler.Update)
+			locationRoutes.DELETE("/:id", locationsWrite, locationHandler.Delete)
+		}
+
+		// Technician and team routes
+		technicianRoutes := protected.Group("/technicians")
+		technicianRoutes.Use(usersManage)
+		{
+			technicianRoutes.GET("", technicianHandler.List)
+			technicianRoutes.GET("/:userId", technicianHandler.Get)
+			technicianRoutes.POST("", technicianHandler.Create)
+			technicianRoutes.PUT("/:userId", technicianHandler.Update)
+			technicianRoutes.DELETE("/:userId", technicianHandler.Delete)
+		}
+
+		teamRoutes := protected.Group("/teams")
+		teamRoutes.Use(usersManage)
+		{
+			teamRoutes.GET("", technicianHandler.ListTeams)
+			teamRoutes.GET("/:id", technicianHandler.GetTeam)
+			teamRoutes.POST("", technicianHandler.CreateTeam)
+			teamRoutes.PUT("/:id", technicianHandler.UpdateTeam)
+			teamRoutes.DELETE("/:id", technicianHandler.DeleteTeam)
+		}
+
+		// Department routes
+		departmentRoutes := protected.Group("/departments")
+		{
+			departmentRoutes.GET("", departmentHandler.List)    // All authenticated users
+			departmentRoutes.GET("/:id", departmentHandler.Get) // All authenticated users
+			departmentRoutes.POST("", departmentsManage, departmentHandler.Create)
+			departmentRoutes.PUT("/:id", departmentsManage, departmentHandler.Update)
+			departmentRoutes.DELETE("/:id", departmentsManage, departmentHandler.Delete)
+		}
+
+		// Report routes
+		reportRoutes := protected.Group("/reports")
+		reportRoutes.Use(reportsRead)
+		{
+			reportRoutes.GET("/departments", departmentHandler.Report)
+			reportRoutes.GET("/asset-status", assetHandler.StatusReport)
+			reportRoutes.GET("/asset-valuation", depreciationHandler.Valuation)
+			reportRoutes.GET("/expiring-coverage", warrantyHandler.Expiring)
+		}
+
+		// Webhook routes
+		webhookRoutes := protected.Group("/webhooks")
+		webhookRoutes.Use(webhooksManage)
+		{
+			webhookRoutes.GET("", webhookHandler.List)
+			webhookRoutes.POST("", webhookHandler.Create)
+			webhookRoutes.GET("/deliveries/:deliveryId", webhookHandler.GetDelivery)
+			webhookRoutes.POST("/deliveries/:deliveryId/replay", webhookHandler.ReplayDelivery)
+			webhookRoutes.GET("/:id", webhookHandler.Get)
+			webhookRoutes.PUT("/:id", webhookHandler.Update)
+			webhookRoutes.DELETE("/:id", webhookHandler.Delete)
+			webhookRoutes.POST("/:id/rotate-secret", webhookHandler.RotateSecret)
+			webhookRoutes.GET("/:id/deliveries", webhookHandler.ListDeliveries)
+		}
+
+		// Notification inbox and template routes
+		notificationRoutes := protected.Group("/notifications")
+		{
+			notificationRoutes.GET("", notificationHandler.ListInbox)             // Own inbox
+			notificationRoutes.POST("/read-all", notificationHandler.MarkAllRead) // Own inbox
+			notificationRoutes.POST("/:id/read", notificationHandler.MarkRead)    // Own inbox
+			notificationRoutes.GET("/templates", notificationsManage, notificationHandler.ListTemplates)
+			notificationRoutes.POST("/templates", notificationsManage, notificationHandler.CreateTemplate)
+			notificationRoutes.GET("/templates/:id", notificationsManage, notificationHandler.GetTemplate)
+			notificationRoutes.PUT("/templates/:id", notificationsManage, notificationHandler.UpdateTemplate)
+			notificationRoutes.DELETE("/templates/:id", notificationsManage, notificationHandler.DeleteTemplate)
+		}
+
+		// Scheduled job routes
+		jobRoutes := protected.Group("/jobs")
+		jobRoutes.Use(jobsManage)
+		{
+			jobRoutes.GET("", jobHandler.List)
+			jobRoutes.GET("/:name", jobHandler.Get)
+			jobRoutes.GET("/:name/runs", jobHandler.ListRuns)
+			jobRoutes.POST("/:name/run", jobHandler.Run)
+		}
+
+		// Preventive maintenance routes
+		maintenanceRoutes := protected.Group("/maintenance")
+		{
+			maintenanceRoutes.GET("/calendar", assetsRead, maintenanceHandler.Calendar) // Filtered by asset scope
+			maintenanceRoutes.GET("/plans", maintenanceManage, maintenanceHandler.ListPlans)
+			maintenanceRoutes.POST("/plans", maintenanceManage, maintenanceHandler.CreatePlan)
+			maintenanceRoutes.GET("/plans/:id", maintenanceManage, maintenanceHandler.GetPlan)
+			maintenanceRoutes.PUT("/plans/:id", maintenanceManage, maintenanceHandler.UpdatePlan)
+			maintenanceRoutes.DELETE("/plans/:id", maintenanceManage, maintenanceHandler.DeletePlan)
+			maintenanceRoutes.GET("/plans/:id/records", maintenanceManage, maintenanceHandler.ListRecords)
+		}
+
+		// Asset disposal routes
+		disposalRoutes := protected.Group("/disposals")
+		{
+			disposalRoutes.GET("", disposalHandler.List)                                   // Own requests, or all for approvers
+			disposalRoutes.GET("/:id", disposalHandler.Get)                                // Requester or approver
+			disposalRoutes.GET("/:id/certificate", disposalHandler.Certificate)            // Requester or approver
+			disposalRoutes.POST("/:id/cancel", disposalHandler.Cancel)                     // Requester only
+			disposalRoutes.POST("/:id/wipe-confirmation", disposalHandler.ConfirmDataWipe) // tickets:work or disposals:approve on the asset
+			disposalRoutes.POST("/:id/approve", disposalsApprove, disposalHandler.Approve)
+			disposalRoutes.POST("/:id/reject", disposalsApprove, disposalHandler.Reject)
+		}
+
+		// Depreciation policy routes
+		depreciationRoutes := protected.Group("/depreciation-policies")
+		depreciationRoutes.Use(financeManage)
+		{
+			depreciationRoutes.GET("", depreciationHandler.ListPolicies)
+			depreciationRoutes.POST("", depreciationHandler.CreatePolicy)
+			depreciationRoutes.GET("/:id", depreciationHandler.GetPolicy)
+			depreciationRoutes.PUT("/:id", depreciationHandler.UpdatePolicy)
+			depreciationRoutes.DELETE("/:id", depreciationHandler.DeletePolicy)
+		}
+
+		// Warranty and support contract routes
+		warrantyRoutes := protected.Group("/warranties")
+		{
+			warrantyRoutes.GET("/:id", assetsRead, warrantyHandler.Get)
+			warrantyRoutes.GET("/:id/claims", assetsRead, warrantyHandler.ListClaims)
+			warrantyRoutes.POST("", warrantiesManage, warrantyHandler.Create)
+			warrantyRoutes.PUT("/:id", warrantiesManage, warrantyHandler.Update)
+			warrantyRoutes.DELETE("/:id", warrantiesManage, warrantyHandler.Delete)
+		}
+		protected.PUT("/warranty-claims/:id", ticketsWork, warrantyHandler.UpdateClaim)
+
+		contractRoutes := protected.Group("/support-contracts")
+		contractRoutes.Use(warrantiesManage)
+		{
+			contractRoutes.GET("", warrantyHandler.ListContracts)
+			contractRoutes.POST("", warrantyHandler.CreateContract)
+			contractRoutes.GET("/:id", warrantyHandler.GetContract)
+			contractRoutes.PUT("/:id", warrantyHandler.UpdateContract)
+			contractRoutes.DELETE("/:id", warrantyHandler.DeleteContract)
+		}
+
+		// Vendor and manufacturer routes
+		vendorRoutes := protected.Group("/vendors")
+		{
+			vendorRoutes.GET("", assetsRead, vendorHandler.List)
+			vendorRoutes.GET("/:id", assetsRead, vendorHandler.Get) // Aggregates filtered by asset and ticket scope
+			vendorRoutes.POST("", vendorsManage, vendorHandler.Create)
+			vendorRoutes.PUT("/:id", vendorsManage, vendorHandler.Update)
+			vendorRoutes.DELETE("/:id", vendorsManage, vendorHandler.Delete)
+		}
+
+		manufacturerRoutes := protected.Group("/manufacturers")
+		{
+			manufacturerRoutes.GET("", assetsRead, manufacturerHandler.List)
+			manufacturerRoutes.GET("/:id", assetsRead, manufacturerHandler.Get)
+			manufacturerRoutes.POST("", vendorsManage, manufacturerHandler.Create)
+			manufacturerRoutes.POST("/normalize", vendorsManage, manufacturerHandler.Normalize)
+			manufacturerRoutes.PUT("/:id", vendorsManage, manufacturerHandler.Update)
+			manufacturerRoutes.DELETE("/:id", vendorsManage, manufacturerHandler.Delete)
+			manufacturerRoutes.POST("/:id/merge", vendorsManage, manufacturerHandler.Merge)
+		}
+
+		// Asset request routes
+		assetRequestRoutes := protected.Group("/asset-requests")
+		{
+			assetRequestRoutes.GET("", assetRequestHandler.List)                 // Own requests, awaiting your decision, or for assets you may write
+			assetRequestRoutes.GET("/:id", assetRequestHandler.Get)              // Requester, approvers and their delegates, or assets:write
+			assetRequestRoutes.POST("/:id/approve", assetRequestHandler.Approve) // Current step's approvers and their delegates
+			assetRequestRoutes.POST("/:id/reject", assetRequestHandler.Reject)   // Current step's approvers and their delegates
+			assetRequestRoutes.POST("/:id/cancel", assetRequestHandler.Cancel)   // Requester only
+		}
+
+		delegationRoutes := protected.Group("/approval-delegations")
+		{
+			delegationRoutes.GET("", assetRequestHandler.ListDelegations)         // Given or received
+			delegationRoutes.POST("", assetRequestHandler.CreateDelegation)       // Your own approvals
+			delegationRoutes.DELETE("/:id", assetRequestHandler.DeleteDelegation) // Delegator only
+		}
+
+		checkoutRoutes := protected.Group("/asset-checkouts")
+		{
+			checkoutRoutes.GET("", assetRequestHandler.ListCheckouts) // Own checkouts, or for assets you may write
+			checkoutRoutes.POST("/:id/return", assetsWrite, assetRequestHandler.ReturnCheckout)
+		}
+
+		// Asset unit routes
+		unitRoutes := protected.Group("/asset-units")
+		{
+			unitRoutes.GET("", assetsRead, assetUnitHandler.List)
+			unitRoutes.GET("/:id", assetsRead, assetUnitHandler.Get)
+			unitRoutes.PUT("/:id", assetsWrite, assetUnitHandler.Update)
+			unitRoutes.PUT("/:id/status", assetsWrite, assetUnitHandler.UpdateStatus)
+			unitRoutes.DELETE("/:id", assetsDelete, assetUnitHandler.Delete)
+			unitRoutes.GET("/:id/history", assetsRead, assetUnitHandler.History)
+		}
+
+		trackingModeRoutes := protected.Group("/tracking-modes")
+		{
+			trackingModeRoutes.GET("", assetsRead, assetUnitHandler.ListTrackingModes)
+			trackingModeRoutes.POST("", assetsWrite, assetUnitHandler.CreateTrackingMode)       // Unrestricted assets:write only
+			trackingModeRoutes.PUT("/:id", assetsWrite, assetUnitHandler.UpdateTrackingMode)    // Unrestricted assets:write only
+			trackingModeRoutes.DELETE("/:id", assetsWrite, assetUnitHandler.DeleteTrackingMode) // Unrestricted assets:write only
+		}
+
+		// Asset relationship routes
+		assetRelationshipRoutes := protected.Group("/asset-relationships")
+		{
+			assetRelationshipRoutes.DELETE("/:id", assetsWrite, assetRelationshipHandler.Detach)
+		}
+
+		// Inventory routes
+		inventoryRoutes := protected.Group("/inventory")
+		{
+			inventoryRoutes.GET("/low-stock", assetsRead, inventoryHandler.LowStock)
+			inventoryRoutes.GET("/reorder-points", assetsRead, inventoryHandler.ListReorderPoints)
+			inventoryRoutes.POST("/reorder-points", assetsWrite, inventoryHandler.CreateReorderPoint) // Category points need assets:write on all assets
+			inventoryRoutes.GET("/reorder-points/:id", assetsRead, inventoryHandler.GetReorderPoint)
+			inventoryRoutes.PUT("/reorder-points/:id", assetsWrite, inventoryHandler.UpdateReorderPoint)
+			inventoryRoutes.DELETE("/reorder-points/:id", assetsWrite, inventoryHandler.DeleteReorderPoint)
+			inventoryRoutes.GET("/expiring-lots", assetsRead, inventoryHandler.ExpiringLots)
+		}
+
+		// Kit routes
+		kitRoutes := protected.Group("/kits")
+		{
+			kitRoutes.GET("", assetsRead, kitHandler.List)
+			kitRoutes.GET("/:id", assetsRead, kitHandler.Get)
+			kitRoutes.POST("", assetsWrite, kitHandler.Create)       // Unrestricted assets:write only
+			kitRoutes.PUT("/:id", assetsWrite, kitHandler.Update)    // Unrestricted assets:write only
+			kitRoutes.DELETE("/:id", assetsWrite, kitHandler.Delete) // Unrestricted assets:write only
+			kitRoutes.GET("/:id/availability", assetsRead, kitHandler.Availability)
+			kitRoutes.POST("/:id/checkout", assetsWrite, kitHandler.Checkout)
+		}
+
+		kitCheckoutRoutes := protected.Group("/kit-checkouts")
+		{
+			kitCheckoutRoutes.GET("", kitHandler.ListCheckouts)   // Own checkouts, or for assets you may write
+			kitCheckoutRoutes.GET("/:id", kitHandler.GetCheckout) // Own checkout, or assets:write on all its items
+			kitCheckoutRoutes.POST("/:id/return", assetsWrite, kitHandler.Return)
+		}
+
+		stockLotRoutes := protected.Group("/stock-lots")
+		{
+			stockLotRoutes.GET("/:id", assetsRead, inventoryHandler.GetLot)
+			stockLotRoutes.PUT("/:id", assetsWrite, inventoryHandler.UpdateLot)
+			stockLotRoutes.PUT("/:id/status", assetsWrite, inventoryHandler.UpdateLotStatus)
+			stockLotRoutes.DELETE("/:id", assetsDelete, inventoryHandler.DeleteLot)
+		}
+
+		// Reservation routes
+		reservationRoutes := protected.Group("/reservations")
+		{
+			reservationRoutes.GET("", reservationHandler.List)                                  // Own reservations, or for assets you may write
+			reservationRoutes.POST("", assetsRead, reservationHandler.Create)                   // Others need assets:write to reserve for someone
+			reservationRoutes.GET("/availability", assetsRead, reservationHandler.Availability) // Assets you may read
+			reservationRoutes.GET("/:id", reservationHandler.Get)                               // Reserving user, or assets:write
+			reservationRoutes.PUT("/:id", reservationHandler.Update)                            // Reserving user, or assets:write
+			reservationRoutes.POST("/:id/cancel", reservationHandler.Cancel)                    // Reserving user, or assets:write
+		}
+
+		// Procurement routes
+		purchaseRequestRoutes := protected.Group("/purchase-requests")
+		{
+			purchaseRequestRoutes.GET("", procurementHandler.ListRequests)              // Own requests, plus those you may approve or order
+			purchaseRequestRoutes.POST("", procurementHandler.CreateRequest)            // For your own department
+			purchaseRequestRoutes.GET("/:id", procurementHandler.GetRequest)            // Requester, approver or buyer
+			purchaseRequestRoutes.POST("/:id/submit", procurementHandler.SubmitRequest) // Requester only
+			purchaseRequestRoutes.POST("/:id/cancel", procurementHandler.CancelRequest) // Requester only
+			purchaseRequestRoutes.POST("/:id/approve", purchasesApprove, procurementHandler.ApproveRequest)
+			purchaseRequestRoutes.POST("/:id/reject", purchasesApprove, procurementHandler.RejectRequest)
+		}
+
+		purchaseOrderRoutes := protected.Group("/purchase-orders")
+		purchaseOrderRoutes.Use(purchasesManage)
+		{
+			purchaseOrderRoutes.GET("", procurementHandler.ListOrders)
+			purchaseOrderRoutes.POST("", procurementHandler.CreateOrder)
+			purchaseOrderRoutes.GET("/:id", procurementHandler.GetOrder)
+			purchaseOrderRoutes.POST("/:id/cancel", procurementHandler.CancelOrder)
+			purchaseOrderRoutes.GET("/:id/receipts", procurementHandler.ListReceipts)
+			purchaseOrderRoutes.POST("/:id/receipts", procurementHandler.ReceiveGoods) // Also needs assets:write where the assets go
+		}
+
+		// Personal access token routes
+		tokenRoutes := protected.Group("/tokens")
+		tokenRoutes.Use(tokensWrite)
+		{
+			tokenRoutes.GET("", tokenHandler.List)          // Own tokens
+			tokenRoutes.POST("", tokenHandler.Create)       // Own tokens
+			tokenRoutes.DELETE("/:id", tokenHandler.Revoke) // Owner or users:manage
+		}
+
+		// Calendar feed token routes
+		calendarFeedRoutes := protected.Group("/calendar-feeds")
+		{
+			calendarFeedRoutes.GET("", reservationHandler.ListFeedTokens)         // Own feed tokens
+			calendarFeedRoutes.POST("", reservationHandler.CreateFeedToken)       // Feeds you may read
+			calendarFeedRoutes.DELETE("/:id", reservationHandler.RevokeFeedToken) // Owner or users:manage
+		}
+
+		// Service account routes
+		serviceAccountRoutes := protected.Group("/service-accounts")
+		serviceAccountRoutes.Use(usersManage)
+		{
+			serviceAccountRoutes.GET("", tokenHandler.ListServiceAccounts)
+			serviceAccountRoutes.POST("", tokenHandler.CreateServiceAccount)
+			serviceAccountRoutes.GET("/:id/tokens", tokenHandler.ListServiceAccountTokens)
+			serviceAccountRoutes.POST("/:id/tokens", tokenHandler.CreateServiceAccountToken)
+		}
+
+		// Role administration routes
+		roleRoutes := protected.Group("/roles")
+		roleRoutes.Use(rolesManage)
+		{
+			roleRoutes.GET("", roleHandler.List)
+			roleRoutes.GET("/:id", roleHandler.Get)
+			roleRoutes.POST("", roleHandler.Create)
+			roleRoutes.PUT("/:id", roleHandler.Update)
+			roleRoutes.DELETE("/:id", roleHandler.Delete)
 		}
 
 		// User routes
 		userRoutes := protected.Group("/users")
 		{
 			userRoutes.GET("/me", func(c *gin.Context) {
-				userID, _ := c.Get("user_id")
-				userRole, _ := c.Get("user_role")
+				principal, _ := middleware.GetPrincipal(c)
 				c.JSON(200, gin.H{
 					"success": true,
 					"data": gin.H{
-						"id":   userID,
-						"role": userRole,
+						"id":          principal.UserID,
+						"role":        principal.Role,
+						"permissions": principal.Permissions(),
 					},
 					"message": "User profile retrieved successfully",
 				})
 			})
+			userRoutes.GET("/me/notification-preferences", notificationHandler.GetPreferences)    // Own preferences
+			userRoutes.PUT("/me/notification-preferences", notificationHandler.UpdatePreferences) // Own preferences
+			userRoutes.PUT("/:id/department", departmentsManage, departmentHandler.SetUserDepartment)
+			userRoutes.PUT("/:id/role", rolesManage, roleHandler.SetUserRole)
+			userRoutes.GET("/:id/roles", rolesManage, roleHandler.ListUserRoles)
+			userRoutes.POST("/:id/roles", rolesManage, roleHandler.AssignUserRole)
+			userRoutes.DELETE("/:id/roles/:assignmentId", rolesManage, roleHandler.RemoveUserRole)
 		}
 	}
 }
@@ -137,7 +608,7 @@ func SetupDependencies() (
 	loginUseCase := auth.NewLoginUseCase(nil)
 	createAssetUseCase := asset.NewCreateAssetUseCase(nil)
 	listAssetsUseCase := asset.NewListAssetsUseCase(nil)
-	createTicketUseCase := ticket.NewCreateTicketUseCase(nil)
+	createTicketUseCase := ticket.NewCreateTicketUseCase(nil, nil, nil, nil)
 	listTicketsUseCase := ticket.NewListTicketsUseCase(nil)
 
 	// Handlers - location handler needs a service, pass nil for now (should be injected from main)
@@ -145,8 +616,8 @@ func SetupDependencies() (
 	locationHandler := handler.NewLocationHandler(locationService)
 
 	authHandler := handler.NewAuthHandler(loginUseCase)
-	assetHandler := handler.NewAssetHandler(createAssetUseCase, listAssetsUseCase)
-	ticketHandler := handler.NewTicketHandler(createTicketUseCase, listTicketsUseCase)
+	assetHandler := handler.NewAssetHandler(createAssetUseCase, listAssetsUseCase, nil, nil, nil, nil, nil, nil)
+	ticketHandler := handler.NewTicketHandler(createTicketUseCase, listTicketsUseCase, nil, nil, nil, nil)
 
 	return authHandler, assetHandler, ticketHandler, locationHandler, jwtManager
 }
\ No newline at end of file
@@ -7,19 +7,31 @@ import (
 )
 
 type Asset struct {
-	ID            uuid.UUID  `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
-	UniqueID      string     `json:"uniqueId" gorm:"not null"`
-	Name          string     `json:"name" gorm:"not null"`
-	Comment       string     `json:"comment"`
-	Detail        string     `json:"detail"`
-	Qty           int        `json:"qty" gorm:"default:1"`
-	Brand         string     `json:"brand"`
-	Type          string     `json:"type" gorm:"check:type IN ('it', 'non_it')"`
-	Status        string     `json:"status" gorm:"default:'available';check:status IN ('available', 'booked', 'broken', 'repair')"`
-	Category      string     `json:"category"`
-	LocationID    *uuid.UUID `json:"locationId" gorm:"type:uuid"`
-	LocationLabel string     `json:"locationLabel"`
-	Location      *Location  `json:"location,omitempty" gorm:"foreignKey:LocationID;references:ID"`
-	CreatedAt     time.Time  `json:"createdAt" gorm:"autoCreateTime"`
-	UpdatedAt     time.Time  `json:"updatedAt" gorm:"autoUpdateTime"`
+	ID               uuid.UUID  `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	UniqueID         string     `json:"uniqueId" gorm:"not null"`
+	Name             string     `json:"name" gorm:"not null"`
+	Comment          string     `json:"comment"`
+	Detail           string     `json:"detail"`
+	Qty              int        `json:"qty" gorm:"default:1"`
+	Brand            string     `json:"brand"`
+	ManufacturerID   *uuid.UUID `json:"manufacturerId" gorm:"type:uuid;index"`
+	Type             string     `json:"type" gorm:"check:type IN ('it', 'non_it')"`
+	Status           string     `json:"status" gorm:"default:'available';check:status IN ('available', 'booked', 'broken', 'repair', 'retired', 'disposed', 'lost')"`
+	Category         string     `json:"category"`
+	LocationID       *uuid.UUID `json:"locationId" gorm:"type:uuid"`
+	LocationLabel    string     `json:"locationLabel"`
+	Location         *Location  `json:"location,omitempty" gorm:"foreignKey:LocationID;references:ID"`
+	DepartmentID     *uuid.UUID `json:"departmentId" gorm:"type:uuid;index"`
+	UsageCount       int        `json:"usageCount" gorm:"not null;default:0"`
+	DataBearing      bool       `json:"dataBearing" gorm:"not null;default:false"`
+	PurchaseCost     Money      `json:"purchaseCost" gorm:"type:numeric(12,2);not null;default:0"`
+	Currency         string     `json:"currency" gorm:"type:char(3);not null;default:'USD'"`
+	PurchaseDate     *time.Time `json:"purchaseDate" gorm:"type:date"`
+	InvoiceNumber    string     `json:"invoiceNumber"`
+	Vendor           string     `json:"vendor"`
+	PurchaseVendorID *uuid.UUID `json:"purchaseVendorId" gorm:"type:uuid;index"`
+	ServiceVendorID  *uuid.UUID `json:"serviceVendorId" gorm:"type:uuid;index"`
+	PurchaseOrderID  *uuid.UUID `json:"purchaseOrderId" gorm:"type:uuid;index"`
+	CreatedAt        time.Time  `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt        time.Time  `json:"updatedAt" gorm:"autoUpdateTime"`
 }
\ No newline at end of file
@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AssetRelationship links two assets, read as "FromAsset <type> ToAsset",
+// such as a server that contains a disk or a laptop that depends on a dock.
+type AssetRelationship struct {
+	ID          uuid.UUID  `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	FromAssetID uuid.UUID  `json:"fromAssetId" gorm:"type:uuid;not null;uniqueIndex:idx_asset_relationships_link"`
+	FromAsset   *Asset     `json:"fromAsset,omitempty" gorm:"foreignKey:FromAssetID;references:ID;constraint:OnDelete:CASCADE"`
+	ToAssetID   uuid.UUID  `json:"toAssetId" gorm:"type:uuid;not null;index;uniqueIndex:idx_asset_relationships_link"`
+	ToAsset     *Asset     `json:"toAsset,omitempty" gorm:"foreignKey:ToAssetID;references:ID;constraint:OnDelete:CASCADE"`
+	Type        string     `json:"type" gorm:"not null;uniqueIndex:idx_asset_relationships_link;check:type IN ('contains', 'installed_in', 'connected_to', 'depends_on')"`
+	Notes       string     `json:"notes"`
+	CreatedBy   *uuid.UUID `json:"createdBy" gorm:"type:uuid"`
+	CreatedAt   time.Time  `json:"createdAt" gorm:"autoCreateTime"`
+}
+
+// ParentID returns the containing asset of a containment relationship.
+func (r *AssetRelationship) ParentID() uuid.UUID {
+	if r.Type == "installed_in" {
+		return r.ToAssetID
+	}
+	return r.FromAssetID
+}
+
+// ChildID returns the component of a containment relationship.
+func (r *AssetRelationship) ChildID() uuid.UUID {
+	if r.Type == "installed_in" {
+		return r.FromAssetID
+	}
+	return r.ToAssetID
+}
+
+// AssetGraph is the part of the relationship graph reachable from Root
+// within Depth hops, limited to the assets the caller can read.
+type AssetGraph struct {
+	Root          uuid.UUID            `json:"root"`
+	Depth         int                  `json:"depth"`
+	Assets        []*Asset             `json:"assets"`
+	Relationships []*AssetRelationship `json:"relationships"`
+	Truncated     bool                 `json:"truncated"`
+}
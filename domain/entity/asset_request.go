@@ -0,0 +1,102 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AssetRequest asks for units of an existing asset from the catalog. It goes
+// through the configured approval chain one step at a time and, once the
+// last step is approved, is fulfilled by checking the units out to the
+// requester. ReturnBy, if set, becomes the due date of the checkout.
+type AssetRequest struct {
+	ID           uuid.UUID               `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	AssetID      uuid.UUID               `json:"assetId" gorm:"type:uuid;not null;index"`
+	Asset        *Asset                  `json:"asset,omitempty" gorm:"foreignKey:AssetID;references:ID"`
+	RequestedBy  uuid.UUID               `json:"requestedBy" gorm:"type:uuid;not null;index"`
+	DepartmentID *uuid.UUID              `json:"departmentId" gorm:"type:uuid;index"`
+	Quantity     int                     `json:"quantity" gorm:"not null;default:1"`
+	Reason       string                  `json:"reason"`
+	ReturnBy     *time.Time              `json:"returnBy"`
+	Status       string                  `json:"status" gorm:"not null;default:'pending';index;check:status IN ('pending', 'rejected', 'cancelled', 'fulfilled')"`
+	Approvals    []*AssetRequestApproval `json:"approvals" gorm:"foreignKey:RequestID;references:ID;constraint:OnDelete:CASCADE"`
+	CheckoutID   *uuid.UUID              `json:"checkoutId" gorm:"type:uuid"`
+	FulfilledAt  *time.Time              `json:"fulfilledAt"`
+	CreatedAt    time.Time               `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt    time.Time               `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// CurrentApproval returns the step awaiting a decision, if any.
+func (r *AssetRequest) CurrentApproval() *AssetRequestApproval {
+	for _, approval := range r.Approvals {
+		if approval.Status == "pending" {
+			return approval
+		}
+	}
+	return nil
+}
+
+// AssetRequestApproval is one step of a request's approval chain. Approver is
+// "line_manager", decided by ApproverID, or the name of the role whose members
+// decide it. A step becomes due SLA after it is activated.
+type AssetRequestApproval struct {
+	ID             uuid.UUID  `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	RequestID      uuid.UUID  `json:"requestId" gorm:"type:uuid;not null;index"`
+	Step           int        `json:"step" gorm:"not null"`
+	Approver       string     `json:"approver" gorm:"not null"`
+	ApproverID     *uuid.UUID `json:"approverId" gorm:"type:uuid;index"`
+	Status         string     `json:"status" gorm:"not null;default:'waiting';index;check:status IN ('waiting', 'pending', 'approved', 'rejected', 'skipped')"`
+	ActivatedAt    *time.Time `json:"activatedAt"`
+	DueAt          *time.Time `json:"dueAt" gorm:"index"`
+	ReminderSentAt *time.Time `json:"reminderSentAt"`
+	DecidedBy      *uuid.UUID `json:"decidedBy" gorm:"type:uuid"`
+	OnBehalfOf     *uuid.UUID `json:"onBehalfOf" gorm:"type:uuid"`
+	DecidedAt      *time.Time `json:"decidedAt"`
+	Comment        string     `json:"comment"`
+	Overdue        bool       `json:"overdue" gorm:"-"`
+}
+
+// IsOverdue reports whether the step was still undecided at its due time.
+func (a *AssetRequestApproval) IsOverdue(now time.Time) bool {
+	if a.DueAt == nil {
+		return false
+	}
+	if a.DecidedAt != nil {
+		return a.DecidedAt.After(*a.DueAt)
+	}
+	return a.Status == "pending" && now.After(*a.DueAt)
+}
+
+// ApprovalDelegation lets DelegateID decide approvals on behalf of
+// DelegatorID between StartsAt and EndsAt, while the delegator is away.
+type ApprovalDelegation struct {
+	ID          uuid.UUID `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	DelegatorID uuid.UUID `json:"delegatorId" gorm:"type:uuid;not null;index"`
+	DelegateID  uuid.UUID `json:"delegateId" gorm:"type:uuid;not null;index"`
+	StartsAt    time.Time `json:"startsAt" gorm:"not null"`
+	EndsAt      time.Time `json:"endsAt" gorm:"not null"`
+	Reason      string    `json:"reason"`
+	CreatedAt   time.Time `json:"createdAt" gorm:"autoCreateTime"`
+}
+
+// AssetCheckout records units of an asset handed out to a user. The units
+// go back into stock when the checkout is returned. Checkouts that are part
+// of a kit are returned with the kit and record the condition the units came
+// back in. A checkout with DueAt is overdue once it is still out after then.
+type AssetCheckout struct {
+	ID              uuid.UUID  `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	AssetID         uuid.UUID  `json:"assetId" gorm:"type:uuid;not null;index"`
+	Asset           *Asset     `json:"asset,omitempty" gorm:"foreignKey:AssetID;references:ID"`
+	UserID          uuid.UUID  `json:"userId" gorm:"type:uuid;not null;index"`
+	RequestID       *uuid.UUID `json:"requestId" gorm:"type:uuid;index"`
+	KitCheckoutID   *uuid.UUID `json:"kitCheckoutId" gorm:"type:uuid;index"`
+	Quantity        int        `json:"quantity" gorm:"not null"`
+	CheckedOutAt    time.Time  `json:"checkedOutAt" gorm:"not null"`
+	DueAt           *time.Time `json:"dueAt" gorm:"index"`
+	ReminderSentAt  *time.Time `json:"reminderSentAt"`
+	ReturnedAt      *time.Time `json:"returnedAt"`
+	ReturnedBy      *uuid.UUID `json:"returnedBy" gorm:"type:uuid"`
+	ReturnCondition string     `json:"returnCondition,omitempty" gorm:"check:return_condition IN ('', 'good', 'damaged', 'missing')"`
+	ReturnNotes     string     `json:"returnNotes,omitempty"`
+}
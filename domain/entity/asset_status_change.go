@@ -0,0 +1,30 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AssetStatusChange is one entry of an asset's status history. TicketID is
+// set when the change was made by a ticket status rule.
+type AssetStatusChange struct {
+	ID         uuid.UUID  `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	AssetID    uuid.UUID  `json:"assetId" gorm:"type:uuid;not null;index:idx_asset_status_changes_asset_created,priority:1"`
+	FromStatus string     `json:"fromStatus" gorm:"not null"`
+	ToStatus   string     `json:"toStatus" gorm:"not null"`
+	TicketID   *uuid.UUID `json:"ticketId" gorm:"type:uuid"`
+	ChangedBy  *uuid.UUID `json:"changedBy" gorm:"type:uuid"`
+	Reason     string     `json:"reason"`
+	CreatedAt  time.Time  `json:"createdAt" gorm:"autoCreateTime;index:idx_asset_status_changes_asset_created,priority:2"`
+}
+
+// AssetStatusTime is one row of the time-in-status report: how many seconds
+// the asset spent in each status during the report period.
+type AssetStatusTime struct {
+	AssetID       uuid.UUID        `json:"assetId"`
+	UniqueID      string           `json:"uniqueId"`
+	Name          string           `json:"name"`
+	CurrentStatus string           `json:"currentStatus"`
+	Seconds       map[string]int64 `json:"seconds"`
+}
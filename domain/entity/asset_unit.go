@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AssetUnit is one physical item of a serialized asset, such as one of ten
+// identical laptops, with its own serial number, status, location and
+// custodian. The asset's quantity is the number of its available units.
+type AssetUnit struct {
+	ID           uuid.UUID  `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	AssetID      uuid.UUID  `json:"assetId" gorm:"type:uuid;not null;index"`
+	Asset        *Asset     `json:"asset,omitempty" gorm:"foreignKey:AssetID;references:ID"`
+	SerialNumber string     `json:"serialNumber" gorm:"not null;uniqueIndex"`
+	Status       string     `json:"status" gorm:"not null;default:'available';check:status IN ('available', 'booked', 'broken', 'repair', 'retired', 'disposed', 'lost')"`
+	LocationID   *uuid.UUID `json:"locationId" gorm:"type:uuid"`
+	Location     *Location  `json:"location,omitempty" gorm:"foreignKey:LocationID;references:ID"`
+	CustodianID  *uuid.UUID `json:"custodianId" gorm:"type:uuid;index"`
+	Notes        string     `json:"notes"`
+	CreatedAt    time.Time  `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt    time.Time  `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// AssetUnitStatusChange is one entry of a unit's status history. TicketID is
+// set when the change was made by a ticket status rule.
+type AssetUnitStatusChange struct {
+	ID         uuid.UUID  `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	UnitID     uuid.UUID  `json:"unitId" gorm:"type:uuid;not null;index"`
+	FromStatus string     `json:"fromStatus" gorm:"not null"`
+	ToStatus   string     `json:"toStatus" gorm:"not null"`
+	TicketID   *uuid.UUID `json:"ticketId" gorm:"type:uuid"`
+	ChangedBy  *uuid.UUID `json:"changedBy" gorm:"type:uuid"`
+	Reason     string     `json:"reason"`
+	CreatedAt  time.Time  `json:"createdAt" gorm:"autoCreateTime"`
+}
+
+// CategoryTrackingMode sets how the assets of a category, matched
+// case-insensitively, are tracked. Categories without one are pooled.
+type CategoryTrackingMode struct {
+	ID        uuid.UUID `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	Category  string    `json:"category" gorm:"not null"`
+	Mode      string    `json:"mode" gorm:"not null;check:mode IN ('pooled', 'serialized')"`
+	CreatedAt time.Time `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt time.Time `json:"updatedAt" gorm:"autoUpdateTime"`
+}
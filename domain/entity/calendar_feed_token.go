@@ -0,0 +1,22 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CalendarFeedToken lets calendar applications, which subscribe by URL and
+// cannot send headers, read one reservation feed: an asset's or a user's.
+// It opens nothing else, reads with its owner's current permissions and is
+// revoked by deleting it.
+type CalendarFeedToken struct {
+	ID          uuid.UUID  `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	UserID      uuid.UUID  `json:"userId" gorm:"type:uuid;not null;index"`
+	Feed        string     `json:"feed" gorm:"not null;check:feed IN ('asset', 'user')"`
+	ResourceID  uuid.UUID  `json:"resourceId" gorm:"type:uuid;not null"`
+	TokenPrefix string     `json:"tokenPrefix" gorm:"not null"`
+	TokenHash   string     `json:"-" gorm:"not null;uniqueIndex"`
+	LastUsedAt  *time.Time `json:"lastUsedAt"`
+	CreatedAt   time.Time  `json:"createdAt" gorm:"autoCreateTime"`
+}
@@ -0,0 +1,32 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Department owns assets and employs users. Departments form a hierarchy and
+// each carries the cost center code used for chargeback.
+type Department struct {
+	ID             uuid.UUID  `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	ParentID       *uuid.UUID `json:"parentId" gorm:"type:uuid;index"`
+	Name           string     `json:"name" gorm:"unique;not null"`
+	CostCenterCode string     `json:"costCenterCode" gorm:"unique;not null"`
+	ManagerID      *uuid.UUID `json:"managerId" gorm:"type:uuid;index"`
+	Description    string     `json:"description"`
+	CreatedAt      time.Time  `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt      time.Time  `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// DepartmentSummary is one row of the per-department report. Counts cover
+// assets owned directly by the department, not by its children.
+type DepartmentSummary struct {
+	DepartmentID    *uuid.UUID `json:"departmentId"`
+	Name            string     `json:"name"`
+	CostCenterCode  string     `json:"costCenterCode"`
+	AssetCount      int        `json:"assetCount"`
+	AssetQuantity   int        `json:"assetQuantity"`
+	TicketCount     int        `json:"ticketCount"`
+	OpenTicketCount int        `json:"openTicketCount"`
+}
@@ -0,0 +1,80 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DepreciationPolicy sets how assets of a category lose value. Assets are
+// written down over UsefulLifeYears to SalvagePercent of their purchase cost,
+// either evenly or, for declining balance, by DecliningRate divided by the
+// useful life of the remaining value each year (2 is double declining).
+type DepreciationPolicy struct {
+	ID              uuid.UUID `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	Category        string    `json:"category" gorm:"not null"`
+	Method          string    `json:"method" gorm:"not null;check:method IN ('straight_line', 'declining_balance')"`
+	UsefulLifeYears int       `json:"usefulLifeYears" gorm:"not null"`
+	SalvagePercent  float64   `json:"salvagePercent" gorm:"type:numeric(5,2);not null;default:0"`
+	DecliningRate   float64   `json:"decliningRate" gorm:"type:numeric(5,2);not null;default:2"`
+	CreatedAt       time.Time `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt       time.Time `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// DepreciationPeriod is one year of an asset's depreciation schedule,
+// counted from the date it was acquired.
+type DepreciationPeriod struct {
+	Year                    int       `json:"year"`
+	Start                   time.Time `json:"start"`
+	End                     time.Time `json:"end"`
+	OpeningValue            Money     `json:"openingValue"`
+	Depreciation            Money     `json:"depreciation"`
+	AccumulatedDepreciation Money     `json:"accumulatedDepreciation"`
+	ClosingValue            Money     `json:"closingValue"`
+}
+
+// DepreciationSchedule is an asset's depreciation over its useful life and
+// its book value today.
+type DepreciationSchedule struct {
+	AssetID      uuid.UUID            `json:"assetId"`
+	Cost         Money                `json:"cost"`
+	Currency     string               `json:"currency"`
+	AcquiredAt   time.Time            `json:"acquiredAt"`
+	SalvageValue Money                `json:"salvageValue"`
+	BookValue    Money                `json:"bookValue"`
+	Policy       *DepreciationPolicy  `json:"policy"`
+	Periods      []DepreciationPeriod `json:"periods"`
+}
+
+// ValuationAmounts totals a set of assets. AssetCount counts asset records
+// and Units the quantity they hold; an asset's purchase cost is what was
+// paid for all of its units, so amounts are per record.
+type ValuationAmounts struct {
+	AssetCount              int   `json:"assetCount"`
+	Units                   int   `json:"units"`
+	Cost                    Money `json:"cost"`
+	AccumulatedDepreciation Money `json:"accumulatedDepreciation"`
+	BookValue               Money `json:"bookValue"`
+}
+
+// ValuationGroup totals the assets of one category at one location in one
+// currency.
+type ValuationGroup struct {
+	Category   string     `json:"category"`
+	LocationID *uuid.UUID `json:"locationId"`
+	Location   string     `json:"location"`
+	Currency   string     `json:"currency"`
+	ValuationAmounts
+}
+
+type ValuationTotal struct {
+	Currency string `json:"currency"`
+	ValuationAmounts
+}
+
+// AssetValuation is the book value of the assets held at a point in time.
+type AssetValuation struct {
+	At     time.Time         `json:"at"`
+	Groups []*ValuationGroup `json:"groups"`
+	Totals []*ValuationTotal `json:"totals"`
+}
@@ -0,0 +1,36 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DisposalRequest proposes retiring an asset for good. Once approved the
+// asset is marked disposed and CertificateNumber identifies the disposal
+// certificate. Data-bearing assets need a confirmed data wipe before they
+// can be approved.
+type DisposalRequest struct {
+	ID                  uuid.UUID  `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	AssetID             uuid.UUID  `json:"assetId" gorm:"type:uuid;not null;index"`
+	Asset               *Asset     `json:"asset,omitempty" gorm:"foreignKey:AssetID;references:ID;constraint:OnDelete:CASCADE"`
+	RequestedBy         uuid.UUID  `json:"requestedBy" gorm:"type:uuid;not null;index"`
+	Reason              string     `json:"reason" gorm:"not null"`
+	Method              string     `json:"method" gorm:"not null;check:method IN ('sell', 'donate', 'recycle', 'destroy')"`
+	EstimatedValue      Money      `json:"estimatedValue" gorm:"type:numeric(12,2);not null;default:0"`
+	Status              string     `json:"status" gorm:"not null;default:'pending';index;check:status IN ('pending', 'approved', 'rejected', 'cancelled')"`
+	DataWipeConfirmedAt *time.Time `json:"dataWipeConfirmedAt"`
+	DataWipeConfirmedBy *uuid.UUID `json:"dataWipeConfirmedBy" gorm:"type:uuid"`
+	DataWipeMethod      string     `json:"dataWipeMethod"`
+	DataWipeNotes       string     `json:"dataWipeNotes"`
+	DecidedBy           *uuid.UUID `json:"decidedBy" gorm:"type:uuid"`
+	DecidedAt           *time.Time `json:"decidedAt"`
+	DecisionComment     string     `json:"decisionComment"`
+	CertificateNumber   string     `json:"certificateNumber"`
+	CreatedAt           time.Time  `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt           time.Time  `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+func (d *DisposalRequest) IsDataWipeConfirmed() bool {
+	return d.DataWipeConfirmedAt != nil
+}
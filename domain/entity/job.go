@@ -0,0 +1,37 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ScheduledJob is the shared state of a recurring job. The instance holding
+// the lease runs the job; the others skip it until the lease expires.
+type ScheduledJob struct {
+	Name        string     `json:"name" gorm:"primaryKey"`
+	Description string     `json:"description"`
+	Schedule    string     `json:"schedule" gorm:"not null"`
+	NextRunAt   time.Time  `json:"nextRunAt" gorm:"not null"`
+	LeaseOwner  string     `json:"leaseOwner"`
+	LeaseUntil  *time.Time `json:"leaseUntil"`
+	LastRun     *JobRun    `json:"lastRun" gorm:"-"`
+	CreatedAt   time.Time  `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt   time.Time  `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// JobRun is one execution of a scheduled job. Result is the job's own summary
+// of what it did.
+type JobRun struct {
+	ID          uuid.UUID  `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	JobName     string     `json:"jobName" gorm:"not null;index"`
+	Trigger     string     `json:"trigger" gorm:"not null;check:trigger IN ('schedule', 'manual')"`
+	TriggeredBy *uuid.UUID `json:"triggeredBy" gorm:"type:uuid"`
+	Instance    string     `json:"instance" gorm:"not null"`
+	Status      string     `json:"status" gorm:"not null;default:'running';check:status IN ('running', 'succeeded', 'failed')"`
+	Result      string     `json:"result"`
+	Error       string     `json:"error"`
+	StartedAt   time.Time  `json:"startedAt" gorm:"not null"`
+	FinishedAt  *time.Time `json:"finishedAt"`
+	DurationMs  int64      `json:"durationMs"`
+}
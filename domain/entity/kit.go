@@ -0,0 +1,71 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Kit is a bundle of assets handed out together, such as a new-hire setup.
+// Each component is either a specific asset or any asset of a category.
+type Kit struct {
+	ID          uuid.UUID       `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	Name        string          `json:"name" gorm:"not null;uniqueIndex"`
+	Description string          `json:"description"`
+	Components  []*KitComponent `json:"components" gorm:"foreignKey:KitID;references:ID;constraint:OnDelete:CASCADE"`
+	CreatedBy   uuid.UUID       `json:"createdBy" gorm:"type:uuid;not null"`
+	CreatedAt   time.Time       `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt   time.Time       `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// KitComponent is Quantity units of AssetID, or of any available pooled
+// assets in Category.
+type KitComponent struct {
+	ID       uuid.UUID  `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	KitID    uuid.UUID  `json:"kitId" gorm:"type:uuid;not null;index"`
+	Position int        `json:"position" gorm:"not null"`
+	AssetID  *uuid.UUID `json:"assetId" gorm:"type:uuid;index"`
+	Asset    *Asset     `json:"asset,omitempty" gorm:"foreignKey:AssetID;references:ID"`
+	Category string     `json:"category"`
+	Quantity int        `json:"quantity" gorm:"not null;default:1"`
+}
+
+// Label names the component in messages.
+func (c *KitComponent) Label() string {
+	if c.Asset != nil {
+		return c.Asset.Name
+	}
+	return c.Category
+}
+
+// KitCheckout records a kit handed out to a user. Its items are the asset
+// checkouts of the components, each returned with its own condition when the
+// kit comes back.
+type KitCheckout struct {
+	ID           uuid.UUID        `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	KitID        uuid.UUID        `json:"kitId" gorm:"type:uuid;not null;index"`
+	Kit          *Kit             `json:"kit,omitempty" gorm:"foreignKey:KitID;references:ID;constraint:OnDelete:CASCADE"`
+	UserID       uuid.UUID        `json:"userId" gorm:"type:uuid;not null;index"`
+	Items        []*AssetCheckout `json:"items" gorm:"foreignKey:KitCheckoutID;references:ID;constraint:OnDelete:SET NULL"`
+	Notes        string           `json:"notes"`
+	CheckedOutBy uuid.UUID        `json:"checkedOutBy" gorm:"type:uuid;not null"`
+	CheckedOutAt time.Time        `json:"checkedOutAt" gorm:"not null"`
+	DueAt        *time.Time       `json:"dueAt"`
+	ReturnedAt   *time.Time       `json:"returnedAt"`
+	ReturnedBy   *uuid.UUID       `json:"returnedBy" gorm:"type:uuid"`
+}
+
+// KitAvailability is how many whole kits can be checked out now, with the
+// free stock of each component. Short is how many units a component lacks
+// for a single kit.
+type KitAvailability struct {
+	Kit        *Kit                        `json:"kit"`
+	Available  int                         `json:"available"`
+	Components []*KitComponentAvailability `json:"components"`
+}
+
+type KitComponentAvailability struct {
+	Component *KitComponent `json:"component"`
+	Free      int           `json:"free"`
+	Short     int           `json:"short"`
+}
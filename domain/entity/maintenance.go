@@ -0,0 +1,81 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaintenancePlan is recurring preventive maintenance for one asset or for
+// every asset in a category. It falls due either every IntervalDays or every
+// UsageInterval units on the asset's usage counter, and a ticket is opened
+// LeadDays (or LeadUsage units) before that.
+type MaintenancePlan struct {
+	ID                  uuid.UUID  `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	Name                string     `json:"name" gorm:"not null"`
+	Description         string     `json:"description"`
+	AssetID             *uuid.UUID `json:"assetId" gorm:"type:uuid;index"`
+	Category            string     `json:"category" gorm:"index"`
+	IntervalDays        int        `json:"intervalDays" gorm:"not null;default:0"`
+	UsageInterval       int        `json:"usageInterval" gorm:"not null;default:0"`
+	LeadDays            int        `json:"leadDays" gorm:"not null;default:0"`
+	LeadUsage           int        `json:"leadUsage" gorm:"not null;default:0"`
+	StartsAt            *time.Time `json:"startsAt"`
+	Checklist           []string   `json:"checklist" gorm:"type:jsonb;serializer:json;not null"`
+	DefaultTechnicianID *uuid.UUID `json:"defaultTechnicianId" gorm:"type:uuid"`
+	Severity            string     `json:"severity" gorm:"not null;default:'low';check:severity IN ('low', 'medium', 'high', 'critical')"`
+	IsActive            bool       `json:"isActive" gorm:"not null;default:true"`
+	CreatedBy           uuid.UUID  `json:"createdBy" gorm:"type:uuid;not null"`
+	CreatedAt           time.Time  `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt           time.Time  `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+func (p *MaintenancePlan) IsUsageBased() bool {
+	return p.UsageInterval > 0
+}
+
+// MaintenanceSchedule tracks when a plan is next due for one asset.
+// OpenTicketID is the generated ticket that has not been completed yet.
+type MaintenanceSchedule struct {
+	ID              uuid.UUID        `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	PlanID          uuid.UUID        `json:"planId" gorm:"type:uuid;not null;uniqueIndex:idx_maintenance_schedules_plan_asset"`
+	Plan            *MaintenancePlan `json:"-" gorm:"foreignKey:PlanID;references:ID;constraint:OnDelete:CASCADE"`
+	AssetID         uuid.UUID        `json:"assetId" gorm:"type:uuid;not null;uniqueIndex:idx_maintenance_schedules_plan_asset"`
+	Asset           *Asset           `json:"-" gorm:"foreignKey:AssetID;references:ID;constraint:OnDelete:CASCADE"`
+	NextDueAt       *time.Time       `json:"nextDueAt"`
+	NextDueUsage    *int             `json:"nextDueUsage"`
+	OpenTicketID    *uuid.UUID       `json:"openTicketId" gorm:"type:uuid;index"`
+	OpenTicket      *Ticket          `json:"-" gorm:"foreignKey:OpenTicketID;references:ID;constraint:OnDelete:SET NULL"`
+	LastPerformedAt *time.Time       `json:"lastPerformedAt"`
+	CreatedAt       time.Time        `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt       time.Time        `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// MaintenanceRecord is completed maintenance. It copies the plan name and
+// checklist so the history survives changes to the plan.
+type MaintenanceRecord struct {
+	ID          uuid.UUID  `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	PlanID      uuid.UUID  `json:"planId" gorm:"type:uuid;not null;index"`
+	PlanName    string     `json:"planName" gorm:"not null"`
+	AssetID     uuid.UUID  `json:"assetId" gorm:"type:uuid;not null;index"`
+	TicketID    uuid.UUID  `json:"ticketId" gorm:"type:uuid;not null"`
+	PerformedAt time.Time  `json:"performedAt" gorm:"not null"`
+	PerformedBy *uuid.UUID `json:"performedBy" gorm:"type:uuid"`
+	UsageCount  int        `json:"usageCount"`
+	Checklist   []string   `json:"checklist" gorm:"type:jsonb;serializer:json;not null"`
+	Notes       string     `json:"notes"`
+	CreatedAt   time.Time  `json:"createdAt" gorm:"autoCreateTime"`
+}
+
+// MaintenanceOccurrence is one entry of the maintenance calendar. Projected
+// occurrences follow the next due date at the plan's interval.
+type MaintenanceOccurrence struct {
+	PlanID    uuid.UUID  `json:"planId"`
+	PlanName  string     `json:"planName"`
+	AssetID   uuid.UUID  `json:"assetId"`
+	AssetName string     `json:"assetName"`
+	UniqueID  string     `json:"uniqueId"`
+	DueAt     time.Time  `json:"dueAt"`
+	TicketID  *uuid.UUID `json:"ticketId"`
+	Projected bool       `json:"projected"`
+}
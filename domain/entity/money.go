@@ -0,0 +1,112 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Money is an amount in cents, so sums and depreciation stay exact. It is
+// stored as NUMERIC(12,2) and written to JSON as a decimal number such as
+// 1234.56.
+type Money int64
+
+// ErrMoneyOutOfRange is returned for amounts too large to hold in cents.
+var ErrMoneyOutOfRange = errors.New("amount out of range")
+
+// MoneyFromFloat converts an amount in currency units, rounding to the cent.
+func MoneyFromFloat(amount float64) Money {
+	return Money(math.Round(amount * 100))
+}
+
+// ParseMoney parses a decimal amount with at most two decimal places.
+func ParseMoney(s string) (Money, error) {
+	s = strings.TrimSpace(s)
+	negative := strings.HasPrefix(s, "-")
+	units, cents, _ := strings.Cut(strings.TrimPrefix(s, "-"), ".")
+	if units == "" && cents == "" || len(cents) > 2 {
+		return 0, fmt.Errorf("invalid amount %q", s)
+	}
+	cents += strings.Repeat("0", 2-len(cents))
+
+	var amount int64
+	for _, digit := range units + cents {
+		if digit < '0' || digit > '9' {
+			return 0, fmt.Errorf("invalid amount %q", s)
+		}
+		if amount > (math.MaxInt64-int64(digit-'0'))/10 {
+			return 0, ErrMoneyOutOfRange
+		}
+		amount = amount*10 + int64(digit-'0')
+	}
+	if negative {
+		amount = -amount
+	}
+	return Money(amount), nil
+}
+
+func (m Money) String() string {
+	sign := ""
+	cents := int64(m)
+	if cents < 0 {
+		sign, cents = "-", -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+func (m *Money) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	return m.parse(strings.Trim(string(data), `"`))
+}
+
+func (m *Money) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*m = 0
+	case string:
+		return m.parse(v)
+	case []byte:
+		return m.parse(string(v))
+	case int64:
+		*m = Money(v * 100)
+	case float64:
+		*m = MoneyFromFloat(v)
+	default:
+		return fmt.Errorf("cannot scan %T into Money", value)
+	}
+	return nil
+}
+
+// parse reads an exact decimal amount, or rounds one with more decimal
+// places or an exponent to the cent.
+func (m *Money) parse(s string) error {
+	amount, err := ParseMoney(s)
+	if errors.Is(err, ErrMoneyOutOfRange) {
+		return err
+	}
+	if err != nil {
+		f, ferr := strconv.ParseFloat(s, 64)
+		if ferr != nil || math.IsNaN(f) {
+			return err
+		}
+		if math.Abs(f*100) >= math.MaxInt64 {
+			return ErrMoneyOutOfRange
+		}
+		amount = MoneyFromFloat(f)
+	}
+	*m = amount
+	return nil
+}
+
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
@@ -0,0 +1,180 @@
+package entity
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    Money
+		wantErr bool
+	}{
+		{name: "cents", input: "12.34", want: 1234},
+		{name: "one decimal place", input: "12.5", want: 1250},
+		{name: "whole units", input: "100", want: 10000},
+		{name: "zero", input: "0", want: 0},
+		{name: "leading zero cents", input: "0.07", want: 7},
+		{name: "trailing point", input: "5.", want: 500},
+		{name: "no units", input: ".5", want: 50},
+		{name: "negative", input: "-3.25", want: -325},
+		{name: "surrounding spaces", input: " 7.10 ", want: 710},
+		{name: "largest amount", input: "92233720368547758.07", want: math.MaxInt64},
+		{name: "overflow", input: "92233720368547758.08", wantErr: true},
+		{name: "far too large", input: "100000000000000000000", wantErr: true},
+		{name: "too many decimal places", input: "1.234", wantErr: true},
+		{name: "empty", input: "", wantErr: true},
+		{name: "point only", input: ".", wantErr: true},
+		{name: "sign only", input: "-", wantErr: true},
+		{name: "plus sign", input: "+5", wantErr: true},
+		{name: "comma", input: "1,50", wantErr: true},
+		{name: "exponent", input: "1e3", wantErr: true},
+		{name: "letters", input: "abc", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseMoney(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseMoney(%q) = %d, want an error", tt.input, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseMoney(%q): %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseMoney(%q) = %d, want %d", tt.input, got, tt.want)
+			}
+		})
+	}
+
+	if _, err := ParseMoney("92233720368547758.08"); !errors.Is(err, ErrMoneyOutOfRange) {
+		t.Errorf("overflow error = %v, want ErrMoneyOutOfRange", err)
+	}
+}
+
+func TestMoneyFromFloat(t *testing.T) {
+	tests := []struct {
+		name  string
+		input float64
+		want  Money
+	}{
+		{name: "whole cents", input: 19.99, want: 1999},
+		{name: "sum with binary error", input: 0.1 + 0.2, want: 30},
+		{name: "rounds up", input: 2.346, want: 235},
+		{name: "rounds down", input: 2.344, want: 234},
+		{name: "half cent away from zero", input: 0.125, want: 13},
+		{name: "negative half cent", input: -0.125, want: -13},
+		// 1.005 is stored as 1.00499999999999989...
+		{name: "half cent below its decimal", input: 1.005, want: 100},
+		{name: "zero", input: 0, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MoneyFromFloat(tt.input); got != tt.want {
+				t.Errorf("MoneyFromFloat(%v) = %d, want %d", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoneyString(t *testing.T) {
+	tests := []struct {
+		input Money
+		want  string
+	}{
+		{input: 0, want: "0.00"},
+		{input: 5, want: "0.05"},
+		{input: -5, want: "-0.05"},
+		{input: 123456, want: "1234.56"},
+		{input: -100, want: "-1.00"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.input.String(); got != tt.want {
+			t.Errorf("Money(%d).String() = %q, want %q", int64(tt.input), got, tt.want)
+		}
+	}
+}
+
+func TestMoneyScan(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   interface{}
+		want    Money
+		wantErr bool
+	}{
+		{name: "numeric string", input: "1234.50", want: 123450},
+		{name: "bytes", input: []byte("0.1"), want: 10},
+		{name: "more decimal places", input: "1.23456", want: 123},
+		{name: "integer", input: int64(3), want: 300},
+		{name: "float", input: 2.5, want: 250},
+		{name: "null", input: nil, want: 0},
+		{name: "not a number", input: "NaN", wantErr: true},
+		{name: "out of range", input: "1e300", wantErr: true},
+		{name: "unsupported type", input: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := Money(42)
+			err := m.Scan(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Scan(%v) = %d, want an error", tt.input, m)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Scan(%v): %v", tt.input, err)
+			}
+			if m != tt.want {
+				t.Errorf("Scan(%v) = %d, want %d", tt.input, m, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoneyJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  Money
+	}{
+		{name: "number", input: `{"amount": 19.99}`, want: 1999},
+		{name: "string", input: `{"amount": "19.99"}`, want: 1999},
+		{name: "exponent", input: `{"amount": 1e2}`, want: 10000},
+		{name: "null keeps the value", input: `{"amount": null}`, want: 42},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := struct {
+				Amount Money `json:"amount"`
+			}{Amount: 42}
+			if err := json.Unmarshal([]byte(tt.input), &v); err != nil {
+				t.Fatalf("Unmarshal(%s): %v", tt.input, err)
+			}
+			if v.Amount != tt.want {
+				t.Errorf("Unmarshal(%s) = %d, want %d", tt.input, v.Amount, tt.want)
+			}
+		})
+	}
+
+	data, err := json.Marshal(struct {
+		Amount Money `json:"amount"`
+	}{Amount: -1250})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"amount":-12.50}` {
+		t.Errorf("Marshal = %s, want {\"amount\":-12.50}", data)
+	}
+}
@@ -0,0 +1,111 @@
+package entity
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NotificationTemplate is the editable subject and body of one notification
+// kind in one locale. Subject and TextBody use Go text/template syntax and
+// HTMLBody uses html/template syntax.
+type NotificationTemplate struct {
+	ID        uuid.UUID `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	Kind      string    `json:"kind" gorm:"not null;uniqueIndex:idx_notification_templates_kind_locale"`
+	Locale    string    `json:"locale" gorm:"not null;uniqueIndex:idx_notification_templates_kind_locale"`
+	Subject   string    `json:"subject" gorm:"not null"`
+	TextBody  string    `json:"textBody" gorm:"type:text;not null"`
+	HTMLBody  string    `json:"htmlBody" gorm:"type:text;not null"`
+	CreatedAt time.Time `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt time.Time `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// NotificationPreference holds a user's notification settings. Muted kinds
+// are neither emailed nor added to the inbox. Users without a row receive
+// every notification in the default locale.
+type NotificationPreference struct {
+	UserID       uuid.UUID `json:"userId" gorm:"type:uuid;primaryKey"`
+	Locale       string    `json:"locale" gorm:"not null"`
+	EmailEnabled bool      `json:"emailEnabled" gorm:"not null"`
+	MutedKinds   []string  `json:"mutedKinds" gorm:"type:jsonb;serializer:json;not null"`
+	UpdatedAt    time.Time `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+func (p *NotificationPreference) Mutes(kind string) bool {
+	for _, muted := range p.MutedKinds {
+		if muted == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *NotificationPreference) Wants(kind string) bool {
+	return p.EmailEnabled && !p.Mutes(kind)
+}
+
+// EmailMessage is a rendered email waiting to be sent, or the record of one
+// that was.
+type EmailMessage struct {
+	ID            uuid.UUID  `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	UserID        *uuid.UUID `json:"userId" gorm:"type:uuid;index"`
+	Recipient     string     `json:"recipient" gorm:"not null"`
+	Kind          string     `json:"kind" gorm:"not null"`
+	Subject       string     `json:"subject" gorm:"not null"`
+	TextBody      string     `json:"textBody" gorm:"type:text;not null"`
+	HTMLBody      string     `json:"htmlBody" gorm:"type:text;not null"`
+	Status        string     `json:"status" gorm:"not null;default:'pending';check:status IN ('pending', 'sent', 'failed')"`
+	Attempts      int        `json:"attempts" gorm:"not null;default:0"`
+	NextAttemptAt *time.Time `json:"nextAttemptAt" gorm:"index"`
+	LastError     string     `json:"lastError"`
+	SentAt        *time.Time `json:"sentAt"`
+	CreatedAt     time.Time  `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt     time.Time  `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// Notification is an entry in a user's in-app inbox. Payload holds the IDs
+// and attributes a client needs to link to the ticket or asset.
+type Notification struct {
+	ID          uuid.UUID              `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	RecipientID uuid.UUID              `json:"recipientId" gorm:"type:uuid;not null;index"`
+	Type        string                 `json:"type" gorm:"not null"`
+	Title       string                 `json:"title" gorm:"not null"`
+	Payload     map[string]interface{} `json:"payload" gorm:"type:jsonb;serializer:json;not null"`
+	ReadAt      *time.Time             `json:"readAt"`
+	CreatedAt   time.Time              `json:"createdAt" gorm:"autoCreateTime"`
+}
+
+// NotificationCursor marks a position in an inbox, which is ordered newest
+// first. Clients receive it as an opaque string.
+type NotificationCursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+func (c NotificationCursor) Encode() string {
+	raw := c.CreatedAt.UTC().Format(time.RFC3339Nano) + "|" + c.ID.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func DecodeNotificationCursor(value string) (*NotificationCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, errors.New("invalid cursor")
+	}
+	createdAt, id, found := strings.Cut(string(raw), "|")
+	if !found {
+		return nil, errors.New("invalid cursor")
+	}
+
+	cursor := &NotificationCursor{}
+	if cursor.CreatedAt, err = time.Parse(time.RFC3339Nano, createdAt); err != nil {
+		return nil, errors.New("invalid cursor")
+	}
+	if cursor.ID, err = uuid.Parse(id); err != nil {
+		return nil, errors.New("invalid cursor")
+	}
+	return cursor, nil
+}
@@ -0,0 +1,23 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OutboxEvent is a domain event written in the same transaction as the change
+// that caused it. A relay worker later hands it to subscribers, so events
+// survive a crash between the commit and delivery.
+type OutboxEvent struct {
+	ID            uuid.UUID  `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	EventType     string     `json:"eventType" gorm:"not null;index"`
+	AggregateType string     `json:"aggregateType" gorm:"not null"`
+	AggregateID   uuid.UUID  `json:"aggregateId" gorm:"type:uuid;not null"`
+	Payload       string     `json:"payload" gorm:"type:jsonb;not null"`
+	OccurredAt    time.Time  `json:"occurredAt" gorm:"not null"`
+	AvailableAt   time.Time  `json:"availableAt" gorm:"not null;index"`
+	ProcessedAt   *time.Time `json:"processedAt" gorm:"index"`
+	Attempts      int        `json:"attempts" gorm:"not null;default:0"`
+	LastError     string     `json:"lastError"`
+}
@@ -0,0 +1,37 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PersonalAccessToken struct {
+	ID          uuid.UUID  `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	UserID      uuid.UUID  `json:"userId" gorm:"type:uuid;not null;index"`
+	Name        string     `json:"name" gorm:"not null"`
+	TokenPrefix string     `json:"tokenPrefix" gorm:"not null"`
+	TokenHash   string     `json:"-" gorm:"not null;uniqueIndex"`
+	Scopes      []string   `json:"scopes" gorm:"type:jsonb;serializer:json;not null"`
+	ExpiresAt   *time.Time `json:"expiresAt"`
+	LastUsedAt  *time.Time `json:"lastUsedAt"`
+	RevokedAt   *time.Time `json:"revokedAt"`
+	CreatedBy   uuid.UUID  `json:"createdBy" gorm:"type:uuid;not null"`
+	CreatedAt   time.Time  `json:"createdAt" gorm:"autoCreateTime"`
+}
+
+func (t *PersonalAccessToken) IsActive(now time.Time) bool {
+	if t.RevokedAt != nil {
+		return false
+	}
+	return t.ExpiresAt == nil || t.ExpiresAt.After(now)
+}
+
+func (t *PersonalAccessToken) HasScope(scope string) bool {
+	for _, s := range t.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
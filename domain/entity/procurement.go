@@ -0,0 +1,100 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PurchaseRequest asks for equipment to be bought. The manager of the
+// requester's department, or anyone who may approve purchases, decides on
+// it; approved requests are turned into a purchase order. Drafts are
+// prepared by the system and wait for their requester to submit them.
+type PurchaseRequest struct {
+	ID              uuid.UUID              `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	RequestedBy     uuid.UUID              `json:"requestedBy" gorm:"type:uuid;not null;index"`
+	DepartmentID    *uuid.UUID             `json:"departmentId" gorm:"type:uuid;index"`
+	Title           string                 `json:"title" gorm:"not null"`
+	Justification   string                 `json:"justification"`
+	Status          string                 `json:"status" gorm:"not null;default:'pending';index;check:status IN ('draft', 'pending', 'approved', 'rejected', 'cancelled', 'ordered')"`
+	Items           []*PurchaseRequestItem `json:"items" gorm:"foreignKey:RequestID;references:ID;constraint:OnDelete:CASCADE"`
+	DecidedBy       *uuid.UUID             `json:"decidedBy" gorm:"type:uuid"`
+	DecidedAt       *time.Time             `json:"decidedAt"`
+	DecisionComment string                 `json:"decisionComment"`
+	CreatedAt       time.Time              `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt       time.Time              `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// PurchaseRequestItem is one requested item, described in free text and
+// optionally tied to an asset category.
+type PurchaseRequestItem struct {
+	ID                uuid.UUID `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	RequestID         uuid.UUID `json:"requestId" gorm:"type:uuid;not null;index"`
+	Position          int       `json:"position" gorm:"not null"`
+	Description       string    `json:"description" gorm:"not null"`
+	Category          string    `json:"category"`
+	Quantity          int       `json:"quantity" gorm:"not null"`
+	EstimatedUnitCost Money     `json:"estimatedUnitCost" gorm:"type:numeric(12,2);not null;default:0"`
+}
+
+// PurchaseOrder is an order placed with a vendor. Its status follows the
+// goods received against its lines.
+type PurchaseOrder struct {
+	ID         uuid.UUID            `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	Number     string               `json:"number" gorm:"not null;uniqueIndex"`
+	VendorID   uuid.UUID            `json:"vendorId" gorm:"type:uuid;not null;index"`
+	Vendor     *Vendor              `json:"vendor,omitempty" gorm:"foreignKey:VendorID;references:ID"`
+	RequestID  *uuid.UUID           `json:"requestId" gorm:"type:uuid;index"`
+	Status     string               `json:"status" gorm:"not null;default:'ordered';index;check:status IN ('ordered', 'partially_received', 'received', 'cancelled')"`
+	Currency   string               `json:"currency" gorm:"type:char(3);not null;default:'USD'"`
+	ExpectedAt *time.Time           `json:"expectedAt" gorm:"type:date"`
+	Notes      string               `json:"notes"`
+	OrderedBy  uuid.UUID            `json:"orderedBy" gorm:"type:uuid;not null"`
+	Lines      []*PurchaseOrderLine `json:"lines" gorm:"foreignKey:OrderID;references:ID;constraint:OnDelete:CASCADE"`
+	CreatedAt  time.Time            `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt  time.Time            `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// PurchaseOrderLine is one ordered item. Receiving it creates new assets, or
+// restocks AssetID when the line refills an existing asset.
+type PurchaseOrderLine struct {
+	ID               uuid.UUID  `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	OrderID          uuid.UUID  `json:"orderId" gorm:"type:uuid;not null;index"`
+	Position         int        `json:"position" gorm:"not null"`
+	RequestItemID    *uuid.UUID `json:"requestItemId" gorm:"type:uuid"`
+	Description      string     `json:"description" gorm:"not null"`
+	Category         string     `json:"category"`
+	AssetType        string     `json:"assetType" gorm:"not null;default:'it';check:asset_type IN ('it', 'non_it')"`
+	Brand            string     `json:"brand"`
+	AssetID          *uuid.UUID `json:"assetId" gorm:"type:uuid"`
+	Quantity         int        `json:"quantity" gorm:"not null"`
+	ReceivedQuantity int        `json:"receivedQuantity" gorm:"not null;default:0"`
+	UnitCost         Money      `json:"unitCost" gorm:"type:numeric(12,2);not null;default:0"`
+}
+
+func (l *PurchaseOrderLine) Outstanding() int {
+	return l.Quantity - l.ReceivedQuantity
+}
+
+// GoodsReceipt records goods delivered against a purchase order. Each line
+// names the asset that was created or restocked.
+type GoodsReceipt struct {
+	ID            uuid.UUID           `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	OrderID       uuid.UUID           `json:"orderId" gorm:"type:uuid;not null;index"`
+	ReceivedBy    uuid.UUID           `json:"receivedBy" gorm:"type:uuid;not null"`
+	ReceivedAt    time.Time           `json:"receivedAt" gorm:"not null"`
+	LocationID    *uuid.UUID          `json:"locationId" gorm:"type:uuid"`
+	InvoiceNumber string              `json:"invoiceNumber"`
+	Notes         string              `json:"notes"`
+	Lines         []*GoodsReceiptLine `json:"lines" gorm:"foreignKey:ReceiptID;references:ID;constraint:OnDelete:CASCADE"`
+	CreatedAt     time.Time           `json:"createdAt" gorm:"autoCreateTime"`
+}
+
+type GoodsReceiptLine struct {
+	ID          uuid.UUID `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	ReceiptID   uuid.UUID `json:"receiptId" gorm:"type:uuid;not null;index"`
+	OrderLineID uuid.UUID `json:"orderLineId" gorm:"type:uuid;not null"`
+	AssetID     uuid.UUID `json:"assetId" gorm:"type:uuid;not null;index"`
+	Quantity    int       `json:"quantity" gorm:"not null"`
+	Restocked   bool      `json:"restocked" gorm:"not null;default:false"`
+}
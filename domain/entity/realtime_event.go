@@ -0,0 +1,31 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RealtimeEvent is a domain event pushed to connected clients. Data is the
+// event payload; it is left out when too large for the broker, in which case
+// clients fetch the resource instead.
+type RealtimeEvent struct {
+	ID          uuid.UUID       `json:"id"`
+	Type        string          `json:"type"`
+	AggregateID uuid.UUID       `json:"aggregateId"`
+	OccurredAt  time.Time       `json:"occurredAt"`
+	Data        json.RawMessage `json:"data,omitempty"`
+	Scope       RealtimeScope   `json:"-"`
+}
+
+// RealtimeScope holds the attributes used to match an event against
+// subscriptions and the subscriber's permissions. It is never sent to
+// clients.
+type RealtimeScope struct {
+	AssetID      *uuid.UUID `json:"assetId,omitempty"`
+	LocationID   *uuid.UUID `json:"locationId,omitempty"`
+	DepartmentID *uuid.UUID `json:"departmentId,omitempty"`
+	Category     string     `json:"category,omitempty"`
+	AssignedTo   *uuid.UUID `json:"assignedTo,omitempty"`
+}
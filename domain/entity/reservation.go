@@ -0,0 +1,38 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Reservation books units of an asset for a user over [StartsAt, EndsAt).
+// Reservations of the same asset may overlap as long as together they never
+// take more units than the asset has.
+type Reservation struct {
+	ID        uuid.UUID `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	AssetID   uuid.UUID `json:"assetId" gorm:"type:uuid;not null;index"`
+	Asset     *Asset    `json:"asset,omitempty" gorm:"foreignKey:AssetID;references:ID"`
+	UserID    uuid.UUID `json:"userId" gorm:"type:uuid;not null;index"`
+	Quantity  int       `json:"quantity" gorm:"not null;default:1"`
+	StartsAt  time.Time `json:"startsAt" gorm:"not null;index"`
+	EndsAt    time.Time `json:"endsAt" gorm:"not null;index"`
+	Purpose   string    `json:"purpose"`
+	Status    string    `json:"status" gorm:"not null;default:'confirmed';index;check:status IN ('confirmed', 'active', 'completed', 'cancelled')"`
+	CreatedBy uuid.UUID `json:"createdBy" gorm:"type:uuid;not null"`
+	CreatedAt time.Time `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt time.Time `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// Overlaps reports whether the reservation takes up time in [from, to).
+func (r *Reservation) Overlaps(from, to time.Time) bool {
+	return r.StartsAt.Before(to) && r.EndsAt.After(from)
+}
+
+// AssetAvailability is how many units of an asset are free for a whole time
+// range: its quantity minus the most units reserved at any one time.
+type AssetAvailability struct {
+	Asset     *Asset `json:"asset"`
+	Reserved  int    `json:"reserved"`
+	Available int    `json:"available"`
+}
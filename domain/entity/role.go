@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Role struct {
+	ID          uuid.UUID `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	Name        string    `json:"name" gorm:"unique;not null"`
+	Description string    `json:"description"`
+	Permissions []string  `json:"permissions" gorm:"type:jsonb;serializer:json;not null"`
+	IsSystem    bool      `json:"isSystem" gorm:"not null;default:false"`
+	CreatedAt   time.Time `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt   time.Time `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// RoleAssignment grants a role to a user in addition to their primary role,
+// optionally scoped to a location subtree and/or an asset category.
+type RoleAssignment struct {
+	ID         uuid.UUID  `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	UserID     uuid.UUID  `json:"userId" gorm:"type:uuid;not null;index"`
+	RoleID     uuid.UUID  `json:"roleId" gorm:"type:uuid;not null"`
+	Role       *Role      `json:"role,omitempty" gorm:"foreignKey:RoleID;references:ID"`
+	LocationID *uuid.UUID `json:"locationId" gorm:"type:uuid"`
+	Category   string     `json:"category"`
+	CreatedAt  time.Time  `json:"createdAt" gorm:"autoCreateTime"`
+}
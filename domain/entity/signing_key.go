@@ -0,0 +1,12 @@
+package entity
+
+import "time"
+
+type SigningKey struct {
+	ID            string     `json:"id" gorm:"primaryKey"`
+	Algorithm     string     `json:"algorithm" gorm:"not null"`
+	PrivateKeyPEM string     `json:"-" gorm:"not null"`
+	ActivatedAt   time.Time  `json:"activatedAt" gorm:"not null"`
+	RetiresAt     *time.Time `json:"retiresAt"`
+	CreatedAt     time.Time  `json:"createdAt" gorm:"autoCreateTime"`
+}
@@ -0,0 +1,93 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReorderPoint sets the minimum stock of one asset or, with Category, of
+// every asset in a category. An asset's own reorder point takes precedence
+// over its category's. An asset is low on stock once its quantity is at or
+// below MinQty; ReorderQty is how much to buy then, and with
+// DraftPurchaseRequest a draft purchase request for it is prepared for
+// CreatedBy to submit.
+type ReorderPoint struct {
+	ID                   uuid.UUID  `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	AssetID              *uuid.UUID `json:"assetId" gorm:"type:uuid;index"`
+	Asset                *Asset     `json:"asset,omitempty" gorm:"foreignKey:AssetID;references:ID"`
+	Category             string     `json:"category"`
+	MinQty               int        `json:"minQty" gorm:"not null"`
+	ReorderQty           int        `json:"reorderQty" gorm:"not null"`
+	DraftPurchaseRequest bool       `json:"draftPurchaseRequest" gorm:"not null;default:false"`
+	CreatedBy            uuid.UUID  `json:"createdBy" gorm:"type:uuid;not null"`
+	CreatedAt            time.Time  `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt            time.Time  `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// StockMovement is one change of an asset's quantity by Delta, leaving
+// QtyAfter. Kind says why it changed.
+type StockMovement struct {
+	ID        uuid.UUID  `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	AssetID   uuid.UUID  `json:"assetId" gorm:"type:uuid;not null;index"`
+	Kind      string     `json:"kind" gorm:"not null;check:kind IN ('issue', 'return', 'receipt', 'adjustment', 'recount')"`
+	Delta     int        `json:"delta" gorm:"not null"`
+	QtyAfter  int        `json:"qtyAfter" gorm:"not null"`
+	ChangedBy *uuid.UUID `json:"changedBy" gorm:"type:uuid"`
+	CreatedAt time.Time  `json:"createdAt" gorm:"autoCreateTime;index"`
+}
+
+// StockAlert is raised once when an asset falls to its reorder point and
+// resolved when it is restocked above it. PurchaseRequestID is the draft
+// purchase request prepared for it, if any.
+type StockAlert struct {
+	ID                uuid.UUID  `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	AssetID           uuid.UUID  `json:"assetId" gorm:"type:uuid;not null;index"`
+	Qty               int        `json:"qty" gorm:"not null"`
+	MinQty            int        `json:"minQty" gorm:"not null"`
+	PurchaseRequestID *uuid.UUID `json:"purchaseRequestId" gorm:"type:uuid"`
+	RaisedAt          time.Time  `json:"raisedAt" gorm:"not null"`
+	ResolvedAt        *time.Time `json:"resolvedAt"`
+}
+
+// LowStockItem is an asset at or below its reorder point. Consumed is how
+// many units were taken out over the last ConsumptionDays, and DaysOfCover
+// how long the current quantity lasts at that rate; it is nil when nothing
+// was consumed.
+type LowStockItem struct {
+	Asset            *Asset        `json:"asset"`
+	ReorderPoint     *ReorderPoint `json:"reorderPoint"`
+	Consumed         int           `json:"consumed"`
+	ConsumptionDays  int           `json:"consumptionDays"`
+	DailyConsumption float64       `json:"dailyConsumption"`
+	DaysOfCover      *float64      `json:"daysOfCover"`
+	Alert            *StockAlert   `json:"alert,omitempty"`
+}
+
+// StockLot is a batch of an asset's stock with its own expiry date. Once an
+// asset has lots its quantity is the stock of its active lots, and stock is
+// issued from the lot expiring first. ExpiresAt is the last day the lot may
+// be used.
+type StockLot struct {
+	ID         uuid.UUID  `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	AssetID    uuid.UUID  `json:"assetId" gorm:"type:uuid;not null;uniqueIndex:idx_stock_lots_asset_lot_number"`
+	Asset      *Asset     `json:"asset,omitempty" gorm:"foreignKey:AssetID;references:ID"`
+	LotNumber  string     `json:"lotNumber" gorm:"not null;uniqueIndex:idx_stock_lots_asset_lot_number"`
+	ExpiresAt  *time.Time `json:"expiresAt" gorm:"type:date;index"`
+	Qty        int        `json:"qty" gorm:"not null;default:0;check:qty >= 0"`
+	Status     string     `json:"status" gorm:"not null;default:'active';check:status IN ('active', 'quarantined')"`
+	ReceivedAt time.Time  `json:"receivedAt" gorm:"not null"`
+	Notes      string     `json:"notes"`
+	CreatedAt  time.Time  `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt  time.Time  `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// IsExpired reports whether the lot is past its expiry date on day.
+func (l *StockLot) IsExpired(day time.Time) bool {
+	return l.ExpiresAt != nil && l.ExpiresAt.Before(day)
+}
+
+// IsIssuable reports whether stock may be taken from the lot on day.
+func (l *StockLot) IsIssuable(day time.Time) bool {
+	return l.Status == "active" && !l.IsExpired(day)
+}
@@ -0,0 +1,57 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Team is a queue of technicians. Tickets whose category is listed in
+// Categories are routed to the team and assigned using its strategy.
+type Team struct {
+	ID                 uuid.UUID `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	Name               string    `json:"name" gorm:"unique;not null"`
+	Description        string    `json:"description"`
+	Categories         []string  `json:"categories" gorm:"type:jsonb;serializer:json;not null"`
+	AssignmentStrategy string    `json:"assignmentStrategy" gorm:"not null;default:'least_loaded';check:assignment_strategy IN ('round_robin', 'least_loaded', 'skill_match')"`
+	CreatedAt          time.Time `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt          time.Time `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+func (t *Team) HandlesCategory(category string) bool {
+	return containsFold(t.Categories, category)
+}
+
+// Technician marks a user as someone who works tickets. Skills are ticket
+// categories and AssetTypes are asset types the technician can handle.
+type Technician struct {
+	UserID         uuid.UUID  `json:"userId" gorm:"type:uuid;primaryKey"`
+	User           *User      `json:"user,omitempty" gorm:"foreignKey:UserID;references:ID"`
+	TeamID         *uuid.UUID `json:"teamId" gorm:"type:uuid;index"`
+	Team           *Team      `json:"team,omitempty" gorm:"foreignKey:TeamID;references:ID"`
+	Skills         []string   `json:"skills" gorm:"type:jsonb;serializer:json;not null"`
+	AssetTypes     []string   `json:"assetTypes" gorm:"type:jsonb;serializer:json;not null"`
+	IsActive       bool       `json:"isActive" gorm:"not null;default:true"`
+	MaxOpenTickets int        `json:"maxOpenTickets" gorm:"default:0"` // 0 means no limit
+	LastAssignedAt *time.Time `json:"lastAssignedAt"`
+	CreatedAt      time.Time  `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt      time.Time  `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+func (t *Technician) HasSkill(category string) bool {
+	return containsFold(t.Skills, category)
+}
+
+func (t *Technician) HandlesAssetType(assetType string) bool {
+	return containsFold(t.AssetTypes, assetType)
+}
+
+func containsFold(values []string, value string) bool {
+	for _, candidate := range values {
+		if strings.EqualFold(candidate, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -7,11 +7,13 @@ import (
 )
 
 type User struct {
-	ID           uuid.UUID `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
-	Name         string    `json:"name" gorm:"not null"`
-	Email        string    `json:"email" gorm:"unique;not null"`
-	PasswordHash string    `json:"-" gorm:"not null"`
-	Role         string    `json:"role" gorm:"not null;check:role IN ('admin', 'employee')"`
-	CreatedAt    time.Time `json:"createdAt" gorm:"autoCreateTime"`
-	UpdatedAt    time.Time `json:"updatedAt" gorm:"autoUpdateTime"`
-}
\ No newline at end of file
+	ID               uuid.UUID  `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	Name             string     `json:"name" gorm:"not null"`
+	Email            string     `json:"email" gorm:"unique;not null"`
+	PasswordHash     string     `json:"-" gorm:"not null"`
+	Role             string     `json:"role" gorm:"not null"`
+	IsServiceAccount bool       `json:"isServiceAccount" gorm:"not null;default:false"`
+	DepartmentID     *uuid.UUID `json:"departmentId" gorm:"type:uuid;index"`
+	CreatedAt        time.Time  `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt        time.Time  `json:"updatedAt" gorm:"autoUpdateTime"`
+}
@@ -0,0 +1,76 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Vendor is a supplier that assets are bought from or serviced by. The
+// support SLA is the response and resolution time the vendor committed to,
+// in hours, during SupportHours; zero means none was agreed.
+type Vendor struct {
+	ID                     uuid.UUID       `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	Name                   string          `json:"name" gorm:"not null"`
+	Website                string          `json:"website"`
+	Email                  string          `json:"email"`
+	Phone                  string          `json:"phone"`
+	Contacts               []VendorContact `json:"contacts" gorm:"type:jsonb;serializer:json;not null"`
+	Addresses              []VendorAddress `json:"addresses" gorm:"type:jsonb;serializer:json;not null"`
+	SupportHours           string          `json:"supportHours"`
+	SupportResponseHours   int             `json:"supportResponseHours" gorm:"not null;default:0"`
+	SupportResolutionHours int             `json:"supportResolutionHours" gorm:"not null;default:0"`
+	Notes                  string          `json:"notes"`
+	CreatedAt              time.Time       `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt              time.Time       `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+type VendorContact struct {
+	Name  string `json:"name"`
+	Role  string `json:"role"`
+	Email string `json:"email"`
+	Phone string `json:"phone"`
+}
+
+type VendorAddress struct {
+	Label      string `json:"label"`
+	Line1      string `json:"line1"`
+	Line2      string `json:"line2"`
+	City       string `json:"city"`
+	Region     string `json:"region"`
+	PostalCode string `json:"postalCode"`
+	Country    string `json:"country"`
+}
+
+// VendorDetail is a vendor with the assets bought from it, the unfinished
+// tickets on the assets it services and what was spent with it. The lists
+// hold the most recent entries; the counts cover all of them.
+type VendorDetail struct {
+	Vendor                *Vendor        `json:"vendor"`
+	PurchasedAssets       []*Asset       `json:"purchasedAssets"`
+	PurchasedAssetCount   int            `json:"purchasedAssetCount"`
+	ServicedAssetCount    int            `json:"servicedAssetCount"`
+	OpenRepairTickets     []*Ticket      `json:"openRepairTickets"`
+	OpenRepairTicketCount int            `json:"openRepairTicketCount"`
+	Spend                 []*VendorSpend `json:"spend"`
+}
+
+// VendorSpend is the purchase cost of the assets bought from a vendor in one
+// currency.
+type VendorSpend struct {
+	Currency   string `json:"currency"`
+	AssetCount int    `json:"assetCount"`
+	Total      Money  `json:"total"`
+}
+
+// Manufacturer is the normalized maker behind the free text Asset.Brand.
+// Brands matching the name or one of the aliases, ignoring case and extra
+// spaces, belong to the manufacturer.
+type Manufacturer struct {
+	ID         uuid.UUID `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	Name       string    `json:"name" gorm:"not null"`
+	Aliases    []string  `json:"aliases" gorm:"type:jsonb;serializer:json;not null"`
+	AssetCount int       `json:"assetCount" gorm:"->;-:migration"`
+	CreatedAt  time.Time `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt  time.Time `json:"updatedAt" gorm:"autoUpdateTime"`
+}
@@ -0,0 +1,106 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Warranty is a manufacturer or vendor warranty on one asset. It covers the
+// asset from StartDate through EndDate. Documents are links to the warranty
+// terms or proof of purchase.
+type Warranty struct {
+	ID               uuid.UUID  `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	AssetID          uuid.UUID  `json:"assetId" gorm:"type:uuid;not null;index"`
+	Asset            *Asset     `json:"asset,omitempty" gorm:"foreignKey:AssetID;references:ID;constraint:OnDelete:CASCADE"`
+	Provider         string     `json:"provider" gorm:"not null"`
+	Coverage         string     `json:"coverage"`
+	Reference        string     `json:"reference"`
+	StartDate        time.Time  `json:"startDate" gorm:"type:date;not null"`
+	EndDate          time.Time  `json:"endDate" gorm:"type:date;not null;index"`
+	Documents        []string   `json:"documents" gorm:"type:jsonb;serializer:json;not null"`
+	Notes            string     `json:"notes"`
+	ExpiryNotifiedAt *time.Time `json:"-"`
+	CreatedBy        uuid.UUID  `json:"createdBy" gorm:"type:uuid;not null"`
+	CreatedAt        time.Time  `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt        time.Time  `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// CoversDate reports whether the warranty is in force on t's day.
+func (w *Warranty) CoversDate(t time.Time) bool {
+	return coversDate(w.StartDate, w.EndDate, t)
+}
+
+// SupportContract is a support or maintenance contract with a provider that
+// can cover many assets, listed in AssetIDs.
+type SupportContract struct {
+	ID               uuid.UUID   `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	Provider         string      `json:"provider" gorm:"not null"`
+	ContractNumber   string      `json:"contractNumber"`
+	Coverage         string      `json:"coverage"`
+	StartDate        time.Time   `json:"startDate" gorm:"type:date;not null"`
+	EndDate          time.Time   `json:"endDate" gorm:"type:date;not null;index"`
+	Cost             Money       `json:"cost" gorm:"type:numeric(12,2);not null;default:0"`
+	Currency         string      `json:"currency" gorm:"type:char(3);not null;default:'USD'"`
+	Documents        []string    `json:"documents" gorm:"type:jsonb;serializer:json;not null"`
+	Notes            string      `json:"notes"`
+	AssetIDs         []uuid.UUID `json:"assetIds" gorm:"-"`
+	ExpiryNotifiedAt *time.Time  `json:"-"`
+	CreatedBy        uuid.UUID   `json:"createdBy" gorm:"type:uuid;not null"`
+	CreatedAt        time.Time   `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt        time.Time   `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+func (c *SupportContract) CoversDate(t time.Time) bool {
+	return coversDate(c.StartDate, c.EndDate, t)
+}
+
+// SupportContractAsset links a support contract to an asset it covers.
+type SupportContractAsset struct {
+	ContractID uuid.UUID        `gorm:"type:uuid;primaryKey"`
+	Contract   *SupportContract `gorm:"foreignKey:ContractID;references:ID;constraint:OnDelete:CASCADE"`
+	AssetID    uuid.UUID        `gorm:"type:uuid;primaryKey;index"`
+	Asset      *Asset           `gorm:"foreignKey:AssetID;references:ID;constraint:OnDelete:CASCADE"`
+}
+
+// WarrantyClaim is a repair claimed under a warranty for a ticket.
+type WarrantyClaim struct {
+	ID                uuid.UUID `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	WarrantyID        uuid.UUID `json:"warrantyId" gorm:"type:uuid;not null;index"`
+	Warranty          *Warranty `json:"-" gorm:"foreignKey:WarrantyID;references:ID;constraint:OnDelete:CASCADE"`
+	TicketID          uuid.UUID `json:"ticketId" gorm:"type:uuid;not null;index"`
+	Ticket            *Ticket   `json:"-" gorm:"foreignKey:TicketID;references:ID;constraint:OnDelete:CASCADE"`
+	Status            string    `json:"status" gorm:"not null;default:'submitted';check:status IN ('submitted', 'approved', 'rejected', 'completed')"`
+	ProviderReference string    `json:"providerReference"`
+	Notes             string    `json:"notes"`
+	ClaimedBy         uuid.UUID `json:"claimedBy" gorm:"type:uuid;not null"`
+	CreatedAt         time.Time `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt         time.Time `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// AssetCoverage is what covers an asset today: its warranty status with the
+// current warranty, or the most recent one once all have expired, and the
+// support contracts in force.
+type AssetCoverage struct {
+	AssetID        uuid.UUID          `json:"assetId"`
+	WarrantyStatus string             `json:"warrantyStatus"`
+	Warranty       *Warranty          `json:"warranty"`
+	Contracts      []*SupportContract `json:"contracts"`
+}
+
+// ExpiringCoverage lists the warranties and support contracts that end by
+// Until.
+type ExpiringCoverage struct {
+	Until      time.Time          `json:"until"`
+	Warranties []*Warranty        `json:"warranties"`
+	Contracts  []*SupportContract `json:"contracts"`
+}
+
+// coversDate compares calendar days, so a warranty ending on a date covers
+// that whole day.
+func coversDate(start, end, t time.Time) bool {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	start = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	end = time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, time.UTC)
+	return !day.Before(start) && !day.After(end)
+}
@@ -0,0 +1,61 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WebhookEndpoint receives HMAC-signed event notifications. Events lists the
+// subscribed event types, or "*" for all of them.
+type WebhookEndpoint struct {
+	ID          uuid.UUID `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	URL         string    `json:"url" gorm:"not null"`
+	Description string    `json:"description"`
+	Secret      string    `json:"-" gorm:"not null"`
+	Events      []string  `json:"events" gorm:"type:jsonb;serializer:json;not null"`
+	IsActive    bool      `json:"isActive" gorm:"not null;default:true"`
+	CreatedBy   uuid.UUID `json:"createdBy" gorm:"type:uuid;not null"`
+	CreatedAt   time.Time `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt   time.Time `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+func (e *WebhookEndpoint) Subscribes(eventType string) bool {
+	for _, subscribed := range e.Events {
+		if subscribed == "*" || subscribed == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookDelivery is one event to be sent to one endpoint. Payload holds the
+// exact JSON body, so retries and replays send identical bytes.
+type WebhookDelivery struct {
+	ID            uuid.UUID                 `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	EndpointID    uuid.UUID                 `json:"endpointId" gorm:"type:uuid;not null;index"`
+	Endpoint      *WebhookEndpoint          `json:"-" gorm:"foreignKey:EndpointID;references:ID;constraint:OnDelete:CASCADE"`
+	EventID       uuid.UUID                 `json:"eventId" gorm:"type:uuid;not null"`
+	EventType     string                    `json:"eventType" gorm:"not null"`
+	Payload       string                    `json:"payload" gorm:"type:jsonb;not null"`
+	Status        string                    `json:"status" gorm:"not null;default:'pending';check:status IN ('pending', 'succeeded', 'failed')"`
+	AttemptCount  int                       `json:"attemptCount" gorm:"not null;default:0"`
+	NextAttemptAt *time.Time                `json:"nextAttemptAt" gorm:"index"`
+	LastAttemptAt *time.Time                `json:"lastAttemptAt"`
+	ReplayOfID    *uuid.UUID                `json:"replayOfId" gorm:"type:uuid"`
+	Attempts      []*WebhookDeliveryAttempt `json:"attempts,omitempty" gorm:"foreignKey:DeliveryID;references:ID;constraint:OnDelete:CASCADE"`
+	CreatedAt     time.Time                 `json:"createdAt" gorm:"autoCreateTime"`
+	UpdatedAt     time.Time                 `json:"updatedAt" gorm:"autoUpdateTime"`
+}
+
+// WebhookDeliveryAttempt logs a single HTTP request for a delivery.
+type WebhookDeliveryAttempt struct {
+	ID             uuid.UUID `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	DeliveryID     uuid.UUID `json:"deliveryId" gorm:"type:uuid;not null;index"`
+	AttemptNumber  int       `json:"attemptNumber" gorm:"not null"`
+	ResponseStatus int       `json:"responseStatus"`
+	ResponseBody   string    `json:"responseBody"`
+	Error          string    `json:"error"`
+	DurationMs     int64     `json:"durationMs"`
+	AttemptedAt    time.Time `json:"attemptedAt" gorm:"not null"`
+}
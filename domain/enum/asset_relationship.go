@@ -0,0 +1,30 @@
+package enum
+
+// RelationshipType is how one asset relates to another. "contains" and
+// "installed_in" make the assets parent and child: the containing asset, or
+// the one the other is installed in, is the parent. "connected_to" has no
+// direction, and "depends_on" points from the dependent asset to the one it
+// needs.
+type RelationshipType string
+
+const (
+	RelationshipContains    RelationshipType = "contains"
+	RelationshipInstalledIn RelationshipType = "installed_in"
+	RelationshipConnectedTo RelationshipType = "connected_to"
+	RelationshipDependsOn   RelationshipType = "depends_on"
+)
+
+func (t RelationshipType) IsValid() bool {
+	switch t {
+	case RelationshipContains, RelationshipInstalledIn, RelationshipConnectedTo, RelationshipDependsOn:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsContainment reports whether the relationship makes one asset a
+// component of the other.
+func (t RelationshipType) IsContainment() bool {
+	return t == RelationshipContains || t == RelationshipInstalledIn
+}
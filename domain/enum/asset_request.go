@@ -0,0 +1,37 @@
+package enum
+
+type AssetRequestStatus string
+
+const (
+	AssetRequestPending   AssetRequestStatus = "pending"
+	AssetRequestRejected  AssetRequestStatus = "rejected"
+	AssetRequestCancelled AssetRequestStatus = "cancelled"
+	AssetRequestFulfilled AssetRequestStatus = "fulfilled"
+)
+
+func (s AssetRequestStatus) IsValid() bool {
+	switch s {
+	case AssetRequestPending, AssetRequestRejected, AssetRequestCancelled, AssetRequestFulfilled:
+		return true
+	default:
+		return false
+	}
+}
+
+// ApprovalStatus is the state of one step of an approval chain. Steps wait
+// until the steps before them are approved; a step nobody can decide on is
+// skipped.
+type ApprovalStatus string
+
+const (
+	ApprovalWaiting  ApprovalStatus = "waiting"
+	ApprovalPending  ApprovalStatus = "pending"
+	ApprovalApproved ApprovalStatus = "approved"
+	ApprovalRejected ApprovalStatus = "rejected"
+	ApprovalSkipped  ApprovalStatus = "skipped"
+)
+
+// ApproverLineManager is the approval step decided by the manager of the
+// requester's department. Any other step names the role whose members
+// decide it.
+const ApproverLineManager = "line_manager"
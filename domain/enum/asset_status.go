@@ -7,13 +7,53 @@ const (
 	AssetStatusBooked    AssetStatus = "booked"
 	AssetStatusBroken    AssetStatus = "broken"
 	AssetStatusRepair    AssetStatus = "repair"
+	AssetStatusRetired   AssetStatus = "retired"
+	AssetStatusDisposed  AssetStatus = "disposed"
+	AssetStatusLost      AssetStatus = "lost"
 )
 
+// assetStatusTransitions lists the statuses each status may change to.
+// Retired and lost assets can only be disposed of, and disposed is final.
+var assetStatusTransitions = map[AssetStatus][]AssetStatus{
+	AssetStatusAvailable: {AssetStatusBooked, AssetStatusBroken, AssetStatusRepair, AssetStatusRetired, AssetStatusDisposed, AssetStatusLost},
+	AssetStatusBooked:    {AssetStatusAvailable, AssetStatusBroken, AssetStatusRepair, AssetStatusLost},
+	AssetStatusBroken:    {AssetStatusAvailable, AssetStatusRepair, AssetStatusRetired, AssetStatusDisposed, AssetStatusLost},
+	AssetStatusRepair:    {AssetStatusAvailable, AssetStatusBroken, AssetStatusRetired, AssetStatusDisposed, AssetStatusLost},
+	AssetStatusRetired:   {AssetStatusDisposed},
+	AssetStatusLost:      {AssetStatusDisposed},
+}
+
+func AllAssetStatuses() []AssetStatus {
+	return []AssetStatus{
+		AssetStatusAvailable, AssetStatusBooked, AssetStatusBroken, AssetStatusRepair,
+		AssetStatusRetired, AssetStatusDisposed, AssetStatusLost,
+	}
+}
+
 func (s AssetStatus) IsValid() bool {
-	switch s {
-	case AssetStatusAvailable, AssetStatusBooked, AssetStatusBroken, AssetStatusRepair:
-		return true
-	default:
-		return false
+	for _, status := range AllAssetStatuses() {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// IsTerminal reports whether the asset has left service for good.
+func (s AssetStatus) IsTerminal() bool {
+	return s == AssetStatusRetired || s == AssetStatusDisposed || s == AssetStatusLost
+}
+
+// RequiresReason reports whether changing to the status needs a reason.
+func (s AssetStatus) RequiresReason() bool {
+	return s.IsTerminal()
+}
+
+func (s AssetStatus) CanTransitionTo(next AssetStatus) bool {
+	for _, status := range assetStatusTransitions[s] {
+		if status == next {
+			return true
+		}
 	}
-}
\ No newline at end of file
+	return false
+}
@@ -0,0 +1,24 @@
+package enum
+
+// AssignmentStrategy decides which technician receives a new ticket.
+type AssignmentStrategy string
+
+const (
+	// AssignmentRoundRobin rotates through technicians, picking whoever was
+	// assigned a ticket longest ago.
+	AssignmentRoundRobin AssignmentStrategy = "round_robin"
+	// AssignmentLeastLoaded picks the technician with the fewest open tickets.
+	AssignmentLeastLoaded AssignmentStrategy = "least_loaded"
+	// AssignmentSkillMatch prefers technicians whose skills cover the ticket
+	// category and the asset type, then the least loaded among them.
+	AssignmentSkillMatch AssignmentStrategy = "skill_match"
+)
+
+func (s AssignmentStrategy) IsValid() bool {
+	switch s {
+	case AssignmentRoundRobin, AssignmentLeastLoaded, AssignmentSkillMatch:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,18 @@
+package enum
+
+// CalendarFeed is the kind of iCalendar feed a feed token opens.
+type CalendarFeed string
+
+const (
+	CalendarFeedAsset CalendarFeed = "asset"
+	CalendarFeedUser  CalendarFeed = "user"
+)
+
+func (f CalendarFeed) IsValid() bool {
+	switch f {
+	case CalendarFeedAsset, CalendarFeedUser:
+		return true
+	default:
+		return false
+	}
+}
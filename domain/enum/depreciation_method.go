@@ -0,0 +1,17 @@
+package enum
+
+type DepreciationMethod string
+
+const (
+	DepreciationStraightLine     DepreciationMethod = "straight_line"
+	DepreciationDecliningBalance DepreciationMethod = "declining_balance"
+)
+
+func (m DepreciationMethod) IsValid() bool {
+	switch m {
+	case DepreciationStraightLine, DepreciationDecliningBalance:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,37 @@
+package enum
+
+type DisposalMethod string
+
+const (
+	DisposalMethodSell    DisposalMethod = "sell"
+	DisposalMethodDonate  DisposalMethod = "donate"
+	DisposalMethodRecycle DisposalMethod = "recycle"
+	DisposalMethodDestroy DisposalMethod = "destroy"
+)
+
+func (m DisposalMethod) IsValid() bool {
+	switch m {
+	case DisposalMethodSell, DisposalMethodDonate, DisposalMethodRecycle, DisposalMethodDestroy:
+		return true
+	default:
+		return false
+	}
+}
+
+type DisposalStatus string
+
+const (
+	DisposalPending   DisposalStatus = "pending"
+	DisposalApproved  DisposalStatus = "approved"
+	DisposalRejected  DisposalStatus = "rejected"
+	DisposalCancelled DisposalStatus = "cancelled"
+)
+
+func (s DisposalStatus) IsValid() bool {
+	switch s {
+	case DisposalPending, DisposalApproved, DisposalRejected, DisposalCancelled:
+		return true
+	default:
+		return false
+	}
+}
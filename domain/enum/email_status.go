@@ -0,0 +1,9 @@
+package enum
+
+type EmailStatus string
+
+const (
+	EmailPending EmailStatus = "pending"
+	EmailSent    EmailStatus = "sent"
+	EmailFailed  EmailStatus = "failed"
+)
@@ -0,0 +1,36 @@
+package enum
+
+// EventType names a domain event published through the outbox, written as
+// "aggregate.action".
+type EventType string
+
+const (
+	EventTicketCreated       EventType = "ticket.created"
+	EventTicketAssigned      EventType = "ticket.assigned"
+	EventTicketStatusChanged EventType = "ticket.status_changed"
+	EventTicketDeleted       EventType = "ticket.deleted"
+	EventAssetCreated        EventType = "asset.created"
+	EventAssetUpdated        EventType = "asset.updated"
+	EventAssetStatusChanged  EventType = "asset.status_changed"
+	EventAssetQtyChanged     EventType = "asset.quantity_changed"
+	EventAssetDeleted        EventType = "asset.deleted"
+)
+
+// EventWildcard subscribes a webhook endpoint to every event type.
+const EventWildcard = "*"
+
+func AllEventTypes() []EventType {
+	return []EventType{
+		EventTicketCreated, EventTicketAssigned, EventTicketStatusChanged, EventTicketDeleted,
+		EventAssetCreated, EventAssetUpdated, EventAssetStatusChanged, EventAssetQtyChanged, EventAssetDeleted,
+	}
+}
+
+func (t EventType) IsValid() bool {
+	for _, eventType := range AllEventTypes() {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,17 @@
+package enum
+
+type JobRunStatus string
+
+const (
+	JobRunRunning   JobRunStatus = "running"
+	JobRunSucceeded JobRunStatus = "succeeded"
+	JobRunFailed    JobRunStatus = "failed"
+)
+
+// JobTrigger records why a job ran.
+type JobTrigger string
+
+const (
+	JobTriggerSchedule JobTrigger = "schedule"
+	JobTriggerManual   JobTrigger = "manual"
+)
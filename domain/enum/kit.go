@@ -0,0 +1,20 @@
+package enum
+
+// ReturnCondition is the state a kit item comes back in. Only items returned
+// in good condition go back into stock.
+type ReturnCondition string
+
+const (
+	ReturnGood    ReturnCondition = "good"
+	ReturnDamaged ReturnCondition = "damaged"
+	ReturnMissing ReturnCondition = "missing"
+)
+
+func (c ReturnCondition) IsValid() bool {
+	switch c {
+	case ReturnGood, ReturnDamaged, ReturnMissing:
+		return true
+	default:
+		return false
+	}
+}
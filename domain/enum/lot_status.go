@@ -0,0 +1,19 @@
+package enum
+
+// LotStatus is whether a lot's stock may be issued. Quarantined lots, such as
+// expired ones, are held back.
+type LotStatus string
+
+const (
+	LotActive      LotStatus = "active"
+	LotQuarantined LotStatus = "quarantined"
+)
+
+func (s LotStatus) IsValid() bool {
+	switch s {
+	case LotActive, LotQuarantined:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,54 @@
+package enum
+
+// NotificationKind names a notification. Email kinds have one template per
+// locale; every kind appears in the in-app inbox, and users can mute kinds in
+// their preferences.
+type NotificationKind string
+
+const (
+	NotificationTicketCreated    NotificationKind = "ticket_created"
+	NotificationTicketAssigned   NotificationKind = "ticket_assigned"
+	NotificationTicketResolved   NotificationKind = "ticket_resolved"
+	NotificationSLABreachWarning NotificationKind = "sla_breach_warning"
+	// Inbox-only kinds
+	NotificationAssetStatusChanged NotificationKind = "asset_status_changed"
+	NotificationCoverageExpiring   NotificationKind = "coverage_expiring"
+	NotificationApprovalRequested  NotificationKind = "approval_requested"
+	NotificationAssetRequestUpdate NotificationKind = "asset_request_updated"
+	NotificationLowStock           NotificationKind = "low_stock"
+	NotificationCheckoutOverdue    NotificationKind = "checkout_overdue"
+)
+
+func AllNotificationKinds() []NotificationKind {
+	return append(EmailNotificationKinds(), NotificationAssetStatusChanged, NotificationCoverageExpiring,
+		NotificationApprovalRequested, NotificationAssetRequestUpdate, NotificationLowStock, NotificationCheckoutOverdue)
+}
+
+// EmailNotificationKinds returns the kinds that are also sent by email and
+// therefore have templates.
+func EmailNotificationKinds() []NotificationKind {
+	return []NotificationKind{
+		NotificationTicketCreated,
+		NotificationTicketAssigned,
+		NotificationTicketResolved,
+		NotificationSLABreachWarning,
+	}
+}
+
+func (k NotificationKind) IsValid() bool {
+	for _, kind := range AllNotificationKinds() {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func (k NotificationKind) HasEmail() bool {
+	for _, kind := range EmailNotificationKinds() {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
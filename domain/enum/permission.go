@@ -0,0 +1,56 @@
+package enum
+
+// Permission names an action on a resource type, written as "resource:action".
+// Roles are made of permissions, and personal access tokens are restricted to
+// a subset of them as scopes.
+type Permission string
+
+const (
+	PermissionAssetsRead          Permission = "assets:read"
+	PermissionAssetsWrite         Permission = "assets:write"
+	PermissionAssetsDelete        Permission = "assets:delete"
+	PermissionTicketsRead         Permission = "tickets:read"
+	PermissionTicketsWrite        Permission = "tickets:write"
+	PermissionTicketsWork         Permission = "tickets:work"
+	PermissionTicketsDelete       Permission = "tickets:delete"
+	PermissionLocationsRead       Permission = "locations:read"
+	PermissionLocationsWrite      Permission = "locations:write"
+	PermissionTokensWrite         Permission = "tokens:write"
+	PermissionUsersManage         Permission = "users:manage"
+	PermissionRolesManage         Permission = "roles:manage"
+	PermissionDepartmentsManage   Permission = "departments:manage"
+	PermissionReportsRead         Permission = "reports:read"
+	PermissionWebhooksManage      Permission = "webhooks:manage"
+	PermissionNotificationsManage Permission = "notifications:manage"
+	PermissionJobsManage          Permission = "jobs:manage"
+	PermissionMaintenanceManage   Permission = "maintenance:manage"
+	PermissionDisposalsApprove    Permission = "disposals:approve"
+	PermissionFinanceManage       Permission = "finance:manage"
+	PermissionWarrantiesManage    Permission = "warranties:manage"
+	PermissionVendorsManage       Permission = "vendors:manage"
+	PermissionPurchasesApprove    Permission = "purchases:approve"
+	PermissionPurchasesManage     Permission = "purchases:manage"
+)
+
+func AllPermissions() []Permission {
+	return []Permission{
+		PermissionAssetsRead, PermissionAssetsWrite, PermissionAssetsDelete,
+		PermissionTicketsRead, PermissionTicketsWrite, PermissionTicketsWork, PermissionTicketsDelete,
+		PermissionLocationsRead, PermissionLocationsWrite,
+		PermissionTokensWrite, PermissionUsersManage, PermissionRolesManage,
+		PermissionDepartmentsManage, PermissionReportsRead,
+		PermissionWebhooksManage, PermissionNotificationsManage, PermissionJobsManage,
+		PermissionMaintenanceManage, PermissionDisposalsApprove, PermissionFinanceManage,
+		PermissionWarrantiesManage, PermissionVendorsManage,
+		PermissionPurchasesApprove, PermissionPurchasesManage,
+	}
+}
+
+func (p Permission) IsValid() bool {
+	for _, permission := range AllPermissions() {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
\ No newline at end of file
@@ -0,0 +1,39 @@
+package enum
+
+type PurchaseRequestStatus string
+
+const (
+	PurchaseRequestDraft     PurchaseRequestStatus = "draft"
+	PurchaseRequestPending   PurchaseRequestStatus = "pending"
+	PurchaseRequestApproved  PurchaseRequestStatus = "approved"
+	PurchaseRequestRejected  PurchaseRequestStatus = "rejected"
+	PurchaseRequestCancelled PurchaseRequestStatus = "cancelled"
+	PurchaseRequestOrdered   PurchaseRequestStatus = "ordered"
+)
+
+func (s PurchaseRequestStatus) IsValid() bool {
+	switch s {
+	case PurchaseRequestDraft, PurchaseRequestPending, PurchaseRequestApproved, PurchaseRequestRejected, PurchaseRequestCancelled, PurchaseRequestOrdered:
+		return true
+	default:
+		return false
+	}
+}
+
+type PurchaseOrderStatus string
+
+const (
+	PurchaseOrderOrdered           PurchaseOrderStatus = "ordered"
+	PurchaseOrderPartiallyReceived PurchaseOrderStatus = "partially_received"
+	PurchaseOrderReceived          PurchaseOrderStatus = "received"
+	PurchaseOrderCancelled         PurchaseOrderStatus = "cancelled"
+)
+
+func (s PurchaseOrderStatus) IsValid() bool {
+	switch s {
+	case PurchaseOrderOrdered, PurchaseOrderPartiallyReceived, PurchaseOrderReceived, PurchaseOrderCancelled:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,25 @@
+package enum
+
+type ReservationStatus string
+
+const (
+	ReservationConfirmed ReservationStatus = "confirmed"
+	ReservationActive    ReservationStatus = "active"
+	ReservationCompleted ReservationStatus = "completed"
+	ReservationCancelled ReservationStatus = "cancelled"
+)
+
+func (s ReservationStatus) IsValid() bool {
+	switch s {
+	case ReservationConfirmed, ReservationActive, ReservationCompleted, ReservationCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+// HoldsUnits reports whether reservations in the status take up units of
+// the asset.
+func (s ReservationStatus) HoldsUnits() bool {
+	return s == ReservationConfirmed || s == ReservationActive
+}
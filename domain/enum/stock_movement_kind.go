@@ -0,0 +1,22 @@
+package enum
+
+// StockMovementKind is why an asset's quantity changed. Consumption counts
+// issues less returns; the other kinds bring stock in or correct it.
+type StockMovementKind string
+
+const (
+	StockMovementIssue      StockMovementKind = "issue"
+	StockMovementReturn     StockMovementKind = "return"
+	StockMovementReceipt    StockMovementKind = "receipt"
+	StockMovementAdjustment StockMovementKind = "adjustment"
+	StockMovementRecount    StockMovementKind = "recount"
+)
+
+func (k StockMovementKind) IsValid() bool {
+	switch k {
+	case StockMovementIssue, StockMovementReturn, StockMovementReceipt, StockMovementAdjustment, StockMovementRecount:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,19 @@
+package enum
+
+// TrackingMode is how the assets of a category are counted: pooled assets
+// are a bare quantity, serialized ones have a unit per physical item.
+type TrackingMode string
+
+const (
+	TrackingPooled     TrackingMode = "pooled"
+	TrackingSerialized TrackingMode = "serialized"
+)
+
+func (m TrackingMode) IsValid() bool {
+	switch m {
+	case TrackingPooled, TrackingSerialized:
+		return true
+	default:
+		return false
+	}
+}
@@ -1,17 +1,21 @@
 package enum
 
+// UserRole names the built-in roles that are created on startup. Admins can
+// define further roles at runtime, so a user's role is not limited to these.
 type UserRole string
 
 const (
-	RoleAdmin    UserRole = "admin"
-	RoleEmployee UserRole = "employee"
+	RoleAdmin           UserRole = "admin"
+	RoleEmployee        UserRole = "employee"
+	RoleTechnician      UserRole = "technician"
+	RoleLocationManager UserRole = "location_manager"
 )
 
 func (r UserRole) IsValid() bool {
 	switch r {
-	case RoleAdmin, RoleEmployee:
+	case RoleAdmin, RoleEmployee, RoleTechnician, RoleLocationManager:
 		return true
 	default:
 		return false
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,28 @@
+package enum
+
+// WarrantyStatus summarizes an asset's warranty cover.
+type WarrantyStatus string
+
+const (
+	WarrantyActive  WarrantyStatus = "active"
+	WarrantyExpired WarrantyStatus = "expired"
+	WarrantyNone    WarrantyStatus = "none"
+)
+
+type WarrantyClaimStatus string
+
+const (
+	WarrantyClaimSubmitted WarrantyClaimStatus = "submitted"
+	WarrantyClaimApproved  WarrantyClaimStatus = "approved"
+	WarrantyClaimRejected  WarrantyClaimStatus = "rejected"
+	WarrantyClaimCompleted WarrantyClaimStatus = "completed"
+)
+
+func (s WarrantyClaimStatus) IsValid() bool {
+	switch s {
+	case WarrantyClaimSubmitted, WarrantyClaimApproved, WarrantyClaimRejected, WarrantyClaimCompleted:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,243 @@
+// Package policy evaluates what an authenticated principal may do. The same
+// evaluation backs the HTTP permission middleware (does the caller hold the
+// permission anywhere?) and the row-level checks inside services (does it
+// hold the permission for this particular asset or ticket?).
+package policy
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/enum"
+)
+
+var ErrForbidden = errors.New("insufficient permissions")
+
+// Grant gives a permission, optionally limited to a set of locations (a
+// location and all of its descendants), a set of departments and one asset
+// category.
+type Grant struct {
+	Permission    enum.Permission
+	LocationIDs   []uuid.UUID
+	DepartmentIDs []uuid.UUID
+	Category      string
+}
+
+func (g Grant) IsUnrestricted() bool {
+	return g.LocationIDs == nil && g.DepartmentIDs == nil && g.Category == ""
+}
+
+// Principal is the caller of a request together with its effective grants.
+// Scopes is nil for interactive sessions; for personal access tokens it lists
+// the token's scopes, which further limit the grants.
+type Principal struct {
+	UserID uuid.UUID
+	Role   string
+	Grants []Grant
+	Scopes []string
+}
+
+// Resource describes the attributes of a row that grants can be scoped by.
+type Resource struct {
+	LocationID   *uuid.UUID
+	DepartmentID *uuid.UUID
+	Category     string
+}
+
+// Condition is the row filter form of a scoped grant, used by repositories
+// to restrict list queries.
+type Condition struct {
+	LocationIDs   []uuid.UUID
+	DepartmentIDs []uuid.UUID
+	Category      string
+}
+
+// Evaluate reports whether the principal may perform perm on res. A nil
+// resource asks whether the permission is held anywhere.
+func Evaluate(p *Principal, perm enum.Permission, res *Resource) bool {
+	if p == nil || !p.scopeAllows(perm) {
+		return false
+	}
+
+	for _, grant := range p.Grants {
+		if grant.Permission != perm {
+			continue
+		}
+		if res == nil || grant.matches(res) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Principal) Can(perm enum.Permission, res *Resource) bool {
+	return Evaluate(p, perm, res)
+}
+
+// Permissions lists the distinct permissions the principal holds anywhere.
+func (p *Principal) Permissions() []enum.Permission {
+	seen := make(map[enum.Permission]bool)
+	var permissions []enum.Permission
+	for _, grant := range p.Grants {
+		if !seen[grant.Permission] && p.scopeAllows(grant.Permission) {
+			seen[grant.Permission] = true
+			permissions = append(permissions, grant.Permission)
+		}
+	}
+	return permissions
+}
+
+// Conditions returns the row filters for perm. unrestricted is true when any
+// grant covers every row; otherwise a row is visible if it matches at least
+// one condition, and no conditions means nothing is visible.
+func (p *Principal) Conditions(perm enum.Permission) (conditions []Condition, unrestricted bool) {
+	if !p.scopeAllows(perm) {
+		return nil, false
+	}
+
+	for _, grant := range p.Grants {
+		if grant.Permission != perm {
+			continue
+		}
+		if grant.IsUnrestricted() {
+			return nil, true
+		}
+		conditions = append(conditions, Condition{
+			LocationIDs:   grant.LocationIDs,
+			DepartmentIDs: grant.DepartmentIDs,
+			Category:      grant.Category,
+		})
+	}
+	return conditions, false
+}
+
+func (p *Principal) scopeAllows(perm enum.Permission) bool {
+	if p.Scopes == nil {
+		return true
+	}
+	for _, scope := range p.Scopes {
+		if scope == string(perm) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g Grant) matches(res *Resource) bool {
+	if g.LocationIDs != nil {
+		if res.LocationID == nil || !containsID(g.LocationIDs, *res.LocationID) {
+			return false
+		}
+	}
+	if g.DepartmentIDs != nil {
+		if res.DepartmentID == nil || !containsID(g.DepartmentIDs, *res.DepartmentID) {
+			return false
+		}
+	}
+	if g.Category != "" && !strings.EqualFold(g.Category, res.Category) {
+		return false
+	}
+	return true
+}
+
+func containsID(ids []uuid.UUID, id uuid.UUID) bool {
+	for _, candidate := range ids {
+		if candidate == id {
+			return true
+		}
+	}
+	return false
+}
+
+type principalKey struct{}
+
+type systemKey struct{}
+
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// AsSystem returns ctx without its principal and marked as the system's own
+// work: startup, background jobs and workers, or work done as a consequence
+// of a user's action rather than on their behalf. Only such contexts pass
+// checks without a principal.
+func AsSystem(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, principalKey{}, (*Principal)(nil))
+	return context.WithValue(ctx, systemKey{}, true)
+}
+
+// IsSystem reports whether ctx was marked by AsSystem and carries no
+// principal since.
+func IsSystem(ctx context.Context) bool {
+	if _, ok := FromContext(ctx); ok {
+		return false
+	}
+	system, _ := ctx.Value(systemKey{}).(bool)
+	return system
+}
+
+func FromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok && p != nil
+}
+
+// Caller returns the principal in ctx, or nil for the system. Contexts with
+// neither fail with ErrForbidden.
+func Caller(ctx context.Context) (*Principal, error) {
+	if p, ok := FromContext(ctx); ok {
+		return p, nil
+	}
+	if IsSystem(ctx) {
+		return nil, nil
+	}
+	return nil, ErrForbidden
+}
+
+// Authorize checks perm on res for the principal in ctx. The system is always
+// allowed; contexts with neither a principal nor the system marker are not.
+func Authorize(ctx context.Context, perm enum.Permission, res *Resource) error {
+	p, err := Caller(ctx)
+	if err != nil || p == nil {
+		return err
+	}
+	if !Evaluate(p, perm, res) {
+		return ErrForbidden
+	}
+	return nil
+}
+
+// AuthorizeOwner allows the system and the user userID, for rows only their
+// owner may change.
+func AuthorizeOwner(ctx context.Context, userID uuid.UUID) error {
+	p, err := Caller(ctx)
+	if err != nil || p == nil {
+		return err
+	}
+	if p.UserID != userID {
+		return ErrForbidden
+	}
+	return nil
+}
+
+// ListConditions returns the row filters for perm for the principal in ctx.
+// restricted is false when all rows are visible.
+func ListConditions(ctx context.Context, perm enum.Permission) (conditions []Condition, restricted bool, err error) {
+	p, err := Caller(ctx)
+	if err != nil {
+		return nil, true, err
+	}
+	if p == nil {
+		return nil, false, nil
+	}
+
+	conditions, unrestricted := p.Conditions(perm)
+	if unrestricted {
+		return nil, false, nil
+	}
+	if len(conditions) == 0 {
+		return nil, true, ErrForbidden
+	}
+	return conditions, true, nil
+}
@@ -0,0 +1,175 @@
+package policy
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/enum"
+)
+
+var (
+	hq        = uuid.MustParse("00000000-0000-0000-0000-0000000000a1")
+	warehouse = uuid.MustParse("00000000-0000-0000-0000-0000000000a2")
+	finance   = uuid.MustParse("00000000-0000-0000-0000-0000000000d1")
+	it        = uuid.MustParse("00000000-0000-0000-0000-0000000000d2")
+)
+
+func TestEvaluate(t *testing.T) {
+	read := enum.PermissionAssetsRead
+	write := enum.PermissionAssetsWrite
+
+	tests := []struct {
+		name      string
+		principal *Principal
+		perm      enum.Permission
+		res       *Resource
+		want      bool
+	}{
+		{name: "no principal", principal: nil, perm: read, want: false},
+		{name: "no grants", principal: &Principal{}, perm: read, want: false},
+		{name: "unrestricted grant", principal: principal(Grant{Permission: read}), perm: read, res: &Resource{}, want: true},
+		{name: "other permission", principal: principal(Grant{Permission: read}), perm: write, res: &Resource{}, want: false},
+		{name: "held anywhere", principal: principal(Grant{Permission: read, LocationIDs: []uuid.UUID{hq}}), perm: read, want: true},
+
+		// Scope matching
+		{name: "location in scope", principal: principal(Grant{Permission: read, LocationIDs: []uuid.UUID{hq, warehouse}}), perm: read, res: &Resource{LocationID: &warehouse}, want: true},
+		{name: "location out of scope", principal: principal(Grant{Permission: read, LocationIDs: []uuid.UUID{hq}}), perm: read, res: &Resource{LocationID: &warehouse}, want: false},
+		{name: "row without location", principal: principal(Grant{Permission: read, LocationIDs: []uuid.UUID{hq}}), perm: read, res: &Resource{}, want: false},
+		{name: "empty location scope", principal: principal(Grant{Permission: read, LocationIDs: []uuid.UUID{}}), perm: read, res: &Resource{LocationID: &hq}, want: false},
+		{name: "department in scope", principal: principal(Grant{Permission: read, DepartmentIDs: []uuid.UUID{it}}), perm: read, res: &Resource{DepartmentID: &it}, want: true},
+		{name: "department out of scope", principal: principal(Grant{Permission: read, DepartmentIDs: []uuid.UUID{it}}), perm: read, res: &Resource{DepartmentID: &finance}, want: false},
+		{name: "category ignores case", principal: principal(Grant{Permission: read, Category: "Laptops"}), perm: read, res: &Resource{Category: "laptops"}, want: true},
+		{name: "other category", principal: principal(Grant{Permission: read, Category: "Laptops"}), perm: read, res: &Resource{Category: "Monitors"}, want: false},
+		{name: "all limits match", principal: principal(Grant{Permission: read, LocationIDs: []uuid.UUID{hq}, DepartmentIDs: []uuid.UUID{it}, Category: "Laptops"}), perm: read, res: &Resource{LocationID: &hq, DepartmentID: &it, Category: "Laptops"}, want: true},
+		{name: "one limit fails", principal: principal(Grant{Permission: read, LocationIDs: []uuid.UUID{hq}, DepartmentIDs: []uuid.UUID{it}}), perm: read, res: &Resource{LocationID: &hq, DepartmentID: &finance}, want: false},
+		{name: "any grant may match", principal: principal(Grant{Permission: read, LocationIDs: []uuid.UUID{hq}}, Grant{Permission: read, DepartmentIDs: []uuid.UUID{finance}}), perm: read, res: &Resource{LocationID: &warehouse, DepartmentID: &finance}, want: true},
+
+		// Token scopes
+		{name: "session without scopes", principal: &Principal{Grants: []Grant{{Permission: read}}, Scopes: nil}, perm: read, res: &Resource{}, want: true},
+		{name: "token scope allows", principal: &Principal{Grants: []Grant{{Permission: read}}, Scopes: []string{"assets:read"}}, perm: read, res: &Resource{}, want: true},
+		{name: "token scope limits grants", principal: &Principal{Grants: []Grant{{Permission: read}, {Permission: write}}, Scopes: []string{"assets:read"}}, perm: write, res: &Resource{}, want: false},
+		{name: "token without scopes", principal: &Principal{Grants: []Grant{{Permission: read}}, Scopes: []string{}}, perm: read, res: &Resource{}, want: false},
+		{name: "scope without grant", principal: &Principal{Scopes: []string{"assets:write"}}, perm: write, res: &Resource{}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Evaluate(tt.principal, tt.perm, tt.res); got != tt.want {
+				t.Errorf("Evaluate = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConditions(t *testing.T) {
+	read := enum.PermissionAssetsRead
+
+	tests := []struct {
+		name             string
+		principal        *Principal
+		wantConditions   []Condition
+		wantUnrestricted bool
+	}{
+		{name: "no grants", principal: &Principal{}},
+		{name: "other permission only", principal: principal(Grant{Permission: enum.PermissionTicketsRead})},
+		{name: "unrestricted", principal: principal(Grant{Permission: read}), wantUnrestricted: true},
+		{
+			name:             "unrestricted wins over scoped",
+			principal:        principal(Grant{Permission: read, LocationIDs: []uuid.UUID{hq}}, Grant{Permission: read}),
+			wantUnrestricted: true,
+		},
+		{
+			name:      "one condition per scoped grant",
+			principal: principal(Grant{Permission: read, LocationIDs: []uuid.UUID{hq}}, Grant{Permission: read, DepartmentIDs: []uuid.UUID{it}, Category: "Laptops"}),
+			wantConditions: []Condition{
+				{LocationIDs: []uuid.UUID{hq}},
+				{DepartmentIDs: []uuid.UUID{it}, Category: "Laptops"},
+			},
+		},
+		{
+			name:      "scope excludes the permission",
+			principal: &Principal{Grants: []Grant{{Permission: read}}, Scopes: []string{"tickets:read"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conditions, unrestricted := tt.principal.Conditions(read)
+			if unrestricted != tt.wantUnrestricted {
+				t.Errorf("unrestricted = %v, want %v", unrestricted, tt.wantUnrestricted)
+			}
+			if !reflect.DeepEqual(conditions, tt.wantConditions) {
+				t.Errorf("conditions = %+v, want %+v", conditions, tt.wantConditions)
+			}
+		})
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	owner := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	reader := &Principal{UserID: owner, Grants: []Grant{{Permission: enum.PermissionAssetsRead}}}
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		perm      enum.Permission
+		want      error
+		wantOwner error
+	}{
+		{name: "no principal", ctx: context.Background(), perm: enum.PermissionAssetsRead, want: ErrForbidden, wantOwner: ErrForbidden},
+		{name: "system", ctx: AsSystem(context.Background()), perm: enum.PermissionAssetsDelete, want: nil, wantOwner: nil},
+		{name: "principal with grant", ctx: WithPrincipal(context.Background(), reader), perm: enum.PermissionAssetsRead, want: nil, wantOwner: nil},
+		{name: "principal without grant", ctx: WithPrincipal(context.Background(), reader), perm: enum.PermissionAssetsDelete, want: ErrForbidden, wantOwner: nil},
+		{name: "system drops the principal", ctx: AsSystem(WithPrincipal(context.Background(), reader)), perm: enum.PermissionAssetsDelete, want: nil, wantOwner: nil},
+		{name: "principal inside system work", ctx: WithPrincipal(AsSystem(context.Background()), &Principal{}), perm: enum.PermissionAssetsRead, want: ErrForbidden, wantOwner: ErrForbidden},
+		{name: "nil principal without marker", ctx: WithPrincipal(context.Background(), nil), perm: enum.PermissionAssetsRead, want: ErrForbidden, wantOwner: ErrForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Authorize(tt.ctx, tt.perm, &Resource{}); !errors.Is(err, tt.want) {
+				t.Errorf("Authorize = %v, want %v", err, tt.want)
+			}
+			if err := AuthorizeOwner(tt.ctx, owner); !errors.Is(err, tt.wantOwner) {
+				t.Errorf("AuthorizeOwner = %v, want %v", err, tt.wantOwner)
+			}
+		})
+	}
+}
+
+func TestListConditions(t *testing.T) {
+	scoped := principal(Grant{Permission: enum.PermissionAssetsRead, LocationIDs: []uuid.UUID{hq}})
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		wantRestricted bool
+		wantConditions int
+		wantErr        error
+	}{
+		{name: "no principal", ctx: context.Background(), wantRestricted: true, wantErr: ErrForbidden},
+		{name: "system", ctx: AsSystem(context.Background())},
+		{name: "unrestricted", ctx: WithPrincipal(context.Background(), principal(Grant{Permission: enum.PermissionAssetsRead}))},
+		{name: "scoped", ctx: WithPrincipal(context.Background(), scoped), wantRestricted: true, wantConditions: 1},
+		{name: "no grant", ctx: WithPrincipal(context.Background(), &Principal{}), wantRestricted: true, wantErr: ErrForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conditions, restricted, err := ListConditions(tt.ctx, enum.PermissionAssetsRead)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("err = %v, want %v", err, tt.wantErr)
+			}
+			if restricted != tt.wantRestricted || len(conditions) != tt.wantConditions {
+				t.Errorf("got %d conditions, restricted %v; want %d, %v", len(conditions), restricted, tt.wantConditions, tt.wantRestricted)
+			}
+		})
+	}
+}
+
+func principal(grants ...Grant) *Principal {
+	return &Principal{Grants: grants}
+}
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+type AssetRelationshipRepository interface {
+	Create(ctx context.Context, relationship *entity.AssetRelationship) error
+	// GetByID returns the relationship with both of its assets.
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.AssetRelationship, error)
+	// GetLink returns the relationship of the type from one asset to the other.
+	GetLink(ctx context.Context, fromID, toID uuid.UUID, relType string) (*entity.AssetRelationship, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	// LockGraph takes a lock on the whole relationship graph until the
+	// transaction ends, so links are checked and added one at a time.
+	LockGraph(ctx context.Context) error
+	// ListByAsset returns the relationships on either side of the asset,
+	// with both of their assets.
+	ListByAsset(ctx context.Context, assetID uuid.UUID) ([]*entity.AssetRelationship, error)
+	// GetParentLink returns the contains or installed_in relationship that
+	// makes the asset a component of another.
+	GetParentLink(ctx context.Context, assetID uuid.UUID) (*entity.AssetRelationship, error)
+	// ListComponentIDs returns the asset and every asset it contains,
+	// directly or through other components.
+	ListComponentIDs(ctx context.Context, assetID uuid.UUID) ([]uuid.UUID, error)
+	// ListDependencyIDs returns the asset and every asset it depends on,
+	// directly or through other dependencies.
+	ListDependencyIDs(ctx context.Context, assetID uuid.UUID) ([]uuid.UUID, error)
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"inventory-ticketing-system/domain/entity"
@@ -10,8 +11,23 @@ import (
 type AssetRepository interface {
 	Create(ctx context.Context, asset *entity.Asset) error
 	GetByID(ctx context.Context, id uuid.UUID) (*entity.Asset, error)
+	// LockByID locks the asset's row until the transaction ends and returns
+	// the asset as it is now, so whatever is checked against its quantity
+	// happens one caller at a time.
+	LockByID(ctx context.Context, id uuid.UUID) (*entity.Asset, error)
+	// Update saves the asset's attributes except its quantity and usage.
 	Update(ctx context.Context, asset *entity.Asset) error
+	// AdjustQty adds delta to the asset's quantity and returns the new
+	// quantity, or false when that would drop below zero.
+	AdjustQty(ctx context.Context, id uuid.UUID, delta int) (int, bool, error)
 	Delete(ctx context.Context, id uuid.UUID) error
 	List(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.Asset, int, error)
 	GetByUniqueID(ctx context.Context, uniqueID string) (*entity.Asset, error)
+	ListByCategory(ctx context.Context, category string) ([]*entity.Asset, error)
+	// IncrementUsage adds amount to the asset's usage counter and returns the
+	// new count.
+	IncrementUsage(ctx context.Context, id uuid.UUID, amount int) (int, error)
+	// ListHeldAt returns the assets acquired (purchased, or created when the
+	// purchase date is unknown) by at and not disposed of by then.
+	ListHeldAt(ctx context.Context, at time.Time) ([]*entity.Asset, error)
 }
\ No newline at end of file
@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+type AssetRequestRepository interface {
+	// Create stores the request together with its approval steps.
+	Create(ctx context.Context, request *entity.AssetRequest) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.AssetRequest, error)
+	// LockByID locks the request's row until the transaction ends and returns
+	// it as it is now, so decisions on one request are made one at a time.
+	LockByID(ctx context.Context, id uuid.UUID) (*entity.AssetRequest, error)
+	Update(ctx context.Context, request *entity.AssetRequest) error
+	UpdateApproval(ctx context.Context, approval *entity.AssetRequestApproval) error
+	List(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.AssetRequest, int, error)
+	// ListOverdueApprovals returns the pending steps due before now that
+	// nobody has been reminded about yet.
+	ListOverdueApprovals(ctx context.Context, now time.Time) ([]*entity.AssetRequestApproval, error)
+}
+
+type ApprovalDelegationRepository interface {
+	Create(ctx context.Context, delegation *entity.ApprovalDelegation) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.ApprovalDelegation, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	// ListByUser returns the delegations the user gave or received, latest
+	// start first.
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]*entity.ApprovalDelegation, error)
+	// ListActiveByDelegators returns the delegations in force at the given
+	// time that any of delegatorIDs gave.
+	ListActiveByDelegators(ctx context.Context, delegatorIDs []uuid.UUID, at time.Time) ([]*entity.ApprovalDelegation, error)
+	// ListActiveForDelegate returns the delegations in force at the given time
+	// that delegateID received.
+	ListActiveForDelegate(ctx context.Context, delegateID uuid.UUID, at time.Time) ([]*entity.ApprovalDelegation, error)
+}
+
+type AssetCheckoutRepository interface {
+	Create(ctx context.Context, checkout *entity.AssetCheckout) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.AssetCheckout, error)
+	Update(ctx context.Context, checkout *entity.AssetCheckout) error
+	// List returns checkouts filtered by "asset_id", "user_id", "open",
+	// "overdue_at" (still out after their due date at that time) and "scope".
+	List(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.AssetCheckout, int, error)
+	// ListOverdue returns the checkouts still out after their due date whose
+	// user has not been reminded yet, with their asset.
+	ListOverdue(ctx context.Context, now time.Time) ([]*entity.AssetCheckout, error)
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+type AssetStatusChangeRepository interface {
+	Create(ctx context.Context, change *entity.AssetStatusChange) error
+	// ListByAsset returns the asset's status history, newest first.
+	ListByAsset(ctx context.Context, assetID uuid.UUID) ([]*entity.AssetStatusChange, error)
+	// SummarizeDurations returns the time each asset spent in each status
+	// between from and to, for assets with history in that period.
+	SummarizeDurations(ctx context.Context, from, to time.Time) ([]*entity.AssetStatusTime, error)
+}
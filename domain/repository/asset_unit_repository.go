@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+type AssetUnitRepository interface {
+	Create(ctx context.Context, unit *entity.AssetUnit) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.AssetUnit, error)
+	GetBySerialNumber(ctx context.Context, serialNumber string) (*entity.AssetUnit, error)
+	Update(ctx context.Context, unit *entity.AssetUnit) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.AssetUnit, int, error)
+	// CountByAsset counts the asset's units, only those with the status
+	// unless it is empty.
+	CountByAsset(ctx context.Context, assetID uuid.UUID, status string) (int, error)
+	// CountByCategory counts the units of the assets in the category, matched
+	// case-insensitively.
+	CountByCategory(ctx context.Context, category string) (int, error)
+}
+
+type AssetUnitStatusChangeRepository interface {
+	Create(ctx context.Context, change *entity.AssetUnitStatusChange) error
+	// ListByUnit returns the unit's status history, newest first.
+	ListByUnit(ctx context.Context, unitID uuid.UUID) ([]*entity.AssetUnitStatusChange, error)
+}
+
+type CategoryTrackingModeRepository interface {
+	Create(ctx context.Context, mode *entity.CategoryTrackingMode) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.CategoryTrackingMode, error)
+	// GetByCategory matches the category case-insensitively.
+	GetByCategory(ctx context.Context, category string) (*entity.CategoryTrackingMode, error)
+	Update(ctx context.Context, mode *entity.CategoryTrackingMode) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context) ([]*entity.CategoryTrackingMode, error)
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+type CalendarFeedTokenRepository interface {
+	Create(ctx context.Context, token *entity.CalendarFeedToken) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.CalendarFeedToken, error)
+	GetByHash(ctx context.Context, tokenHash string) (*entity.CalendarFeedToken, error)
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]*entity.CalendarFeedToken, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	TouchLastUsed(ctx context.Context, id uuid.UUID, usedAt time.Time) error
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+type DepartmentRepository interface {
+	Create(ctx context.Context, department *entity.Department) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Department, error)
+	GetByName(ctx context.Context, name string) (*entity.Department, error)
+	GetByCostCenterCode(ctx context.Context, code string) (*entity.Department, error)
+	Update(ctx context.Context, department *entity.Department) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context) ([]*entity.Department, error)
+	ListManagedBy(ctx context.Context, userID uuid.UUID) ([]*entity.Department, error)
+	ListDescendantIDs(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error)
+	CountMembers(ctx context.Context, id uuid.UUID) (int, error)
+	Summarize(ctx context.Context, from, to *time.Time) ([]*entity.DepartmentSummary, error)
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+type DepreciationPolicyRepository interface {
+	Create(ctx context.Context, policy *entity.DepreciationPolicy) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.DepreciationPolicy, error)
+	// GetByCategory matches the category case-insensitively.
+	GetByCategory(ctx context.Context, category string) (*entity.DepreciationPolicy, error)
+	Update(ctx context.Context, policy *entity.DepreciationPolicy) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context) ([]*entity.DepreciationPolicy, error)
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+type DisposalRepository interface {
+	Create(ctx context.Context, disposal *entity.DisposalRequest) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.DisposalRequest, error)
+	Update(ctx context.Context, disposal *entity.DisposalRequest) error
+	// List returns disposal requests, newest first, filtered by "status",
+	// "asset_id", "requested_by" and "scope" (conditions on the asset).
+	List(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.DisposalRequest, int, error)
+	// GetPendingByAsset returns the asset's pending request, if any.
+	GetPendingByAsset(ctx context.Context, assetID uuid.UUID) (*entity.DisposalRequest, error)
+}
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"inventory-ticketing-system/domain/entity"
+)
+
+type ScheduledJobRepository interface {
+	// Upsert creates the job or updates its description and schedule. An
+	// existing job keeps its lease, and its next run unless the schedule
+	// changed.
+	Upsert(ctx context.Context, job *entity.ScheduledJob) error
+	GetByName(ctx context.Context, name string) (*entity.ScheduledJob, error)
+	List(ctx context.Context) ([]*entity.ScheduledJob, error)
+	// Claim leases the job to owner until leaseUntil unless another instance
+	// holds an unexpired lease. With dueOnly set, the job is only claimed
+	// once its next run is due at now.
+	Claim(ctx context.Context, name, owner string, now, leaseUntil time.Time, dueOnly bool) (bool, error)
+	// Release ends owner's lease and, when nextRunAt is set, moves the next
+	// run.
+	Release(ctx context.Context, name, owner string, nextRunAt *time.Time) error
+}
+
+type JobRunRepository interface {
+	Create(ctx context.Context, run *entity.JobRun) error
+	Update(ctx context.Context, run *entity.JobRun) error
+	ListByJob(ctx context.Context, jobName string, limit, offset int) ([]*entity.JobRun, int, error)
+	// LatestByJob returns the most recent run of every job, keyed by name.
+	LatestByJob(ctx context.Context) (map[string]*entity.JobRun, error)
+	// FailRunning marks the job's unfinished runs failed. Called after
+	// claiming a lease, when any unfinished run belongs to an instance that
+	// died.
+	FailRunning(ctx context.Context, jobName, reason string, at time.Time) error
+	DeleteFinishedBefore(ctx context.Context, before time.Time) (int64, error)
+}
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+type KitRepository interface {
+	// Create stores the kit together with its components.
+	Create(ctx context.Context, kit *entity.Kit) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Kit, error)
+	GetByName(ctx context.Context, name string) (*entity.Kit, error)
+	// Update saves the kit and replaces its components.
+	Update(ctx context.Context, kit *entity.Kit) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.Kit, int, error)
+	// ListCategoryAssets returns the available pooled assets with stock in
+	// any of the categories, which match case-insensitively. The "scope"
+	// filter limits them to the assets matching a grant's conditions.
+	ListCategoryAssets(ctx context.Context, categories []string, filters map[string]interface{}) ([]*entity.Asset, error)
+}
+
+type KitCheckoutRepository interface {
+	Create(ctx context.Context, checkout *entity.KitCheckout) error
+	// GetByID returns the checkout with its kit and items.
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.KitCheckout, error)
+	Update(ctx context.Context, checkout *entity.KitCheckout) error
+	List(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.KitCheckout, int, error)
+	// CountOpen returns how many checkouts of the kit are not returned yet.
+	CountOpen(ctx context.Context, kitID uuid.UUID) (int, error)
+}
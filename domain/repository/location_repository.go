@@ -14,4 +14,5 @@ type LocationRepository interface {
 	Delete(ctx context.Context, id uuid.UUID) error
 	List(ctx context.Context, limit, offset int) ([]*entity.Location, int, error)
 	GetByName(ctx context.Context, name string) (*entity.Location, error)
+	ListDescendantIDs(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error)
 }
\ No newline at end of file
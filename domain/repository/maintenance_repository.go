@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+type MaintenancePlanRepository interface {
+	Create(ctx context.Context, plan *entity.MaintenancePlan) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.MaintenancePlan, error)
+	Update(ctx context.Context, plan *entity.MaintenancePlan) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	// List returns plans filtered by "asset_id", "category" and "is_active".
+	List(ctx context.Context, filters map[string]interface{}) ([]*entity.MaintenancePlan, error)
+}
+
+type MaintenanceScheduleRepository interface {
+	// Create saves the schedule unless the plan already has one for the
+	// asset.
+	Create(ctx context.Context, schedule *entity.MaintenanceSchedule) error
+	Update(ctx context.Context, schedule *entity.MaintenanceSchedule) error
+	// LockByPlanAndAsset returns the plan's schedule for the asset and locks
+	// it until the transaction ends, or nil if there is none.
+	LockByPlanAndAsset(ctx context.Context, planID, assetID uuid.UUID) (*entity.MaintenanceSchedule, error)
+	// GetByOpenTicket returns the schedule the ticket was opened for with its
+	// plan and asset, or nil if there is none.
+	GetByOpenTicket(ctx context.Context, ticketID uuid.UUID) (*entity.MaintenanceSchedule, error)
+	// ListCalendar returns the schedules of active calendar-based plans with
+	// their plan and asset.
+	ListCalendar(ctx context.Context) ([]*entity.MaintenanceSchedule, error)
+	// DeleteStale removes the plan's schedules for assets it no longer
+	// covers, unless they have an open ticket.
+	DeleteStale(ctx context.Context, planID uuid.UUID, assetIDs []uuid.UUID) error
+}
+
+type MaintenanceRecordRepository interface {
+	Create(ctx context.Context, record *entity.MaintenanceRecord) error
+	ListByPlan(ctx context.Context, planID uuid.UUID, limit, offset int) ([]*entity.MaintenanceRecord, int, error)
+}
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+type NotificationTemplateRepository interface {
+	Create(ctx context.Context, template *entity.NotificationTemplate) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.NotificationTemplate, error)
+	GetByKindAndLocale(ctx context.Context, kind, locale string) (*entity.NotificationTemplate, error)
+	Update(ctx context.Context, template *entity.NotificationTemplate) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context) ([]*entity.NotificationTemplate, error)
+}
+
+type NotificationPreferenceRepository interface {
+	GetByUserID(ctx context.Context, userID uuid.UUID) (*entity.NotificationPreference, error)
+	Save(ctx context.Context, preference *entity.NotificationPreference) error
+}
+
+type EmailMessageRepository interface {
+	Create(ctx context.Context, message *entity.EmailMessage) error
+	Update(ctx context.Context, message *entity.EmailMessage) error
+	// ClaimDue returns up to limit pending messages due at now and pushes
+	// their next attempt to leaseUntil so other instances skip them.
+	ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*entity.EmailMessage, error)
+	DeleteSentBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+type NotificationRepository interface {
+	Create(ctx context.Context, notification *entity.Notification) error
+	// ListByRecipient returns up to limit notifications older than after, or
+	// the newest ones when after is nil, newest first.
+	ListByRecipient(ctx context.Context, recipientID uuid.UUID, unreadOnly bool, after *entity.NotificationCursor, limit int) ([]*entity.Notification, error)
+	CountUnread(ctx context.Context, recipientID uuid.UUID) (int64, error)
+	// MarkRead marks one of the recipient's notifications read and reports
+	// whether it exists. Already read notifications keep their read time.
+	MarkRead(ctx context.Context, recipientID, id uuid.UUID, at time.Time) (bool, error)
+	MarkAllRead(ctx context.Context, recipientID uuid.UUID, at time.Time) (int64, error)
+	DeleteReadBefore(ctx context.Context, before time.Time) (int64, error)
+}
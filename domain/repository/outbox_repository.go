@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+type OutboxRepository interface {
+	Create(ctx context.Context, event *entity.OutboxEvent) error
+	// ClaimNext locks the oldest unprocessed event that is due, skipping
+	// events locked by other relays. It must run inside a transaction and
+	// returns nil when there is nothing to process.
+	ClaimNext(ctx context.Context, now time.Time) (*entity.OutboxEvent, error)
+	MarkProcessed(ctx context.Context, id uuid.UUID, at time.Time) error
+	MarkFailed(ctx context.Context, id uuid.UUID, lastError string, retryAt time.Time) error
+	DeleteProcessedBefore(ctx context.Context, before time.Time) (int, error)
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+type PersonalAccessTokenRepository interface {
+	Create(ctx context.Context, token *entity.PersonalAccessToken) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.PersonalAccessToken, error)
+	GetByHash(ctx context.Context, tokenHash string) (*entity.PersonalAccessToken, error)
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]*entity.PersonalAccessToken, error)
+	Revoke(ctx context.Context, id uuid.UUID, revokedAt time.Time) error
+	TouchLastUsed(ctx context.Context, id uuid.UUID, usedAt time.Time) error
+	DeleteInactiveBefore(ctx context.Context, before time.Time) (int64, error)
+}
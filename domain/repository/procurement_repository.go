@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+type PurchaseRequestRepository interface {
+	// Create stores the request together with its items.
+	Create(ctx context.Context, request *entity.PurchaseRequest) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.PurchaseRequest, error)
+	Update(ctx context.Context, request *entity.PurchaseRequest) error
+	List(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.PurchaseRequest, int, error)
+}
+
+type PurchaseOrderRepository interface {
+	// Create stores the order together with its lines.
+	Create(ctx context.Context, order *entity.PurchaseOrder) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.PurchaseOrder, error)
+	Update(ctx context.Context, order *entity.PurchaseOrder) error
+	UpdateLine(ctx context.Context, line *entity.PurchaseOrderLine) error
+	List(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.PurchaseOrder, int, error)
+}
+
+type GoodsReceiptRepository interface {
+	// Create stores the receipt together with its lines.
+	Create(ctx context.Context, receipt *entity.GoodsReceipt) error
+	// ListByOrder returns the receipts of an order, oldest first.
+	ListByOrder(ctx context.Context, orderID uuid.UUID) ([]*entity.GoodsReceipt, error)
+}
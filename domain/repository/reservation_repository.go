@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+type ReservationRepository interface {
+	Create(ctx context.Context, reservation *entity.Reservation) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Reservation, error)
+	Update(ctx context.Context, reservation *entity.Reservation) error
+	List(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.Reservation, int, error)
+	// ListOverlapping returns the confirmed and active reservations of the
+	// assets that take up time in [from, to), leaving out excludeID.
+	ListOverlapping(ctx context.Context, assetIDs []uuid.UUID, from, to time.Time, excludeID *uuid.UUID) ([]*entity.Reservation, error)
+	// ListDue returns the confirmed reservations that have started and the
+	// active ones that have ended by now.
+	ListDue(ctx context.Context, now time.Time) ([]*entity.Reservation, error)
+}
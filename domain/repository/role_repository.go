@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+type RoleRepository interface {
+	Create(ctx context.Context, role *entity.Role) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Role, error)
+	GetByName(ctx context.Context, name string) (*entity.Role, error)
+	Update(ctx context.Context, role *entity.Role) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context) ([]*entity.Role, error)
+	CountUsers(ctx context.Context, role *entity.Role) (int, error)
+}
+
+type RoleAssignmentRepository interface {
+	Create(ctx context.Context, assignment *entity.RoleAssignment) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.RoleAssignment, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]*entity.RoleAssignment, error)
+}
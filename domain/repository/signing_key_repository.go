@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"inventory-ticketing-system/domain/entity"
+)
+
+type SigningKeyRepository interface {
+	Create(ctx context.Context, key *entity.SigningKey) error
+	ListUsable(ctx context.Context, algorithm string, at time.Time) ([]*entity.SigningKey, error)
+	RetireActive(ctx context.Context, algorithm string, exceptID string, retiresAt time.Time) error
+	// LockRotation blocks until no other transaction is rotating keys and
+	// holds the lock until the transaction ends.
+	LockRotation(ctx context.Context) error
+}
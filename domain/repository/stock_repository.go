@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+type ReorderPointRepository interface {
+	Create(ctx context.Context, point *entity.ReorderPoint) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.ReorderPoint, error)
+	GetByAsset(ctx context.Context, assetID uuid.UUID) (*entity.ReorderPoint, error)
+	// GetByCategory matches the category case-insensitively.
+	GetByCategory(ctx context.Context, category string) (*entity.ReorderPoint, error)
+	Update(ctx context.Context, point *entity.ReorderPoint) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context, filters map[string]interface{}) ([]*entity.ReorderPoint, error)
+	// ListAssets returns the assets in service that have a reorder point of
+	// their own or of their category.
+	ListAssets(ctx context.Context, filters map[string]interface{}) ([]*entity.Asset, error)
+}
+
+type StockMovementRepository interface {
+	Create(ctx context.Context, movement *entity.StockMovement) error
+	// SumConsumption returns how many units of each asset were issued since
+	// the given time, less those returned. Receipts and corrections don't
+	// count.
+	SumConsumption(ctx context.Context, assetIDs []uuid.UUID, since time.Time) (map[uuid.UUID]int, error)
+}
+
+type StockAlertRepository interface {
+	Create(ctx context.Context, alert *entity.StockAlert) error
+	Update(ctx context.Context, alert *entity.StockAlert) error
+	// ListOpen returns the unresolved alerts of the given assets, or of all
+	// assets when assetIDs is nil.
+	ListOpen(ctx context.Context, assetIDs []uuid.UUID) ([]*entity.StockAlert, error)
+}
+
+type StockLotRepository interface {
+	Create(ctx context.Context, lot *entity.StockLot) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.StockLot, error)
+	GetByNumber(ctx context.Context, assetID uuid.UUID, lotNumber string) (*entity.StockLot, error)
+	// Update saves the lot's attributes except its quantity.
+	Update(ctx context.Context, lot *entity.StockLot) error
+	// AdjustQty adds delta to the lot's quantity, or returns false when that
+	// would drop below zero.
+	AdjustQty(ctx context.Context, id uuid.UUID, delta int) (bool, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	// ListByAsset returns the asset's lots in the order stock is issued from
+	// them: earliest expiry first, lots without one last.
+	ListByAsset(ctx context.Context, assetID uuid.UUID) ([]*entity.StockLot, error)
+	// LockByAsset is ListByAsset with the lots locked until the transaction
+	// ends.
+	LockByAsset(ctx context.Context, assetID uuid.UUID) ([]*entity.StockLot, error)
+	// List returns lots with stock, earliest expiry first.
+	List(ctx context.Context, filters map[string]interface{}) ([]*entity.StockLot, error)
+	CountByAsset(ctx context.Context, assetID uuid.UUID) (int, error)
+	// ListExpired returns the active lots that expired before day.
+	ListExpired(ctx context.Context, day time.Time) ([]*entity.StockLot, error)
+}
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+type TechnicianRepository interface {
+	Create(ctx context.Context, technician *entity.Technician) error
+	GetByUserID(ctx context.Context, userID uuid.UUID) (*entity.Technician, error)
+	Update(ctx context.Context, technician *entity.Technician) error
+	Delete(ctx context.Context, userID uuid.UUID) error
+	List(ctx context.Context, teamID *uuid.UUID) ([]*entity.Technician, error)
+	ListActive(ctx context.Context, teamID *uuid.UUID) ([]*entity.Technician, error)
+	TouchLastAssigned(ctx context.Context, userID uuid.UUID, at time.Time) error
+	// LockAssignment blocks until no other transaction is auto-assigning a
+	// ticket and holds the lock until the transaction ends.
+	LockAssignment(ctx context.Context) error
+}
+
+type TeamRepository interface {
+	Create(ctx context.Context, team *entity.Team) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Team, error)
+	GetByName(ctx context.Context, name string) (*entity.Team, error)
+	Update(ctx context.Context, team *entity.Team) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context) ([]*entity.Team, error)
+	CountTechnicians(ctx context.Context, id uuid.UUID) (int, error)
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"inventory-ticketing-system/domain/entity"
@@ -15,4 +16,9 @@ type TicketRepository interface {
 	List(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.Ticket, int, error)
 	GetByAssetID(ctx context.Context, assetID uuid.UUID) ([]*entity.Ticket, error)
 	GetByReporter(ctx context.Context, reporterID uuid.UUID) ([]*entity.Ticket, error)
+	ListQueue(ctx context.Context, assigneeID uuid.UUID, teamIDs []uuid.UUID) ([]*entity.Ticket, error)
+	CountOpenByAssignee(ctx context.Context, assigneeIDs []uuid.UUID) (map[uuid.UUID]int, error)
+	ListDueWithoutWarning(ctx context.Context, dueBefore time.Time) ([]*entity.Ticket, error)
+	MarkSLAWarningSent(ctx context.Context, id uuid.UUID, at time.Time) error
+	ListResolvedBefore(ctx context.Context, before time.Time) ([]*entity.Ticket, error)
 }
\ No newline at end of file
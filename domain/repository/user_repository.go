@@ -14,4 +14,6 @@ type UserRepository interface {
 	Update(ctx context.Context, user *entity.User) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	List(ctx context.Context, limit, offset int) ([]*entity.User, error)
+	ListServiceAccounts(ctx context.Context) ([]*entity.User, error)
+	ListByRole(ctx context.Context, role string) ([]*entity.User, error)
 }
\ No newline at end of file
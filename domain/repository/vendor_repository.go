@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+type VendorRepository interface {
+	Create(ctx context.Context, vendor *entity.Vendor) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Vendor, error)
+	GetByName(ctx context.Context, name string) (*entity.Vendor, error)
+	Update(ctx context.Context, vendor *entity.Vendor) error
+	// Delete removes the vendor and unlinks it from assets.
+	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.Vendor, int, error)
+	// Spend sums the purchase cost of the assets bought from the vendor per
+	// currency, filtered like asset lists.
+	Spend(ctx context.Context, id uuid.UUID, filters map[string]interface{}) ([]*entity.VendorSpend, error)
+}
+
+type ManufacturerRepository interface {
+	Create(ctx context.Context, manufacturer *entity.Manufacturer) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Manufacturer, error)
+	// FindByName matches the name or an alias, ignoring case.
+	FindByName(ctx context.Context, name string) (*entity.Manufacturer, error)
+	Update(ctx context.Context, manufacturer *entity.Manufacturer) error
+	// Delete removes the manufacturer and unlinks it from assets, which keep
+	// their brand.
+	Delete(ctx context.Context, id uuid.UUID) error
+	// List returns the manufacturers by name with their asset counts.
+	List(ctx context.Context, search string) ([]*entity.Manufacturer, error)
+	// ReassignAssets moves the assets of the fromIDs manufacturers to toID and
+	// sets their brand, returning how many assets changed.
+	ReassignAssets(ctx context.Context, fromIDs []uuid.UUID, toID uuid.UUID, brand string) (int, error)
+	// ListUnlinkedBrands returns the distinct brands of assets without a
+	// manufacturer.
+	ListUnlinkedBrands(ctx context.Context) ([]string, error)
+	// LinkBrand links the assets without a manufacturer whose brand is
+	// exactly brand and renames it, returning how many assets changed.
+	LinkBrand(ctx context.Context, brand string, manufacturerID uuid.UUID, name string) (int, error)
+}
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+type WarrantyRepository interface {
+	Create(ctx context.Context, warranty *entity.Warranty) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Warranty, error)
+	Update(ctx context.Context, warranty *entity.Warranty) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	// ListByAsset returns the asset's warranties, latest ending first.
+	ListByAsset(ctx context.Context, assetID uuid.UUID) ([]*entity.Warranty, error)
+	// ListExpiring returns the warranties ending between from and until with
+	// their asset, optionally only those not alerted about yet.
+	ListExpiring(ctx context.Context, from, until time.Time, unnotifiedOnly bool) ([]*entity.Warranty, error)
+	MarkExpiryNotified(ctx context.Context, ids []uuid.UUID, at time.Time) error
+}
+
+type SupportContractRepository interface {
+	Create(ctx context.Context, contract *entity.SupportContract) error
+	// GetByID returns the contract with its AssetIDs, as do the lists.
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.SupportContract, error)
+	Update(ctx context.Context, contract *entity.SupportContract) error
+	// SetAssets replaces the assets the contract covers.
+	SetAssets(ctx context.Context, contractID uuid.UUID, assetIDs []uuid.UUID) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	// List returns contracts, latest ending first, filtered by "asset_id",
+	// "provider" and "active_on" (a date the contract covers).
+	List(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.SupportContract, int, error)
+	ListExpiring(ctx context.Context, from, until time.Time, unnotifiedOnly bool) ([]*entity.SupportContract, error)
+	MarkExpiryNotified(ctx context.Context, ids []uuid.UUID, at time.Time) error
+}
+
+type WarrantyClaimRepository interface {
+	Create(ctx context.Context, claim *entity.WarrantyClaim) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.WarrantyClaim, error)
+	Update(ctx context.Context, claim *entity.WarrantyClaim) error
+	ListByWarranty(ctx context.Context, warrantyID uuid.UUID) ([]*entity.WarrantyClaim, error)
+	ListByTicket(ctx context.Context, ticketID uuid.UUID) ([]*entity.WarrantyClaim, error)
+}
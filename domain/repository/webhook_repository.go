@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+type WebhookEndpointRepository interface {
+	Create(ctx context.Context, endpoint *entity.WebhookEndpoint) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.WebhookEndpoint, error)
+	Update(ctx context.Context, endpoint *entity.WebhookEndpoint) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context) ([]*entity.WebhookEndpoint, error)
+	ListActive(ctx context.Context) ([]*entity.WebhookEndpoint, error)
+}
+
+type WebhookDeliveryRepository interface {
+	Create(ctx context.Context, delivery *entity.WebhookDelivery) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.WebhookDelivery, error)
+	Update(ctx context.Context, delivery *entity.WebhookDelivery) error
+	ListByEndpoint(ctx context.Context, endpointID uuid.UUID, limit, offset int, status string) ([]*entity.WebhookDelivery, int, error)
+	// ClaimDue returns pending deliveries whose next attempt is due and moves
+	// their next attempt to leaseUntil, so concurrent dispatchers skip them.
+	ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*entity.WebhookDelivery, error)
+	CreateAttempt(ctx context.Context, attempt *entity.WebhookDeliveryAttempt) error
+}
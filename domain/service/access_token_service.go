@@ -0,0 +1,20 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+type AccessTokenService interface {
+	JobProvider
+	CreateToken(ctx context.Context, token *entity.PersonalAccessToken) (string, error)
+	GetToken(ctx context.Context, id uuid.UUID) (*entity.PersonalAccessToken, error)
+	ListTokens(ctx context.Context, userID uuid.UUID) ([]*entity.PersonalAccessToken, error)
+	RevokeToken(ctx context.Context, id uuid.UUID) error
+	Authenticate(ctx context.Context, plaintext string) (*entity.PersonalAccessToken, *entity.User, error)
+	IsAccessToken(value string) bool
+	CreateServiceAccount(ctx context.Context, user *entity.User) error
+	ListServiceAccounts(ctx context.Context) ([]*entity.User, error)
+}
@@ -0,0 +1,25 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+type AssetRelationshipService interface {
+	// Attach links relationship.FromAssetID to relationship.ToAssetID. An
+	// asset is a component of at most one other, and neither containment
+	// nor dependencies may loop back on themselves.
+	Attach(ctx context.Context, relationship *entity.AssetRelationship) error
+	Detach(ctx context.Context, id uuid.UUID) error
+	// ListRelationships returns the relationships on either side of the
+	// asset.
+	ListRelationships(ctx context.Context, assetID uuid.UUID) ([]*entity.AssetRelationship, error)
+	// GetGraph walks the relationships outward from the asset, following
+	// at most depth of them.
+	GetGraph(ctx context.Context, assetID uuid.UUID, depth int) (*entity.AssetGraph, error)
+	// UpdateWithComponents updates the asset like AssetService.UpdateAsset
+	// and moves every asset it contains, however deep, to its new location.
+	UpdateWithComponents(ctx context.Context, id uuid.UUID, asset *entity.Asset) error
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+type AssetRequestService interface {
+	JobProvider
+	// CreateRequest asks for units of an available asset the caller can read
+	// and starts its approval chain.
+	CreateRequest(ctx context.Context, request *entity.AssetRequest) error
+	// GetRequest returns a request to its requester, its approvers and their
+	// delegates, and whoever may write the asset.
+	GetRequest(ctx context.Context, id uuid.UUID) (*entity.AssetRequest, error)
+	// ListRequests returns the requests for assets the caller may write, or
+	// the caller's own requests; with the "awaiting" filter, the requests
+	// waiting for the caller's decision, directly or by delegation.
+	ListRequests(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.AssetRequest, int, error)
+	// ApproveRequest approves the current step. Approving the last step
+	// fulfils the request by checking the units out to the requester.
+	ApproveRequest(ctx context.Context, id uuid.UUID, comment string) (*entity.AssetRequest, error)
+	RejectRequest(ctx context.Context, id uuid.UUID, comment string) (*entity.AssetRequest, error)
+	// CancelRequest withdraws a pending request; only its requester may.
+	CancelRequest(ctx context.Context, id uuid.UUID) (*entity.AssetRequest, error)
+	// RemindOverdue notifies the approvers of steps past their SLA, once per
+	// step, and returns how many steps were overdue.
+	RemindOverdue(ctx context.Context) (int, error)
+	// RemindOverdueCheckouts notifies users of checkouts still out after
+	// their due date, once per checkout.
+	RemindOverdueCheckouts(ctx context.Context) (int, error)
+
+	// CreateDelegation lets another user decide the caller's approvals for a
+	// period.
+	CreateDelegation(ctx context.Context, delegation *entity.ApprovalDelegation) error
+	// ListDelegations returns the delegations the caller gave or received.
+	ListDelegations(ctx context.Context) ([]*entity.ApprovalDelegation, error)
+	// DeleteDelegation ends a delegation; only its delegator may.
+	DeleteDelegation(ctx context.Context, id uuid.UUID) error
+
+	// ListCheckouts returns the checkouts of assets the caller may write, or
+	// the caller's own checkouts.
+	ListCheckouts(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.AssetCheckout, int, error)
+	// ReturnCheckout puts checked out units back into stock.
+	ReturnCheckout(ctx context.Context, id uuid.UUID) (*entity.AssetCheckout, error)
+}
@@ -2,18 +2,54 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/enum"
 )
 
+// AssetStatusRules decide how the ticket lifecycle changes the status of the
+// ticket's asset. OnCreated maps a ticket severity to the status an asset in
+// service gets when such a ticket is filed. OnAssigned is the status once a
+// technician is assigned. With RestoreOnResolve, resolving the asset's last
+// unfinished ticket puts back the status it had before tickets changed it.
+type AssetStatusRules struct {
+	OnCreated        map[string]string
+	OnAssigned       string
+	RestoreOnResolve bool
+}
+
 type AssetService interface {
 	CreateAsset(ctx context.Context, asset *entity.Asset) error
 	GetAsset(ctx context.Context, id uuid.UUID) (*entity.Asset, error)
 	UpdateAsset(ctx context.Context, id uuid.UUID, asset *entity.Asset) error
 	DeleteAsset(ctx context.Context, id uuid.UUID) error
 	ListAssets(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.Asset, int, error)
-	UpdateAssetStatus(ctx context.Context, id uuid.UUID, status string) error
-	DecreaseAssetQuantity(ctx context.Context, id uuid.UUID, qty int) error
-	IncreaseAssetQuantity(ctx context.Context, id uuid.UUID, qty int) error
-}
\ No newline at end of file
+	// UpdateAssetStatus changes the status along an allowed transition.
+	// Retiring, disposing of or losing an asset needs a reason.
+	UpdateAssetStatus(ctx context.Context, id uuid.UUID, status, reason string) error
+	// GetStatusHistory returns the asset's status changes, newest first.
+	GetStatusHistory(ctx context.Context, id uuid.UUID) ([]*entity.AssetStatusChange, error)
+	// GetStatusReport returns how long each asset spent in each status
+	// between from (or the beginning) and to (or now).
+	GetStatusReport(ctx context.Context, from, to *time.Time) ([]*entity.AssetStatusTime, error)
+	// DecreaseAssetQuantity and IncreaseAssetQuantity change the quantity of
+	// a pooled asset; serialized assets are changed through their units. An
+	// asset with lots is issued from its lots first-expired-first-out, and
+	// stock put back goes to the lot that is issued next. kind is recorded
+	// on the stock movement.
+	DecreaseAssetQuantity(ctx context.Context, id uuid.UUID, qty int, kind enum.StockMovementKind) error
+	IncreaseAssetQuantity(ctx context.Context, id uuid.UUID, qty int, kind enum.StockMovementKind) error
+	// RecountUnits sets a serialized asset's quantity to the number of its
+	// available units. Call it after units are added, removed or change
+	// status.
+	RecountUnits(ctx context.Context, id uuid.UUID) error
+	// RecountLots sets a lot-tracked asset's quantity to the stock of its
+	// active, unexpired lots, recording the change as kind. Call it after
+	// lots are added, removed or change.
+	RecountLots(ctx context.Context, id uuid.UUID, kind enum.StockMovementKind) error
+	// SyncStatusWithTicket applies the asset status rules after event
+	// happened to ticket. Call it inside the ticket's transaction.
+	SyncStatusWithTicket(ctx context.Context, ticket *entity.Ticket, event enum.EventType) error
+}
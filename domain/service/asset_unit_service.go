@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+type AssetUnitService interface {
+	// CreateUnit adds a unit to an asset whose category is serialized.
+	CreateUnit(ctx context.Context, unit *entity.AssetUnit) error
+	GetUnit(ctx context.Context, id uuid.UUID) (*entity.AssetUnit, error)
+	// UpdateUnit changes a unit's serial number, location, custodian and
+	// notes; its status changes through UpdateUnitStatus.
+	UpdateUnit(ctx context.Context, id uuid.UUID, unit *entity.AssetUnit) (*entity.AssetUnit, error)
+	// UpdateUnitStatus changes the status along an allowed transition, like
+	// an asset's.
+	UpdateUnitStatus(ctx context.Context, id uuid.UUID, status, reason string) (*entity.AssetUnit, error)
+	DeleteUnit(ctx context.Context, id uuid.UUID) error
+	ListUnits(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.AssetUnit, int, error)
+	// GetUnitHistory returns the unit's status changes, newest first.
+	GetUnitHistory(ctx context.Context, id uuid.UUID) ([]*entity.AssetUnitStatusChange, error)
+
+	CreateTrackingMode(ctx context.Context, mode *entity.CategoryTrackingMode) error
+	UpdateTrackingMode(ctx context.Context, id uuid.UUID, mode *entity.CategoryTrackingMode) error
+	// DeleteTrackingMode makes the category pooled again, which needs its
+	// assets to have no units.
+	DeleteTrackingMode(ctx context.Context, id uuid.UUID) error
+	ListTrackingModes(ctx context.Context) ([]*entity.CategoryTrackingMode, error)
+}
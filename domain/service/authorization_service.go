@@ -0,0 +1,23 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/policy"
+)
+
+type AuthorizationService interface {
+	BuildPrincipal(ctx context.Context, userID uuid.UUID, scopes []string) (*policy.Principal, error)
+	EnsureSystemRoles(ctx context.Context) error
+	CreateRole(ctx context.Context, role *entity.Role) error
+	GetRole(ctx context.Context, id uuid.UUID) (*entity.Role, error)
+	UpdateRole(ctx context.Context, id uuid.UUID, role *entity.Role) error
+	DeleteRole(ctx context.Context, id uuid.UUID) error
+	ListRoles(ctx context.Context) ([]*entity.Role, error)
+	SetUserRole(ctx context.Context, userID uuid.UUID, roleName string) error
+	AssignRole(ctx context.Context, assignment *entity.RoleAssignment) error
+	RemoveRoleAssignment(ctx context.Context, userID, assignmentID uuid.UUID) error
+	ListRoleAssignments(ctx context.Context, userID uuid.UUID) ([]*entity.RoleAssignment, error)
+}
@@ -0,0 +1,19 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+type CalendarFeedService interface {
+	// CreateFeedToken issues the caller a token for one feed and returns the
+	// plaintext, which is not stored and cannot be retrieved again.
+	CreateFeedToken(ctx context.Context, token *entity.CalendarFeedToken) (string, error)
+	GetFeedToken(ctx context.Context, id uuid.UUID) (*entity.CalendarFeedToken, error)
+	ListFeedTokens(ctx context.Context, userID uuid.UUID) ([]*entity.CalendarFeedToken, error)
+	// RevokeFeedToken deletes a token, so its feed URL stops working.
+	RevokeFeedToken(ctx context.Context, id uuid.UUID) error
+	Authenticate(ctx context.Context, plaintext string) (*entity.CalendarFeedToken, error)
+}
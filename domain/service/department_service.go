@@ -0,0 +1,19 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+type DepartmentService interface {
+	CreateDepartment(ctx context.Context, department *entity.Department) error
+	GetDepartment(ctx context.Context, id uuid.UUID) (*entity.Department, error)
+	UpdateDepartment(ctx context.Context, id uuid.UUID, department *entity.Department) error
+	DeleteDepartment(ctx context.Context, id uuid.UUID) error
+	ListDepartments(ctx context.Context) ([]*entity.Department, error)
+	SetUserDepartment(ctx context.Context, userID uuid.UUID, departmentID *uuid.UUID) error
+	GetReport(ctx context.Context, from, to *time.Time) ([]*entity.DepartmentSummary, error)
+}
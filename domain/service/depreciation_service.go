@@ -0,0 +1,24 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+type DepreciationService interface {
+	CreatePolicy(ctx context.Context, policy *entity.DepreciationPolicy) error
+	GetPolicy(ctx context.Context, id uuid.UUID) (*entity.DepreciationPolicy, error)
+	UpdatePolicy(ctx context.Context, id uuid.UUID, policy *entity.DepreciationPolicy) error
+	DeletePolicy(ctx context.Context, id uuid.UUID) error
+	ListPolicies(ctx context.Context) ([]*entity.DepreciationPolicy, error)
+	// GetSchedule returns the depreciation schedule of an asset the caller can
+	// read, using the policy of its category.
+	GetSchedule(ctx context.Context, assetID uuid.UUID) (*entity.DepreciationSchedule, error)
+	// GetValuation values the assets held at a point in time, grouped by
+	// category, location and currency. Assets in categories without a policy
+	// are held at cost.
+	GetValuation(ctx context.Context, at time.Time) (*entity.AssetValuation, error)
+}
@@ -0,0 +1,27 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+type DisposalService interface {
+	// Propose files a disposal request for an asset the caller can read.
+	Propose(ctx context.Context, disposal *entity.DisposalRequest) error
+	GetDisposal(ctx context.Context, id uuid.UUID) (*entity.DisposalRequest, error)
+	// ListDisposals returns every request to approvers and the caller's own
+	// requests to everyone else.
+	ListDisposals(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.DisposalRequest, int, error)
+	// ConfirmDataWipe records that a data-bearing asset has been wiped.
+	ConfirmDataWipe(ctx context.Context, id uuid.UUID, method, notes string) (*entity.DisposalRequest, error)
+	// Approve accepts a pending request and marks the asset disposed.
+	Approve(ctx context.Context, id uuid.UUID, comment string) (*entity.DisposalRequest, error)
+	Reject(ctx context.Context, id uuid.UUID, comment string) (*entity.DisposalRequest, error)
+	// Cancel withdraws a pending request; only its requester may.
+	Cancel(ctx context.Context, id uuid.UUID) (*entity.DisposalRequest, error)
+	// Certificate renders the disposal certificate of an approved request as
+	// a PDF.
+	Certificate(ctx context.Context, id uuid.UUID) (*entity.DisposalRequest, []byte, error)
+}
@@ -0,0 +1,18 @@
+package service
+
+import (
+	"context"
+
+	"inventory-ticketing-system/domain/entity"
+)
+
+// EventBroker fans realtime events out to every running instance of the
+// application.
+type EventBroker interface {
+	// Publish hands the event to the broker. Inside a transaction it is only
+	// delivered once the transaction commits.
+	Publish(ctx context.Context, event *entity.RealtimeEvent) error
+	// Subscribe receives events published by any instance until cancel is
+	// called. Slow subscribers miss events rather than block others.
+	Subscribe() (events <-chan *entity.RealtimeEvent, cancel func())
+}
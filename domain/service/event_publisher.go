@@ -0,0 +1,23 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/enum"
+)
+
+// EventPublisher records domain events in the outbox. Call Publish inside
+// the transaction that makes the change, so the event is stored exactly when
+// the change commits.
+type EventPublisher interface {
+	Publish(ctx context.Context, eventType enum.EventType, aggregateID uuid.UUID, payload interface{}) error
+}
+
+// EventSubscriber receives events from the outbox relay. HandleEvent runs in
+// the relay's transaction; returning an error rolls back its writes and the
+// event is retried later.
+type EventSubscriber interface {
+	HandleEvent(ctx context.Context, event *entity.OutboxEvent) error
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+// JobFunc does one run of a job and returns a short summary of what it did
+// for the run history.
+type JobFunc func(ctx context.Context) (string, error)
+
+// Job is recurring work. Schedule is a cron expression evaluated in UTC, or a
+// descriptor such as @hourly or @every 5m. Timeout bounds a run and is also
+// how long the lease is held.
+type Job struct {
+	Name        string
+	Description string
+	Schedule    string
+	Timeout     time.Duration
+	Run         JobFunc
+}
+
+// JobProvider is implemented by services that have recurring work.
+type JobProvider interface {
+	Jobs() []Job
+}
+
+// JobScheduler runs registered jobs on their schedule. A Postgres lease makes
+// sure only one instance runs a job at a time.
+type JobScheduler interface {
+	JobProvider
+	// Register adds the providers' jobs. Call it on startup, before RunDue.
+	Register(ctx context.Context, providers ...JobProvider) error
+	// RunDue starts every job that is due and not leased by another
+	// instance, and returns how many were started. Jobs run in the
+	// background.
+	RunDue(ctx context.Context) (int, error)
+	ListJobs(ctx context.Context) ([]*entity.ScheduledJob, error)
+	GetJob(ctx context.Context, name string) (*entity.ScheduledJob, error)
+	ListRuns(ctx context.Context, name string, limit, offset int) ([]*entity.JobRun, int, error)
+	// Trigger starts a job now, outside its schedule, and returns the run.
+	Trigger(ctx context.Context, name string, triggeredBy uuid.UUID) (*entity.JobRun, error)
+}
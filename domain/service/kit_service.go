@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+// KitReturnItem is the condition one item of a kit checkout came back in.
+type KitReturnItem struct {
+	CheckoutID uuid.UUID
+	Condition  string
+	Notes      string
+}
+
+type KitService interface {
+	CreateKit(ctx context.Context, kit *entity.Kit) error
+	GetKit(ctx context.Context, id uuid.UUID) (*entity.Kit, error)
+	// UpdateKit changes a kit and replaces its components. Open checkouts
+	// keep the items they were given.
+	UpdateKit(ctx context.Context, id uuid.UUID, kit *entity.Kit) (*entity.Kit, error)
+	// DeleteKit deletes a kit that has no open checkouts, along with the
+	// record of its returned ones; their asset checkouts remain.
+	DeleteKit(ctx context.Context, id uuid.UUID) error
+	ListKits(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.Kit, int, error)
+	// GetAvailability computes how many whole kits the free stock of the
+	// components allows, and what each component lacks for one kit.
+	GetAvailability(ctx context.Context, id uuid.UUID) (*entity.KitAvailability, error)
+
+	// CheckOutKit checks every component of the kit out to checkout.UserID
+	// at once. When any component is short nothing is checked out and the
+	// error names the missing components.
+	CheckOutKit(ctx context.Context, kitID uuid.UUID, checkout *entity.KitCheckout) error
+	GetCheckout(ctx context.Context, id uuid.UUID) (*entity.KitCheckout, error)
+	// ListCheckouts returns the kit checkouts of assets the caller may
+	// write, or the caller's own checkouts.
+	ListCheckouts(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.KitCheckout, int, error)
+	// ReturnKit checks every item of the checkout back in with its
+	// condition. Items in good condition go back into stock.
+	ReturnKit(ctx context.Context, id uuid.UUID, items []KitReturnItem) (*entity.KitCheckout, error)
+}
@@ -0,0 +1,13 @@
+package service
+
+import (
+	"context"
+
+	"inventory-ticketing-system/domain/entity"
+)
+
+// Mailer delivers a rendered email. Implementations live in
+// infrastructure/mail.
+type Mailer interface {
+	Send(ctx context.Context, message *entity.EmailMessage) error
+}
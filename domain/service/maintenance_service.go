@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+type MaintenanceService interface {
+	EventSubscriber
+	JobProvider
+	CreatePlan(ctx context.Context, plan *entity.MaintenancePlan) error
+	GetPlan(ctx context.Context, id uuid.UUID) (*entity.MaintenancePlan, error)
+	UpdatePlan(ctx context.Context, id uuid.UUID, plan *entity.MaintenancePlan) error
+	DeletePlan(ctx context.Context, id uuid.UUID) error
+	ListPlans(ctx context.Context, filters map[string]interface{}) ([]*entity.MaintenancePlan, error)
+	ListRecords(ctx context.Context, planID uuid.UUID, limit, offset int) ([]*entity.MaintenanceRecord, int, error)
+	// Calendar returns the calendar-based maintenance due between from and
+	// to on assets the caller can read.
+	Calendar(ctx context.Context, from, to time.Time) ([]*entity.MaintenanceOccurrence, error)
+	// RecordUsage adds amount to the asset's usage counter and opens tickets
+	// for usage-based plans that have come due.
+	RecordUsage(ctx context.Context, assetID uuid.UUID, amount int) (*entity.Asset, error)
+	// GenerateDueTickets opens a ticket for every plan and asset whose
+	// maintenance is within the plan's lead time and returns how many were
+	// opened.
+	GenerateDueTickets(ctx context.Context) (int, error)
+}
@@ -0,0 +1,25 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+type ManufacturerService interface {
+	CreateManufacturer(ctx context.Context, manufacturer *entity.Manufacturer) error
+	GetManufacturer(ctx context.Context, id uuid.UUID) (*entity.Manufacturer, error)
+	// UpdateManufacturer renames the manufacturer or changes its aliases; the
+	// brand of its assets follows the name.
+	UpdateManufacturer(ctx context.Context, id uuid.UUID, manufacturer *entity.Manufacturer) error
+	DeleteManufacturer(ctx context.Context, id uuid.UUID) error
+	ListManufacturers(ctx context.Context, search string) ([]*entity.Manufacturer, error)
+	// MergeManufacturers moves the assets of the sources to the target, keeps
+	// the source names as aliases of the target and deletes the sources.
+	MergeManufacturers(ctx context.Context, targetID uuid.UUID, sourceIDs []uuid.UUID) (*entity.Manufacturer, error)
+	// NormalizeBrands links every asset with a brand but no manufacturer to
+	// the matching manufacturer, creating one where none matches, and
+	// returns how many assets were linked.
+	NormalizeBrands(ctx context.Context) (int, error)
+}
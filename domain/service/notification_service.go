@@ -0,0 +1,33 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/enum"
+)
+
+type NotificationService interface {
+	EventSubscriber
+	JobProvider
+	EnsureDefaultTemplates(ctx context.Context) error
+	ListTemplates(ctx context.Context) ([]*entity.NotificationTemplate, error)
+	GetTemplate(ctx context.Context, id uuid.UUID) (*entity.NotificationTemplate, error)
+	CreateTemplate(ctx context.Context, template *entity.NotificationTemplate) error
+	UpdateTemplate(ctx context.Context, id uuid.UUID, template *entity.NotificationTemplate) error
+	DeleteTemplate(ctx context.Context, id uuid.UUID) error
+	GetPreferences(ctx context.Context, userID uuid.UUID) (*entity.NotificationPreference, error)
+	UpdatePreferences(ctx context.Context, preference *entity.NotificationPreference) error
+	// ListInbox returns a page of the user's in-app notifications, newest
+	// first, and the cursor of the next page.
+	ListInbox(ctx context.Context, userID uuid.UUID, unreadOnly bool, cursor string, limit int) ([]*entity.Notification, string, error)
+	CountUnread(ctx context.Context, userID uuid.UUID) (int64, error)
+	MarkRead(ctx context.Context, userID, id uuid.UUID) error
+	MarkAllRead(ctx context.Context, userID uuid.UUID) (int64, error)
+	// NotifyInbox adds an inbox-only notification for each recipient who has
+	// not muted the kind.
+	NotifyInbox(ctx context.Context, kind enum.NotificationKind, recipientIDs []uuid.UUID, title string, payload map[string]interface{}) error
+	QueueSLAWarnings(ctx context.Context) (int, error)
+	DispatchDue(ctx context.Context) (int, error)
+}
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+// ReceiptItem is the quantity of a purchase order line delivered in one
+// goods receipt. UniqueIDs, when given, name one asset per unit; otherwise the
+// units become a single asset with that quantity.
+type ReceiptItem struct {
+	OrderLineID uuid.UUID
+	Quantity    int
+	UniqueIDs   []string
+}
+
+type ProcurementService interface {
+	// CreateRequest files a purchase request for the caller's department.
+	CreateRequest(ctx context.Context, request *entity.PurchaseRequest) error
+	// DraftRequest prepares a draft request on behalf of its RequestedBy,
+	// for the department set on it. Drafts are not decided on until their
+	// requester submits them.
+	DraftRequest(ctx context.Context, request *entity.PurchaseRequest) error
+	// SubmitRequest sends a draft for approval; only its requester may.
+	SubmitRequest(ctx context.Context, id uuid.UUID) (*entity.PurchaseRequest, error)
+	GetRequest(ctx context.Context, id uuid.UUID) (*entity.PurchaseRequest, error)
+	// ListRequests returns the caller's own requests and the requests they
+	// may approve or order.
+	ListRequests(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.PurchaseRequest, int, error)
+	// ApproveRequest accepts a pending request. Nobody may approve their own
+	// request.
+	ApproveRequest(ctx context.Context, id uuid.UUID, comment string) (*entity.PurchaseRequest, error)
+	RejectRequest(ctx context.Context, id uuid.UUID, comment string) (*entity.PurchaseRequest, error)
+	// CancelRequest withdraws a draft or a request that has not been ordered
+	// yet; only its requester may.
+	CancelRequest(ctx context.Context, id uuid.UUID) (*entity.PurchaseRequest, error)
+	// CreateOrder places an order with a vendor. An order for an approved
+	// request without lines of its own takes over the request's items.
+	CreateOrder(ctx context.Context, order *entity.PurchaseOrder) error
+	GetOrder(ctx context.Context, id uuid.UUID) (*entity.PurchaseOrder, error)
+	ListOrders(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.PurchaseOrder, int, error)
+	// CancelOrder cancels an order nothing has been received for yet and
+	// puts its request back to approved.
+	CancelOrder(ctx context.Context, id uuid.UUID) (*entity.PurchaseOrder, error)
+	// ReceiveGoods books a delivery against an order, creating or restocking
+	// the assets, which link back to the order.
+	ReceiveGoods(ctx context.Context, orderID uuid.UUID, receipt *entity.GoodsReceipt, items []ReceiptItem) (*entity.GoodsReceipt, error)
+	ListReceipts(ctx context.Context, orderID uuid.UUID) ([]*entity.GoodsReceipt, error)
+}
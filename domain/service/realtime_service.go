@@ -0,0 +1,15 @@
+package service
+
+import (
+	"context"
+
+	"inventory-ticketing-system/domain/entity"
+)
+
+type RealtimeService interface {
+	EventSubscriber
+	// Subscribe streams the events matching any of the topics that the
+	// principal in ctx may read. No topics means every readable event. The
+	// channel is closed when ctx is done.
+	Subscribe(ctx context.Context, topics []string) (<-chan *entity.RealtimeEvent, error)
+}
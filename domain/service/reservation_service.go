@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+type ReservationService interface {
+	JobProvider
+	// CreateReservation books units of an asset, failing when together with
+	// the overlapping reservations more units would be taken than the asset
+	// has.
+	CreateReservation(ctx context.Context, reservation *entity.Reservation) error
+	GetReservation(ctx context.Context, id uuid.UUID) (*entity.Reservation, error)
+	// UpdateReservation moves or resizes a reservation that has not started
+	// yet; an active one can only have its end changed.
+	UpdateReservation(ctx context.Context, id uuid.UUID, reservation *entity.Reservation) (*entity.Reservation, error)
+	// CancelReservation cancels an upcoming reservation, or ends an active
+	// one now.
+	CancelReservation(ctx context.Context, id uuid.UUID) (*entity.Reservation, error)
+	ListReservations(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.Reservation, int, error)
+	// SearchAvailability returns the assets matching the asset filters that
+	// have at least quantity units free for all of [from, to).
+	SearchAvailability(ctx context.Context, from, to time.Time, quantity int, filters map[string]interface{}) ([]*entity.AssetAvailability, error)
+	// AssetCalendar returns the asset's reservations as an iCalendar feed.
+	AssetCalendar(ctx context.Context, assetID uuid.UUID) ([]byte, error)
+	// UserCalendar returns the user's reservations as an iCalendar feed.
+	UserCalendar(ctx context.Context, userID uuid.UUID) ([]byte, error)
+	// SyncStatuses starts and ends the reservations that are due, booking
+	// assets while all their units are taken and making them available
+	// again afterwards, and returns how many reservations changed.
+	SyncStatuses(ctx context.Context) (int, error)
+}
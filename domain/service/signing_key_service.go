@@ -0,0 +1,13 @@
+package service
+
+import (
+	"context"
+
+	"inventory-ticketing-system/domain/entity"
+)
+
+type SigningKeyService interface {
+	LoadKeys(ctx context.Context) error
+	RotateKey(ctx context.Context) (*entity.SigningKey, error)
+	StartRotation(ctx context.Context)
+}
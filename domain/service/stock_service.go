@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+type StockService interface {
+	EventSubscriber
+	JobProvider
+	CreateReorderPoint(ctx context.Context, point *entity.ReorderPoint) error
+	GetReorderPoint(ctx context.Context, id uuid.UUID) (*entity.ReorderPoint, error)
+	UpdateReorderPoint(ctx context.Context, id uuid.UUID, point *entity.ReorderPoint) error
+	DeleteReorderPoint(ctx context.Context, id uuid.UUID) error
+	ListReorderPoints(ctx context.Context, filters map[string]interface{}) ([]*entity.ReorderPoint, error)
+	// ListLowStock returns the assets the caller can read that are at or
+	// below their reorder point, the ones running out soonest first.
+	ListLowStock(ctx context.Context, filters map[string]interface{}) ([]*entity.LowStockItem, error)
+	// DetectLowStock raises an alert for every asset that fell to its reorder
+	// point, resolves the alerts of restocked assets and returns how many
+	// alerts were raised.
+	DetectLowStock(ctx context.Context) (int, error)
+	// CreateLot receives a lot of a pooled asset. Receiving a lot number the
+	// asset already has adds to that lot, whose expiry must match.
+	CreateLot(ctx context.Context, lot *entity.StockLot) error
+	GetLot(ctx context.Context, id uuid.UUID) (*entity.StockLot, error)
+	// UpdateLot changes a lot's number, expiry, quantity and notes; the
+	// quantity is for correcting counts, stock is issued through the asset.
+	UpdateLot(ctx context.Context, id uuid.UUID, lot *entity.StockLot) (*entity.StockLot, error)
+	// UpdateLotStatus quarantines a lot or releases it. Expired lots cannot
+	// be released.
+	UpdateLotStatus(ctx context.Context, id uuid.UUID, status string) (*entity.StockLot, error)
+	DeleteLot(ctx context.Context, id uuid.UUID) error
+	// ListAssetLots returns the asset's lots in the order stock is issued
+	// from them.
+	ListAssetLots(ctx context.Context, assetID uuid.UUID) ([]*entity.StockLot, error)
+	// ListExpiringLots returns the lots with stock the caller can read that
+	// expire within days, including those already expired, earliest first.
+	ListExpiringLots(ctx context.Context, days int, filters map[string]interface{}) ([]*entity.StockLot, error)
+	// QuarantineExpiredLots quarantines the active lots past their expiry
+	// and returns how many there were.
+	QuarantineExpiredLots(ctx context.Context) (int, error)
+}
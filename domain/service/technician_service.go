@@ -0,0 +1,23 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+type TechnicianService interface {
+	CreateTechnician(ctx context.Context, technician *entity.Technician) error
+	GetTechnician(ctx context.Context, userID uuid.UUID) (*entity.Technician, error)
+	UpdateTechnician(ctx context.Context, userID uuid.UUID, technician *entity.Technician) error
+	DeleteTechnician(ctx context.Context, userID uuid.UUID) error
+	ListTechnicians(ctx context.Context, teamID *uuid.UUID) ([]*entity.Technician, error)
+	CreateTeam(ctx context.Context, team *entity.Team) error
+	GetTeam(ctx context.Context, id uuid.UUID) (*entity.Team, error)
+	UpdateTeam(ctx context.Context, id uuid.UUID, team *entity.Team) error
+	DeleteTeam(ctx context.Context, id uuid.UUID) error
+	ListTeams(ctx context.Context) ([]*entity.Team, error)
+	AutoAssign(ctx context.Context, ticket *entity.Ticket) error
+	GetQueue(ctx context.Context, userID uuid.UUID) ([]*entity.Ticket, error)
+}
@@ -8,6 +8,7 @@ import (
 )
 
 type TicketService interface {
+	JobProvider
 	CreateTicket(ctx context.Context, ticket *entity.Ticket) error
 	GetTicket(ctx context.Context, id uuid.UUID) (*entity.Ticket, error)
 	UpdateTicket(ctx context.Context, id uuid.UUID, ticket *entity.Ticket) error
@@ -0,0 +1,19 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+type VendorService interface {
+	CreateVendor(ctx context.Context, vendor *entity.Vendor) error
+	GetVendor(ctx context.Context, id uuid.UUID) (*entity.Vendor, error)
+	// GetVendorDetail aggregates the assets, tickets and spend the caller may
+	// read.
+	GetVendorDetail(ctx context.Context, id uuid.UUID) (*entity.VendorDetail, error)
+	UpdateVendor(ctx context.Context, id uuid.UUID, vendor *entity.Vendor) error
+	DeleteVendor(ctx context.Context, id uuid.UUID) error
+	ListVendors(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.Vendor, int, error)
+}
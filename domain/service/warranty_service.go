@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+type WarrantyService interface {
+	JobProvider
+	CreateWarranty(ctx context.Context, warranty *entity.Warranty) error
+	GetWarranty(ctx context.Context, id uuid.UUID) (*entity.Warranty, error)
+	UpdateWarranty(ctx context.Context, id uuid.UUID, warranty *entity.Warranty) error
+	DeleteWarranty(ctx context.Context, id uuid.UUID) error
+	ListAssetWarranties(ctx context.Context, assetID uuid.UUID) ([]*entity.Warranty, error)
+	CreateContract(ctx context.Context, contract *entity.SupportContract) error
+	GetContract(ctx context.Context, id uuid.UUID) (*entity.SupportContract, error)
+	UpdateContract(ctx context.Context, id uuid.UUID, contract *entity.SupportContract) error
+	DeleteContract(ctx context.Context, id uuid.UUID) error
+	ListContracts(ctx context.Context, limit, offset int, filters map[string]interface{}) ([]*entity.SupportContract, int, error)
+	// GetCoverage returns the warranty status and support contracts of an
+	// asset the caller can read.
+	GetCoverage(ctx context.Context, assetID uuid.UUID) (*entity.AssetCoverage, error)
+	// ClaimWarranty files a claim for a ticket under claim.WarrantyID, or
+	// under the asset's current warranty when that is unset.
+	ClaimWarranty(ctx context.Context, ticketID uuid.UUID, claim *entity.WarrantyClaim) error
+	UpdateClaim(ctx context.Context, id uuid.UUID, status, providerReference, notes string) (*entity.WarrantyClaim, error)
+	ListClaims(ctx context.Context, warrantyID uuid.UUID) ([]*entity.WarrantyClaim, error)
+	// GetExpiring lists the warranties and support contracts ending within
+	// the next days days.
+	GetExpiring(ctx context.Context, days int) (*entity.ExpiringCoverage, error)
+	// AlertExpiring notifies admins and department managers about coverage
+	// that has come within the alert window and returns how many warranties
+	// and contracts were reported.
+	AlertExpiring(ctx context.Context) (int, error)
+}
@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"inventory-ticketing-system/domain/entity"
+)
+
+type WebhookService interface {
+	EventSubscriber
+	CreateEndpoint(ctx context.Context, endpoint *entity.WebhookEndpoint) (string, error)
+	GetEndpoint(ctx context.Context, id uuid.UUID) (*entity.WebhookEndpoint, error)
+	UpdateEndpoint(ctx context.Context, id uuid.UUID, endpoint *entity.WebhookEndpoint) error
+	DeleteEndpoint(ctx context.Context, id uuid.UUID) error
+	ListEndpoints(ctx context.Context) ([]*entity.WebhookEndpoint, error)
+	RotateSecret(ctx context.Context, id uuid.UUID) (string, error)
+	ListDeliveries(ctx context.Context, endpointID uuid.UUID, limit, offset int, status string) ([]*entity.WebhookDelivery, int, error)
+	GetDelivery(ctx context.Context, id uuid.UUID) (*entity.WebhookDelivery, error)
+	ReplayDelivery(ctx context.Context, id uuid.UUID) (*entity.WebhookDelivery, error)
+	DispatchDue(ctx context.Context) (int, error)
+}
@@ -1,16 +1,48 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
+	"inventory-ticketing-system/domain/enum"
+	"inventory-ticketing-system/domain/service"
+	"inventory-ticketing-system/infrastructure/jwt"
 )
 
+const DefaultJWTSecret = "your-default-secret-key"
+
+// approvalStepPattern matches line_manager and role names.
+var approvalStepPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{1,49}$`)
+
+// placeholderJWTSecrets are secrets shipped in this repository's defaults and
+// examples. None of them may be used to sign tokens in production.
+var placeholderJWTSecrets = []string{
+	DefaultJWTSecret,
+	"your-super-secret-jwt-key-change-this-in-production",
+	"your-jwt-secret-key-for-docker-compose",
+	"your-secret-key",
+}
+
 type Config struct {
-	ServerPort     string
-	DatabaseConfig DatabaseConfig
-	JWTSecret      string
+	ServerPort         string
+	AppEnv             string
+	DatabaseConfig     DatabaseConfig
+	JWTSecret          string
+	JWTConfig          JWTConfig
+	TicketConfig       TicketConfig
+	WorkerConfig       WorkerConfig
+	WebhookConfig      WebhookConfig
+	MailConfig         MailConfig
+	NotificationConfig NotificationConfig
+	WarrantyConfig     WarrantyConfig
+	AssetRequestConfig AssetRequestConfig
+	InventoryConfig    InventoryConfig
 }
 
 type DatabaseConfig struct {
@@ -22,6 +54,82 @@ type DatabaseConfig struct {
 	SSLMode  string
 }
 
+type JWTConfig struct {
+	Algorithm           string
+	TokenLifetime       time.Duration
+	KeyRotationInterval time.Duration
+	KeyOverlapWindow    time.Duration
+}
+
+// TicketConfig controls auto-assignment, auto-closing and how tickets change
+// the status of their asset. AssignmentStrategy applies to tickets that no
+// team handles; "none" leaves them unassigned. Resolved tickets are closed
+// after AutoCloseAfter without updates; zero disables this.
+// AssetStatusOnCreate lists "severity:status" pairs, AssetStatusOnAssign is
+// a status; either may be empty to turn the rule off.
+type TicketConfig struct {
+	AssignmentStrategy  string
+	AutoCloseAfter      time.Duration
+	AssetStatusOnCreate string
+	AssetStatusOnAssign string
+	RestoreAssetStatus  bool
+}
+
+// WorkerConfig controls the background loops. PollInterval is how often the
+// outbox relay, the dispatchers and the job scheduler look for work.
+type WorkerConfig struct {
+	PollInterval time.Duration
+}
+
+// WebhookConfig controls outbound webhook delivery. A delivery is marked
+// failed after MaxAttempts unsuccessful attempts.
+type WebhookConfig struct {
+	MaxAttempts int
+	Timeout     time.Duration
+}
+
+// MailConfig selects how notification emails are delivered: "smtp" sends
+// them through SMTPHost, "file" writes them to FileDir.
+type MailConfig struct {
+	Driver       string
+	From         string
+	SMTPHost     string
+	SMTPPort     string
+	SMTPUsername string
+	SMTPPassword string
+	FileDir      string
+}
+
+// NotificationConfig controls ticket notifications. Tickets get an SLA
+// warning once they are due within SLAWarningWindow, checked every
+// SLACheckInterval. Read inbox notifications are deleted after
+// InboxRetention.
+type NotificationConfig struct {
+	DefaultLocale    string
+	SLAWarningWindow time.Duration
+	SLACheckInterval time.Duration
+	InboxRetention   time.Duration
+}
+
+// WarrantyConfig controls the daily alert about warranties and support
+// contracts ending within ExpiryAlertDays.
+type WarrantyConfig struct {
+	ExpiryAlertDays int
+}
+
+// AssetRequestConfig sets the approval chain of asset requests, as a comma
+// separated list of steps, and how long each step may take.
+type AssetRequestConfig struct {
+	ApprovalChain string
+	ApprovalSLA   time.Duration
+}
+
+// InventoryConfig sets how many days of consumption the low-stock report
+// bases days of cover on.
+type InventoryConfig struct {
+	ConsumptionDays int
+}
+
 func LoadConfig() (*Config, error) {
 	// Load .env file
 	if err := godotenv.Load(); err != nil {
@@ -31,7 +139,53 @@ func LoadConfig() (*Config, error) {
 
 	config := &Config{
 		ServerPort: getEnv("SERVER_PORT", "8080"),
-		JWTSecret:  getEnv("JWT_SECRET", "your-default-secret-key"),
+		AppEnv:     getEnv("APP_ENV", "development"),
+		JWTSecret:  getEnv("JWT_SECRET", DefaultJWTSecret),
+		JWTConfig: JWTConfig{
+			Algorithm:           getEnv("JWT_ALGORITHM", jwt.AlgorithmHS256),
+			TokenLifetime:       getDurationEnv("JWT_TOKEN_LIFETIME", 24*time.Hour),
+			KeyRotationInterval: getDurationEnv("JWT_KEY_ROTATION_INTERVAL", 30*24*time.Hour),
+			KeyOverlapWindow:    getDurationEnv("JWT_KEY_OVERLAP_WINDOW", 48*time.Hour),
+		},
+		TicketConfig: TicketConfig{
+			AssignmentStrategy:  getEnv("TICKET_ASSIGNMENT_STRATEGY", string(enum.AssignmentLeastLoaded)),
+			AutoCloseAfter:      getDurationEnv("TICKET_AUTO_CLOSE_AFTER", 7*24*time.Hour),
+			AssetStatusOnCreate: getEnv("TICKET_ASSET_STATUS_ON_CREATE", "high:broken,critical:broken"),
+			AssetStatusOnAssign: getEnv("TICKET_ASSET_STATUS_ON_ASSIGN", string(enum.AssetStatusRepair)),
+			RestoreAssetStatus:  getBoolEnv("TICKET_RESTORE_ASSET_STATUS", true),
+		},
+		WorkerConfig: WorkerConfig{
+			PollInterval: getDurationEnv("WORKER_POLL_INTERVAL", 5*time.Second),
+		},
+		WebhookConfig: WebhookConfig{
+			MaxAttempts: getIntEnv("WEBHOOK_MAX_ATTEMPTS", 8),
+			Timeout:     getDurationEnv("WEBHOOK_TIMEOUT", 10*time.Second),
+		},
+		MailConfig: MailConfig{
+			Driver:       getEnv("MAIL_DRIVER", "file"),
+			From:         getEnv("MAIL_FROM", "Inventory & Ticketing <no-reply@localhost>"),
+			SMTPHost:     getEnv("SMTP_HOST", "localhost"),
+			SMTPPort:     getEnv("SMTP_PORT", "1025"),
+			SMTPUsername: getEnv("SMTP_USERNAME", ""),
+			SMTPPassword: getEnv("SMTP_PASSWORD", ""),
+			FileDir:      getEnv("MAIL_FILE_DIR", "tmp/mail"),
+		},
+		NotificationConfig: NotificationConfig{
+			DefaultLocale:    getEnv("NOTIFICATION_DEFAULT_LOCALE", "en"),
+			SLAWarningWindow: getDurationEnv("NOTIFICATION_SLA_WARNING_WINDOW", time.Hour),
+			SLACheckInterval: getDurationEnv("NOTIFICATION_SLA_CHECK_INTERVAL", time.Minute),
+			InboxRetention:   getDurationEnv("NOTIFICATION_INBOX_RETENTION", 30*24*time.Hour),
+		},
+		WarrantyConfig: WarrantyConfig{
+			ExpiryAlertDays: getIntEnv("WARRANTY_EXPIRY_ALERT_DAYS", 30),
+		},
+		AssetRequestConfig: AssetRequestConfig{
+			ApprovalChain: getEnv("ASSET_REQUEST_APPROVAL_CHAIN", enum.ApproverLineManager+","+string(enum.RoleAdmin)),
+			ApprovalSLA:   getDurationEnv("ASSET_REQUEST_APPROVAL_SLA", 24*time.Hour),
+		},
+		InventoryConfig: InventoryConfig{
+			ConsumptionDays: getIntEnv("INVENTORY_CONSUMPTION_DAYS", 30),
+		},
 		DatabaseConfig: DatabaseConfig{
 			Host:     getEnv("DB_HOST", "localhost"),
 			Port:     getEnv("DB_PORT", "5432"),
@@ -42,9 +196,48 @@ func LoadConfig() (*Config, error) {
 		},
 	}
 
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
 	return config, nil
 }
 
+func (c *Config) IsProduction() bool {
+	return strings.EqualFold(c.AppEnv, "production")
+}
+
+// DefaultAssignmentStrategy returns the strategy for tickets outside any team
+// queue, or an empty strategy when auto-assignment is disabled for them.
+func (c *Config) DefaultAssignmentStrategy() enum.AssignmentStrategy {
+	if c.TicketConfig.AssignmentStrategy == "none" {
+		return ""
+	}
+	return enum.AssignmentStrategy(c.TicketConfig.AssignmentStrategy)
+}
+
+// AssetStatusRules returns the rules for changing an asset's status along
+// its tickets' lifecycle. The settings are checked by LoadConfig.
+func (c *Config) AssetStatusRules() service.AssetStatusRules {
+	onCreated, _ := parseSeverityStatuses(c.TicketConfig.AssetStatusOnCreate)
+	onAssigned := c.TicketConfig.AssetStatusOnAssign
+	if onAssigned == "none" {
+		onAssigned = ""
+	}
+	return service.AssetStatusRules{
+		OnCreated:        onCreated,
+		OnAssigned:       onAssigned,
+		RestoreOnResolve: c.TicketConfig.RestoreAssetStatus,
+	}
+}
+
+// AssetRequestApprovalChain returns the approval steps of asset requests in
+// order: line_manager or a role name. The setting is checked by LoadConfig.
+func (c *Config) AssetRequestApprovalChain() []string {
+	chain, _ := parseApprovalChain(c.AssetRequestConfig.ApprovalChain)
+	return chain
+}
+
 func (c *Config) GetDatabaseDSN() string {
 	return fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
 		c.DatabaseConfig.Host,
@@ -56,9 +249,171 @@ func (c *Config) GetDatabaseDSN() string {
 	)
 }
 
+func (c *Config) validate() error {
+	if c.JWTConfig.TokenLifetime <= 0 {
+		return errors.New("JWT_TOKEN_LIFETIME must be positive")
+	}
+	switch c.JWTConfig.Algorithm {
+	case jwt.AlgorithmHS256:
+		if c.IsProduction() && isPlaceholderSecret(c.JWTSecret) {
+			return errors.New("JWT_SECRET must be set to a non-default value in production")
+		}
+	case jwt.AlgorithmRS256, jwt.AlgorithmEdDSA:
+		if c.JWTConfig.KeyOverlapWindow < c.JWTConfig.TokenLifetime {
+			return fmt.Errorf("JWT_KEY_OVERLAP_WINDOW must be at least the token lifetime (%s), or tokens signed before a rotation stop validating early", c.JWTConfig.TokenLifetime)
+		}
+	default:
+		return fmt.Errorf("unsupported JWT_ALGORITHM %q (expected HS256, RS256 or EdDSA)", c.JWTConfig.Algorithm)
+	}
+
+	if strategy := c.TicketConfig.AssignmentStrategy; strategy != "none" && !enum.AssignmentStrategy(strategy).IsValid() {
+		return fmt.Errorf("unsupported TICKET_ASSIGNMENT_STRATEGY %q (expected round_robin, least_loaded, skill_match or none)", strategy)
+	}
+
+	if c.TicketConfig.AutoCloseAfter < 0 {
+		return errors.New("TICKET_AUTO_CLOSE_AFTER must not be negative")
+	}
+	if _, err := parseSeverityStatuses(c.TicketConfig.AssetStatusOnCreate); err != nil {
+		return fmt.Errorf("invalid TICKET_ASSET_STATUS_ON_CREATE: %w", err)
+	}
+	if status := c.TicketConfig.AssetStatusOnAssign; status != "none" && !enum.AssetStatus(status).IsValid() {
+		return fmt.Errorf("unsupported TICKET_ASSET_STATUS_ON_ASSIGN %q (expected an asset status or none)", status)
+	}
+
+	if c.WorkerConfig.PollInterval <= 0 {
+		return errors.New("WORKER_POLL_INTERVAL must be positive")
+	}
+	if c.WebhookConfig.MaxAttempts < 1 {
+		return errors.New("WEBHOOK_MAX_ATTEMPTS must be at least 1")
+	}
+	if c.WebhookConfig.Timeout <= 0 {
+		return errors.New("WEBHOOK_TIMEOUT must be positive")
+	}
+
+	if c.MailConfig.Driver != "smtp" && c.MailConfig.Driver != "file" {
+		return fmt.Errorf("unsupported MAIL_DRIVER %q (expected smtp or file)", c.MailConfig.Driver)
+	}
+	if c.NotificationConfig.SLACheckInterval <= 0 {
+		return errors.New("NOTIFICATION_SLA_CHECK_INTERVAL must be positive")
+	}
+	if c.NotificationConfig.InboxRetention <= 0 {
+		return errors.New("NOTIFICATION_INBOX_RETENTION must be positive")
+	}
+	if days := c.WarrantyConfig.ExpiryAlertDays; days < 1 || days > 366 {
+		return errors.New("WARRANTY_EXPIRY_ALERT_DAYS must be between 1 and 366")
+	}
+	if _, err := parseApprovalChain(c.AssetRequestConfig.ApprovalChain); err != nil {
+		return fmt.Errorf("invalid ASSET_REQUEST_APPROVAL_CHAIN: %w", err)
+	}
+	if c.AssetRequestConfig.ApprovalSLA <= 0 {
+		return errors.New("ASSET_REQUEST_APPROVAL_SLA must be positive")
+	}
+	if days := c.InventoryConfig.ConsumptionDays; days < 1 || days > 366 {
+		return errors.New("INVENTORY_CONSUMPTION_DAYS must be between 1 and 366")
+	}
+
+	return nil
+}
+
+// parseSeverityStatuses parses "severity:status" pairs separated by commas.
+// "none" or an empty string means no pairs.
+func parseSeverityStatuses(value string) (map[string]string, error) {
+	statuses := make(map[string]string)
+	if value == "" || value == "none" {
+		return statuses, nil
+	}
+
+	for _, pair := range strings.Split(value, ",") {
+		severity, status, ok := strings.Cut(strings.TrimSpace(pair), ":")
+		if !ok {
+			return nil, fmt.Errorf("%q is not severity:status", pair)
+		}
+		if !enum.TicketSeverity(severity).IsValid() {
+			return nil, fmt.Errorf("unknown severity %q", severity)
+		}
+		if !enum.AssetStatus(status).IsValid() {
+			return nil, fmt.Errorf("unknown asset status %q", status)
+		}
+		statuses[severity] = status
+	}
+	return statuses, nil
+}
+
+// parseApprovalChain parses approval steps separated by commas. "none" or an
+// empty string means requests need no approval.
+func parseApprovalChain(value string) ([]string, error) {
+	var chain []string
+	if value == "" || value == "none" {
+		return chain, nil
+	}
+
+	for _, step := range strings.Split(value, ",") {
+		step = strings.TrimSpace(step)
+		if !approvalStepPattern.MatchString(step) {
+			return nil, fmt.Errorf("%q is not line_manager or a role name", step)
+		}
+		chain = append(chain, step)
+	}
+	return chain, nil
+}
+
+func isPlaceholderSecret(secret string) bool {
+	if secret == "" {
+		return true
+	}
+	for _, placeholder := range placeholderJWTSecrets {
+		if secret == placeholder {
+			return true
+		}
+	}
+	return false
+}
+
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
 	}
 	return defaultValue
-}
\ No newline at end of file
+}
+
+func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		fmt.Printf("Warning: invalid duration for %s, using default %s\n", key, defaultValue)
+		return defaultValue
+	}
+	return duration
+}
+
+func getIntEnv(key string, defaultValue int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		fmt.Printf("Warning: invalid integer for %s, using default %d\n", key, defaultValue)
+		return defaultValue
+	}
+	return number
+}
+
+func getBoolEnv(key string, defaultValue bool) bool {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	enabled, err := strconv.ParseBool(value)
+	if err != nil {
+		fmt.Printf("Warning: invalid boolean for %s, using default %t\n", key, defaultValue)
+		return defaultValue
+	}
+	return enabled
+}
@@ -2,6 +2,8 @@ package jwt
 
 import (
 	"errors"
+	"fmt"
+	"sync"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -16,12 +18,107 @@ type Claims struct {
 
 type JWTManager struct {
 	secretKey string
+	algorithm string
+
+	mu   sync.RWMutex
+	keys map[string]*SigningKey
+
+	reloadMu    sync.Mutex
+	reload      func() error
+	reloadEvery time.Duration
+	lastReload  time.Time
 }
 
+// NewJWTManager returns a manager that signs and verifies HS256 tokens with a
+// single shared secret.
 func NewJWTManager(secretKey string) *JWTManager {
 	return &JWTManager{
 		secretKey: secretKey,
+		algorithm: AlgorithmHS256,
+	}
+}
+
+// NewAsymmetricJWTManager returns a manager for RS256 or EdDSA tokens. It has
+// no keys until SetKeys is called.
+func NewAsymmetricJWTManager(algorithm string) (*JWTManager, error) {
+	if !IsAsymmetricAlgorithm(algorithm) {
+		return nil, fmt.Errorf("unsupported signing algorithm: %s", algorithm)
 	}
+
+	return &JWTManager{
+		algorithm: algorithm,
+		keys:      make(map[string]*SigningKey),
+	}, nil
+}
+
+func (j *JWTManager) Algorithm() string {
+	return j.algorithm
+}
+
+// SetKeys replaces the key set. Keys whose RetiresAt has passed are dropped.
+// Keys activating in the future are published and verify tokens, but sign
+// only once their ActivatedAt has passed; until then the most recently
+// activated key keeps signing.
+func (j *JWTManager) SetKeys(keys []*SigningKey) error {
+	if !IsAsymmetricAlgorithm(j.algorithm) {
+		return errors.New("key set is only supported for asymmetric algorithms")
+	}
+
+	now := time.Now()
+	keySet := make(map[string]*SigningKey, len(keys))
+
+	for _, key := range keys {
+		if key.Algorithm != j.algorithm {
+			continue
+		}
+		if key.RetiresAt != nil && !key.RetiresAt.After(now) {
+			continue
+		}
+
+		keySet[key.ID] = key
+	}
+
+	if signingKey(keySet, now) == nil {
+		return errors.New("no active signing key")
+	}
+
+	j.mu.Lock()
+	j.keys = keySet
+	j.mu.Unlock()
+
+	return nil
+}
+
+// SetReloader registers fn to refresh the key set when a token names a key
+// this manager does not know, which happens when another instance has just
+// rotated. fn runs at most once per every.
+func (j *JWTManager) SetReloader(fn func() error, every time.Duration) {
+	j.reloadMu.Lock()
+	defer j.reloadMu.Unlock()
+	j.reload = fn
+	j.reloadEvery = every
+}
+
+// ActiveKey returns the key currently used for signing, or nil for HS256.
+func (j *JWTManager) ActiveKey() *SigningKey {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	return signingKey(j.keys, time.Now())
+}
+
+// signingKey returns the most recently activated key that has not retired
+// by now, or nil.
+func signingKey(keys map[string]*SigningKey, now time.Time) *SigningKey {
+	var active *SigningKey
+	for _, key := range keys {
+		if key.ActivatedAt.After(now) || key.RetiresAt != nil && !key.RetiresAt.After(now) {
+			continue
+		}
+		if active == nil || key.ActivatedAt.After(active.ActivatedAt) {
+			active = key
+		}
+	}
+	return active
 }
 
 func (j *JWTManager) GenerateToken(userID uuid.UUID, role string, duration time.Duration) (string, error) {
@@ -29,24 +126,30 @@ func (j *JWTManager) GenerateToken(userID uuid.UUID, role string, duration time.
 		UserID: userID,
 		Role:   role,
 		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   userID.String(),
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(duration)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			NotBefore: jwt.NewNumericDate(time.Now()),
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(j.secretKey))
+	if j.algorithm == AlgorithmHS256 {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+		return token.SignedString([]byte(j.secretKey))
+	}
+
+	key := j.ActiveKey()
+	if key == nil {
+		return "", errors.New("no active signing key")
+	}
+
+	token := jwt.NewWithClaims(jwt.GetSigningMethod(key.Algorithm), claims)
+	token.Header["kid"] = key.ID
+	return token.SignedString(key.PrivateKey)
 }
 
 func (j *JWTManager) ValidateToken(tokenString string) (uuid.UUID, string, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, errors.New("unexpected signing method")
-		}
-		return []byte(j.secretKey), nil
-	})
-
+	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, j.keyFunc, jwt.WithValidMethods([]string{j.algorithm}))
 	if err != nil {
 		return uuid.Nil, "", err
 	}
@@ -57,4 +160,66 @@ func (j *JWTManager) ValidateToken(tokenString string) (uuid.UUID, string, error
 	}
 
 	return claims.UserID, claims.Role, nil
-}
\ No newline at end of file
+}
+
+// JWKS returns the public keys that verifiers should accept. HS256 secrets are
+// never published, so the set is empty in that mode.
+func (j *JWTManager) JWKS() JWKSet {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+
+	set := JWKSet{Keys: make([]JWK, 0, len(j.keys))}
+	for _, key := range j.keys {
+		set.Keys = append(set.Keys, key.PublicJWK())
+	}
+	return set
+}
+
+func (j *JWTManager) keyFunc(token *jwt.Token) (interface{}, error) {
+	if j.algorithm == AlgorithmHS256 {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("unexpected signing method")
+		}
+		return []byte(j.secretKey), nil
+	}
+
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		return nil, errors.New("token has no key ID")
+	}
+
+	key, ok := j.key(kid)
+	if !ok && j.reloadKeys() {
+		key, ok = j.key(kid)
+	}
+	if !ok {
+		return nil, errors.New("unknown key ID")
+	}
+	if key.RetiresAt != nil && !key.RetiresAt.After(time.Now()) {
+		return nil, errors.New("signing key has been retired")
+	}
+
+	return key.PrivateKey.Public(), nil
+}
+
+func (j *JWTManager) key(kid string) (*SigningKey, bool) {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	key, ok := j.keys[kid]
+	return key, ok
+}
+
+// reloadKeys runs the reloader unless it ran within its interval, so tokens
+// with made-up key IDs cannot hammer the key store. It reports whether the
+// key set was reloaded.
+func (j *JWTManager) reloadKeys() bool {
+	j.reloadMu.Lock()
+	defer j.reloadMu.Unlock()
+
+	if j.reload == nil || time.Since(j.lastReload) < j.reloadEvery {
+		return false
+	}
+	j.lastReload = time.Now()
+
+	return j.reload() == nil
+}
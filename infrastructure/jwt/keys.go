@@ -0,0 +1,173 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+const (
+	AlgorithmHS256 = "HS256"
+	AlgorithmRS256 = "RS256"
+	AlgorithmEdDSA = "EdDSA"
+
+	rsaKeyBits = 2048
+)
+
+// SigningKey is an asymmetric key pair identified by its key ID (kid).
+// A key without RetiresAt is eligible to sign new tokens; retired keys are
+// still used for verification until RetiresAt has passed.
+type SigningKey struct {
+	ID          string
+	Algorithm   string
+	PrivateKey  crypto.Signer
+	ActivatedAt time.Time
+	RetiresAt   *time.Time
+}
+
+func IsAsymmetricAlgorithm(algorithm string) bool {
+	return algorithm == AlgorithmRS256 || algorithm == AlgorithmEdDSA
+}
+
+// GenerateSigningKey creates a fresh key pair for the given algorithm. The
+// key ID is the RFC 7638 thumbprint of the public key.
+func GenerateSigningKey(algorithm string) (*SigningKey, error) {
+	var signer crypto.Signer
+
+	switch algorithm {
+	case AlgorithmRS256:
+		key, err := rsa.GenerateKey(rand.Reader, rsaKeyBits)
+		if err != nil {
+			return nil, err
+		}
+		signer = key
+	case AlgorithmEdDSA:
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		signer = key
+	default:
+		return nil, fmt.Errorf("unsupported signing algorithm: %s", algorithm)
+	}
+
+	kid, err := thumbprint(signer.Public())
+	if err != nil {
+		return nil, err
+	}
+
+	return &SigningKey{
+		ID:          kid,
+		Algorithm:   algorithm,
+		PrivateKey:  signer,
+		ActivatedAt: time.Now(),
+	}, nil
+}
+
+// MarshalPrivateKeyPEM encodes the private key as a PKCS#8 PEM block.
+func (k *SigningKey) MarshalPrivateKeyPEM() (string, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(k.PrivateKey)
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})), nil
+}
+
+// ParsePrivateKeyPEM decodes a PKCS#8 PEM block produced by MarshalPrivateKeyPEM
+// and checks that it matches the expected algorithm.
+func ParsePrivateKeyPEM(algorithm, data string) (crypto.Signer, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil {
+		return nil, errors.New("invalid private key PEM")
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		if algorithm != AlgorithmRS256 {
+			return nil, fmt.Errorf("RSA key cannot be used with %s", algorithm)
+		}
+		return k, nil
+	case ed25519.PrivateKey:
+		if algorithm != AlgorithmEdDSA {
+			return nil, fmt.Errorf("Ed25519 key cannot be used with %s", algorithm)
+		}
+		return k, nil
+	default:
+		return nil, errors.New("unsupported private key type")
+	}
+}
+
+// JWK is the public part of a signing key as published in the JWKS document.
+type JWK struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	N         string `json:"n,omitempty"`
+	E         string `json:"e,omitempty"`
+	Curve     string `json:"crv,omitempty"`
+	X         string `json:"x,omitempty"`
+}
+
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+func (k *SigningKey) PublicJWK() JWK {
+	jwk := JWK{
+		KeyID:     k.ID,
+		Use:       "sig",
+		Algorithm: k.Algorithm,
+	}
+
+	switch pub := k.PrivateKey.Public().(type) {
+	case *rsa.PublicKey:
+		jwk.KeyType = "RSA"
+		jwk.N = encodeSegment(pub.N.Bytes())
+		jwk.E = encodeSegment(big.NewInt(int64(pub.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.KeyType = "OKP"
+		jwk.Curve = "Ed25519"
+		jwk.X = encodeSegment(pub)
+	}
+
+	return jwk
+}
+
+func thumbprint(pub crypto.PublicKey) (string, error) {
+	var canonical string
+
+	// Members must be in lexicographic order, see RFC 7638 section 3.2.
+	switch p := pub.(type) {
+	case *rsa.PublicKey:
+		canonical = fmt.Sprintf(`{"e":"%s","kty":"RSA","n":"%s"}`,
+			encodeSegment(big.NewInt(int64(p.E)).Bytes()),
+			encodeSegment(p.N.Bytes()),
+		)
+	case ed25519.PublicKey:
+		canonical = fmt.Sprintf(`{"crv":"Ed25519","kty":"OKP","x":"%s"}`, encodeSegment(p))
+	default:
+		return "", errors.New("unsupported public key type")
+	}
+
+	sum := sha256.Sum256([]byte(canonical))
+	return encodeSegment(sum[:]), nil
+}
+
+func encodeSegment(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
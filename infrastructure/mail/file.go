@@ -0,0 +1,41 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/domain/service"
+)
+
+// FileMailer writes every email to dir as <time>-<id>.eml instead of sending
+// it. The files open in any mail client.
+type FileMailer struct {
+	dir  string
+	from string
+}
+
+func NewFileMailer(dir, from string) service.Mailer {
+	return &FileMailer{
+		dir:  dir,
+		from: from,
+	}
+}
+
+func (m *FileMailer) Send(ctx context.Context, message *entity.EmailMessage) error {
+	now := time.Now()
+	body, err := buildMessage(m.from, message, now)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(m.dir, 0o755); err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%s-%s.eml", now.UTC().Format("20060102T150405"), message.ID)
+	return os.WriteFile(filepath.Join(m.dir, name), body, 0o644)
+}
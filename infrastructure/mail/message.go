@@ -0,0 +1,76 @@
+// Package mail sends notification emails. SMTPMailer talks to any SMTP
+// server, including a local Mailpit instance; FileMailer writes each email to
+// a directory as an .eml file for development.
+package mail
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"time"
+
+	"inventory-ticketing-system/domain/entity"
+)
+
+// buildMessage renders message as a multipart/alternative MIME email with a
+// plain text and an HTML part.
+func buildMessage(from string, message *entity.EmailMessage, now time.Time) ([]byte, error) {
+	boundary, err := randomBoundary()
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	headers := [][2]string{
+		{"From", from},
+		{"To", message.Recipient},
+		{"Subject", mime.QEncoding.Encode("utf-8", message.Subject)},
+		{"Date", now.Format(time.RFC1123Z)},
+		{"Message-ID", fmt.Sprintf("<%s@inventory-ticketing>", message.ID)},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", fmt.Sprintf("multipart/alternative; boundary=%q", boundary)},
+	}
+	for _, header := range headers {
+		fmt.Fprintf(&buf, "%s: %s\r\n", header[0], header[1])
+	}
+	buf.WriteString("\r\n")
+
+	parts := []struct {
+		contentType string
+		body        string
+	}{
+		{"text/plain", message.TextBody},
+		{"text/html", message.HTMLBody},
+	}
+	for _, part := range parts {
+		if part.body == "" {
+			continue
+		}
+		fmt.Fprintf(&buf, "--%s\r\n", boundary)
+		fmt.Fprintf(&buf, "Content-Type: %s; charset=utf-8\r\n", part.contentType)
+		buf.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+
+		writer := quotedprintable.NewWriter(&buf)
+		if _, err := writer.Write([]byte(part.body)); err != nil {
+			return nil, err
+		}
+		if err := writer.Close(); err != nil {
+			return nil, err
+		}
+		buf.WriteString("\r\n")
+	}
+	fmt.Fprintf(&buf, "--%s--\r\n", boundary)
+
+	return buf.Bytes(), nil
+}
+
+func randomBoundary() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,149 @@
+// Package realtime distributes events between application instances using
+// Postgres LISTEN/NOTIFY.
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"gorm.io/gorm"
+	"inventory-ticketing-system/domain/entity"
+	"inventory-ticketing-system/pkg/database"
+)
+
+const (
+	// channelName is the NOTIFY channel shared by all instances.
+	channelName = "inventory_events"
+	// maxPayloadSize keeps notifications under Postgres' 8000 byte limit.
+	maxPayloadSize = 7900
+	// subscriberBuffer is how many events a subscriber may fall behind
+	// before it starts missing events.
+	subscriberBuffer = 64
+	// reconnectDelay is the wait before listening again after the
+	// connection dropped.
+	reconnectDelay = 5 * time.Second
+)
+
+// message is the NOTIFY payload: the event together with its routing scope,
+// which is not part of the event's JSON.
+type message struct {
+	*entity.RealtimeEvent
+	Scope entity.RealtimeScope `json:"scope"`
+}
+
+// PostgresBroker publishes events with pg_notify through the application's
+// database handle and receives them on a dedicated LISTEN connection.
+type PostgresBroker struct {
+	db  *gorm.DB
+	dsn string
+
+	mu          sync.RWMutex
+	subscribers map[chan *entity.RealtimeEvent]struct{}
+}
+
+func NewPostgresBroker(db *gorm.DB, dsn string) *PostgresBroker {
+	return &PostgresBroker{
+		db:          db,
+		dsn:         dsn,
+		subscribers: make(map[chan *entity.RealtimeEvent]struct{}),
+	}
+}
+
+func (b *PostgresBroker) Publish(ctx context.Context, event *entity.RealtimeEvent) error {
+	payload, err := json.Marshal(message{RealtimeEvent: event, Scope: event.Scope})
+	if err != nil {
+		return err
+	}
+
+	if len(payload) > maxPayloadSize {
+		trimmed := *event
+		trimmed.Data = nil
+		if payload, err = json.Marshal(message{RealtimeEvent: &trimmed, Scope: event.Scope}); err != nil {
+			return err
+		}
+	}
+
+	return database.Conn(ctx, b.db).Exec("SELECT pg_notify(?, ?)", channelName, string(payload)).Error
+}
+
+func (b *PostgresBroker) Subscribe() (<-chan *entity.RealtimeEvent, func()) {
+	events := make(chan *entity.RealtimeEvent, subscriberBuffer)
+
+	b.mu.Lock()
+	b.subscribers[events] = struct{}{}
+	b.mu.Unlock()
+
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(b.subscribers, events)
+			b.mu.Unlock()
+			close(events)
+		})
+	}
+	return events, cancel
+}
+
+// Start listens for notifications until ctx is done, reconnecting when the
+// connection drops.
+func (b *PostgresBroker) Start(ctx context.Context) {
+	go func() {
+		for {
+			if err := b.listen(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("Realtime listener stopped, reconnecting: %v", err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(reconnectDelay):
+			}
+		}
+	}()
+}
+
+func (b *PostgresBroker) listen(ctx context.Context) error {
+	conn, err := pgx.Connect(ctx, b.dsn)
+	if err != nil {
+		return err
+	}
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+channelName); err != nil {
+		return err
+	}
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		var msg message
+		if err := json.Unmarshal([]byte(notification.Payload), &msg); err != nil || msg.RealtimeEvent == nil {
+			log.Printf("Ignoring malformed realtime notification: %v", err)
+			continue
+		}
+		msg.RealtimeEvent.Scope = msg.Scope
+
+		b.broadcast(msg.RealtimeEvent)
+	}
+}
+
+func (b *PostgresBroker) broadcast(event *entity.RealtimeEvent) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for subscriber := range b.subscribers {
+		select {
+		case subscriber <- event:
+		default:
+			// The subscriber is too slow; it misses this event
+		}
+	}
+}
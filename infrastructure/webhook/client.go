@@ -0,0 +1,109 @@
+// Package webhook sends signed webhook requests.
+//
+// Each request carries the header
+//
+//	X-Webhook-Signature: t=<unix seconds>,v1=<hex HMAC-SHA256>
+//
+// where the HMAC is computed over "<unix seconds>.<raw body>" with the
+// endpoint secret. Receivers should recompute it, compare in constant time
+// and reject old timestamps to prevent replays.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	SignatureHeader = "X-Webhook-Signature"
+	EventHeader     = "X-Webhook-Event"
+	DeliveryHeader  = "X-Webhook-Delivery"
+
+	// maxResponseBody is how much of a response body is kept in the attempt log.
+	maxResponseBody = 2048
+)
+
+// Sign returns the signature header value for body sent at timestamp.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	unix := strconv.FormatInt(timestamp.Unix(), 10)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(unix))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return fmt.Sprintf("t=%s,v1=%s", unix, hex.EncodeToString(mac.Sum(nil)))
+}
+
+type Request struct {
+	URL        string
+	Secret     string
+	DeliveryID string
+	EventType  string
+	Body       []byte
+}
+
+type Response struct {
+	StatusCode int
+	Body       string
+	Duration   time.Duration
+}
+
+// Succeeded reports whether the receiver accepted the delivery.
+func (r *Response) Succeeded() bool {
+	return r.StatusCode >= 200 && r.StatusCode < 300
+}
+
+type Client struct {
+	httpClient *http.Client
+}
+
+func NewClient(timeout time.Duration) *Client {
+	return &Client{
+		httpClient: &http.Client{
+			Timeout: timeout,
+			// A redirect could forward the signed payload somewhere else
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+// Send posts the request. A non-2xx answer is returned as a response, not an
+// error; errors mean no response was received.
+func (c *Client) Send(ctx context.Context, req Request) (*Response, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(req.Body))
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("User-Agent", "inventory-ticketing-webhooks/1.0")
+	httpReq.Header.Set(EventHeader, req.EventType)
+	httpReq.Header.Set(DeliveryHeader, req.DeliveryID)
+	httpReq.Header.Set(SignatureHeader, Sign(req.Secret, time.Now(), req.Body))
+
+	start := time.Now()
+	httpResp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(httpResp.Body, maxResponseBody))
+
+	return &Response{
+		StatusCode: httpResp.StatusCode,
+		Body:       string(body),
+		Duration:   time.Since(start),
+	}, nil
+}
@@ -0,0 +1,11 @@
+-- Create signing_keys table for asymmetric JWT signing (RS256 / EdDSA)
+CREATE TABLE IF NOT EXISTS signing_keys (
+    id TEXT PRIMARY KEY,
+    algorithm VARCHAR(20) NOT NULL,
+    private_key_pem TEXT NOT NULL,
+    activated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    retires_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_signing_keys_algorithm ON signing_keys(algorithm);
@@ -0,0 +1,44 @@
+-- Roles are stored rather than hardcoded; users.role references roles.name
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_role_check;
+ALTER TABLE users DROP CONSTRAINT IF EXISTS chk_users_role;
+
+CREATE TABLE IF NOT EXISTS roles (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(50) NOT NULL UNIQUE,
+    description TEXT,
+    permissions JSONB NOT NULL DEFAULT '[]',
+    is_system BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TRIGGER update_roles_updated_at BEFORE UPDATE ON roles
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- Additional roles granted to a user, optionally limited to a location subtree and/or asset category
+CREATE TABLE IF NOT EXISTS role_assignments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role_id UUID NOT NULL REFERENCES roles(id) ON DELETE CASCADE,
+    location_id UUID REFERENCES locations(id) ON DELETE CASCADE,
+    category VARCHAR(255),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_role_assignments_user_id ON role_assignments(user_id);
+
+-- Locations form a hierarchy so location scopes cover child locations
+ALTER TABLE locations ADD COLUMN IF NOT EXISTS parent_id UUID REFERENCES locations(id) ON DELETE RESTRICT;
+CREATE INDEX IF NOT EXISTS idx_locations_parent_id ON locations(parent_id);
+
+-- System roles (the application also creates these on startup)
+INSERT INTO roles (name, description, permissions, is_system) VALUES
+('admin', 'Full access to every resource',
+ '["assets:read","assets:write","assets:delete","tickets:read","tickets:write","tickets:work","tickets:delete","locations:read","locations:write","tokens:write","users:manage","roles:manage"]', TRUE),
+('employee', 'Browse assets and report tickets',
+ '["assets:read","tickets:read","tickets:write","locations:read","tokens:write"]', TRUE),
+('technician', 'Work tickets without managing assets',
+ '["assets:read","tickets:read","tickets:write","tickets:work","locations:read","tokens:write"]', TRUE),
+('location_manager', 'Edit assets; assign with a location scope to limit it to a building',
+ '["assets:read","assets:write","tickets:read","tickets:write","locations:read"]', TRUE)
+ON CONFLICT (name) DO NOTHING;
@@ -0,0 +1,35 @@
+-- Teams are ticket queues; tickets whose category is listed are routed to the team
+CREATE TABLE IF NOT EXISTS teams (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(255) NOT NULL UNIQUE,
+    description TEXT,
+    categories JSONB NOT NULL DEFAULT '[]',
+    assignment_strategy VARCHAR(20) NOT NULL DEFAULT 'least_loaded' CHECK (assignment_strategy IN ('round_robin', 'least_loaded', 'skill_match')),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TRIGGER update_teams_updated_at BEFORE UPDATE ON teams
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- Technician profiles: skills are ticket categories, asset_types are asset types they handle
+CREATE TABLE IF NOT EXISTS technicians (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    team_id UUID REFERENCES teams(id) ON DELETE SET NULL,
+    skills JSONB NOT NULL DEFAULT '[]',
+    asset_types JSONB NOT NULL DEFAULT '[]',
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    max_open_tickets INTEGER DEFAULT 0,
+    last_assigned_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_technicians_team_id ON technicians(team_id);
+
+CREATE TRIGGER update_technicians_updated_at BEFORE UPDATE ON technicians
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+ALTER TABLE tickets ADD COLUMN IF NOT EXISTS team_id UUID REFERENCES teams(id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS idx_tickets_team_id ON tickets(team_id);
+CREATE INDEX IF NOT EXISTS idx_tickets_assigned_to ON tickets(assigned_to);
@@ -0,0 +1,28 @@
+-- Departments own assets and employ users; cost_center_code is used for chargeback
+CREATE TABLE IF NOT EXISTS departments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    parent_id UUID REFERENCES departments(id) ON DELETE RESTRICT,
+    name VARCHAR(255) NOT NULL UNIQUE,
+    cost_center_code VARCHAR(50) NOT NULL UNIQUE,
+    manager_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    description TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_departments_parent_id ON departments(parent_id);
+CREATE INDEX IF NOT EXISTS idx_departments_manager_id ON departments(manager_id);
+
+CREATE TRIGGER update_departments_updated_at BEFORE UPDATE ON departments
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS department_id UUID REFERENCES departments(id) ON DELETE RESTRICT;
+CREATE INDEX IF NOT EXISTS idx_users_department_id ON users(department_id);
+
+ALTER TABLE assets ADD COLUMN IF NOT EXISTS department_id UUID REFERENCES departments(id) ON DELETE RESTRICT;
+CREATE INDEX IF NOT EXISTS idx_assets_department_id ON assets(department_id);
+
+-- New permissions for the admin role (the application also syncs admin on startup)
+UPDATE roles
+SET permissions = permissions || '["departments:manage", "reports:read"]'::jsonb
+WHERE name = 'admin' AND NOT permissions @> '["reports:read"]'::jsonb;
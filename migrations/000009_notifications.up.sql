@@ -0,0 +1,55 @@
+-- Editable email templates, one per notification kind and locale
+CREATE TABLE IF NOT EXISTS notification_templates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    kind VARCHAR(50) NOT NULL,
+    locale VARCHAR(10) NOT NULL,
+    subject TEXT NOT NULL,
+    text_body TEXT NOT NULL,
+    html_body TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_notification_templates_kind_locale ON notification_templates(kind, locale);
+
+CREATE TRIGGER update_notification_templates_updated_at BEFORE UPDATE ON notification_templates
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    locale VARCHAR(10) NOT NULL DEFAULT 'en',
+    email_enabled BOOLEAN NOT NULL DEFAULT true,
+    muted_kinds JSONB NOT NULL DEFAULT '[]',
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Rendered emails waiting to be sent, kept for 30 days after sending
+CREATE TABLE IF NOT EXISTS email_messages (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    recipient VARCHAR(255) NOT NULL,
+    kind VARCHAR(50) NOT NULL,
+    subject TEXT NOT NULL,
+    text_body TEXT NOT NULL,
+    html_body TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'sent', 'failed')),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP WITH TIME ZONE,
+    last_error TEXT,
+    sent_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_email_messages_user_id ON email_messages(user_id);
+CREATE INDEX IF NOT EXISTS idx_email_messages_next_attempt_at ON email_messages(next_attempt_at) WHERE status = 'pending';
+
+CREATE TRIGGER update_email_messages_updated_at BEFORE UPDATE ON email_messages
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+ALTER TABLE tickets ADD COLUMN IF NOT EXISTS sla_warning_sent_at TIMESTAMP WITH TIME ZONE;
+
+-- New permission for the admin role (the application also syncs admin on startup)
+UPDATE roles
+SET permissions = permissions || '["notifications:manage"]'::jsonb
+WHERE name = 'admin' AND NOT permissions @> '["notifications:manage"]'::jsonb;
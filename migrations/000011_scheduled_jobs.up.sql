@@ -0,0 +1,36 @@
+-- Recurring jobs; the instance holding the lease runs the job
+CREATE TABLE IF NOT EXISTS scheduled_jobs (
+    name VARCHAR(100) PRIMARY KEY,
+    description TEXT,
+    schedule VARCHAR(100) NOT NULL,
+    next_run_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    lease_owner VARCHAR(255),
+    lease_until TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TRIGGER update_scheduled_jobs_updated_at BEFORE UPDATE ON scheduled_jobs
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- Run history, kept for 30 days
+CREATE TABLE IF NOT EXISTS job_runs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    job_name VARCHAR(100) NOT NULL,
+    trigger VARCHAR(20) NOT NULL CHECK (trigger IN ('schedule', 'manual')),
+    triggered_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    instance VARCHAR(255) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'running' CHECK (status IN ('running', 'succeeded', 'failed')),
+    result TEXT,
+    error TEXT,
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    finished_at TIMESTAMP WITH TIME ZONE,
+    duration_ms BIGINT
+);
+
+CREATE INDEX IF NOT EXISTS idx_job_runs_job_name_started_at ON job_runs(job_name, started_at DESC);
+
+-- New permission for the admin role (the application also syncs admin on startup)
+UPDATE roles
+SET permissions = permissions || '["jobs:manage"]'::jsonb
+WHERE name = 'admin' AND NOT permissions @> '["jobs:manage"]'::jsonb;
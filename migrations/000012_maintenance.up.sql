@@ -0,0 +1,73 @@
+-- Usage counter for usage-based maintenance
+ALTER TABLE assets ADD COLUMN IF NOT EXISTS usage_count INTEGER NOT NULL DEFAULT 0;
+
+-- Preventive maintenance plans for one asset or an asset category
+CREATE TABLE IF NOT EXISTS maintenance_plans (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    asset_id UUID REFERENCES assets(id) ON DELETE CASCADE,
+    category VARCHAR(100),
+    interval_days INTEGER NOT NULL DEFAULT 0,
+    usage_interval INTEGER NOT NULL DEFAULT 0,
+    lead_days INTEGER NOT NULL DEFAULT 0,
+    lead_usage INTEGER NOT NULL DEFAULT 0,
+    starts_at TIMESTAMP WITH TIME ZONE,
+    checklist JSONB NOT NULL DEFAULT '[]',
+    default_technician_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    severity VARCHAR(20) NOT NULL DEFAULT 'low' CHECK (severity IN ('low', 'medium', 'high', 'critical')),
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_by UUID NOT NULL REFERENCES users(id),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CHECK ((asset_id IS NULL) <> (category IS NULL OR category = '')),
+    CHECK ((interval_days > 0) <> (usage_interval > 0))
+);
+
+CREATE INDEX IF NOT EXISTS idx_maintenance_plans_asset_id ON maintenance_plans(asset_id);
+CREATE INDEX IF NOT EXISTS idx_maintenance_plans_category ON maintenance_plans(category);
+
+CREATE TRIGGER update_maintenance_plans_updated_at BEFORE UPDATE ON maintenance_plans
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- When each plan is next due for each asset it covers
+CREATE TABLE IF NOT EXISTS maintenance_schedules (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    plan_id UUID NOT NULL REFERENCES maintenance_plans(id) ON DELETE CASCADE,
+    asset_id UUID NOT NULL REFERENCES assets(id) ON DELETE CASCADE,
+    next_due_at TIMESTAMP WITH TIME ZONE,
+    next_due_usage INTEGER,
+    open_ticket_id UUID REFERENCES tickets(id) ON DELETE SET NULL,
+    last_performed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_maintenance_schedules_plan_asset ON maintenance_schedules(plan_id, asset_id);
+CREATE INDEX IF NOT EXISTS idx_maintenance_schedules_open_ticket_id ON maintenance_schedules(open_ticket_id);
+
+CREATE TRIGGER update_maintenance_schedules_updated_at BEFORE UPDATE ON maintenance_schedules
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- Completed maintenance; kept when the plan is deleted
+CREATE TABLE IF NOT EXISTS maintenance_records (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    plan_id UUID NOT NULL,
+    plan_name VARCHAR(255) NOT NULL,
+    asset_id UUID NOT NULL REFERENCES assets(id) ON DELETE CASCADE,
+    ticket_id UUID NOT NULL,
+    performed_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    performed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    usage_count INTEGER NOT NULL DEFAULT 0,
+    checklist JSONB NOT NULL DEFAULT '[]',
+    notes TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_maintenance_records_plan_id ON maintenance_records(plan_id, performed_at DESC);
+CREATE INDEX IF NOT EXISTS idx_maintenance_records_asset_id ON maintenance_records(asset_id);
+
+-- New permission for the admin role (the application also syncs admin on startup)
+UPDATE roles
+SET permissions = permissions || '["maintenance:manage"]'::jsonb
+WHERE name = 'admin' AND NOT permissions @> '["maintenance:manage"]'::jsonb;
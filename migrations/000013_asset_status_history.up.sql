@@ -0,0 +1,13 @@
+-- Asset status history; ticket_id is set for changes made by ticket status rules
+CREATE TABLE IF NOT EXISTS asset_status_changes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    asset_id UUID NOT NULL REFERENCES assets(id) ON DELETE CASCADE,
+    from_status VARCHAR(20) NOT NULL,
+    to_status VARCHAR(20) NOT NULL,
+    ticket_id UUID REFERENCES tickets(id) ON DELETE SET NULL,
+    changed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    reason TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_asset_status_changes_asset_created ON asset_status_changes(asset_id, created_at);
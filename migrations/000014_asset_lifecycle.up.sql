@@ -0,0 +1,21 @@
+-- Terminal asset statuses
+ALTER TABLE assets DROP CONSTRAINT IF EXISTS assets_status_check;
+ALTER TABLE assets DROP CONSTRAINT IF EXISTS chk_assets_status;
+ALTER TABLE assets ADD CONSTRAINT chk_assets_status
+    CHECK (status IN ('available', 'booked', 'broken', 'repair', 'retired', 'disposed', 'lost'));
+
+-- Start every asset's history with its initial status, so time in each
+-- status can be reported from creation
+INSERT INTO asset_status_changes (asset_id, from_status, to_status, created_at)
+SELECT a.id, '',
+    COALESCE((
+        SELECT c.from_status FROM asset_status_changes c
+        WHERE c.asset_id = a.id
+        ORDER BY c.created_at, c.id
+        LIMIT 1
+    ), a.status),
+    a.created_at
+FROM assets a
+WHERE NOT EXISTS (
+    SELECT 1 FROM asset_status_changes c WHERE c.asset_id = a.id AND c.from_status = ''
+);
@@ -0,0 +1,38 @@
+-- Assets that store data must be wiped before disposal; IT assets by default
+ALTER TABLE assets ADD COLUMN IF NOT EXISTS data_bearing BOOLEAN NOT NULL DEFAULT FALSE;
+UPDATE assets SET data_bearing = TRUE WHERE type = 'it';
+
+-- Proposals to dispose of an asset and their approval
+CREATE TABLE IF NOT EXISTS disposal_requests (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    asset_id UUID NOT NULL REFERENCES assets(id) ON DELETE CASCADE,
+    requested_by UUID NOT NULL REFERENCES users(id),
+    reason TEXT NOT NULL,
+    method VARCHAR(20) NOT NULL CHECK (method IN ('sell', 'donate', 'recycle', 'destroy')),
+    estimated_value NUMERIC(12,2) NOT NULL DEFAULT 0,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected', 'cancelled')),
+    data_wipe_confirmed_at TIMESTAMP WITH TIME ZONE,
+    data_wipe_confirmed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    data_wipe_method VARCHAR(255),
+    data_wipe_notes TEXT,
+    decided_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    decided_at TIMESTAMP WITH TIME ZONE,
+    decision_comment TEXT,
+    certificate_number VARCHAR(50),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_disposal_requests_asset_id ON disposal_requests(asset_id);
+CREATE INDEX IF NOT EXISTS idx_disposal_requests_requested_by ON disposal_requests(requested_by);
+CREATE INDEX IF NOT EXISTS idx_disposal_requests_status ON disposal_requests(status);
+-- At most one pending request per asset
+CREATE UNIQUE INDEX IF NOT EXISTS idx_disposal_requests_pending_asset ON disposal_requests(asset_id) WHERE status = 'pending';
+
+CREATE TRIGGER update_disposal_requests_updated_at BEFORE UPDATE ON disposal_requests
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- New permission for the admin role (the application also syncs admin on startup)
+UPDATE roles
+SET permissions = permissions || '["disposals:approve"]'::jsonb
+WHERE name = 'admin' AND NOT permissions @> '["disposals:approve"]'::jsonb;
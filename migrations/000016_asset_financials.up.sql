@@ -0,0 +1,28 @@
+-- Procurement and financial details of assets
+ALTER TABLE assets ADD COLUMN IF NOT EXISTS purchase_cost NUMERIC(12,2) NOT NULL DEFAULT 0;
+ALTER TABLE assets ADD COLUMN IF NOT EXISTS currency CHAR(3) NOT NULL DEFAULT 'USD';
+ALTER TABLE assets ADD COLUMN IF NOT EXISTS purchase_date DATE;
+ALTER TABLE assets ADD COLUMN IF NOT EXISTS invoice_number VARCHAR(100);
+ALTER TABLE assets ADD COLUMN IF NOT EXISTS vendor VARCHAR(255);
+
+-- How assets of each category depreciate
+CREATE TABLE IF NOT EXISTS depreciation_policies (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    category VARCHAR(100) NOT NULL,
+    method VARCHAR(20) NOT NULL CHECK (method IN ('straight_line', 'declining_balance')),
+    useful_life_years INTEGER NOT NULL CHECK (useful_life_years > 0),
+    salvage_percent NUMERIC(5,2) NOT NULL DEFAULT 0 CHECK (salvage_percent >= 0 AND salvage_percent < 100),
+    declining_rate NUMERIC(5,2) NOT NULL DEFAULT 2 CHECK (declining_rate > 0),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_depreciation_policies_category ON depreciation_policies(LOWER(category));
+
+CREATE TRIGGER update_depreciation_policies_updated_at BEFORE UPDATE ON depreciation_policies
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- New permission for the admin role (the application also syncs admin on startup)
+UPDATE roles
+SET permissions = permissions || '["finance:manage"]'::jsonb
+WHERE name = 'admin' AND NOT permissions @> '["finance:manage"]'::jsonb;
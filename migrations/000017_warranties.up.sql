@@ -0,0 +1,79 @@
+-- Warranties on single assets
+CREATE TABLE IF NOT EXISTS warranties (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    asset_id UUID NOT NULL REFERENCES assets(id) ON DELETE CASCADE,
+    provider VARCHAR(255) NOT NULL,
+    coverage TEXT,
+    reference VARCHAR(255),
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    documents JSONB NOT NULL DEFAULT '[]'::jsonb,
+    notes TEXT,
+    expiry_notified_at TIMESTAMP WITH TIME ZONE,
+    created_by UUID NOT NULL REFERENCES users(id),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CHECK (end_date >= start_date)
+);
+
+CREATE INDEX IF NOT EXISTS idx_warranties_asset_id ON warranties(asset_id);
+CREATE INDEX IF NOT EXISTS idx_warranties_end_date ON warranties(end_date);
+
+CREATE TRIGGER update_warranties_updated_at BEFORE UPDATE ON warranties
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- Support contracts covering any number of assets
+CREATE TABLE IF NOT EXISTS support_contracts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    provider VARCHAR(255) NOT NULL,
+    contract_number VARCHAR(100),
+    coverage TEXT,
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    cost NUMERIC(12,2) NOT NULL DEFAULT 0,
+    currency CHAR(3) NOT NULL DEFAULT 'USD',
+    documents JSONB NOT NULL DEFAULT '[]'::jsonb,
+    notes TEXT,
+    expiry_notified_at TIMESTAMP WITH TIME ZONE,
+    created_by UUID NOT NULL REFERENCES users(id),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CHECK (end_date >= start_date)
+);
+
+CREATE INDEX IF NOT EXISTS idx_support_contracts_end_date ON support_contracts(end_date);
+
+CREATE TRIGGER update_support_contracts_updated_at BEFORE UPDATE ON support_contracts
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TABLE IF NOT EXISTS support_contract_assets (
+    contract_id UUID NOT NULL REFERENCES support_contracts(id) ON DELETE CASCADE,
+    asset_id UUID NOT NULL REFERENCES assets(id) ON DELETE CASCADE,
+    PRIMARY KEY (contract_id, asset_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_support_contract_assets_asset_id ON support_contract_assets(asset_id);
+
+-- Repairs claimed under warranty for a ticket
+CREATE TABLE IF NOT EXISTS warranty_claims (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    warranty_id UUID NOT NULL REFERENCES warranties(id) ON DELETE CASCADE,
+    ticket_id UUID NOT NULL REFERENCES tickets(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'submitted' CHECK (status IN ('submitted', 'approved', 'rejected', 'completed')),
+    provider_reference VARCHAR(255),
+    notes TEXT,
+    claimed_by UUID NOT NULL REFERENCES users(id),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_warranty_claims_warranty_id ON warranty_claims(warranty_id);
+CREATE INDEX IF NOT EXISTS idx_warranty_claims_ticket_id ON warranty_claims(ticket_id);
+
+CREATE TRIGGER update_warranty_claims_updated_at BEFORE UPDATE ON warranty_claims
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- New permission for the admin role (the application also syncs admin on startup)
+UPDATE roles
+SET permissions = permissions || '["warranties:manage"]'::jsonb
+WHERE name = 'admin' AND NOT permissions @> '["warranties:manage"]'::jsonb;
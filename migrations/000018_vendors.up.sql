@@ -0,0 +1,78 @@
+-- Suppliers that assets are bought from and serviced by
+CREATE TABLE IF NOT EXISTS vendors (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(255) NOT NULL,
+    website VARCHAR(255),
+    email VARCHAR(255),
+    phone VARCHAR(50),
+    contacts JSONB NOT NULL DEFAULT '[]'::jsonb,
+    addresses JSONB NOT NULL DEFAULT '[]'::jsonb,
+    support_hours VARCHAR(100),
+    support_response_hours INTEGER NOT NULL DEFAULT 0 CHECK (support_response_hours >= 0),
+    support_resolution_hours INTEGER NOT NULL DEFAULT 0 CHECK (support_resolution_hours >= 0),
+    notes TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_vendors_name ON vendors(LOWER(name));
+
+CREATE TRIGGER update_vendors_updated_at BEFORE UPDATE ON vendors
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- Normalized makers behind the free text asset brand
+CREATE TABLE IF NOT EXISTS manufacturers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(255) NOT NULL,
+    aliases JSONB NOT NULL DEFAULT '[]'::jsonb,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_manufacturers_name ON manufacturers(LOWER(name));
+
+CREATE TRIGGER update_manufacturers_updated_at BEFORE UPDATE ON manufacturers
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+ALTER TABLE assets ADD COLUMN IF NOT EXISTS manufacturer_id UUID REFERENCES manufacturers(id) ON DELETE SET NULL;
+ALTER TABLE assets ADD COLUMN IF NOT EXISTS purchase_vendor_id UUID REFERENCES vendors(id) ON DELETE SET NULL;
+ALTER TABLE assets ADD COLUMN IF NOT EXISTS service_vendor_id UUID REFERENCES vendors(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_assets_manufacturer_id ON assets(manufacturer_id);
+CREATE INDEX IF NOT EXISTS idx_assets_purchase_vendor_id ON assets(purchase_vendor_id);
+CREATE INDEX IF NOT EXISTS idx_assets_service_vendor_id ON assets(service_vendor_id);
+
+-- One manufacturer per brand, ignoring case and extra spaces, spelled as on
+-- the oldest asset
+INSERT INTO manufacturers (name)
+SELECT DISTINCT ON (LOWER(regexp_replace(TRIM(brand), '\s+', ' ', 'g')))
+    regexp_replace(TRIM(brand), '\s+', ' ', 'g')
+FROM assets
+WHERE TRIM(COALESCE(brand, '')) <> ''
+ORDER BY LOWER(regexp_replace(TRIM(brand), '\s+', ' ', 'g')), created_at
+ON CONFLICT DO NOTHING;
+
+UPDATE assets a
+SET manufacturer_id = m.id, brand = m.name
+FROM manufacturers m
+WHERE a.manufacturer_id IS NULL
+  AND LOWER(regexp_replace(TRIM(a.brand), '\s+', ' ', 'g')) = LOWER(m.name);
+
+-- Vendors recorded as free text become vendor records the same way
+INSERT INTO vendors (name)
+SELECT DISTINCT ON (LOWER(TRIM(vendor))) TRIM(vendor)
+FROM assets
+WHERE TRIM(COALESCE(vendor, '')) <> ''
+ORDER BY LOWER(TRIM(vendor)), created_at
+ON CONFLICT DO NOTHING;
+
+UPDATE assets a
+SET purchase_vendor_id = v.id, vendor = v.name
+FROM vendors v
+WHERE a.purchase_vendor_id IS NULL
+  AND LOWER(TRIM(a.vendor)) = LOWER(v.name);
+
+-- New permission for the admin role (the application also syncs admin on startup)
+UPDATE roles
+SET permissions = permissions || '["vendors:manage"]'::jsonb
+WHERE name = 'admin' AND NOT permissions @> '["vendors:manage"]'::jsonb;
@@ -0,0 +1,113 @@
+-- Requests to buy equipment and their approval
+CREATE TABLE IF NOT EXISTS purchase_requests (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    requested_by UUID NOT NULL REFERENCES users(id),
+    department_id UUID REFERENCES departments(id) ON DELETE SET NULL,
+    title VARCHAR(255) NOT NULL,
+    justification TEXT,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected', 'cancelled', 'ordered')),
+    decided_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    decided_at TIMESTAMP WITH TIME ZONE,
+    decision_comment TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_purchase_requests_requested_by ON purchase_requests(requested_by);
+CREATE INDEX IF NOT EXISTS idx_purchase_requests_department_id ON purchase_requests(department_id);
+CREATE INDEX IF NOT EXISTS idx_purchase_requests_status ON purchase_requests(status);
+
+CREATE TRIGGER update_purchase_requests_updated_at BEFORE UPDATE ON purchase_requests
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TABLE IF NOT EXISTS purchase_request_items (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    request_id UUID NOT NULL REFERENCES purchase_requests(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    description TEXT NOT NULL,
+    category VARCHAR(100),
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    estimated_unit_cost NUMERIC(12,2) NOT NULL DEFAULT 0 CHECK (estimated_unit_cost >= 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_purchase_request_items_request_id ON purchase_request_items(request_id);
+
+-- Orders placed with vendors
+CREATE TABLE IF NOT EXISTS purchase_orders (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    number VARCHAR(50) NOT NULL,
+    vendor_id UUID NOT NULL REFERENCES vendors(id),
+    request_id UUID REFERENCES purchase_requests(id) ON DELETE SET NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'ordered' CHECK (status IN ('ordered', 'partially_received', 'received', 'cancelled')),
+    currency CHAR(3) NOT NULL DEFAULT 'USD',
+    expected_at DATE,
+    notes TEXT,
+    ordered_by UUID NOT NULL REFERENCES users(id),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_purchase_orders_number ON purchase_orders(number);
+CREATE INDEX IF NOT EXISTS idx_purchase_orders_vendor_id ON purchase_orders(vendor_id);
+CREATE INDEX IF NOT EXISTS idx_purchase_orders_request_id ON purchase_orders(request_id);
+CREATE INDEX IF NOT EXISTS idx_purchase_orders_status ON purchase_orders(status);
+
+CREATE TRIGGER update_purchase_orders_updated_at BEFORE UPDATE ON purchase_orders
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TABLE IF NOT EXISTS purchase_order_lines (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    order_id UUID NOT NULL REFERENCES purchase_orders(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    request_item_id UUID REFERENCES purchase_request_items(id) ON DELETE SET NULL,
+    description TEXT NOT NULL,
+    category VARCHAR(100),
+    asset_type VARCHAR(20) NOT NULL DEFAULT 'it' CHECK (asset_type IN ('it', 'non_it')),
+    brand VARCHAR(255),
+    -- Set when the line restocks an existing asset
+    asset_id UUID REFERENCES assets(id) ON DELETE SET NULL,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    received_quantity INTEGER NOT NULL DEFAULT 0 CHECK (received_quantity >= 0 AND received_quantity <= quantity),
+    unit_cost NUMERIC(12,2) NOT NULL DEFAULT 0 CHECK (unit_cost >= 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_purchase_order_lines_order_id ON purchase_order_lines(order_id);
+
+-- Deliveries booked against orders and the assets they created or restocked
+CREATE TABLE IF NOT EXISTS goods_receipts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    order_id UUID NOT NULL REFERENCES purchase_orders(id) ON DELETE CASCADE,
+    received_by UUID NOT NULL REFERENCES users(id),
+    received_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    location_id UUID REFERENCES locations(id) ON DELETE SET NULL,
+    invoice_number VARCHAR(100),
+    notes TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_goods_receipts_order_id ON goods_receipts(order_id);
+
+CREATE TABLE IF NOT EXISTS goods_receipt_lines (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    receipt_id UUID NOT NULL REFERENCES goods_receipts(id) ON DELETE CASCADE,
+    order_line_id UUID NOT NULL REFERENCES purchase_order_lines(id) ON DELETE CASCADE,
+    asset_id UUID NOT NULL REFERENCES assets(id) ON DELETE CASCADE,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    restocked BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE INDEX IF NOT EXISTS idx_goods_receipt_lines_receipt_id ON goods_receipt_lines(receipt_id);
+CREATE INDEX IF NOT EXISTS idx_goods_receipt_lines_asset_id ON goods_receipt_lines(asset_id);
+
+-- Assets received against a purchase order link back to it
+ALTER TABLE assets ADD COLUMN IF NOT EXISTS purchase_order_id UUID REFERENCES purchase_orders(id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS idx_assets_purchase_order_id ON assets(purchase_order_id);
+
+-- New permissions for the admin role (the application also syncs admin on startup)
+UPDATE roles
+SET permissions = permissions || '["purchases:approve"]'::jsonb
+WHERE name = 'admin' AND NOT permissions @> '["purchases:approve"]'::jsonb;
+
+UPDATE roles
+SET permissions = permissions || '["purchases:manage"]'::jsonb
+WHERE name = 'admin' AND NOT permissions @> '["purchases:manage"]'::jsonb;
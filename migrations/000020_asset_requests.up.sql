@@ -0,0 +1,75 @@
+-- Employee requests for assets from the catalog
+CREATE TABLE IF NOT EXISTS asset_requests (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    asset_id UUID NOT NULL REFERENCES assets(id) ON DELETE CASCADE,
+    requested_by UUID NOT NULL REFERENCES users(id),
+    department_id UUID REFERENCES departments(id) ON DELETE SET NULL,
+    quantity INTEGER NOT NULL DEFAULT 1 CHECK (quantity > 0),
+    reason TEXT,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'rejected', 'cancelled', 'fulfilled')),
+    checkout_id UUID,
+    fulfilled_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_asset_requests_asset_id ON asset_requests(asset_id);
+CREATE INDEX IF NOT EXISTS idx_asset_requests_requested_by ON asset_requests(requested_by);
+CREATE INDEX IF NOT EXISTS idx_asset_requests_department_id ON asset_requests(department_id);
+CREATE INDEX IF NOT EXISTS idx_asset_requests_status ON asset_requests(status);
+
+CREATE TRIGGER update_asset_requests_updated_at BEFORE UPDATE ON asset_requests
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- One row per step of a request's approval chain
+CREATE TABLE IF NOT EXISTS asset_request_approvals (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    request_id UUID NOT NULL REFERENCES asset_requests(id) ON DELETE CASCADE,
+    step INTEGER NOT NULL,
+    approver VARCHAR(50) NOT NULL,
+    approver_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'waiting' CHECK (status IN ('waiting', 'pending', 'approved', 'rejected', 'skipped')),
+    activated_at TIMESTAMP WITH TIME ZONE,
+    due_at TIMESTAMP WITH TIME ZONE,
+    reminder_sent_at TIMESTAMP WITH TIME ZONE,
+    decided_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    on_behalf_of UUID REFERENCES users(id) ON DELETE SET NULL,
+    decided_at TIMESTAMP WITH TIME ZONE,
+    comment TEXT,
+    UNIQUE (request_id, step)
+);
+
+CREATE INDEX IF NOT EXISTS idx_asset_request_approvals_approver_id ON asset_request_approvals(approver_id);
+CREATE INDEX IF NOT EXISTS idx_asset_request_approvals_status ON asset_request_approvals(status);
+CREATE INDEX IF NOT EXISTS idx_asset_request_approvals_due_at ON asset_request_approvals(due_at);
+
+-- Approvers standing in for each other while away
+CREATE TABLE IF NOT EXISTS approval_delegations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    delegator_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    delegate_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    starts_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    ends_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    reason TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_approval_delegations_delegator_id ON approval_delegations(delegator_id);
+CREATE INDEX IF NOT EXISTS idx_approval_delegations_delegate_id ON approval_delegations(delegate_id);
+
+-- Units of an asset handed out to a user
+CREATE TABLE IF NOT EXISTS asset_checkouts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    asset_id UUID NOT NULL REFERENCES assets(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id),
+    request_id UUID REFERENCES asset_requests(id) ON DELETE SET NULL,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    checked_out_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    returned_at TIMESTAMP WITH TIME ZONE,
+    returned_by UUID REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_asset_checkouts_asset_id ON asset_checkouts(asset_id);
+CREATE INDEX IF NOT EXISTS idx_asset_checkouts_user_id ON asset_checkouts(user_id);
+CREATE INDEX IF NOT EXISTS idx_asset_checkouts_request_id ON asset_checkouts(request_id);
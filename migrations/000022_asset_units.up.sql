@@ -0,0 +1,49 @@
+-- Serialized assets: one unit per physical item
+CREATE TABLE IF NOT EXISTS asset_units (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    asset_id UUID NOT NULL REFERENCES assets(id) ON DELETE CASCADE,
+    serial_number VARCHAR(255) NOT NULL UNIQUE,
+    status VARCHAR(20) NOT NULL DEFAULT 'available' CHECK (status IN ('available', 'booked', 'broken', 'repair', 'retired', 'disposed', 'lost')),
+    location_id UUID REFERENCES locations(id) ON DELETE SET NULL,
+    custodian_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    notes TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_asset_units_asset_id ON asset_units(asset_id);
+CREATE INDEX IF NOT EXISTS idx_asset_units_custodian_id ON asset_units(custodian_id);
+
+CREATE TRIGGER update_asset_units_updated_at BEFORE UPDATE ON asset_units
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TABLE IF NOT EXISTS asset_unit_status_changes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    unit_id UUID NOT NULL REFERENCES asset_units(id) ON DELETE CASCADE,
+    from_status VARCHAR(20) NOT NULL,
+    to_status VARCHAR(20) NOT NULL,
+    ticket_id UUID REFERENCES tickets(id) ON DELETE SET NULL,
+    changed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    reason TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_asset_unit_status_changes_unit_id ON asset_unit_status_changes(unit_id);
+
+-- Categories without a row are pooled
+CREATE TABLE IF NOT EXISTS category_tracking_modes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    category VARCHAR(100) NOT NULL,
+    mode VARCHAR(20) NOT NULL CHECK (mode IN ('pooled', 'serialized')),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_category_tracking_modes_category ON category_tracking_modes(LOWER(category));
+
+CREATE TRIGGER update_category_tracking_modes_updated_at BEFORE UPDATE ON category_tracking_modes
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- Tickets may be about one unit of a serialized asset
+ALTER TABLE tickets ADD COLUMN IF NOT EXISTS unit_id UUID REFERENCES asset_units(id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS idx_tickets_unit_id ON tickets(unit_id);
@@ -0,0 +1,49 @@
+-- Purchase requests prepared by the system start as drafts
+ALTER TABLE purchase_requests DROP CONSTRAINT IF EXISTS purchase_requests_status_check;
+ALTER TABLE purchase_requests ADD CONSTRAINT purchase_requests_status_check
+    CHECK (status IN ('draft', 'pending', 'approved', 'rejected', 'cancelled', 'ordered'));
+
+-- Minimum stock of an asset or of a category
+CREATE TABLE IF NOT EXISTS reorder_points (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    asset_id UUID REFERENCES assets(id) ON DELETE CASCADE,
+    category VARCHAR(100),
+    min_qty INTEGER NOT NULL CHECK (min_qty >= 0),
+    reorder_qty INTEGER NOT NULL CHECK (reorder_qty > 0),
+    draft_purchase_request BOOLEAN NOT NULL DEFAULT FALSE,
+    created_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CHECK ((asset_id IS NULL) <> (COALESCE(category, '') = ''))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_reorder_points_asset_id ON reorder_points(asset_id) WHERE asset_id IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_reorder_points_category ON reorder_points(LOWER(category)) WHERE asset_id IS NULL;
+
+CREATE TRIGGER update_reorder_points_updated_at BEFORE UPDATE ON reorder_points
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- Every change of an asset's quantity
+CREATE TABLE IF NOT EXISTS stock_movements (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    asset_id UUID NOT NULL REFERENCES assets(id) ON DELETE CASCADE,
+    delta INTEGER NOT NULL,
+    qty_after INTEGER NOT NULL,
+    changed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_stock_movements_asset_created ON stock_movements(asset_id, created_at);
+
+-- One open alert per asset while it is low on stock
+CREATE TABLE IF NOT EXISTS stock_alerts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    asset_id UUID NOT NULL REFERENCES assets(id) ON DELETE CASCADE,
+    qty INTEGER NOT NULL,
+    min_qty INTEGER NOT NULL,
+    purchase_request_id UUID REFERENCES purchase_requests(id) ON DELETE SET NULL,
+    raised_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    resolved_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_stock_alerts_open ON stock_alerts(asset_id) WHERE resolved_at IS NULL;
@@ -0,0 +1,19 @@
+-- Batches of an asset's stock, each with its own expiry date
+CREATE TABLE IF NOT EXISTS stock_lots (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    asset_id UUID NOT NULL REFERENCES assets(id) ON DELETE CASCADE,
+    lot_number VARCHAR(100) NOT NULL,
+    expires_at DATE,
+    qty INTEGER NOT NULL DEFAULT 0 CHECK (qty >= 0),
+    status VARCHAR(20) NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'quarantined')),
+    received_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    notes TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_stock_lots_asset_lot_number ON stock_lots(asset_id, lot_number);
+CREATE INDEX IF NOT EXISTS idx_stock_lots_expires_at ON stock_lots(expires_at);
+
+CREATE TRIGGER update_stock_lots_updated_at BEFORE UPDATE ON stock_lots
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
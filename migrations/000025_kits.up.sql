@@ -0,0 +1,50 @@
+-- Bundles of assets handed out together
+CREATE TABLE IF NOT EXISTS kits (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    created_by UUID NOT NULL REFERENCES users(id),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_kits_name ON kits(LOWER(name));
+
+CREATE TRIGGER update_kits_updated_at BEFORE UPDATE ON kits
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- Each component is a quantity of a specific asset or of any asset in a category
+CREATE TABLE IF NOT EXISTS kit_components (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    kit_id UUID NOT NULL REFERENCES kits(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    asset_id UUID REFERENCES assets(id) ON DELETE CASCADE,
+    category VARCHAR(100),
+    quantity INTEGER NOT NULL DEFAULT 1 CHECK (quantity > 0),
+    CHECK ((asset_id IS NULL) <> (COALESCE(category, '') = ''))
+);
+
+CREATE INDEX IF NOT EXISTS idx_kit_components_kit_id ON kit_components(kit_id);
+CREATE INDEX IF NOT EXISTS idx_kit_components_asset_id ON kit_components(asset_id);
+
+-- Kits handed out; the components are asset checkouts linked to the kit checkout
+CREATE TABLE IF NOT EXISTS kit_checkouts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    kit_id UUID NOT NULL REFERENCES kits(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    notes TEXT,
+    checked_out_by UUID NOT NULL REFERENCES users(id),
+    checked_out_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    returned_at TIMESTAMP WITH TIME ZONE,
+    returned_by UUID REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_kit_checkouts_kit_id ON kit_checkouts(kit_id);
+CREATE INDEX IF NOT EXISTS idx_kit_checkouts_user_id ON kit_checkouts(user_id);
+
+ALTER TABLE asset_checkouts ADD COLUMN IF NOT EXISTS kit_checkout_id UUID REFERENCES kit_checkouts(id) ON DELETE SET NULL;
+ALTER TABLE asset_checkouts ADD COLUMN IF NOT EXISTS return_condition VARCHAR(20)
+    CHECK (return_condition IN ('', 'good', 'damaged', 'missing'));
+ALTER TABLE asset_checkouts ADD COLUMN IF NOT EXISTS return_notes TEXT;
+
+CREATE INDEX IF NOT EXISTS idx_asset_checkouts_kit_checkout_id ON asset_checkouts(kit_checkout_id);
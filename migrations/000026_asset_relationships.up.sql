@@ -0,0 +1,14 @@
+-- Typed links between assets; contains and installed_in make one a component of the other
+CREATE TABLE IF NOT EXISTS asset_relationships (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    from_asset_id UUID NOT NULL REFERENCES assets(id) ON DELETE CASCADE,
+    to_asset_id UUID NOT NULL REFERENCES assets(id) ON DELETE CASCADE,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('contains', 'installed_in', 'connected_to', 'depends_on')),
+    notes TEXT,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CHECK (from_asset_id <> to_asset_id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_asset_relationships_link ON asset_relationships(from_asset_id, to_asset_id, type);
+CREATE INDEX IF NOT EXISTS idx_asset_relationships_to_asset_id ON asset_relationships(to_asset_id);
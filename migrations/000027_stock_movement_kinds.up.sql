@@ -0,0 +1,7 @@
+-- Why each quantity change happened, so consumption counts only issues less
+-- returns. Earlier movements keep counting as before: decreases as issues.
+ALTER TABLE stock_movements ADD COLUMN IF NOT EXISTS kind VARCHAR(20);
+UPDATE stock_movements SET kind = CASE WHEN delta < 0 THEN 'issue' ELSE 'receipt' END WHERE kind IS NULL;
+ALTER TABLE stock_movements ALTER COLUMN kind SET NOT NULL;
+ALTER TABLE stock_movements ADD CONSTRAINT stock_movements_kind_check
+    CHECK (kind IN ('issue', 'return', 'receipt', 'adjustment', 'recount'));
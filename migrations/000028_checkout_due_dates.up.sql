@@ -0,0 +1,7 @@
+-- When checked out units are due back, and whether the user was reminded
+ALTER TABLE asset_requests ADD COLUMN IF NOT EXISTS return_by TIMESTAMP WITH TIME ZONE;
+ALTER TABLE asset_checkouts ADD COLUMN IF NOT EXISTS due_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE asset_checkouts ADD COLUMN IF NOT EXISTS reminder_sent_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE kit_checkouts ADD COLUMN IF NOT EXISTS due_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS idx_asset_checkouts_due_at ON asset_checkouts(due_at);
@@ -0,0 +1,14 @@
+-- Create calendar_feed_tokens table; each token reads one reservation feed
+-- (only the SHA-256 hash of a token is stored)
+CREATE TABLE IF NOT EXISTS calendar_feed_tokens (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    feed VARCHAR(20) NOT NULL CHECK (feed IN ('asset', 'user')),
+    resource_id UUID NOT NULL,
+    token_prefix VARCHAR(20) NOT NULL,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    last_used_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_calendar_feed_tokens_user_id ON calendar_feed_tokens(user_id);
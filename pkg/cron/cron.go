@@ -0,0 +1,220 @@
+// Package cron parses cron expressions and computes when they next fire.
+//
+// Expressions have the five standard fields (minute, hour, day of month,
+// month, day of week), each a "*", a value, a range "a-b" or a comma
+// separated list of those, optionally stepped with "/n". Months and weekdays
+// may be written as JAN-DEC and SUN-SAT, and Sunday is 0 or 7. When both day
+// fields are restricted, a day matches if either does. The descriptors
+// @yearly, @monthly, @weekly, @daily, @hourly and @every <duration> are also
+// accepted.
+package cron
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule is a parsed cron expression.
+type Schedule interface {
+	// Next returns the first activation strictly after t, in t's location.
+	Next(t time.Time) time.Time
+}
+
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var (
+	monthNames = map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}
+	dayNames = map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}
+)
+
+type field struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var fields = []field{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: monthNames},
+	{name: "day of week", min: 0, max: 7, names: dayNames},
+}
+
+// Parse parses a cron expression or descriptor.
+func Parse(expr string) (Schedule, error) {
+	expr = strings.TrimSpace(expr)
+
+	if strings.HasPrefix(expr, "@every ") {
+		interval, err := time.ParseDuration(strings.TrimSpace(strings.TrimPrefix(expr, "@every ")))
+		if err != nil {
+			return nil, fmt.Errorf("invalid @every interval: %w", err)
+		}
+		if interval < time.Second {
+			return nil, errors.New("@every interval must be at least 1s")
+		}
+		return everySchedule{interval: interval}, nil
+	}
+	if standard, ok := descriptors[strings.ToLower(expr)]; ok {
+		expr = standard
+	}
+
+	parts := strings.Fields(expr)
+	if len(parts) != len(fields) {
+		return nil, fmt.Errorf("expected %d fields, got %d", len(fields), len(parts))
+	}
+
+	var bits [5]uint64
+	for i, part := range parts {
+		var err error
+		if bits[i], err = parseField(part, fields[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	// Sunday may be written as 7
+	if bits[4]&(1<<7) != 0 {
+		bits[4] = bits[4]&^(1<<7) | 1
+	}
+
+	return &cronSchedule{
+		minute:     bits[0],
+		hour:       bits[1],
+		dayOfMonth: bits[2],
+		month:      bits[3],
+		dayOfWeek:  bits[4],
+		domStar:    parts[2] == "*" || parts[2] == "?",
+		dowStar:    parts[4] == "*" || parts[4] == "?",
+	}, nil
+}
+
+func parseField(expr string, f field) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(expr, ",") {
+		rangeExpr, stepExpr, stepped := strings.Cut(item, "/")
+
+		step := 1
+		if stepped {
+			var err error
+			if step, err = strconv.Atoi(stepExpr); err != nil || step < 1 {
+				return 0, fmt.Errorf("invalid step %q in %s field", stepExpr, f.name)
+			}
+		}
+
+		var low, high int
+		switch {
+		case rangeExpr == "*" || rangeExpr == "?":
+			low, high = f.min, f.max
+			if f.name == "day of week" {
+				high = 6
+			}
+		case strings.Contains(rangeExpr, "-"):
+			lowExpr, highExpr, _ := strings.Cut(rangeExpr, "-")
+			var err error
+			if low, err = parseValue(lowExpr, f); err != nil {
+				return 0, err
+			}
+			if high, err = parseValue(highExpr, f); err != nil {
+				return 0, err
+			}
+			if low > high {
+				return 0, fmt.Errorf("invalid range %q in %s field", rangeExpr, f.name)
+			}
+		default:
+			var err error
+			if low, err = parseValue(rangeExpr, f); err != nil {
+				return 0, err
+			}
+			high = low
+			// "5/15" means every 15 starting at 5
+			if stepped {
+				high = f.max
+			}
+		}
+
+		for value := low; value <= high; value += step {
+			bits |= 1 << uint(value)
+		}
+	}
+	return bits, nil
+}
+
+func parseValue(expr string, f field) (int, error) {
+	if value, ok := f.names[strings.ToLower(expr)]; ok {
+		return value, nil
+	}
+	value, err := strconv.Atoi(expr)
+	if err != nil || value < f.min || value > f.max {
+		return 0, fmt.Errorf("invalid value %q in %s field (expected %d-%d)", expr, f.name, f.min, f.max)
+	}
+	return value, nil
+}
+
+type everySchedule struct {
+	interval time.Duration
+}
+
+func (s everySchedule) Next(t time.Time) time.Time {
+	return t.Add(s.interval).Truncate(time.Second)
+}
+
+type cronSchedule struct {
+	minute, hour, dayOfMonth, month, dayOfWeek uint64
+	domStar, dowStar                           bool
+}
+
+// maxSearchYears bounds the search for expressions such as "0 0 30 2 *" that
+// never fire.
+const maxSearchYears = 5
+
+func (s *cronSchedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	t = t.Add(time.Minute - time.Duration(t.Second())*time.Second - time.Duration(t.Nanosecond()))
+	limit := t.Year() + maxSearchYears
+
+	for t.Year() <= limit {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+func (s *cronSchedule) dayMatches(t time.Time) bool {
+	domMatch := s.dayOfMonth&(1<<uint(t.Day())) != 0
+	dowMatch := s.dayOfWeek&(1<<uint(t.Weekday())) != 0
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
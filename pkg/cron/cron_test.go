@@ -0,0 +1,121 @@
+package cron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+	}{
+		{name: "empty", expr: ""},
+		{name: "too few fields", expr: "* * * *"},
+		{name: "too many fields", expr: "* * * * * *"},
+		{name: "minute out of range", expr: "60 * * * *"},
+		{name: "hour out of range", expr: "* 24 * * *"},
+		{name: "day of month zero", expr: "* * 0 * *"},
+		{name: "month out of range", expr: "* * * 13 *"},
+		{name: "day of week out of range", expr: "* * * * 8"},
+		{name: "unknown name", expr: "* * * FOO *"},
+		{name: "reversed range", expr: "5-1 * * * *"},
+		{name: "zero step", expr: "*/0 * * * *"},
+		{name: "non-numeric step", expr: "*/x * * * *"},
+		{name: "empty list item", expr: "1,,2 * * * *"},
+		{name: "unknown descriptor", expr: "@sometimes"},
+		{name: "every without duration", expr: "@every soon"},
+		{name: "every below a second", expr: "@every 500ms"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse(tt.expr); err == nil {
+				t.Errorf("Parse(%q) succeeded, want an error", tt.expr)
+			}
+		})
+	}
+}
+
+func TestNext(t *testing.T) {
+	// 2025-01-15 is a Wednesday
+	from := time.Date(2025, time.January, 15, 10, 7, 30, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		expr string
+		from time.Time
+		want time.Time
+	}{
+		// Values, ranges, steps and lists
+		{name: "every minute", expr: "* * * * *", from: from, want: date(2025, 1, 15, 10, 8)},
+		{name: "strictly after", expr: "0 * * * *", from: date(2025, 1, 15, 10, 0), want: date(2025, 1, 15, 11, 0)},
+		{name: "single value", expr: "45 10 * * *", from: from, want: date(2025, 1, 15, 10, 45)},
+		{name: "range", expr: "5-10 * * * *", from: from, want: date(2025, 1, 15, 10, 8)},
+		{name: "step", expr: "*/15 * * * *", from: from, want: date(2025, 1, 15, 10, 15)},
+		{name: "step from value", expr: "5/20 * * * *", from: from, want: date(2025, 1, 15, 10, 25)},
+		{name: "stepped range", expr: "10-40/10 * * * *", from: from, want: date(2025, 1, 15, 10, 10)},
+		{name: "stepped hours", expr: "0 */6 * * *", from: from, want: date(2025, 1, 15, 12, 0)},
+		{name: "list", expr: "0,30 9-17 * * *", from: from, want: date(2025, 1, 15, 10, 30)},
+		{name: "list past the last hour", expr: "0,30 9-17 * * *", from: date(2025, 1, 15, 17, 30), want: date(2025, 1, 16, 9, 0)},
+		{name: "list of ranges", expr: "0 1-2,22-23 * * *", from: from, want: date(2025, 1, 15, 22, 0)},
+		{name: "weekday names", expr: "0 9 * * MON-FRI", from: date(2025, 1, 17, 18, 0), want: date(2025, 1, 20, 9, 0)},
+		{name: "month names", expr: "0 0 1 JAN,JUL *", from: date(2025, 2, 1, 0, 0), want: date(2025, 7, 1, 0, 0)},
+		{name: "sunday as 7", expr: "0 0 * * 7", from: from, want: date(2025, 1, 19, 0, 0)},
+		{name: "sunday as 0", expr: "0 0 * * 0", from: from, want: date(2025, 1, 19, 0, 0)},
+
+		// Day of month and day of week
+		{name: "day of month only", expr: "0 0 13 * *", from: date(2025, 1, 1, 0, 0), want: date(2025, 1, 13, 0, 0)},
+		{name: "day of week only", expr: "0 0 * * FRI", from: date(2025, 1, 1, 0, 0), want: date(2025, 1, 3, 0, 0)},
+		{name: "either day, weekday first", expr: "0 0 13 * FRI", from: date(2025, 1, 1, 0, 0), want: date(2025, 1, 3, 0, 0)},
+		{name: "either day, month day first", expr: "0 0 13 * FRI", from: date(2025, 1, 10, 0, 0), want: date(2025, 1, 13, 0, 0)},
+		{name: "question mark is unrestricted", expr: "0 0 ? * FRI", from: date(2025, 1, 1, 0, 0), want: date(2025, 1, 3, 0, 0)},
+
+		// Rollover
+		{name: "next day", expr: "30 23 * * *", from: date(2025, 1, 15, 23, 45), want: date(2025, 1, 16, 23, 30)},
+		{name: "next month", expr: "30 23 * * *", from: date(2025, 1, 31, 23, 45), want: date(2025, 2, 1, 23, 30)},
+		{name: "skips short months", expr: "0 0 31 * *", from: date(2025, 1, 31, 12, 0), want: date(2025, 3, 31, 0, 0)},
+		{name: "skips to the next year", expr: "0 0 1 1 *", from: date(2025, 6, 1, 0, 0), want: date(2026, 1, 1, 0, 0)},
+		{name: "december to january", expr: "0 12 * * *", from: date(2025, 12, 31, 13, 0), want: date(2026, 1, 1, 12, 0)},
+		{name: "leap day", expr: "0 0 29 2 *", from: date(2025, 1, 1, 0, 0), want: date(2028, 2, 29, 0, 0)},
+		{name: "never fires", expr: "0 0 30 2 *", from: from, want: time.Time{}},
+
+		// Descriptors
+		{name: "hourly", expr: "@hourly", from: from, want: date(2025, 1, 15, 11, 0)},
+		{name: "daily", expr: "@daily", from: from, want: date(2025, 1, 16, 0, 0)},
+		{name: "weekly", expr: "@weekly", from: from, want: date(2025, 1, 19, 0, 0)},
+		{name: "monthly", expr: "@monthly", from: date(2025, 12, 15, 0, 0), want: date(2026, 1, 1, 0, 0)},
+		{name: "yearly", expr: "@yearly", from: from, want: date(2026, 1, 1, 0, 0)},
+		{name: "every", expr: "@every 90m", from: from, want: time.Date(2025, 1, 15, 11, 37, 30, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := Parse(tt.expr)
+			if err != nil {
+				t.Fatalf("Parse(%q): %v", tt.expr, err)
+			}
+			if got := schedule.Next(tt.from); !got.Equal(tt.want) {
+				t.Errorf("Next(%s) for %q = %s, want %s", tt.from, tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNextKeepsLocation(t *testing.T) {
+	loc := time.FixedZone("UTC+2", 2*60*60)
+	schedule, err := Parse("0 9 * * *")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := schedule.Next(time.Date(2025, 1, 15, 10, 0, 0, 0, loc))
+	want := time.Date(2025, 1, 16, 9, 0, 0, 0, loc)
+	if !got.Equal(want) || got.Location() != loc {
+		t.Errorf("Next = %s, want %s", got, want)
+	}
+}
+
+func date(year int, month time.Month, day, hour, minute int) time.Time {
+	return time.Date(year, month, day, hour, minute, 0, 0, time.UTC)
+}
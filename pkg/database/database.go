@@ -28,9 +28,61 @@ func NewDatabase(dsn string) (*gorm.DB, error) {
 func autoMigrate(db *gorm.DB) error {
 	return db.AutoMigrate(
 		&entity.Location{},
+		&entity.Department{},
 		&entity.User{},
 		&entity.Asset{},
 		&entity.Ticket{},
+		&entity.SigningKey{},
+		&entity.PersonalAccessToken{},
+		&entity.Role{},
+		&entity.RoleAssignment{},
+		&entity.Team{},
+		&entity.Technician{},
+		&entity.OutboxEvent{},
+		&entity.WebhookEndpoint{},
+		&entity.WebhookDelivery{},
+		&entity.WebhookDeliveryAttempt{},
+		&entity.NotificationTemplate{},
+		&entity.NotificationPreference{},
+		&entity.EmailMessage{},
+		&entity.Notification{},
+		&entity.ScheduledJob{},
+		&entity.JobRun{},
+		&entity.MaintenancePlan{},
+		&entity.MaintenanceSchedule{},
+		&entity.MaintenanceRecord{},
+		&entity.AssetStatusChange{},
+		&entity.DisposalRequest{},
+		&entity.DepreciationPolicy{},
+		&entity.Warranty{},
+		&entity.SupportContract{},
+		&entity.SupportContractAsset{},
+		&entity.WarrantyClaim{},
+		&entity.Vendor{},
+		&entity.Manufacturer{},
+		&entity.PurchaseRequest{},
+		&entity.PurchaseRequestItem{},
+		&entity.PurchaseOrder{},
+		&entity.PurchaseOrderLine{},
+		&entity.GoodsReceipt{},
+		&entity.GoodsReceiptLine{},
+		&entity.AssetRequest{},
+		&entity.AssetRequestApproval{},
+		&entity.ApprovalDelegation{},
+		&entity.AssetCheckout{},
+		&entity.Reservation{},
+		&entity.CalendarFeedToken{},
+		&entity.AssetUnit{},
+		&entity.AssetUnitStatusChange{},
+		&entity.CategoryTrackingMode{},
+		&entity.ReorderPoint{},
+		&entity.StockMovement{},
+		&entity.StockAlert{},
+		&entity.StockLot{},
+		&entity.Kit{},
+		&entity.KitComponent{},
+		&entity.KitCheckout{},
+		&entity.AssetRelationship{},
 	)
 }
 
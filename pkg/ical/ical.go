@@ -0,0 +1,115 @@
+// Package ical writes iCalendar (RFC 5545) feeds with timed events, as read
+// by calendar applications subscribing to a URL.
+package ical
+
+import (
+	"bytes"
+	"strings"
+	"time"
+)
+
+// ContentType is the media type of iCalendar documents.
+const ContentType = "text/calendar; charset=utf-8"
+
+// maxLineOctets is the longest content line allowed before folding.
+const maxLineOctets = 75
+
+const timestampFormat = "20060102T150405Z"
+
+type Event struct {
+	UID         string
+	Start       time.Time
+	End         time.Time
+	Summary     string
+	Description string
+	Location    string
+	// Status is TENTATIVE, CONFIRMED or CANCELLED.
+	Status   string
+	Modified time.Time
+}
+
+type Calendar struct {
+	Name   string
+	Events []Event
+}
+
+func New(name string) *Calendar {
+	return &Calendar{Name: name}
+}
+
+func (c *Calendar) Add(event Event) {
+	c.Events = append(c.Events, event)
+}
+
+// Bytes returns the calendar with all times in UTC.
+func (c *Calendar) Bytes() []byte {
+	var b bytes.Buffer
+	writeLine(&b, "BEGIN:VCALENDAR")
+	writeLine(&b, "VERSION:2.0")
+	writeLine(&b, "PRODID:-//Inventory & Ticketing Management System//EN")
+	writeLine(&b, "CALSCALE:GREGORIAN")
+	writeLine(&b, "METHOD:PUBLISH")
+	if c.Name != "" {
+		writeLine(&b, "X-WR-CALNAME:"+escape(c.Name))
+	}
+
+	stamp := time.Now().UTC().Format(timestampFormat)
+	for _, event := range c.Events {
+		writeLine(&b, "BEGIN:VEVENT")
+		writeLine(&b, "UID:"+escape(event.UID))
+		writeLine(&b, "DTSTAMP:"+stamp)
+		writeLine(&b, "DTSTART:"+event.Start.UTC().Format(timestampFormat))
+		writeLine(&b, "DTEND:"+event.End.UTC().Format(timestampFormat))
+		writeLine(&b, "SUMMARY:"+escape(event.Summary))
+		if event.Description != "" {
+			writeLine(&b, "DESCRIPTION:"+escape(event.Description))
+		}
+		if event.Location != "" {
+			writeLine(&b, "LOCATION:"+escape(event.Location))
+		}
+		if event.Status != "" {
+			writeLine(&b, "STATUS:"+event.Status)
+		}
+		if !event.Modified.IsZero() {
+			writeLine(&b, "LAST-MODIFIED:"+event.Modified.UTC().Format(timestampFormat))
+		}
+		writeLine(&b, "END:VEVENT")
+	}
+
+	writeLine(&b, "END:VCALENDAR")
+	return b.Bytes()
+}
+
+// writeLine ends the line with CRLF and folds it into lines of at most 75
+// octets, continued with a leading space, without splitting a UTF-8
+// character.
+func writeLine(b *bytes.Buffer, line string) {
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !isRuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// The leading space counts toward the continuation line
+		limit = maxLineOctets - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
+
+func isRuneStart(c byte) bool {
+	return c&0xC0 != 0x80
+}
+
+func escape(text string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(text)
+}
@@ -0,0 +1,149 @@
+// Package pdf writes simple single-page PDF documents: text in the standard
+// Helvetica fonts and straight lines. The standard fonts are built into
+// every PDF viewer, so nothing is embedded and the output stays small.
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// A4 page size in points.
+const (
+	PageWidth  = 595.0
+	PageHeight = 842.0
+)
+
+// averageCharWidth approximates the width of a Helvetica character as a
+// fraction of the font size, for wrapping text.
+const averageCharWidth = 0.5
+
+type Document struct {
+	content bytes.Buffer
+}
+
+func New() *Document {
+	return &Document{}
+}
+
+// Text draws one line of text with its baseline at (x, y), measured in
+// points from the bottom left corner of the page.
+func (d *Document) Text(x, y, size float64, bold bool, text string) {
+	font := "F1"
+	if bold {
+		font = "F2"
+	}
+	fmt.Fprintf(&d.content, "BT /%s %.1f Tf %.2f %.2f Td (%s) Tj ET\n", font, size, x, y, escape(text))
+}
+
+// Paragraph draws text wrapped to width starting at (x, y) and returns the
+// baseline below the last line.
+func (d *Document) Paragraph(x, y, width, size float64, text string) float64 {
+	maxChars := int(width / (size * averageCharWidth))
+	leading := size * 1.4
+	for _, line := range wrap(text, maxChars) {
+		d.Text(x, y, size, false, line)
+		y -= leading
+	}
+	return y
+}
+
+// Line draws a straight line of the given width.
+func (d *Document) Line(x1, y1, x2, y2, width float64) {
+	fmt.Fprintf(&d.content, "%.2f w %.2f %.2f m %.2f %.2f l S\n", width, x1, y1, x2, y2)
+}
+
+// Bytes returns the finished document.
+func (d *Document) Bytes() []byte {
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.0f %.0f] "+
+			"/Resources << /Font << /F1 5 0 R /F2 6 0 R >> >> /Contents 4 0 R >>", PageWidth, PageHeight),
+		fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", d.content.Len(), d.content.String()),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>",
+	}
+
+	var out bytes.Buffer
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = out.Len()
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+
+	return out.Bytes()
+}
+
+// escape encodes text as a PDF string in WinAnsiEncoding. Characters outside
+// it become "?".
+func escape(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '\n' || r == '\r' || r == '\t':
+			b.WriteByte(' ')
+		case r >= 0x20 && r < 0x7f:
+			b.WriteRune(r)
+		case r >= 0xa0 && r <= 0xff:
+			b.WriteByte(byte(r))
+		case r == '€':
+			b.WriteByte(0x80)
+		case r == '–':
+			b.WriteByte(0x96)
+		case r == '—':
+			b.WriteByte(0x97)
+		default:
+			b.WriteByte('?')
+		}
+	}
+	return b.String()
+}
+
+// wrap splits text into lines of at most maxChars characters, breaking at
+// spaces where possible.
+func wrap(text string, maxChars int) []string {
+	if maxChars < 1 {
+		maxChars = 1
+	}
+
+	var lines []string
+	for _, paragraph := range strings.Split(text, "\n") {
+		line := ""
+		for _, word := range strings.Fields(paragraph) {
+			for len([]rune(word)) > maxChars {
+				if line != "" {
+					lines = append(lines, line)
+					line = ""
+				}
+				runes := []rune(word)
+				lines = append(lines, string(runes[:maxChars]))
+				word = string(runes[maxChars:])
+			}
+			switch {
+			case line == "":
+				line = word
+			case len([]rune(line))+1+len([]rune(word)) <= maxChars:
+				line += " " + word
+			default:
+				lines = append(lines, line)
+				line = word
+			}
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
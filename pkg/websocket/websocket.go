@@ -0,0 +1,243 @@
+// Package websocket implements the server side of RFC 6455, limited to what
+// pushing JSON to browsers needs: the handshake, text, ping and close frames
+// from the server, and reading (and mostly discarding) client frames.
+package websocket
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	opContinuation = 0x0
+	opText         = 0x1
+	opBinary       = 0x2
+	opClose        = 0x8
+	opPing         = 0x9
+	opPong         = 0xA
+
+	// acceptGUID is the fixed key suffix from RFC 6455 section 1.3.
+	acceptGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+	// maxFrameSize bounds client frames; clients only send small control
+	// messages.
+	maxFrameSize = 64 * 1024
+	// writeTimeout bounds a single frame write.
+	writeTimeout = 10 * time.Second
+)
+
+var ErrClosed = errors.New("websocket: connection closed")
+
+// IsUpgradeRequest reports whether r asks to switch to the WebSocket
+// protocol.
+func IsUpgradeRequest(r *http.Request) bool {
+	return headerContains(r.Header, "Connection", "upgrade") &&
+		headerContains(r.Header, "Upgrade", "websocket")
+}
+
+// Conn is a server-side WebSocket connection. Writes are safe for concurrent
+// use; ReadLoop must run in a single goroutine.
+type Conn struct {
+	conn   net.Conn
+	reader *bufio.Reader
+
+	writeMu   sync.Mutex
+	closeOnce sync.Once
+}
+
+// Upgrade completes the opening handshake and takes over the connection.
+func Upgrade(w http.ResponseWriter, r *http.Request) (*Conn, error) {
+	if r.Method != http.MethodGet || !IsUpgradeRequest(r) {
+		http.Error(w, "WebSocket upgrade required", http.StatusBadRequest)
+		return nil, errors.New("websocket: not an upgrade request")
+	}
+	if r.Header.Get("Sec-WebSocket-Version") != "13" {
+		w.Header().Set("Sec-WebSocket-Version", "13")
+		http.Error(w, "Unsupported WebSocket version", http.StatusUpgradeRequired)
+		return nil, errors.New("websocket: unsupported version")
+	}
+	key := r.Header.Get("Sec-WebSocket-Key")
+	if key == "" {
+		http.Error(w, "Missing Sec-WebSocket-Key", http.StatusBadRequest)
+		return nil, errors.New("websocket: missing key")
+	}
+
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, "WebSocket not supported", http.StatusInternalServerError)
+		return nil, errors.New("websocket: response does not support hijacking")
+	}
+	netConn, rw, err := hijacker.Hijack()
+	if err != nil {
+		return nil, err
+	}
+
+	response := "HTTP/1.1 101 Switching Protocols\r\n" +
+		"Upgrade: websocket\r\n" +
+		"Connection: Upgrade\r\n" +
+		"Sec-WebSocket-Accept: " + acceptKey(key) + "\r\n\r\n"
+	netConn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	if _, err := netConn.Write([]byte(response)); err != nil {
+		netConn.Close()
+		return nil, err
+	}
+
+	return &Conn{conn: netConn, reader: rw.Reader}, nil
+}
+
+func (c *Conn) WriteText(data []byte) error {
+	return c.writeFrame(opText, data)
+}
+
+func (c *Conn) WritePing() error {
+	return c.writeFrame(opPing, nil)
+}
+
+// Close sends a normal closure frame and closes the connection.
+func (c *Conn) Close() error {
+	var err error
+	c.closeOnce.Do(func() {
+		c.writeFrame(opClose, []byte{0x03, 0xE8}) // 1000 normal closure
+		err = c.conn.Close()
+	})
+	return err
+}
+
+// ReadLoop reads client frames until the client closes the connection or an
+// error occurs. Pings are answered; data frames are passed to onMessage, which
+// may be nil.
+func (c *Conn) ReadLoop(onMessage func(data []byte)) error {
+	for {
+		opcode, payload, err := c.readFrame()
+		if err != nil {
+			return err
+		}
+
+		switch opcode {
+		case opClose:
+			return ErrClosed
+		case opPing:
+			if err := c.writeFrame(opPong, payload); err != nil {
+				return err
+			}
+		case opText, opBinary:
+			if onMessage != nil {
+				onMessage(payload)
+			}
+		}
+	}
+}
+
+func (c *Conn) writeFrame(opcode byte, payload []byte) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	header := []byte{0x80 | opcode, 0}
+	switch length := len(payload); {
+	case length < 126:
+		header[1] = byte(length)
+	case length <= 0xFFFF:
+		header[1] = 126
+		header = binary.BigEndian.AppendUint16(header, uint16(length))
+	default:
+		header[1] = 127
+		header = binary.BigEndian.AppendUint64(header, uint64(length))
+	}
+
+	c.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	if _, err := c.conn.Write(header); err != nil {
+		return err
+	}
+	_, err := c.conn.Write(payload)
+	return err
+}
+
+// readFrame reads one complete message. Fragmented messages are joined.
+func (c *Conn) readFrame() (byte, []byte, error) {
+	var message []byte
+	var messageOpcode byte
+
+	for {
+		var head [2]byte
+		if _, err := io.ReadFull(c.reader, head[:]); err != nil {
+			return 0, nil, err
+		}
+
+		fin := head[0]&0x80 != 0
+		opcode := head[0] & 0x0F
+		masked := head[1]&0x80 != 0
+		length := uint64(head[1] & 0x7F)
+
+		switch length {
+		case 126:
+			var ext [2]byte
+			if _, err := io.ReadFull(c.reader, ext[:]); err != nil {
+				return 0, nil, err
+			}
+			length = uint64(binary.BigEndian.Uint16(ext[:]))
+		case 127:
+			var ext [8]byte
+			if _, err := io.ReadFull(c.reader, ext[:]); err != nil {
+				return 0, nil, err
+			}
+			length = binary.BigEndian.Uint64(ext[:])
+		}
+
+		if !masked {
+			return 0, nil, errors.New("websocket: client frame not masked")
+		}
+		if length > maxFrameSize || uint64(len(message))+length > maxFrameSize {
+			return 0, nil, errors.New("websocket: frame too large")
+		}
+
+		var mask [4]byte
+		if _, err := io.ReadFull(c.reader, mask[:]); err != nil {
+			return 0, nil, err
+		}
+		payload := make([]byte, length)
+		if _, err := io.ReadFull(c.reader, payload); err != nil {
+			return 0, nil, err
+		}
+		for i := range payload {
+			payload[i] ^= mask[i%4]
+		}
+
+		// Control frames may arrive between fragments and are never
+		// fragmented themselves
+		if opcode >= opClose {
+			return opcode, payload, nil
+		}
+
+		if opcode != opContinuation {
+			messageOpcode = opcode
+		}
+		message = append(message, payload...)
+		if fin {
+			return messageOpcode, message, nil
+		}
+	}
+}
+
+func acceptKey(key string) string {
+	hash := sha1.Sum([]byte(key + acceptGUID))
+	return base64.StdEncoding.EncodeToString(hash[:])
+}
+
+func headerContains(header http.Header, name, token string) bool {
+	for _, value := range header.Values(name) {
+		for _, part := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(part), token) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -5,25 +5,30 @@ BASE_URL="http://localhost:8080/api/v1"
 
 echo "🚀 Starting API Testing..."
 
-# Login dan dapatkan token
-echo "📝 Logging in..."
-LOGIN_RESPONSE=$(curl -s -X POST "$BASE_URL/auth/login" \
-  -H "Content-Type: application/json" \
-  -d '{
-    "email": "admin@company.com",
-    "password": "admin123"
-  }')
-
-TOKEN=$(echo $LOGIN_RESPONSE | grep -o '"token":"[^"]*' | cut -d'"' -f4)
-
-if [ -z "$TOKEN" ]; then
-  echo "❌ Login failed!"
-  echo "Response: $LOGIN_RESPONSE"
-  exit 1
+# Use a personal access token when provided, otherwise log in for a JWT
+if [ -n "$API_TOKEN" ]; then
+  TOKEN="$API_TOKEN"
+  echo "🔑 Using personal access token from API_TOKEN."
+else
+  echo "📝 Logging in..."
+  LOGIN_RESPONSE=$(curl -s -X POST "$BASE_URL/auth/login" \
+    -H "Content-Type: application/json" \
+    -d '{
+      "email": "admin@company.com",
+      "password": "admin123"
+    }')
+
+  TOKEN=$(echo $LOGIN_RESPONSE | grep -o '"token":"[^"]*' | cut -d'"' -f4)
+
+  if [ -z "$TOKEN" ]; then
+    echo "❌ Login failed!"
+    echo "Response: $LOGIN_RESPONSE"
+    exit 1
+  fi
+
+  echo "✅ Login successful! Token obtained."
 fi
 
-echo "✅ Login successful! Token obtained."
-
 # Health check
 echo "🏥 Testing health check..."
 curl -s -X GET "$BASE_URL/health" | jq .